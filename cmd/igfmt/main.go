@@ -0,0 +1,214 @@
+package main
+
+import (
+	"IG-Parser/core/config"
+	"IG-Parser/core/formatter"
+	"IG-Parser/core/tree"
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+/*
+This file is the main entry point for the IG Script formatter as a command-line tool, which reprints
+IG Script-encoded statements in canonical form (see core/formatter).
+
+By default, input holds one statement per line, either as plain IG Script or in the input format of the
+command-line version of IG Parser ('ID<TAB>IG Script' or 'ID<TAB>Original Statement<TAB>IG Script'), of which only
+the IG Script column is formatted. Empty lines, comment lines (starting with '#') and lines that cannot be
+formatted are retained as is. Alternatively, the entire input is treated as a single statement (-statement),
+which permits multi-line output.
+*/
+
+// Exit codes
+const EXIT_SUCCESS = 0
+const EXIT_FORMATTING_ERROR = 1
+const EXIT_USAGE_ERROR = 2
+const EXIT_IO_ERROR = 3
+
+// Name used for stdin/stdout in input/output flags
+const STDIO = "-"
+
+// Separator between columns of input lines
+const INPUT_SEPARATOR = "\t"
+
+// Prefix for comment lines in input
+const INPUT_COMMENT_PREFIX = "#"
+
+/*
+Main entry point for IG Script formatter.
+*/
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+/*
+Runs the formatter with the given arguments and streams, and returns the exit code (EXIT_SUCCESS,
+EXIT_FORMATTING_ERROR if any statement could not be formatted or, in check mode, is not formatted,
+EXIT_USAGE_ERROR for invalid arguments, EXIT_IO_ERROR if input or output files could not be accessed).
+*/
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+
+	flags := flag.NewFlagSet("igfmt", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "IG Parser "+config.IG_PARSER_VERSION+" - Formatting of IG Script-encoded statements")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Usage: igfmt [options]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Input holds one statement per line as IG Script, 'ID<TAB>IG Script' or 'ID<TAB>Original Statement<TAB>IG Script'")
+		fmt.Fprintln(stderr, "(unless -statement is specified). Empty lines and lines starting with '"+INPUT_COMMENT_PREFIX+"' are retained as is.")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Options:")
+		flags.PrintDefaults()
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Exit codes: 0 (success), 1 (formatting error or unformatted statements), 2 (invalid arguments), 3 (I/O error)")
+	}
+
+	input := flags.String("input", STDIO, "Input file ('"+STDIO+"' for stdin)")
+	output := flags.String("output", STDIO, "Output file ('"+STDIO+"' for stdout)")
+	statement := flags.Bool("statement", false, "Treat entire input as single statement (which may span multiple lines)")
+	multiLine := flags.Bool("multiline", false, "Spread nested statement combinations and component pairs across multiple lines (requires -statement)")
+	indent := flags.Int("indent", len(formatter.DEFAULT_INDENT), "Number of spaces per nesting level in multi-line output (0 for tabs)")
+	check := flags.Bool("check", false, "Only check whether statements are formatted (reporting unformatted ones), without writing output")
+
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return EXIT_SUCCESS
+		}
+		return EXIT_USAGE_ERROR
+	}
+	if flags.NArg() > 0 {
+		fmt.Fprintln(stderr, "Unexpected arguments:", strings.Join(flags.Args(), " "))
+		flags.Usage()
+		return EXIT_USAGE_ERROR
+	}
+	if *multiLine && !*statement {
+		fmt.Fprintln(stderr, "Multi-line output requires -statement, since line-based input holds one statement per line")
+		return EXIT_USAGE_ERROR
+	}
+	if *indent < 0 {
+		fmt.Fprintln(stderr, "Invalid indentation:", *indent)
+		return EXIT_USAGE_ERROR
+	}
+
+	opts := formatter.DefaultOptions()
+	opts.MultiLine = *multiLine
+	opts.Indent = strings.Repeat(" ", *indent)
+	if *indent == 0 {
+		opts.Indent = "\t"
+	}
+
+	// Read input
+	reader := stdin
+	if *input != STDIO {
+		f, err := os.Open(*input)
+		if err != nil {
+			fmt.Fprintln(stderr, "Error opening input file:", err.Error())
+			return EXIT_IO_ERROR
+		}
+		defer f.Close()
+		reader = f
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		fmt.Fprintln(stderr, "Error reading input:", err.Error())
+		return EXIT_IO_ERROR
+	}
+
+	// Format input
+	exitCode := EXIT_SUCCESS
+	result := ""
+	if *statement {
+		formatted, formattingErr := formatter.Format(string(content), opts)
+		if formattingErr.ErrorCode != tree.PARSING_NO_ERROR {
+			fmt.Fprintln(stderr, describeError("Statement", formattingErr))
+			return EXIT_FORMATTING_ERROR
+		}
+		if *check && formatted != strings.TrimRight(string(content), "\r\n") {
+			fmt.Fprintln(stderr, "Statement is not formatted")
+			exitCode = EXIT_FORMATTING_ERROR
+		}
+		result = formatted + "\n"
+	} else {
+		lines := []string{}
+		lineNo := 0
+		scanner := bufio.NewScanner(strings.NewReader(string(content)))
+		// Allow for long statements (default token size is 64KB)
+		scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+		for scanner.Scan() {
+			lineNo++
+			line := strings.TrimRight(scanner.Text(), "\r")
+			formatted, formattingErr := formatLine(line, opts)
+			if formattingErr.ErrorCode != tree.PARSING_NO_ERROR {
+				fmt.Fprintln(stderr, describeError(fmt.Sprintf("Line %d", lineNo), formattingErr))
+				exitCode = EXIT_FORMATTING_ERROR
+			} else if *check && formatted != line {
+				fmt.Fprintf(stderr, "Line %d: Statement is not formatted\n", lineNo)
+				exitCode = EXIT_FORMATTING_ERROR
+			}
+			lines = append(lines, formatted)
+		}
+		if err := scanner.Err(); err != nil {
+			fmt.Fprintln(stderr, "Error reading input:", err.Error())
+			return EXIT_IO_ERROR
+		}
+		if len(lines) > 0 {
+			result = strings.Join(lines, "\n") + "\n"
+		}
+	}
+
+	if *check {
+		return exitCode
+	}
+
+	// Write output
+	writer := stdout
+	if *output != STDIO {
+		f, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(stderr, "Error creating output file:", err.Error())
+			return EXIT_IO_ERROR
+		}
+		defer f.Close()
+		writer = f
+	}
+	if _, err := io.WriteString(writer, result); err != nil {
+		fmt.Fprintln(stderr, "Error writing output:", err.Error())
+		return EXIT_IO_ERROR
+	}
+	return exitCode
+}
+
+/*
+Formats the IG Script column (i.e., the last column) of a given input line. Empty lines and comment lines are
+returned as is. Returns the given line alongside the error if the statement cannot be formatted.
+*/
+func formatLine(line string, opts formatter.Options) (string, tree.ParsingError) {
+	if strings.TrimSpace(line) == "" || strings.HasPrefix(strings.TrimSpace(line), INPUT_COMMENT_PREFIX) {
+		return line, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+	}
+	start := strings.LastIndex(line, INPUT_SEPARATOR) + 1
+	formatted, err := formatter.Format(line[start:], opts)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return line, err
+	}
+	return line[:start] + formatted, err
+}
+
+/*
+Produces a human-readable description of an error for a given statement location.
+*/
+func describeError(location string, err tree.ParsingError) string {
+	msg := location + ": " + err.ErrorCode
+	if err.ErrorMessage != "" {
+		msg += " - " + err.ErrorMessage
+	}
+	if len(err.ErrorSpans) > 0 && err.ErrorSpans[0].IsLocated() {
+		msg += fmt.Sprintf(" (IG Script line %d, column %d)", err.ErrorSpans[0].Line, err.ErrorSpans[0].Column)
+	}
+	return msg
+}
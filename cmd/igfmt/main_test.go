@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+/*
+Tests formatting of line-based input, retaining IDs, Original Statements, comments, empty lines and unparseable statements.
+*/
+func TestRunLines(t *testing.T) {
+
+	input := "# Comment line\n" +
+		"1\tA(farmer)   D(must)  I(comply)\n" +
+		"\n" +
+		"2\tFarmers may sell.\tA(farmer) Cac{  Cac{A(x) I(y)}[XOR]Cac{A(z) I(w)}} I(sell)\r\n" +
+		"3\tA(farmer) I(comply\n" +
+		"A(citizen)  I(vote)\n"
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	code := run([]string{}, strings.NewReader(input), stdout, stderr)
+
+	if code != EXIT_FORMATTING_ERROR {
+		t.Fatal("Formatting should report error for unparseable statement, but returned", code)
+	}
+	expected := "# Comment line\n" +
+		"1\tA(farmer) D(must) I(comply)\n" +
+		"\n" +
+		"2\tFarmers may sell.\tA(farmer) I(sell) Cac{Cac{A(x) I(y)} [XOR] Cac{A(z) I(w)}}\n" +
+		"3\tA(farmer) I(comply\n" +
+		"A(citizen) I(vote)\n"
+	if stdout.String() != expected {
+		t.Fatal("Formatted output is incorrect:\n" + stdout.String())
+	}
+	if !strings.HasPrefix(stderr.String(), "Line 5: IMBALANCED_PARENTHESES") {
+		t.Fatal("Error report is incorrect:", stderr.String())
+	}
+}
+
+/*
+Tests multi-line formatting of an individual statement, and rejection of multi-line output for line-based input.
+*/
+func TestRunStatement(t *testing.T) {
+
+	stdout := &bytes.Buffer{}
+	code := run([]string{"-statement", "-multiline", "-indent", "2"},
+		strings.NewReader("A(x) I(y) Cac{Cac{A(a) I(b)} [XOR] Cac{A(c) I(d)}}\n"), stdout, &bytes.Buffer{})
+	if code != EXIT_SUCCESS {
+		t.Fatal("Formatting should succeed, but returned", code)
+	}
+	if stdout.String() != "A(x) I(y) Cac{\n  Cac{A(a) I(b)}\n  [XOR]\n  Cac{A(c) I(d)}\n}\n" {
+		t.Fatal("Formatted output is incorrect:\n" + stdout.String())
+	}
+
+	// Formatted multi-line output is accepted as input
+	code = run([]string{"-statement", "-multiline", "-indent", "2", "-check"}, strings.NewReader(stdout.String()),
+		&bytes.Buffer{}, &bytes.Buffer{})
+	if code != EXIT_SUCCESS {
+		t.Fatal("Formatted statement should pass check, but returned", code)
+	}
+
+	code = run([]string{"-multiline"}, strings.NewReader(""), &bytes.Buffer{}, &bytes.Buffer{})
+	if code != EXIT_USAGE_ERROR {
+		t.Fatal("Multi-line output for line-based input should be rejected, but returned", code)
+	}
+}
+
+/*
+Tests check mode, which reports unformatted statements without writing output.
+*/
+func TestRunCheck(t *testing.T) {
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	code := run([]string{"-check"}, strings.NewReader("1\tA(farmer) I(comply)\n2\tA(farmer)  I(comply)\n"), stdout, stderr)
+	if code != EXIT_FORMATTING_ERROR || stdout.Len() != 0 {
+		t.Fatal("Check should fail without output, but returned", code, "and output", stdout.String())
+	}
+	if strings.TrimSpace(stderr.String()) != "Line 2: Statement is not formatted" {
+		t.Fatal("Check report is incorrect:", stderr.String())
+	}
+}
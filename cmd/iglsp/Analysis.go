@@ -0,0 +1,207 @@
+package main
+
+import (
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+This file contains the language features of the language server (diagnostics, completion, hover and
+document symbols), which operate on the statements of a document (see Document.go).
+*/
+
+// Source indicated for diagnostics
+const DIAGNOSTIC_SOURCE = "ig-parser"
+
+// Logical operators offered for completion
+var LOGICAL_OPERATORS = []string{tree.AND_BRACKETS, tree.OR_BRACKETS, tree.XOR_BRACKETS, tree.NOT_BRACKETS}
+
+// Mapping of diagnostic severities to protocol severities
+var DIAGNOSTIC_SEVERITIES = map[string]int{
+	tree.SEVERITY_ERROR:   DIAGNOSTIC_SEVERITY_ERROR,
+	tree.SEVERITY_WARNING: DIAGNOSTIC_SEVERITY_WARNING,
+	tree.SEVERITY_INFO:    DIAGNOSTIC_SEVERITY_INFORMATION,
+}
+
+/*
+Returns the diagnostics (errors, warnings and information) for all statements of a document
+(see parser.ParseStatementWithDiagnostics()). Diagnostics without located offending content
+span the entire statement.
+*/
+func Diagnostics(doc *Document) []Diagnostic {
+	diagnostics := []Diagnostic{}
+	for _, stmt := range doc.Statements {
+		_, diags := parser.ParseStatementWithDiagnostics(stmt.Coded)
+		for _, d := range diags {
+			rng := doc.Range(stmt, 0, len(stmt.Coded))
+			if len(d.ErrorSpans) > 0 && d.ErrorSpans[0].IsLocated() {
+				rng = doc.Range(stmt, d.ErrorSpans[0].Offset, d.ErrorSpans[0].EndOffset)
+			}
+			message := d.ErrorMessage
+			if message == "" {
+				message = d.ErrorCode
+			}
+			if len(d.ErrorIgnoredElements) > 0 {
+				message += " (Ignored elements: \"" + strings.Join(d.ErrorIgnoredElements, ", ") + "\")"
+			}
+			diagnostics = append(diagnostics, Diagnostic{Range: rng, Severity: DIAGNOSTIC_SEVERITIES[d.Severity],
+				Code: d.ErrorCode, Source: DIAGNOSTIC_SOURCE, Message: message})
+		}
+	}
+	return diagnostics
+}
+
+/*
+Returns completion items for a given position. Within logical operators (i.e., following '['),
+logical operators are offered; otherwise component symbols (see tree.IGComponentSymbols) and logical
+operators are offered. The typed prefix is replaced by the selected item.
+*/
+func Completion(doc *Document, pos Position) []CompletionItem {
+	items := []CompletionItem{}
+	stmt := doc.StatementAt(pos.Line)
+	offset := doc.Offset(pos)
+	if stmt == nil {
+		// Line does not (yet) hold a statement
+		stmt = &DocumentStatement{Line: pos.Line, Start: offset}
+	}
+	if offset < stmt.Start {
+		// Position is not in IG Script-encoded statement
+		return items
+	}
+	line := doc.Lines[pos.Line][:offset]
+
+	// Determine typed prefix
+	start := len(line)
+	for start > 0 && isLetter(line[start-1]) {
+		start--
+	}
+	if strings.HasSuffix(line[:start], tree.PROPERTY_SYNTAX_SUFFIX[:1]) && strings.HasPrefix(line[start:], tree.PROPERTY_SYNTAX_SUFFIX[1:]) {
+		// Completion of property symbol (e.g., 'A,p')
+		start--
+		for start > 0 && isLetter(line[start-1]) {
+			start--
+		}
+	}
+	operatorOnly := start > 0 && line[start-1] == tree.LEFT_BRACKET[0]
+	if operatorOnly {
+		start--
+	}
+	rng := Range{Start: Position{Line: pos.Line, Character: utf16Length(doc.Lines[pos.Line][:start])}, End: pos}
+
+	for _, operator := range LOGICAL_OPERATORS {
+		items = append(items, CompletionItem{Label: operator, Kind: COMPLETION_KIND_KEYWORD, Detail: "Logical operator",
+			TextEdit: &TextEdit{Range: rng, NewText: operator}})
+	}
+	if operatorOnly {
+		return items
+	}
+	for _, symbol := range componentSymbols() {
+		items = append(items, CompletionItem{Label: symbol, Kind: COMPLETION_KIND_FIELD, Detail: tree.IGComponentSymbolNameMap[symbol],
+			TextEdit: &TextEdit{Range: rng, NewText: symbol}})
+	}
+	return items
+}
+
+/*
+Returns the component symbols that can be used in IG Script (i.e., excluding the symbols
+for annotations and references used in output only).
+*/
+func componentSymbols() []string {
+	symbols := []string{}
+	for _, symbol := range tree.IGComponentSymbols {
+		if !strings.Contains(symbol, " ") && !strings.HasSuffix(symbol, tree.REF_SUFFIX) {
+			symbols = append(symbols, symbol)
+		}
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+/*
+Returns hover information for the component at a given position, including component name and
+Degree of Variability (DoV) of the component and the overall statement (see tree.Statement.CalculateComplexity()).
+Returns nil if the position is not within a component.
+*/
+func HoverAt(doc *Document, pos Position) *Hover {
+	stmt := doc.StatementAt(pos.Line)
+	if stmt == nil {
+		return nil
+	}
+	offset := doc.Offset(pos) - stmt.Start
+	expr := ComponentAt(ScanComponents(stmt.Coded, 0), offset)
+	if expr == nil {
+		return nil
+	}
+
+	content := "**" + expr.Label + "**"
+	if expr.Symbol != "" {
+		content += ": " + tree.IGComponentSymbolNameMap[expr.Symbol]
+	}
+	if dov, ok := degreeOfVariability(stmt.Coded[expr.Start:expr.End]); ok && expr.Symbol != "" {
+		content += "\n\nDegree of Variability (component): " + strconv.Itoa(dov)
+	}
+	if dov, ok := degreeOfVariability(stmt.Coded); ok {
+		content += "\n\nDegree of Variability (statement): " + strconv.Itoa(dov)
+	}
+	rng := doc.Range(*stmt, expr.Start, expr.End)
+	return &Hover{Contents: MarkupContent{Kind: MARKUP_KIND_MARKDOWN, Value: content}, Range: &rng}
+}
+
+/*
+Calculates the Degree of Variability of a given IG Script expression, aggregated across all atomic statements
+(e.g., generated from component pair combinations). Returns false if the expression cannot be parsed.
+*/
+func degreeOfVariability(coded string) (int, bool) {
+	stmts, err := parser.ParseStatement(coded)
+	if err.ErrorCode != tree.PARSING_NO_ERROR && err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		return 0, false
+	}
+	dov := 0
+	for _, node := range stmts {
+		if stmt, ok := node.Entry.(*tree.Statement); ok {
+			dov += stmt.CalculateComplexity().TotalStateComplexity
+		}
+	}
+	return dov, dov > 0
+}
+
+/*
+Returns document symbols for all statements of a document, with each statement's symbol holding
+the symbols of its components, mirroring the nested statement structure.
+*/
+func DocumentSymbols(doc *Document) []DocumentSymbol {
+	symbols := []DocumentSymbol{}
+	for _, stmt := range doc.Statements {
+		rng := doc.Range(stmt, 0, len(stmt.Coded))
+		symbols = append(symbols, DocumentSymbol{Name: stmt.Id, Detail: "Statement", Kind: SYMBOL_KIND_NAMESPACE,
+			Range: rng, SelectionRange: rng, Children: componentSymbolsOf(doc, stmt, ScanComponents(stmt.Coded, 0))})
+	}
+	return symbols
+}
+
+/*
+Returns document symbols for given component expressions of a statement (including nested expressions).
+*/
+func componentSymbolsOf(doc *Document, stmt DocumentStatement, exprs []ComponentExpression) []DocumentSymbol {
+	symbols := []DocumentSymbol{}
+	for _, expr := range exprs {
+		symbol := DocumentSymbol{Name: expr.Label, Kind: SYMBOL_KIND_FIELD, Range: doc.Range(stmt, expr.Start, expr.End),
+			SelectionRange: doc.Range(stmt, expr.Start, expr.HeaderEnd)}
+		switch {
+		case expr.Symbol == "":
+			symbol.Kind = SYMBOL_KIND_ARRAY
+			symbol.SelectionRange = symbol.Range
+		case expr.Nested:
+			symbol.Kind = SYMBOL_KIND_STRUCT
+			symbol.Detail = tree.IGComponentSymbolNameMap[expr.Symbol] + " (nested)"
+		default:
+			symbol.Detail = tree.IGComponentSymbolNameMap[expr.Symbol]
+		}
+		symbol.Children = componentSymbolsOf(doc, stmt, expr.Children)
+		symbols = append(symbols, symbol)
+	}
+	return symbols
+}
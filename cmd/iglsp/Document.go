@@ -0,0 +1,282 @@
+package main
+
+import (
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+/*
+This file contains the document model of the language server. Documents hold one statement per line,
+either as plain IG Script or in the tab-separated input format of the command-line tool
+('ID<TAB>IG Script' or 'ID<TAB>Original Statement<TAB>IG Script'). Empty lines and lines
+starting with '#' are ignored.
+*/
+
+// Separator between columns of statement lines
+const COLUMN_SEPARATOR = "\t"
+
+// Prefix for comment lines
+const COMMENT_PREFIX = "#"
+
+// Name of component pair combinations (braced combinations without component symbol)
+const NAME_COMPONENT_PAIR = "Component pair combination"
+
+/*
+Open text document.
+*/
+type Document struct {
+	// Document URI
+	Uri string
+	// Lines of document (without line terminators)
+	Lines []string
+	// Statements held in document
+	Statements []DocumentStatement
+}
+
+/*
+Statement held in a line of a document.
+*/
+type DocumentStatement struct {
+	// Statement ID (or line number if no ID is given)
+	Id string
+	// Zero-based line of statement in document
+	Line int
+	// Byte offset of IG Script-encoded statement in line
+	Start int
+	// IG Script-encoded statement
+	Coded string
+}
+
+/*
+Component expression in an IG Script-encoded statement (e.g., 'Cac{A(actor) I(act)}'),
+with positions as byte offsets in the coded statement.
+*/
+type ComponentExpression struct {
+	// Component symbol (e.g., 'Cac'), empty for component pair combinations
+	Symbol string
+	// Component symbol including suffix (e.g., 'Cac1')
+	Label string
+	// Start of expression
+	Start int
+	// End of component header (symbol, suffix and annotation)
+	HeaderEnd int
+	// End of expression (exclusive)
+	End int
+	// Indicates whether content is nested (i.e., braced)
+	Nested bool
+	// Component expressions within nested content
+	Children []ComponentExpression
+}
+
+/*
+Creates document for given URI and content and identifies the statements it holds.
+*/
+func NewDocument(uri string, text string) *Document {
+	doc := &Document{Uri: uri, Lines: strings.Split(text, "\n")}
+	for i, line := range doc.Lines {
+		line = strings.TrimRight(line, "\r")
+		doc.Lines[i] = line
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(strings.TrimSpace(line), COMMENT_PREFIX) {
+			continue
+		}
+		stmt := DocumentStatement{Id: "Line " + strconv.Itoa(i+1), Line: i}
+		// IG Script-encoded statement is held in last column
+		if sep := strings.LastIndex(line, COLUMN_SEPARATOR); sep != -1 {
+			stmt.Start = sep + len(COLUMN_SEPARATOR)
+			if id := strings.TrimSpace(line[:strings.Index(line, COLUMN_SEPARATOR)]); id != "" {
+				stmt.Id = id
+			}
+		}
+		content := line[stmt.Start:]
+		stmt.Start += len(content) - len(strings.TrimLeft(content, " "))
+		stmt.Coded = strings.TrimSpace(line[stmt.Start:])
+		if stmt.Coded == "" {
+			continue
+		}
+		doc.Statements = append(doc.Statements, stmt)
+	}
+	return doc
+}
+
+/*
+Returns the statement held in a given line, or nil if the line does not hold a statement.
+*/
+func (doc *Document) StatementAt(line int) *DocumentStatement {
+	for i := range doc.Statements {
+		if doc.Statements[i].Line == line {
+			return &doc.Statements[i]
+		}
+	}
+	return nil
+}
+
+/*
+Returns the position of a given byte offset in the coded statement.
+*/
+func (doc *Document) Position(stmt DocumentStatement, offset int) Position {
+	return Position{Line: stmt.Line, Character: utf16Length(doc.Lines[stmt.Line][:stmt.Start+offset])}
+}
+
+/*
+Returns the range of given byte offsets in the coded statement.
+*/
+func (doc *Document) Range(stmt DocumentStatement, start int, end int) Range {
+	return Range{Start: doc.Position(stmt, start), End: doc.Position(stmt, end)}
+}
+
+/*
+Returns the byte offset in the given line corresponding to a character position (in UTF-16 code units).
+Positions beyond the end of the line are mapped to the end of the line.
+*/
+func (doc *Document) Offset(pos Position) int {
+	if pos.Line < 0 || pos.Line >= len(doc.Lines) {
+		return 0
+	}
+	line := doc.Lines[pos.Line]
+	units := 0
+	for i, r := range line {
+		if units >= pos.Character {
+			return i
+		}
+		units += len(utf16.Encode([]rune{r}))
+	}
+	return len(line)
+}
+
+/*
+Returns the length of a given string in UTF-16 code units.
+*/
+func utf16Length(s string) int {
+	length := 0
+	for _, r := range s {
+		length += len(utf16.Encode([]rune{r}))
+	}
+	return length
+}
+
+/*
+Identifies the component expressions in a given IG Script-encoded statement (or nested content thereof),
+including the component expressions within nested content (component-level nesting, nested statements
+and component pair combinations). Positions are relative to the given offset. The identification is
+tolerant towards malformed input (e.g., unterminated expressions extend to the end of the content)
+in order to support statements under editing.
+*/
+func ScanComponents(text string, offset int) []ComponentExpression {
+	exprs := []ComponentExpression{}
+	for i := 0; i < len(text); {
+		if text[i] == parser.LEFT_BRACE[0] {
+			// Component pair combination
+			end, contentEnd := matchingBracket(text, i)
+			exprs = append(exprs, ComponentExpression{Label: NAME_COMPONENT_PAIR, Start: offset + i, HeaderEnd: offset + i,
+				End: offset + end, Nested: true, Children: ScanComponents(text[i+1:contentEnd], offset+i+1)})
+			i = end
+			continue
+		}
+		if expr, contentEnd, ok := scanComponent(text, i); ok {
+			if expr.Nested {
+				expr.Children = ScanComponents(text[expr.HeaderEnd+1:contentEnd], offset+expr.HeaderEnd+1)
+			}
+			expr.Start += offset
+			expr.HeaderEnd += offset
+			expr.End += offset
+			exprs = append(exprs, expr)
+			i = expr.End - offset
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+	}
+	return exprs
+}
+
+/*
+Identifies a component expression (symbol, optional suffix, optional annotation and content)
+starting at a given byte offset. Returns the expression and the end of its content (i.e., the
+start of the closing bracket), or false if no component expression starts at the offset.
+*/
+func scanComponent(text string, start int) (ComponentExpression, int, bool) {
+	expr := ComponentExpression{Start: start}
+	if start > 0 && isAlphaNumeric(text[start-1]) {
+		return expr, 0, false
+	}
+	// Component symbol, ...
+	i := start
+	for i < len(text) && isLetter(text[i]) {
+		i++
+	}
+	if strings.HasPrefix(text[i:], tree.PROPERTY_SYNTAX_SUFFIX) {
+		i += len(tree.PROPERTY_SYNTAX_SUFFIX)
+	}
+	expr.Symbol = text[start:i]
+	if expr.Symbol == "" || !tree.ValidIGComponentSymbol(expr.Symbol) {
+		return expr, 0, false
+	}
+	// ... followed by optional suffix ...
+	for i < len(text) && isAlphaNumeric(text[i]) {
+		i++
+	}
+	expr.Label = text[start:i]
+	// ... followed by optional annotation ...
+	if i < len(text) && text[i] == parser.LEFT_BRACKET[0] {
+		i, _ = matchingBracket(text, i)
+	}
+	// ... followed by content
+	if i >= len(text) || (text[i] != parser.LEFT_PARENTHESIS[0] && text[i] != parser.LEFT_BRACE[0]) {
+		return expr, 0, false
+	}
+	expr.HeaderEnd = i
+	expr.Nested = text[i] == parser.LEFT_BRACE[0]
+	end, contentEnd := matchingBracket(text, i)
+	expr.End = end
+	return expr, contentEnd, true
+}
+
+/*
+Returns the byte offset following the bracket that closes the bracket at a given offset
+(counting brackets of the same type only) and the offset of the closing bracket itself.
+Both correspond to the end of the text if the bracket is not closed.
+*/
+func matchingBracket(text string, start int) (int, int) {
+	open := text[start]
+	closing := map[byte]byte{'(': ')', '{': '}', '[': ']'}[open]
+	depth := 0
+	for i := start; i < len(text); i++ {
+		switch text[i] {
+		case open:
+			depth++
+		case closing:
+			depth--
+			if depth == 0 {
+				return i + 1, i
+			}
+		}
+	}
+	return len(text), len(text)
+}
+
+/*
+Returns the innermost component expression containing a given byte offset, or nil if none contains it.
+*/
+func ComponentAt(exprs []ComponentExpression, offset int) *ComponentExpression {
+	for i := range exprs {
+		if offset >= exprs[i].Start && offset < exprs[i].End {
+			if inner := ComponentAt(exprs[i].Children, offset); inner != nil {
+				return inner
+			}
+			return &exprs[i]
+		}
+	}
+	return nil
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isAlphaNumeric(c byte) bool {
+	return isLetter(c) || (c >= '0' && c <= '9')
+}
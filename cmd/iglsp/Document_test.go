@@ -0,0 +1,123 @@
+package main
+
+import (
+	"testing"
+)
+
+/*
+Tests identification of statements in documents with and without statement IDs and Original Statements.
+*/
+func TestNewDocument(t *testing.T) {
+
+	doc := NewDocument("file:///test.ig", "# Comment\r\n"+
+		"A(farmer) D(must)\r\n"+
+		"\n"+
+		"2\t  A(citizen) I(vote)\n"+
+		"3\tCitizens vote.\tA(citizen) I(vote)\n"+
+		"4\t\n")
+
+	if len(doc.Statements) != 3 {
+		t.Fatal("Number of statements is incorrect:", doc.Statements)
+	}
+	expected := []DocumentStatement{
+		{Id: "Line 2", Line: 1, Start: 0, Coded: "A(farmer) D(must)"},
+		{Id: "2", Line: 3, Start: 4, Coded: "A(citizen) I(vote)"},
+		{Id: "3", Line: 4, Start: 17, Coded: "A(citizen) I(vote)"},
+	}
+	for i, stmt := range expected {
+		if doc.Statements[i] != stmt {
+			t.Fatal("Statement is identified incorrectly:", doc.Statements[i])
+		}
+	}
+	if doc.StatementAt(2) != nil || doc.StatementAt(4).Id != "3" {
+		t.Fatal("Statement lookup by line is incorrect")
+	}
+}
+
+/*
+Tests conversion between byte offsets and positions in UTF-16 code units.
+*/
+func TestDocumentPositions(t *testing.T) {
+
+	doc := NewDocument("file:///test.ig", "1\tA(Bürger 𝄞) I(vote)")
+	stmt := doc.Statements[0]
+
+	// 'ü' is encoded in two bytes (one UTF-16 code unit), '𝄞' in four bytes (two UTF-16 code units)
+	if pos := doc.Position(stmt, len("A(Bürger 𝄞)")); pos != (Position{Line: 0, Character: 14}) {
+		t.Fatal("Position is incorrect:", pos)
+	}
+	if offset := doc.Offset(Position{Line: 0, Character: 14}); offset != len("1\tA(Bürger 𝄞)") {
+		t.Fatal("Offset is incorrect:", offset)
+	}
+	if offset := doc.Offset(Position{Line: 0, Character: 100}); offset != len(doc.Lines[0]) {
+		t.Fatal("Offset beyond end of line is incorrect:", offset)
+	}
+}
+
+/*
+Tests identification of component expressions, including suffixes, annotations, properties,
+component-level nesting, component pair combinations and unterminated expressions.
+*/
+func TestScanComponents(t *testing.T) {
+
+	text := "A,p(certified) A1[role=farmer](farmer) I(comply (with rules)) " +
+		"Bdir{A(actor) I(act)} {I(sell) Bdir(goods) [XOR] I(buy) Bdir(food)} Cac{A(x"
+
+	exprs := ScanComponents(text, 0)
+	labels := []string{"A,p", "A1", "I", "Bdir", NAME_COMPONENT_PAIR, "Cac"}
+	if len(exprs) != len(labels) {
+		t.Fatal("Number of component expressions is incorrect:", exprs)
+	}
+	for i, label := range labels {
+		if exprs[i].Label != label {
+			t.Fatal("Component expression is incorrect:", exprs[i])
+		}
+	}
+	if exprs[1].Symbol != "A" || text[exprs[1].Start:exprs[1].HeaderEnd] != "A1[role=farmer]" {
+		t.Fatal("Component header is incorrect:", exprs[1])
+	}
+	if text[exprs[2].Start:exprs[2].End] != "I(comply (with rules))" {
+		t.Fatal("Component content is incorrect:", text[exprs[2].Start:exprs[2].End])
+	}
+	if !exprs[3].Nested || len(exprs[3].Children) != 2 || text[exprs[3].Children[1].Start:exprs[3].Children[1].End] != "I(act)" {
+		t.Fatal("Nested component is incorrect:", exprs[3])
+	}
+	if len(exprs[4].Children) != 4 || exprs[4].Symbol != "" {
+		t.Fatal("Component pair combination is incorrect:", exprs[4])
+	}
+	if exprs[5].End != len(text) || len(exprs[5].Children) != 1 || exprs[5].Children[0].End != len(text) {
+		t.Fatal("Unterminated component expression is incorrect:", exprs[5])
+	}
+
+	if inner := ComponentAt(exprs, exprs[3].Children[1].Start+2); inner == nil || inner.Label != "I" {
+		t.Fatal("Innermost component expression is incorrect:", inner)
+	}
+	if ComponentAt(exprs, len("A,p(certified)")) != nil {
+		t.Fatal("Position between components should not be within component expression")
+	}
+}
+
+/*
+Tests completion of component symbols and logical operators, including replacement of typed prefix.
+*/
+func TestCompletion(t *testing.T) {
+
+	doc := NewDocument("file:///test.ig", "1\tA(x [AN")
+
+	items := Completion(doc, Position{Line: 0, Character: 9})
+	if len(items) != len(LOGICAL_OPERATORS) || items[0].Label != "[AND]" ||
+		items[0].TextEdit.Range.Start.Character != 6 || items[0].TextEdit.Range.End.Character != 9 {
+		t.Fatal("Operator completion is incorrect:", items)
+	}
+
+	items = Completion(doc, Position{Line: 0, Character: 1})
+	if len(items) != 0 {
+		t.Fatal("Completion should not be offered outside of IG Script:", items)
+	}
+
+	for _, symbol := range componentSymbols() {
+		if symbol == "A (Annotation)" || symbol == "Bdir-Ref" {
+			t.Fatal("Component symbol is not usable in IG Script:", symbol)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+/*
+This file contains the subset of Language Server Protocol structures used by the language server.
+Positions are zero-based, with characters counted in UTF-16 code units (as mandated by the protocol).
+*/
+
+// Methods handled by the server
+const METHOD_INITIALIZE = "initialize"
+const METHOD_INITIALIZED = "initialized"
+const METHOD_SHUTDOWN = "shutdown"
+const METHOD_EXIT = "exit"
+const METHOD_DID_OPEN = "textDocument/didOpen"
+const METHOD_DID_CHANGE = "textDocument/didChange"
+const METHOD_DID_CLOSE = "textDocument/didClose"
+const METHOD_COMPLETION = "textDocument/completion"
+const METHOD_HOVER = "textDocument/hover"
+const METHOD_DOCUMENT_SYMBOL = "textDocument/documentSymbol"
+
+// Methods of notifications sent by the server
+const METHOD_PUBLISH_DIAGNOSTICS = "textDocument/publishDiagnostics"
+
+// Text document synchronization (full document content is sent on every change)
+const TEXT_DOCUMENT_SYNC_FULL = 1
+
+// Diagnostic severities
+const DIAGNOSTIC_SEVERITY_ERROR = 1
+const DIAGNOSTIC_SEVERITY_WARNING = 2
+const DIAGNOSTIC_SEVERITY_INFORMATION = 3
+
+// Completion item kinds
+const COMPLETION_KIND_FIELD = 5
+const COMPLETION_KIND_KEYWORD = 14
+
+// Symbol kinds
+const SYMBOL_KIND_NAMESPACE = 3
+const SYMBOL_KIND_FIELD = 8
+const SYMBOL_KIND_ARRAY = 18
+const SYMBOL_KIND_STRUCT = 23
+
+// Markup kind for hover content
+const MARKUP_KIND_MARKDOWN = "markdown"
+
+type Position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type Range struct {
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
+type TextDocumentIdentifier struct {
+	Uri string `json:"uri"`
+}
+
+type TextDocumentItem struct {
+	Uri        string `json:"uri"`
+	LanguageId string `json:"languageId"`
+	Version    int    `json:"version"`
+	Text       string `json:"text"`
+}
+
+type TextDocumentPositionParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	Position     Position               `json:"position"`
+}
+
+type InitializeResult struct {
+	Capabilities ServerCapabilities `json:"capabilities"`
+	ServerInfo   ServerInfo         `json:"serverInfo"`
+}
+
+type ServerCapabilities struct {
+	TextDocumentSync       int               `json:"textDocumentSync"`
+	CompletionProvider     CompletionOptions `json:"completionProvider"`
+	HoverProvider          bool              `json:"hoverProvider"`
+	DocumentSymbolProvider bool              `json:"documentSymbolProvider"`
+}
+
+type CompletionOptions struct {
+	TriggerCharacters []string `json:"triggerCharacters,omitempty"`
+}
+
+type ServerInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type DidOpenTextDocumentParams struct {
+	TextDocument TextDocumentItem `json:"textDocument"`
+}
+
+type DidChangeTextDocumentParams struct {
+	TextDocument   TextDocumentIdentifier           `json:"textDocument"`
+	ContentChanges []TextDocumentContentChangeEvent `json:"contentChanges"`
+}
+
+type TextDocumentContentChangeEvent struct {
+	Text string `json:"text"`
+}
+
+type DidCloseTextDocumentParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+type PublishDiagnosticsParams struct {
+	Uri         string       `json:"uri"`
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+type Diagnostic struct {
+	Range    Range  `json:"range"`
+	Severity int    `json:"severity"`
+	Code     string `json:"code,omitempty"`
+	Source   string `json:"source"`
+	Message  string `json:"message"`
+}
+
+type CompletionItem struct {
+	Label    string    `json:"label"`
+	Kind     int       `json:"kind"`
+	Detail   string    `json:"detail,omitempty"`
+	TextEdit *TextEdit `json:"textEdit,omitempty"`
+}
+
+type TextEdit struct {
+	Range   Range  `json:"range"`
+	NewText string `json:"newText"`
+}
+
+type Hover struct {
+	Contents MarkupContent `json:"contents"`
+	Range    *Range        `json:"range,omitempty"`
+}
+
+type MarkupContent struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+}
+
+type DocumentSymbolParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+type DocumentSymbol struct {
+	Name           string           `json:"name"`
+	Detail         string           `json:"detail,omitempty"`
+	Kind           int              `json:"kind"`
+	Range          Range            `json:"range"`
+	SelectionRange Range            `json:"selectionRange"`
+	Children       []DocumentSymbol `json:"children,omitempty"`
+}
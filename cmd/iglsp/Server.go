@@ -0,0 +1,207 @@
+package main
+
+import (
+	"IG-Parser/core/config"
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+/*
+This file contains the language server, which processes the messages received from the client
+(see Transport.go) and dispatches them to the language features (see Analysis.go).
+Messages are processed sequentially in order of arrival.
+*/
+
+// Name of the language server
+const SERVER_NAME = "iglsp"
+
+/*
+Language server operating on given input and output streams.
+*/
+type Server struct {
+	reader *bufio.Reader
+	writer io.Writer
+	log    io.Writer
+	// Open documents by URI
+	documents map[string]*Document
+	// Indicates whether the client has requested shutdown
+	shutdown bool
+}
+
+/*
+Creates language server reading messages from input and writing messages to output.
+Errors are logged on the given log writer.
+*/
+func NewServer(input io.Reader, output io.Writer, log io.Writer) *Server {
+	return &Server{reader: bufio.NewReader(input), writer: output, log: log, documents: map[string]*Document{}}
+}
+
+/*
+Serves requests until the client sends the exit notification or the input ends.
+Returns EXIT_SUCCESS if the client has requested shutdown prior to exiting, and EXIT_ERROR otherwise.
+*/
+func (s *Server) Serve() int {
+	for {
+		content, err := ReadMessage(s.reader)
+		if err != nil {
+			if err != io.EOF {
+				fmt.Fprintln(s.log, "Error reading message:", err.Error())
+			}
+			return s.exitCode()
+		}
+		msg := RequestMessage{}
+		if err := json.Unmarshal(content, &msg); err != nil {
+			s.replyError(nil, RPC_ERROR_PARSE, "Invalid message: "+err.Error())
+			continue
+		}
+		if msg.Method == METHOD_EXIT {
+			return s.exitCode()
+		}
+		s.handle(msg)
+	}
+}
+
+/*
+Returns the exit code depending on whether shutdown has been requested.
+*/
+func (s *Server) exitCode() int {
+	if s.shutdown {
+		return EXIT_SUCCESS
+	}
+	return EXIT_ERROR
+}
+
+/*
+Dispatches an individual request or notification to the corresponding handler.
+Unknown notifications are ignored, unknown requests are answered with an error.
+*/
+func (s *Server) handle(msg RequestMessage) {
+	switch msg.Method {
+	case METHOD_INITIALIZE:
+		s.reply(msg.Id, InitializeResult{
+			Capabilities: ServerCapabilities{
+				TextDocumentSync:       TEXT_DOCUMENT_SYNC_FULL,
+				CompletionProvider:     CompletionOptions{TriggerCharacters: []string{"["}},
+				HoverProvider:          true,
+				DocumentSymbolProvider: true,
+			},
+			ServerInfo: ServerInfo{Name: SERVER_NAME, Version: config.IG_PARSER_VERSION},
+		})
+	case METHOD_INITIALIZED:
+	case METHOD_SHUTDOWN:
+		s.shutdown = true
+		s.reply(msg.Id, nil)
+	case METHOD_DID_OPEN:
+		params := DidOpenTextDocumentParams{}
+		if s.decode(msg, &params) {
+			s.update(params.TextDocument.Uri, params.TextDocument.Text)
+		}
+	case METHOD_DID_CHANGE:
+		params := DidChangeTextDocumentParams{}
+		// Full synchronization, i.e., the last change holds the entire document
+		if s.decode(msg, &params) && len(params.ContentChanges) > 0 {
+			s.update(params.TextDocument.Uri, params.ContentChanges[len(params.ContentChanges)-1].Text)
+		}
+	case METHOD_DID_CLOSE:
+		params := DidCloseTextDocumentParams{}
+		if s.decode(msg, &params) {
+			delete(s.documents, params.TextDocument.Uri)
+			s.notify(METHOD_PUBLISH_DIAGNOSTICS, PublishDiagnosticsParams{Uri: params.TextDocument.Uri, Diagnostics: []Diagnostic{}})
+		}
+	case METHOD_COMPLETION:
+		params := TextDocumentPositionParams{}
+		if doc, ok := s.document(msg, &params.TextDocument, &params); ok {
+			s.reply(msg.Id, Completion(doc, params.Position))
+		}
+	case METHOD_HOVER:
+		params := TextDocumentPositionParams{}
+		if doc, ok := s.document(msg, &params.TextDocument, &params); ok {
+			s.reply(msg.Id, HoverAt(doc, params.Position))
+		}
+	case METHOD_DOCUMENT_SYMBOL:
+		params := DocumentSymbolParams{}
+		if doc, ok := s.document(msg, &params.TextDocument, &params); ok {
+			s.reply(msg.Id, DocumentSymbols(doc))
+		}
+	default:
+		if !msg.IsNotification() {
+			s.replyError(msg.Id, RPC_ERROR_METHOD_NOT_FOUND, "Method not supported: "+msg.Method)
+		}
+	}
+}
+
+/*
+Stores the given content for a document and publishes the corresponding diagnostics.
+*/
+func (s *Server) update(uri string, text string) {
+	doc := NewDocument(uri, text)
+	s.documents[uri] = doc
+	s.notify(METHOD_PUBLISH_DIAGNOSTICS, PublishDiagnosticsParams{Uri: uri, Diagnostics: Diagnostics(doc)})
+}
+
+/*
+Decodes the parameters of a message into the given value. Answers requests with an error and
+returns false if the parameters cannot be decoded.
+*/
+func (s *Server) decode(msg RequestMessage, params interface{}) bool {
+	if err := json.Unmarshal(msg.Params, params); err != nil {
+		if msg.IsNotification() {
+			fmt.Fprintln(s.log, "Invalid parameters for "+msg.Method+":", err.Error())
+		} else {
+			s.replyError(msg.Id, RPC_ERROR_INVALID_PARAMS, "Invalid parameters: "+err.Error())
+		}
+		return false
+	}
+	return true
+}
+
+/*
+Decodes the parameters of a request into the given value and returns the open document identified therein
+(populated during decoding). Answers the request with an error and returns false if the parameters cannot
+be decoded or the document is not open.
+*/
+func (s *Server) document(msg RequestMessage, id *TextDocumentIdentifier, params interface{}) (*Document, bool) {
+	if !s.decode(msg, params) {
+		return nil, false
+	}
+	doc, ok := s.documents[id.Uri]
+	if !ok {
+		s.replyError(msg.Id, RPC_ERROR_INVALID_PARAMS, "Document not open: "+id.Uri)
+	}
+	return doc, ok
+}
+
+/*
+Sends response for a given request.
+*/
+func (s *Server) reply(id json.RawMessage, result interface{}) {
+	s.write(ResponseMessage{Jsonrpc: JSONRPC_VERSION, Id: id, Result: result})
+}
+
+/*
+Sends error response for a given request (or null ID if the request could not be read).
+*/
+func (s *Server) replyError(id json.RawMessage, code int, message string) {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	s.write(ErrorResponseMessage{Jsonrpc: JSONRPC_VERSION, Id: id, Error: ResponseError{Code: code, Message: message}})
+}
+
+/*
+Sends notification to the client.
+*/
+func (s *Server) notify(method string, params interface{}) {
+	s.write(NotificationMessage{Jsonrpc: JSONRPC_VERSION, Method: method, Params: params})
+}
+
+/*
+Writes message to the client, logging errors.
+*/
+func (s *Server) write(message interface{}) {
+	if err := WriteMessage(s.writer, message); err != nil {
+		fmt.Fprintln(s.log, "Error writing message:", err.Error())
+	}
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+/*
+Performs a session with the given messages against the language server and returns the exit code and the
+messages sent by the server.
+*/
+func runSession(t *testing.T, messages ...interface{}) (int, []map[string]json.RawMessage) {
+	input := &bytes.Buffer{}
+	for _, msg := range messages {
+		if err := WriteMessage(input, msg); err != nil {
+			t.Fatal("Error when writing message. Error:", err.Error())
+		}
+	}
+	output := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	code := run([]string{"-stdio"}, input, output, stderr)
+
+	responses := []map[string]json.RawMessage{}
+	reader := bufio.NewReader(output)
+	for {
+		content, err := ReadMessage(reader)
+		if err != nil {
+			break
+		}
+		response := map[string]json.RawMessage{}
+		if err := json.Unmarshal(content, &response); err != nil {
+			t.Fatal("Server sent invalid message:", string(content))
+		}
+		responses = append(responses, response)
+	}
+	return code, responses
+}
+
+/*
+Creates request message with given ID, method and parameters.
+*/
+func request(id int, method string, params interface{}) map[string]interface{} {
+	msg := map[string]interface{}{"jsonrpc": JSONRPC_VERSION, "method": method, "params": params}
+	if id > 0 {
+		msg["id"] = id
+	}
+	return msg
+}
+
+/*
+Returns the response for a given request ID, decoded into the given value.
+*/
+func responseFor(t *testing.T, responses []map[string]json.RawMessage, id int, result interface{}) {
+	for _, response := range responses {
+		if string(response["id"]) == strconv.Itoa(id) {
+			if _, failed := response["error"]; failed {
+				t.Fatal("Request", id, "failed:", string(response["error"]))
+			}
+			if err := json.Unmarshal(response["result"], result); err != nil {
+				t.Fatal("Result of request", id, "cannot be decoded:", string(response["result"]))
+			}
+			return
+		}
+	}
+	t.Fatal("No response for request", id)
+}
+
+/*
+Tests a complete session including initialization, diagnostics, hover, completion, document symbols and shutdown.
+*/
+func TestServerSession(t *testing.T) {
+
+	text := "# Statements\n" +
+		"1\tA(farmer) D(must) I(comply) Cac{A(actor) I((act [XOR] rest))}\n" +
+		"2\tFarmers must comply.\tA(farmer) D(must) I((comply [AND] report) [OR] pay)\n"
+	uri := "file:///statements.ig"
+	doc := map[string]interface{}{"uri": uri}
+
+	code, responses := runSession(t,
+		request(1, METHOD_INITIALIZE, map[string]interface{}{}),
+		request(0, METHOD_INITIALIZED, map[string]interface{}{}),
+		request(0, METHOD_DID_OPEN, map[string]interface{}{"textDocument": map[string]interface{}{"uri": uri, "text": text}}),
+		request(2, METHOD_HOVER, map[string]interface{}{"textDocument": doc, "position": Position{Line: 1, Character: 31}}),
+		request(3, METHOD_COMPLETION, map[string]interface{}{"textDocument": doc, "position": Position{Line: 1, Character: 2}}),
+		request(4, METHOD_DOCUMENT_SYMBOL, map[string]interface{}{"textDocument": doc}),
+		request(5, "textDocument/unknown", map[string]interface{}{}),
+		request(6, METHOD_SHUTDOWN, nil),
+		request(0, METHOD_EXIT, nil))
+
+	if code != EXIT_SUCCESS {
+		t.Fatal("Server should exit successfully after shutdown, but returned", code)
+	}
+
+	initResult := InitializeResult{}
+	responseFor(t, responses, 1, &initResult)
+	if initResult.Capabilities.TextDocumentSync != TEXT_DOCUMENT_SYNC_FULL || !initResult.Capabilities.HoverProvider ||
+		!initResult.Capabilities.DocumentSymbolProvider || initResult.ServerInfo.Name != SERVER_NAME {
+		t.Fatal("Capabilities are incorrect:", initResult)
+	}
+
+	// No diagnostics are published for valid statements
+	published := PublishDiagnosticsParams{}
+	for _, response := range responses {
+		if string(response["method"]) == `"`+METHOD_PUBLISH_DIAGNOSTICS+`"` {
+			if err := json.Unmarshal(response["params"], &published); err != nil {
+				t.Fatal("Diagnostics cannot be decoded:", string(response["params"]))
+			}
+		}
+	}
+	if published.Uri != uri || len(published.Diagnostics) != 0 {
+		t.Fatal("Published diagnostics are incorrect:", published)
+	}
+
+	hover := Hover{}
+	responseFor(t, responses, 2, &hover)
+	if !strings.Contains(hover.Contents.Value, "**Cac**: Activation Condition") ||
+		!strings.Contains(hover.Contents.Value, "Degree of Variability (component): 2") ||
+		!strings.Contains(hover.Contents.Value, "Degree of Variability (statement): 2") {
+		t.Fatal("Hover content is incorrect:", hover.Contents.Value)
+	}
+	if hover.Range == nil || hover.Range.Start.Character != 30 || hover.Range.End.Character != 63 {
+		t.Fatal("Hover range is incorrect:", hover.Range)
+	}
+
+	items := []CompletionItem{}
+	responseFor(t, responses, 3, &items)
+	if len(items) != len(LOGICAL_OPERATORS)+len(componentSymbols()) {
+		t.Fatal("Number of completion items is incorrect:", len(items))
+	}
+
+	symbols := []DocumentSymbol{}
+	responseFor(t, responses, 4, &symbols)
+	if len(symbols) != 2 || symbols[0].Name != "1" || symbols[1].Name != "2" || len(symbols[0].Children) != 4 ||
+		symbols[0].Children[3].Name != "Cac" || len(symbols[0].Children[3].Children) != 2 {
+		t.Fatal("Document symbols are incorrect:", symbols)
+	}
+
+	for _, response := range responses {
+		if string(response["id"]) == "5" {
+			if !strings.Contains(string(response["error"]), "-32601") {
+				t.Fatal("Unknown request should be rejected:", response)
+			}
+		}
+	}
+}
+
+/*
+Tests publishing of diagnostics with positions upon changes, and clearing upon closing of documents.
+*/
+func TestServerDiagnostics(t *testing.T) {
+
+	uri := "file:///statements.ig"
+	_, responses := runSession(t,
+		request(1, METHOD_INITIALIZE, map[string]interface{}{}),
+		request(0, METHOD_DID_OPEN, map[string]interface{}{"textDocument": map[string]interface{}{"uri": uri,
+			"text": "A(farmer) D(must) I(comply)"}}),
+		request(0, METHOD_DID_CHANGE, map[string]interface{}{"textDocument": map[string]interface{}{"uri": uri},
+			"contentChanges": []map[string]interface{}{{"text": "# Comment\n1\tA((x [AND] y [OR] z)) D(must) I(comply) (remark)"}}}),
+		request(0, METHOD_DID_CLOSE, map[string]interface{}{"textDocument": map[string]interface{}{"uri": uri}}),
+		request(0, METHOD_EXIT, nil))
+
+	published := []PublishDiagnosticsParams{}
+	for _, response := range responses {
+		params := PublishDiagnosticsParams{}
+		if err := json.Unmarshal(response["params"], &params); err == nil && params.Uri == uri {
+			published = append(published, params)
+		}
+	}
+	if len(published) != 3 {
+		t.Fatal("Diagnostics should be published three times, but were published", len(published), "times")
+	}
+	if len(published[0].Diagnostics) != 0 || len(published[2].Diagnostics) != 0 {
+		t.Fatal("Diagnostics should be empty:", published[0], published[2])
+	}
+
+	diags := published[1].Diagnostics
+	if len(diags) != 2 {
+		t.Fatal("Number of diagnostics is incorrect:", diags)
+	}
+	if diags[0].Severity != DIAGNOSTIC_SEVERITY_ERROR || diags[0].Source != DIAGNOSTIC_SOURCE ||
+		diags[0].Range.Start != (Position{Line: 1, Character: 2}) || diags[0].Range.End != (Position{Line: 1, Character: 23}) {
+		t.Fatal("Error diagnostic is incorrect:", diags[0])
+	}
+	if diags[1].Severity != DIAGNOSTIC_SEVERITY_WARNING || diags[1].Range.Start.Line != 1 {
+		t.Fatal("Warning diagnostic is incorrect:", diags[1])
+	}
+}
+
+/*
+Tests exit code if client exits without prior shutdown request, and rejection of invalid arguments.
+*/
+func TestServerExitWithoutShutdown(t *testing.T) {
+
+	code, _ := runSession(t, request(1, METHOD_INITIALIZE, map[string]interface{}{}), request(0, METHOD_EXIT, nil))
+	if code != EXIT_ERROR {
+		t.Fatal("Server should exit with error code, but returned", code)
+	}
+
+	if code := run([]string{"-port", "8080"}, &bytes.Buffer{}, &bytes.Buffer{}, &bytes.Buffer{}); code != EXIT_USAGE_ERROR {
+		t.Fatal("Invalid arguments should be rejected, but returned", code)
+	}
+}
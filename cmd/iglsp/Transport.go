@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+/*
+This file contains the JSON-RPC transport of the language server, i.e., the reading and writing
+of messages framed by a 'Content-Length' header (see Language Server Protocol base protocol).
+*/
+
+// Header holding the length of the message content
+const HEADER_CONTENT_LENGTH = "Content-Length"
+
+// JSON-RPC version
+const JSONRPC_VERSION = "2.0"
+
+// JSON-RPC error codes
+const RPC_ERROR_PARSE = -32700
+const RPC_ERROR_INVALID_PARAMS = -32602
+const RPC_ERROR_METHOD_NOT_FOUND = -32601
+const RPC_ERROR_INVALID_REQUEST = -32600
+
+/*
+Incoming message (request or notification). Notifications do not carry an ID.
+*/
+type RequestMessage struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	Id      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+/*
+Indicates whether the message is a notification (i.e., does not expect a response).
+*/
+func (m RequestMessage) IsNotification() bool {
+	return len(m.Id) == 0
+}
+
+/*
+Response to a successfully processed request (the result may be null).
+*/
+type ResponseMessage struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	Id      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result"`
+}
+
+/*
+Response to a failed request.
+*/
+type ErrorResponseMessage struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	Id      json.RawMessage `json:"id"`
+	Error   ResponseError   `json:"error"`
+}
+
+/*
+Error information of failed request.
+*/
+type ResponseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+/*
+Notification sent by the server (e.g., diagnostics).
+*/
+type NotificationMessage struct {
+	Jsonrpc string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+}
+
+/*
+Reads the content of the next message from the given reader. Returns io.EOF if the input has ended
+before a new message, or error if the header is malformed.
+*/
+func ReadMessage(reader *bufio.Reader) ([]byte, error) {
+	length := -1
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			if err == io.EOF && line == "" && length == -1 {
+				return nil, io.EOF
+			}
+			return nil, fmt.Errorf("reading message header: %w", err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			// Empty line terminates header
+			break
+		}
+		name, value, found := cut(line, ":")
+		if !found {
+			return nil, errors.New("malformed message header: " + line)
+		}
+		if strings.EqualFold(strings.TrimSpace(name), HEADER_CONTENT_LENGTH) {
+			length, err = strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || length < 0 {
+				return nil, errors.New("invalid content length: " + value)
+			}
+		}
+	}
+	if length == -1 {
+		return nil, errors.New("missing " + HEADER_CONTENT_LENGTH + " header")
+	}
+	content := make([]byte, length)
+	if _, err := io.ReadFull(reader, content); err != nil {
+		return nil, fmt.Errorf("reading message content: %w", err)
+	}
+	return content, nil
+}
+
+/*
+Writes the given message as JSON to the given writer, preceded by the 'Content-Length' header.
+*/
+func WriteMessage(writer io.Writer, message interface{}) error {
+	content, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(writer, "%s: %d\r\n\r\n", HEADER_CONTENT_LENGTH, len(content)); err != nil {
+		return err
+	}
+	_, err = writer.Write(content)
+	return err
+}
+
+/*
+Splits a string around the first instance of the separator (strings.Cut is not available in Go 1.16).
+*/
+func cut(s string, sep string) (string, string, bool) {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
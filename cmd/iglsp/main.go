@@ -0,0 +1,71 @@
+package main
+
+import (
+	"IG-Parser/core/config"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+/*
+This file is the main entry point for the IG Parser language server, which supports the editing of
+IG Script-encoded statements in editors implementing the Language Server Protocol (e.g., VS Code).
+It relies on the IG Parser core package functionality and communicates with the editor via stdin and
+stdout, i.e., it runs locally without network access.
+*/
+
+// Exit codes
+const EXIT_SUCCESS = 0
+const EXIT_ERROR = 1
+const EXIT_USAGE_ERROR = 2
+
+/*
+Main entry point for the language server.
+*/
+func main() {
+	// Messages are exclusively written to the original stdout; any other output
+	// (e.g., debug output of the core packages) is redirected to stderr
+	stdout := os.Stdout
+	os.Stdout = os.Stderr
+	os.Exit(run(os.Args[1:], os.Stdin, stdout, os.Stderr))
+}
+
+/*
+Runs the language server with the given arguments and streams, and returns the exit code
+(EXIT_SUCCESS if the client requested shutdown before exiting, EXIT_ERROR otherwise,
+EXIT_USAGE_ERROR for invalid arguments).
+*/
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+
+	flags := flag.NewFlagSet(SERVER_NAME, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "IG Parser "+config.IG_PARSER_VERSION+" - Language server for IG Script")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Usage: "+SERVER_NAME+" [options]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Communicates via stdin and stdout using the Language Server Protocol. Documents hold one statement")
+		fmt.Fprintln(stderr, "per line, either as IG Script or as 'ID<TAB>IG Script' or 'ID<TAB>Original Statement<TAB>IG Script'.")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Options:")
+		flags.PrintDefaults()
+	}
+	// Accepted for compatibility with clients that indicate the transport explicitly
+	flags.Bool("stdio", true, "Communicate via stdin and stdout (default and only transport)")
+
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return EXIT_SUCCESS
+		}
+		return EXIT_USAGE_ERROR
+	}
+	if flags.NArg() > 0 {
+		fmt.Fprintln(stderr, "Unexpected arguments:", strings.Join(flags.Args(), " "))
+		flags.Usage()
+		return EXIT_USAGE_ERROR
+	}
+
+	return NewServer(stdin, stdout, stderr).Serve()
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"IG-Parser/core/endpoints"
+	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/tree"
+	"errors"
+	"strings"
+)
+
+/*
+This file contains the conversion of individual input statements into the selected output format
+by delegating to the core endpoints (see core/endpoints).
+*/
+
+// Output formats supported by command-line tool
+const FORMAT_TABULAR = "tabular"
+const FORMAT_VISUAL = "visual"
+const FORMAT_JSON = "json"
+const FORMAT_XML = "xml"
+const FORMAT_STANDOFF = "standoff"
+
+// All supported output formats
+var FORMATS = []string{FORMAT_TABULAR, FORMAT_VISUAL, FORMAT_JSON, FORMAT_XML, FORMAT_STANDOFF}
+
+// Tabular output types (short names mapped to tabular.OUTPUT_TYPES)
+var TABULAR_OUTPUT_TYPES = map[string]string{
+	"csv":          tabular.OUTPUT_TYPE_CSV,
+	"googlesheets": tabular.OUTPUT_TYPE_GOOGLE_SHEETS,
+}
+
+// Inclusion options for Original Statement (short names mapped to tabular.ORIGINAL_STATEMENT_INCLUSION_OPTIONS)
+var ORIGINAL_STATEMENT_INCLUSION = map[string]string{
+	"none":  tabular.ORIGINAL_STATEMENT_OUTPUT_NONE,
+	"first": tabular.ORIGINAL_STATEMENT_OUTPUT_FIRST_ENTRY,
+	"all":   tabular.ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES,
+}
+
+// Inclusion options for IG Script input (short names mapped to tabular.IG_SCRIPT_INCLUSION_OPTIONS)
+var IG_SCRIPT_INCLUSION = map[string]string{
+	"none":  tabular.IG_SCRIPT_OUTPUT_NONE,
+	"first": tabular.IG_SCRIPT_OUTPUT_FIRST_ENTRY,
+	"all":   tabular.IG_SCRIPT_OUTPUT_ALL_ENTRIES,
+}
+
+/*
+Conversion settings reflecting the options exposed in the web frontend.
+*/
+type ConversionConfig struct {
+	// Output format (see FORMATS)
+	Format string
+	// Tabular output type (see tabular.OUTPUT_TYPES)
+	OutputType string
+	// Dynamic output (only columns for components present in statement)
+	DynamicOutput bool
+	// IG Extended output (component-level nesting)
+	IGExtendedOutput bool
+	// Inclusion of annotations
+	IncludeAnnotations bool
+	// Inclusion of statement type (regulative, constitutive, hybrid)
+	IncludeStatementType bool
+	// Inclusion of complexity measures (tabular output only)
+	IncludeComplexity bool
+	// Inclusion of Degree of Variability (visual output only)
+	IncludeDoV bool
+	// Inclusion of header row (tabular output only)
+	IncludeHeaders bool
+	// Inclusion of Original Statement (see tabular.ORIGINAL_STATEMENT_INCLUSION_OPTIONS)
+	PrintOriginalStatement string
+	// Inclusion of IG Script input (see tabular.IG_SCRIPT_INCLUSION_OPTIONS)
+	PrintIgScript string
+	// Flat printing of properties (visual output only)
+	FlatOutput bool
+	// Binary tree printing (visual output only)
+	BinaryOutput bool
+	// Activation conditions on top of visual tree (visual output only)
+	ActivationConditionsOnTop bool
+}
+
+/*
+Validates the format-related settings of the configuration.
+*/
+func (config ConversionConfig) Validate() error {
+	valid := false
+	for _, format := range FORMATS {
+		if config.Format == format {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return errors.New("unknown output format '" + config.Format + "' (supported: " + strings.Join(FORMATS, ", ") + ")")
+	}
+	return nil
+}
+
+/*
+Returns the core output options (see tree.Options) reflecting the configuration.
+*/
+func (config ConversionConfig) Options() tree.Options {
+	// Default settings as used by web frontend
+	opts := tree.DefaultOptions()
+	opts.SetDynamicOutput(config.DynamicOutput)
+	opts.IGExtendedOutput = config.IGExtendedOutput
+	opts.IncludeAnnotations = config.IncludeAnnotations
+	opts.IncludeStatementType = config.IncludeStatementType
+	opts.IncludeComplexity = config.IncludeComplexity
+	opts.IncludeDegreeOfVariability = config.IncludeDoV
+	opts.IncludeHeaders = config.IncludeHeaders
+	opts.FlatPrinting = config.FlatOutput
+	opts.BinaryPrinting = config.BinaryOutput
+	opts.MoveActivationConditionsToFront = config.ActivationConditionsOnTop
+	return opts
+}
+
+/*
+Converts an individual input statement into the configured output format.
+Returns the generated output and parsing error (tree.PARSING_NO_ERROR if successful).
+*/
+func ConvertStatement(config ConversionConfig, stmt InputStatement) (string, tree.ParsingError) {
+	switch config.Format {
+	case FORMAT_TABULAR:
+		results, err := endpoints.ConvertIGScriptToTabularOutput(stmt.Original, stmt.Coded, stmt.Id, config.OutputType,
+			"", true, config.Options(), config.PrintOriginalStatement, config.PrintIgScript)
+		if err.ErrorCode != tree.PARSING_NO_ERROR && err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+			return "", err
+		}
+		output := ""
+		for _, res := range results {
+			output += res.Output
+		}
+		return output, err
+	case FORMAT_VISUAL:
+		return endpoints.ConvertIGScriptToVisualTree(stmt.Coded, stmt.Id, "", config.Options())
+	case FORMAT_JSON:
+		return endpoints.ConvertIGScriptToJSON(stmt.Coded, stmt.Id, "")
+	case FORMAT_XML:
+		return endpoints.ConvertIGScriptToXML(stmt.Coded, stmt.Id, "")
+	case FORMAT_STANDOFF:
+		return endpoints.ConvertIGScriptToStandoff(stmt.Original, stmt.Coded, stmt.Id, "")
+	}
+	return "", tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_OUTPUT_TYPE,
+		ErrorMessage: "Unknown output format '" + config.Format + "'."}
+}
+
+/*
+Converts all input statements into a single tabular output with consistent schema (i.e., a single header row
+and merged columns in dynamic output mode).
+Returns the combined output alongside errors and warnings for individual statements.
+*/
+func ConvertCorpus(config ConversionConfig, stmts []InputStatement) tabular.CorpusTabularOutputResult {
+	records := []tabular.StatementRecord{}
+	for _, stmt := range stmts {
+		records = append(records, tabular.StatementRecord{Id: stmt.Id, OriginalStatement: stmt.Original, IgScript: stmt.Coded})
+	}
+	return endpoints.ConvertIGScriptCorpusToTabularOutput(records, config.OutputType, "", true,
+		config.Options(), config.PrintOriginalStatement, config.PrintIgScript)
+}
+
+/*
+Returns the file extension for output files of the configured format.
+*/
+func (config ConversionConfig) FileExtension() string {
+	switch config.Format {
+	case FORMAT_TABULAR:
+		if config.OutputType == tabular.OUTPUT_TYPE_CSV {
+			return ".csv"
+		}
+		return ".txt"
+	case FORMAT_XML:
+		return ".xml"
+	}
+	return ".json"
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"IG-Parser/core/config"
+	"IG-Parser/core/endpoints"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+/*
+This file contains the 'import' subcommand, which reconstructs IG Script-encoded statements from tabular output
+generated by IG Parser (see tabular.ParseTabularInput()), and writes them in the input format of the command-line
+tool (i.e., 'ID<TAB>IG Script' or 'ID<TAB>Original Statement<TAB>IG Script').
+*/
+
+// Name of subcommand for the reconstruction of statements from tabular output
+const COMMAND_IMPORT = "import"
+
+/*
+Runs the 'import' subcommand with the given arguments (i.e., excluding the subcommand name) and streams, and
+returns the exit code (EXIT_SUCCESS, EXIT_PARSING_ERROR if any statement could not be reconstructed,
+EXIT_USAGE_ERROR for invalid arguments or input, EXIT_IO_ERROR if input or output files could not be accessed).
+*/
+func runImport(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+
+	flags := flag.NewFlagSet("igparser "+COMMAND_IMPORT, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "IG Parser "+config.IG_PARSER_VERSION+" - Reconstruction of IG Script-encoded statements from tabular output")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Usage: igparser "+COMMAND_IMPORT+" [options]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Input holds tabular output of IG Parser (IG Extended, static schema, CSV or Google Sheets format including header row).")
+		fmt.Fprintln(stderr, "Output holds one statement per line as 'ID<TAB>IG Script' or 'ID<TAB>Original Statement<TAB>IG Script'.")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Options:")
+		flags.PrintDefaults()
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Exit codes: 0 (success), 1 (statement not reconstructable), 2 (invalid arguments or input), 3 (I/O error)")
+	}
+
+	input := flags.String("input", STDIO, "Input file ('"+STDIO+"' for stdin)")
+	output := flags.String("output", STDIO, "Output file ('"+STDIO+"' for stdout)")
+
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return EXIT_SUCCESS
+		}
+		return EXIT_USAGE_ERROR
+	}
+	if flags.NArg() != 0 {
+		fmt.Fprintln(stderr, "Unexpected arguments:", strings.Join(flags.Args(), " "))
+		flags.Usage()
+		return EXIT_USAGE_ERROR
+	}
+
+	// Read input
+	reader := stdin
+	if *input != STDIO {
+		f, err := os.Open(*input)
+		if err != nil {
+			fmt.Fprintln(stderr, "Error opening input file:", err.Error())
+			return EXIT_IO_ERROR
+		}
+		defer f.Close()
+		reader = f
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		fmt.Fprintln(stderr, "Error reading input:", err.Error())
+		return EXIT_IO_ERROR
+	}
+
+	// Reconstruct statements and report statements that could not be reconstructed
+	records, stmtErrors, parsingErr := endpoints.ConvertTabularToIGScript(string(content))
+	if len(records) == 0 && len(stmtErrors) == 0 {
+		fmt.Fprintln(stderr, "Invalid input:", parsingErr.ErrorCode, "-", parsingErr.ErrorMessage)
+		return EXIT_USAGE_ERROR
+	}
+	exitCode := EXIT_SUCCESS
+	for _, stmtErr := range stmtErrors {
+		fmt.Fprintln(stderr, "Error in statement '"+stmtErr.Id+"': "+stmtErr.Error.ErrorCode+" - "+stmtErr.Error.ErrorMessage)
+		exitCode = EXIT_PARSING_ERROR
+	}
+	out := strings.Builder{}
+	for _, record := range records {
+		out.WriteString(record.Id + "\t")
+		if record.OriginalStatement != "" {
+			out.WriteString(record.OriginalStatement + "\t")
+		}
+		out.WriteString(record.IgScript + "\n")
+	}
+
+	writer := stdout
+	if *output != STDIO {
+		f, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(stderr, "Error creating output file:", err.Error())
+			return EXIT_IO_ERROR
+		}
+		defer f.Close()
+		writer = f
+	}
+	if _, err := io.WriteString(writer, out.String()); err != nil {
+		fmt.Fprintln(stderr, "Error writing output:", err.Error())
+		return EXIT_IO_ERROR
+	}
+
+	return exitCode
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+/*
+This file contains the reading of IG Script-encoded statements from files or stdin.
+*/
+
+// Separator between columns of input lines (statement ID, optional original statement, IG Script-encoded statement)
+const INPUT_SEPARATOR = "\t"
+
+// Prefix for comment lines in input (ignored during processing)
+const INPUT_COMMENT_PREFIX = "#"
+
+/*
+Individual statement read from input.
+*/
+type InputStatement struct {
+	// Statement ID
+	Id string
+	// Original statement (optional)
+	Original string
+	// IG Script-encoded statement
+	Coded string
+	// Line number in input (for error reporting)
+	Line int
+}
+
+/*
+Reads statements from the given reader. Each non-empty line that is not a comment (i.e., starting with '#') holds
+a statement in tab-separated form, either as 'ID<TAB>IG Script' or 'ID<TAB>Original Statement<TAB>IG Script'.
+Returns read statements, or error if lines are malformed or statement IDs are duplicated.
+*/
+func ReadStatements(reader io.Reader) ([]InputStatement, error) {
+
+	stmts := []InputStatement{}
+	ids := map[string]int{}
+
+	scanner := bufio.NewScanner(reader)
+	// Allow for long statements (default token size is 64KB)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(strings.TrimSpace(line), INPUT_COMMENT_PREFIX) {
+			continue
+		}
+
+		columns := strings.Split(line, INPUT_SEPARATOR)
+		stmt := InputStatement{Id: strings.TrimSpace(columns[0]), Line: lineNo}
+		switch len(columns) {
+		case 2:
+			stmt.Coded = strings.TrimSpace(columns[1])
+		case 3:
+			stmt.Original = strings.TrimSpace(columns[1])
+			stmt.Coded = strings.TrimSpace(columns[2])
+		default:
+			return nil, fmt.Errorf("line %d: expected 2 or 3 tab-separated columns (ID, optional original statement, "+
+				"IG Script), but found %d", lineNo, len(columns))
+		}
+
+		if stmt.Id == "" {
+			return nil, fmt.Errorf("line %d: missing statement ID", lineNo)
+		}
+		if previous, ok := ids[stmt.Id]; ok {
+			return nil, fmt.Errorf("line %d: duplicate statement ID '%s' (first used in line %d)", lineNo, stmt.Id, previous)
+		}
+		ids[stmt.Id] = lineNo
+
+		stmts = append(stmts, stmt)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(stmts) == 0 {
+		return nil, errors.New("input does not contain any statement")
+	}
+
+	return stmts, nil
+}
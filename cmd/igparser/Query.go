@@ -0,0 +1,127 @@
+package main
+
+import (
+	"IG-Parser/core/config"
+	"IG-Parser/core/query"
+	"IG-Parser/core/tree"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+/*
+This file contains the 'query' subcommand, which evaluates structural queries (see core/query) against the
+statements read from a file (or stdin), and writes the matching statements alongside the selected elements
+in CSV or JSON format.
+*/
+
+// Name of subcommand for structural queries
+const COMMAND_QUERY = "query"
+
+/*
+Runs the 'query' subcommand with the given arguments (i.e., excluding the subcommand name) and streams, and
+returns the exit code (EXIT_SUCCESS, EXIT_PARSING_ERROR if any statement failed to parse, EXIT_USAGE_ERROR for
+invalid arguments, queries or input, EXIT_IO_ERROR if input or output files could not be accessed).
+*/
+func runQuery(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+
+	flags := flag.NewFlagSet("igparser "+COMMAND_QUERY, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "IG Parser "+config.IG_PARSER_VERSION+" - Structural queries over IG Script-encoded statements")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Usage: igparser "+COMMAND_QUERY+" [options] <query>")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Example: igparser "+COMMAND_QUERY+" -input statements.tsv \"A[content*=farmer] & Cac:nested:has(I)\"")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Options:")
+		flags.PrintDefaults()
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Exit codes: 0 (success), 1 (parsing error), 2 (invalid arguments, query or input), 3 (I/O error)")
+	}
+
+	input := flags.String("input", STDIO, "Input file ('"+STDIO+"' for stdin)")
+	output := flags.String("output", STDIO, "Output file ('"+STDIO+"' for stdout)")
+	format := flags.String("format", query.OUTPUT_FORMAT_CSV, "Output format ("+strings.Join(query.OUTPUT_FORMATS, ", ")+")")
+
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return EXIT_SUCCESS
+		}
+		return EXIT_USAGE_ERROR
+	}
+	if flags.NArg() != 1 {
+		fmt.Fprintln(stderr, "Expected exactly one query, but found", flags.NArg(), "arguments")
+		flags.Usage()
+		return EXIT_USAGE_ERROR
+	}
+	q, err := query.Parse(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintln(stderr, "Invalid query:", err.Error())
+		return EXIT_USAGE_ERROR
+	}
+	generate, ok := map[string]func(query.Result) (string, error){
+		query.OUTPUT_FORMAT_CSV:  query.GenerateCSV,
+		query.OUTPUT_FORMAT_JSON: query.GenerateJSON,
+	}[*format]
+	if !ok {
+		fmt.Fprintln(stderr, "Invalid output format '"+*format+"'")
+		return EXIT_USAGE_ERROR
+	}
+
+	// Read input
+	reader := stdin
+	if *input != STDIO {
+		f, err := os.Open(*input)
+		if err != nil {
+			fmt.Fprintln(stderr, "Error opening input file:", err.Error())
+			return EXIT_IO_ERROR
+		}
+		defer f.Close()
+		reader = f
+	}
+	stmts, err := ReadStatements(reader)
+	if err != nil {
+		fmt.Fprintln(stderr, "Invalid input:", err.Error())
+		return EXIT_USAGE_ERROR
+	}
+	records := []query.Record{}
+	stmtsById := map[string]InputStatement{}
+	for _, stmt := range stmts {
+		records = append(records, query.Record{StmtId: stmt.Id, Statement: stmt.Coded})
+		stmtsById[stmt.Id] = stmt
+	}
+
+	// Evaluate query and report statements excluded from it
+	result := query.Search(records, q)
+	exitCode := EXIT_SUCCESS
+	for _, excluded := range result.Excluded {
+		reportErrors(stderr, stmtsById[excluded.StmtId], tree.ParsingError{ErrorCode: excluded.ErrorCode,
+			ErrorMessage: excluded.ErrorMessage})
+		exitCode = EXIT_PARSING_ERROR
+	}
+	out, err := generate(result)
+	if err != nil {
+		fmt.Fprintln(stderr, "Error generating output:", err.Error())
+		return EXIT_PARSING_ERROR
+	}
+
+	writer := stdout
+	if *output != STDIO {
+		f, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(stderr, "Error creating output file:", err.Error())
+			return EXIT_IO_ERROR
+		}
+		defer f.Close()
+		writer = f
+	}
+	if _, err := io.WriteString(writer, out); err != nil {
+		fmt.Fprintln(stderr, "Error writing output:", err.Error())
+		return EXIT_IO_ERROR
+	}
+
+	return exitCode
+}
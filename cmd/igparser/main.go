@@ -0,0 +1,287 @@
+package main
+
+import (
+	"IG-Parser/core/config"
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+/*
+This file is the main entry point for the IG Parser as a command-line tool for the batch conversion
+of IG Script-encoded statements. It relies on the IG Parser core package functionality.
+
+Input is read from a file (or stdin) and holds one statement per line in tab-separated form
+('ID<TAB>IG Script' or 'ID<TAB>Original Statement<TAB>IG Script'; see ReadStatements()).
+Output is written to stdout, an individual file, or one file per statement in a given directory.
+The subcommand 'query' evaluates structural queries against the statements instead (see Query.go).
+The subcommand 'import' reconstructs statements in this input format from tabular output (see Import.go).
+*/
+
+// Exit codes
+const EXIT_SUCCESS = 0
+const EXIT_PARSING_ERROR = 1
+const EXIT_USAGE_ERROR = 2
+const EXIT_IO_ERROR = 3
+
+// Name used for stdin/stdout in input/output flags
+const STDIO = "-"
+
+// Characters not permitted in output file names derived from statement IDs
+var invalidFilenameCharacters = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+/*
+Main entry point for command-line version of IG Parser.
+*/
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+/*
+Runs the command-line tool with the given arguments and streams, and returns the exit code
+(EXIT_SUCCESS, EXIT_PARSING_ERROR if any statement failed to parse, EXIT_USAGE_ERROR for invalid arguments or input,
+EXIT_IO_ERROR if input or output files could not be accessed).
+*/
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+
+	// Subcommands
+	if len(args) > 0 && args[0] == COMMAND_QUERY {
+		return runQuery(args[1:], stdin, stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == COMMAND_IMPORT {
+		return runImport(args[1:], stdin, stdout, stderr)
+	}
+
+	flags := flag.NewFlagSet("igparser", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "IG Parser "+config.IG_PARSER_VERSION+" - Batch conversion of IG Script-encoded statements")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Usage: igparser [options]")
+		fmt.Fprintln(stderr, "       igparser "+COMMAND_QUERY+" [options] <query> (see 'igparser "+COMMAND_QUERY+" -h')")
+		fmt.Fprintln(stderr, "       igparser "+COMMAND_IMPORT+" [options] (see 'igparser "+COMMAND_IMPORT+" -h')")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Input holds one statement per line as 'ID<TAB>IG Script' or 'ID<TAB>Original Statement<TAB>IG Script'.")
+		fmt.Fprintln(stderr, "Empty lines and lines starting with '"+INPUT_COMMENT_PREFIX+"' are ignored.")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Options:")
+		flags.PrintDefaults()
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Exit codes: 0 (success), 1 (parsing error), 2 (invalid arguments or input), 3 (I/O error)")
+	}
+
+	input := flags.String("input", STDIO, "Input file ('"+STDIO+"' for stdin)")
+	output := flags.String("output", STDIO, "Output file ('"+STDIO+"' for stdout); ignored if -outdir is specified")
+	outDir := flags.String("outdir", "", "Output directory for one file per statement (named after statement ID)")
+	format := flags.String("format", FORMAT_TABULAR, "Output format ("+strings.Join(FORMATS, ", ")+")")
+	outputType := flags.String("type", "googlesheets", "Tabular output type ("+strings.Join(sortedKeys(TABULAR_OUTPUT_TYPES), ", ")+")")
+	dynamic := flags.Bool("dynamic", false, "Dynamic output schema (only columns for components present in statements)")
+	extended := flags.Bool("extended", tree.DefaultOptions().IGExtendedOutput, "IG Extended output (component-level nesting)")
+	annotations := flags.Bool("annotations", false, "Include annotations")
+	stmtType := flags.Bool("stmttype", false, "Include statement type (regulative, constitutive, hybrid)")
+	complexity := flags.Bool("complexity", false, "Include complexity measures (Degree of Variability, nesting depth, nested statements, options and complexity per component) (tabular output only)")
+	dov := flags.Bool("dov", false, "Include Degree of Variability (visual output only)")
+	headers := flags.Bool("headers", true, "Include header row (tabular output only)")
+	originalStmt := flags.String("original", "none", "Inclusion of Original Statement in tabular output ("+strings.Join(sortedKeys(ORIGINAL_STATEMENT_INCLUSION), ", ")+")")
+	igScript := flags.String("igscript", "none", "Inclusion of IG Script input in tabular output ("+strings.Join(sortedKeys(IG_SCRIPT_INCLUSION), ", ")+")")
+	flat := flags.Bool("flat", false, "Flat printing of properties (visual output only)")
+	binary := flags.Bool("binary", false, "Binary tree printing (visual output only)")
+	acOnTop := flags.Bool("acontop", false, "Print activation conditions on top of visual tree (visual output only)")
+	strict := flags.Bool("strict", false, "Treat warnings (potentially non-parsed content) as errors")
+
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return EXIT_SUCCESS
+		}
+		return EXIT_USAGE_ERROR
+	}
+	if flags.NArg() > 0 {
+		fmt.Fprintln(stderr, "Unexpected arguments:", strings.Join(flags.Args(), " "))
+		flags.Usage()
+		return EXIT_USAGE_ERROR
+	}
+
+	// Assemble and validate configuration
+	conf := ConversionConfig{
+		Format:                    *format,
+		DynamicOutput:             *dynamic,
+		IGExtendedOutput:          *extended,
+		IncludeAnnotations:        *annotations,
+		IncludeStatementType:      *stmtType,
+		IncludeComplexity:         *complexity,
+		IncludeDoV:                *dov,
+		IncludeHeaders:            *headers,
+		FlatOutput:                *flat,
+		BinaryOutput:              *binary,
+		ActivationConditionsOnTop: *acOnTop,
+	}
+	var ok bool
+	if conf.OutputType, ok = TABULAR_OUTPUT_TYPES[*outputType]; !ok {
+		fmt.Fprintln(stderr, "Invalid tabular output type '"+*outputType+"'")
+		return EXIT_USAGE_ERROR
+	}
+	if conf.PrintOriginalStatement, ok = ORIGINAL_STATEMENT_INCLUSION[*originalStmt]; !ok {
+		fmt.Fprintln(stderr, "Invalid inclusion option for Original Statement '"+*originalStmt+"'")
+		return EXIT_USAGE_ERROR
+	}
+	if conf.PrintIgScript, ok = IG_SCRIPT_INCLUSION[*igScript]; !ok {
+		fmt.Fprintln(stderr, "Invalid inclusion option for IG Script '"+*igScript+"'")
+		return EXIT_USAGE_ERROR
+	}
+	if err := conf.Validate(); err != nil {
+		fmt.Fprintln(stderr, "Invalid configuration:", err.Error())
+		return EXIT_USAGE_ERROR
+	}
+
+	// Read input
+	reader := stdin
+	if *input != STDIO {
+		f, err := os.Open(*input)
+		if err != nil {
+			fmt.Fprintln(stderr, "Error opening input file:", err.Error())
+			return EXIT_IO_ERROR
+		}
+		defer f.Close()
+		reader = f
+	}
+	stmts, err := ReadStatements(reader)
+	if err != nil {
+		fmt.Fprintln(stderr, "Invalid input:", err.Error())
+		return EXIT_USAGE_ERROR
+	}
+
+	// Prepare output
+	if *outDir != "" {
+		if err := os.MkdirAll(*outDir, 0755); err != nil {
+			fmt.Fprintln(stderr, "Error creating output directory:", err.Error())
+			return EXIT_IO_ERROR
+		}
+	}
+	writer := stdout
+	if *outDir == "" && *output != STDIO {
+		f, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(stderr, "Error creating output file:", err.Error())
+			return EXIT_IO_ERROR
+		}
+		defer f.Close()
+		writer = f
+	}
+
+	exitCode := EXIT_SUCCESS
+
+	// Aggregated tabular output is generated with consistent schema across all statements
+	if conf.Format == FORMAT_TABULAR && *outDir == "" {
+		result := ConvertCorpus(conf, stmts)
+		// Index statements for error reporting
+		stmtsById := map[string]InputStatement{}
+		for _, stmt := range stmts {
+			stmtsById[stmt.Id] = stmt
+		}
+		for _, stmtErr := range result.StatementErrors {
+			if stmtErr.Error.ErrorCode == tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT && !*strict {
+				fmt.Fprintln(stderr, describeError("Warning", stmtsById[stmtErr.Id], stmtErr.Error))
+			} else {
+				reportErrors(stderr, stmtsById[stmtErr.Id], stmtErr.Error)
+				exitCode = EXIT_PARSING_ERROR
+			}
+		}
+		if result.Error.ErrorCode != tree.PARSING_NO_ERROR {
+			fmt.Fprintln(stderr, "Error generating output:", result.Error.ErrorCode, "-", result.Error.ErrorMessage)
+			return EXIT_PARSING_ERROR
+		}
+		if _, err := io.WriteString(writer, result.Output); err != nil {
+			fmt.Fprintln(stderr, "Error writing output:", err.Error())
+			return EXIT_IO_ERROR
+		}
+		return exitCode
+	}
+
+	for _, stmt := range stmts {
+		out, parsingErr := ConvertStatement(conf, stmt)
+		if parsingErr.ErrorCode != tree.PARSING_NO_ERROR {
+			if parsingErr.ErrorCode == tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT && !*strict {
+				fmt.Fprintln(stderr, describeError("Warning", stmt, parsingErr))
+			} else {
+				reportErrors(stderr, stmt, parsingErr)
+				exitCode = EXIT_PARSING_ERROR
+				continue
+			}
+		}
+
+		if *outDir != "" {
+			filename := filepath.Join(*outDir, invalidFilenameCharacters.ReplaceAllString(stmt.Id, "_")+conf.FileExtension())
+			if err := os.WriteFile(filename, []byte(out), 0644); err != nil {
+				fmt.Fprintln(stderr, "Error writing output file:", err.Error())
+				return EXIT_IO_ERROR
+			}
+			continue
+		}
+		if conf.Format != FORMAT_TABULAR && !strings.HasSuffix(out, "\n") {
+			// Separate individual documents in aggregated output
+			out += "\n"
+		}
+		if _, err := io.WriteString(writer, out); err != nil {
+			fmt.Fprintln(stderr, "Error writing output:", err.Error())
+			return EXIT_IO_ERROR
+		}
+	}
+
+	return exitCode
+}
+
+/*
+Reports all errors and warnings for a failed input statement (see parser.ParseStatementWithDiagnostics()),
+so that multiple problems in a statement can be fixed at once. Falls back to the given error if the
+diagnostics do not contain errors (e.g., for errors during output generation).
+*/
+func reportErrors(stderr io.Writer, stmt InputStatement, err tree.ParsingError) {
+	_, diagnostics := parser.ParseStatementWithDiagnostics(stmt.Coded)
+	if !tree.HasErrors(diagnostics) {
+		diagnostics = []tree.Diagnostic{{Severity: tree.SEVERITY_ERROR, ParsingError: err}}
+	}
+	for _, d := range diagnostics {
+		switch d.Severity {
+		case tree.SEVERITY_ERROR:
+			fmt.Fprintln(stderr, describeError("Error", stmt, d.ParsingError))
+		case tree.SEVERITY_WARNING:
+			fmt.Fprintln(stderr, describeError("Warning", stmt, d.ParsingError))
+		}
+	}
+}
+
+/*
+Produces a human-readable description of a parsing error or warning for a given input statement.
+*/
+func describeError(prefix string, stmt InputStatement, err tree.ParsingError) string {
+	msg := fmt.Sprintf("%s in statement '%s' (line %d): %s", prefix, stmt.Id, stmt.Line, err.ErrorCode)
+	if err.ErrorMessage != "" {
+		msg += " - " + err.ErrorMessage
+	}
+	if len(err.ErrorIgnoredElements) > 0 {
+		msg += " (Ignored elements: \"" + strings.Join(err.ErrorIgnoredElements, ", ") + "\")"
+	}
+	if len(err.ErrorSpans) > 0 && err.ErrorSpans[0].IsLocated() {
+		msg += fmt.Sprintf(" (IG Script line %d, column %d)", err.ErrorSpans[0].Line, err.ErrorSpans[0].Column)
+	}
+	return msg
+}
+
+/*
+Returns the sorted keys of a given map (for consistent printing of options).
+*/
+func sortedKeys(options map[string]string) []string {
+	keys := []string{}
+	for k := range options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
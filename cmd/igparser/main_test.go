@@ -0,0 +1,361 @@
+package main
+
+import (
+	"IG-Parser/core/tree"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+/*
+Tests reading of statements with and without Original Statement, including comments and empty lines.
+*/
+func TestReadStatements(t *testing.T) {
+
+	input := "# Comment line\n" +
+		"1\tA(farmer) D(must) I(comply)\n" +
+		"\n" +
+		"2\tFarmers may sell.\tA(farmer) D(may) I(sell)\r\n"
+
+	stmts, err := ReadStatements(strings.NewReader(input))
+	if err != nil {
+		t.Fatal("Reading of statements should not fail. Error:", err)
+	}
+
+	if len(stmts) != 2 {
+		t.Fatal("Number of read statements is wrong:", len(stmts))
+	}
+
+	if stmts[0].Id != "1" || stmts[0].Original != "" || stmts[0].Coded != "A(farmer) D(must) I(comply)" || stmts[0].Line != 2 {
+		t.Fatal("First statement is read incorrectly:", stmts[0])
+	}
+
+	if stmts[1].Id != "2" || stmts[1].Original != "Farmers may sell." || stmts[1].Coded != "A(farmer) D(may) I(sell)" || stmts[1].Line != 4 {
+		t.Fatal("Second statement is read incorrectly:", stmts[1])
+	}
+}
+
+/*
+Tests rejection of malformed input (missing columns, duplicate IDs, empty input).
+*/
+func TestReadStatementsInvalidInput(t *testing.T) {
+
+	inputs := []string{
+		"A(farmer) D(must) I(comply)\n",
+		"1\tA(farmer)\n1\tA(citizen)\n",
+		"\tA(farmer)\n",
+		"# Only comment\n",
+	}
+
+	for _, input := range inputs {
+		_, err := ReadStatements(strings.NewReader(input))
+		if err == nil {
+			t.Fatal("Reading of input should fail:", input)
+		}
+	}
+}
+
+/*
+Tests conversion of multiple statements into CSV output on stdout, including single header row.
+*/
+func TestRunTabularOutput(t *testing.T) {
+
+	input := "1\tFarmers must comply.\tA(farmer) D(must) I(comply)\n" +
+		"2\tFarmers may sell.\tA(farmer) D(may) I(sell)\n"
+
+	stdout := bytes.Buffer{}
+	stderr := bytes.Buffer{}
+	code := run([]string{"-type", "csv", "-original", "all"}, strings.NewReader(input), &stdout, &stderr)
+	if code != EXIT_SUCCESS {
+		t.Fatal("Conversion should succeed, but returned exit code", code, "Error output:", stderr.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatal("Output should contain header row and two statements, but contains", len(lines), "lines:", stdout.String())
+	}
+
+	if !strings.HasPrefix(lines[0], "Statement ID|Original Statement|Attributes|") {
+		t.Fatal("Header row is incorrect:", lines[0])
+	}
+
+	if !strings.HasPrefix(lines[1], "'1|Farmers must comply.|farmer|") ||
+		!strings.HasPrefix(lines[2], "'2|Farmers may sell.|farmer|") {
+		t.Fatal("Generated output is incorrect:", stdout.String())
+	}
+}
+
+/*
+Tests stand-off output with positions in Original Statement.
+*/
+func TestRunStandoffOutput(t *testing.T) {
+
+	input := "1\tFarmers must comply.\tA(Farmers) D(must) I(comply)\n"
+
+	stdout := bytes.Buffer{}
+	stderr := bytes.Buffer{}
+	code := run([]string{"-format", FORMAT_STANDOFF}, strings.NewReader(input), &stdout, &stderr)
+	if code != EXIT_SUCCESS {
+		t.Fatal("Conversion should succeed, but returned exit code", code, "Error output:", stderr.String())
+	}
+
+	if !strings.Contains(stdout.String(), "\"originalStatement\": \"Farmers must comply.\"") ||
+		strings.Count(stdout.String(), "\"originalStatementSpan\"") != 3 {
+		t.Fatal("Generated output is incorrect:", stdout.String())
+	}
+}
+
+/*
+Tests combination of multiple statements with varying components into a single table in dynamic output mode.
+*/
+func TestRunTabularDynamicOutput(t *testing.T) {
+
+	input := "1\tA(farmer) D(must) I(comply)\n" +
+		"2\tA(certifier) I(inspect) Cex(regularly)\n"
+
+	stdout := bytes.Buffer{}
+	code := run([]string{"-type", "csv", "-dynamic"}, strings.NewReader(input), &stdout, &bytes.Buffer{})
+	if code != EXIT_SUCCESS {
+		t.Fatal("Conversion should succeed, but returned exit code", code)
+	}
+
+	expectedOutput := "Statement ID|Attributes|Deontic|Aim|Execution Constraint|Logical Linkage (Statements)|Logical Linkage (Components)|\n" +
+		"'1|farmer|must|comply||||\n" +
+		"'2|certifier||inspect|regularly|||\n"
+
+	if stdout.String() != expectedOutput {
+		t.Fatal("Generated output is incorrect:", stdout.String())
+	}
+}
+
+/*
+Tests whether the default flags produce the output of the default options used by the library (tree.DefaultOptions()),
+e.g., IG Extended output for component-level nesting.
+*/
+func TestRunDefaultOptions(t *testing.T) {
+
+	input := "1\tA(farmer) D(must) I(comply) Bdir{A(council) I(approves)}\n"
+
+	defaultOutput := bytes.Buffer{}
+	code := run([]string{"-type", "csv"}, strings.NewReader(input), &defaultOutput, &bytes.Buffer{})
+	if code != EXIT_SUCCESS {
+		t.Fatal("Conversion should succeed, but returned exit code", code)
+	}
+
+	flag := "-extended=" + fmt.Sprint(tree.DefaultOptions().IGExtendedOutput)
+	explicitOutput := bytes.Buffer{}
+	code = run([]string{"-type", "csv", flag}, strings.NewReader(input), &explicitOutput, &bytes.Buffer{})
+	if code != EXIT_SUCCESS {
+		t.Fatal("Conversion should succeed, but returned exit code", code)
+	}
+	if defaultOutput.String() != explicitOutput.String() {
+		t.Fatal("Default output should correspond to output with", flag, "but is:", defaultOutput.String())
+	}
+
+	otherOutput := bytes.Buffer{}
+	code = run([]string{"-type", "csv", "-extended=" + fmt.Sprint(!tree.DefaultOptions().IGExtendedOutput)},
+		strings.NewReader(input), &otherOutput, &bytes.Buffer{})
+	if code != EXIT_SUCCESS {
+		t.Fatal("Conversion should succeed, but returned exit code", code)
+	}
+	if defaultOutput.String() == otherOutput.String() {
+		t.Fatal("Output should differ depending on IG Extended output, but is:", otherOutput.String())
+	}
+}
+
+/*
+Tests non-zero exit code on parsing errors, while still converting valid statements.
+*/
+func TestRunParsingError(t *testing.T) {
+
+	input := "1\tA(farmer) D(must) I(comply)\n" +
+		"2\tA(farmer D(may) I(sell)\n"
+
+	stdout := bytes.Buffer{}
+	stderr := bytes.Buffer{}
+	code := run([]string{"-format", "json"}, strings.NewReader(input), &stdout, &stderr)
+	if code != EXIT_PARSING_ERROR {
+		t.Fatal("Conversion should fail with parsing error, but returned exit code", code)
+	}
+
+	if !strings.Contains(stdout.String(), "\"statementId\": \"1\"") {
+		t.Fatal("Valid statement should still be converted:", stdout.String())
+	}
+
+	if !strings.Contains(stderr.String(), "Error in statement '2' (line 2)") {
+		t.Fatal("Parsing error should be reported for second statement:", stderr.String())
+	}
+}
+
+/*
+Tests reporting of all errors in a statement (as opposed to the first one only).
+*/
+func TestRunMultipleParsingErrors(t *testing.T) {
+
+	input := "1\tA((x [AND] y [OR] z)) D(must) I((a [AND] b [XOR] c))\n"
+
+	stderr := bytes.Buffer{}
+	code := run([]string{"-format", "json"}, strings.NewReader(input), &bytes.Buffer{}, &stderr)
+	if code != EXIT_PARSING_ERROR {
+		t.Fatal("Conversion should fail with parsing error, but returned exit code", code)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stderr.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatal("Both errors should be reported:", stderr.String())
+	}
+	if !strings.Contains(lines[0], "(IG Script line 1, column 1)") || !strings.Contains(lines[1], "(IG Script line 1, column 31)") {
+		t.Fatal("Errors should be reported with position:", stderr.String())
+	}
+}
+
+/*
+Tests treatment of warnings as errors in strict mode.
+*/
+func TestRunStrictWarnings(t *testing.T) {
+
+	input := "1\tA(farmer) D(must) I(comply) (unparsed content)\n"
+
+	code := run([]string{"-format", "visual"}, strings.NewReader(input), &bytes.Buffer{}, &bytes.Buffer{})
+	if code != EXIT_SUCCESS {
+		t.Fatal("Warnings should not fail conversion, but returned exit code", code)
+	}
+
+	code = run([]string{"-format", "visual", "-strict"}, strings.NewReader(input), &bytes.Buffer{}, &bytes.Buffer{})
+	if code != EXIT_PARSING_ERROR {
+		t.Fatal("Warnings should fail conversion in strict mode, but returned exit code", code)
+	}
+}
+
+/*
+Tests writing of one output file per statement into output directory.
+*/
+func TestRunOutputDirectory(t *testing.T) {
+
+	dir := t.TempDir()
+	input := "stmt/1\tA(farmer) D(must) I(comply)\n" +
+		"stmt 2\tA(farmer) D(may) I(sell)\n"
+
+	code := run([]string{"-format", "xml", "-outdir", dir}, strings.NewReader(input), &bytes.Buffer{}, &bytes.Buffer{})
+	if code != EXIT_SUCCESS {
+		t.Fatal("Conversion should succeed, but returned exit code", code)
+	}
+
+	for _, name := range []string{"stmt_1.xml", "stmt_2.xml"} {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal("Output file "+name+" has not been written. Error:", err)
+		}
+		if !strings.Contains(string(content), "<statement") {
+			t.Fatal("Output file "+name+" does not contain XML output:", string(content))
+		}
+	}
+}
+
+/*
+Tests rejection of invalid arguments.
+*/
+func TestRunInvalidArguments(t *testing.T) {
+
+	args := [][]string{
+		{"-format", "yaml"},
+		{"-type", "excel"},
+		{"-original", "some"},
+		{"-igscript", "some"},
+		{"unexpected"},
+	}
+
+	for _, arg := range args {
+		code := run(arg, strings.NewReader("1\tA(farmer)\n"), &bytes.Buffer{}, &bytes.Buffer{})
+		if code != EXIT_USAGE_ERROR {
+			t.Fatal("Invalid arguments", arg, "should be rejected, but returned exit code", code)
+		}
+	}
+}
+
+/*
+Tests the 'query' subcommand, including reporting of statements that cannot be parsed and rejection of
+invalid queries.
+*/
+func TestRunQuery(t *testing.T) {
+
+	input := "1\tA(farmer) D(must) I((sell [XOR] buy))\n" +
+		"2\tA(farmer) D(may) I(sell) Cac{A(council) I(approves)}\n" +
+		"3\tA(farmer) I(sell\n"
+
+	stdout := bytes.Buffer{}
+	stderr := bytes.Buffer{}
+	code := run([]string{COMMAND_QUERY, "Cac > A[content^=coun]"}, strings.NewReader(input), &stdout, &stderr)
+	if code != EXIT_PARSING_ERROR {
+		t.Fatal("Query with unparseable statement should return exit code", EXIT_PARSING_ERROR, "but returned", code)
+	}
+	if stdout.String() != "Statement ID,Path,Component,Content,Suffix,Annotations,Level\n2,Cac/A,A,council,,,1\n" {
+		t.Fatal("Query output is wrong:\n", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "Error in statement '3' (line 3)") {
+		t.Fatal("Unparseable statement should be reported, but stderr was:", stderr.String())
+	}
+
+	stdout.Reset()
+	code = run([]string{COMMAND_QUERY, "-format", "json", "I[operator=XOR]"}, strings.NewReader(input), &stdout, &bytes.Buffer{})
+	if code != EXIT_PARSING_ERROR || !strings.Contains(stdout.String(), "\"matchingStatements\": 1") {
+		t.Fatal("JSON query output is wrong (exit code", code, "):\n", stdout.String())
+	}
+
+	args := [][]string{
+		{COMMAND_QUERY},
+		{COMMAND_QUERY, "A[color=red]"},
+		{COMMAND_QUERY, "-format", "xlsx", "A"},
+		{COMMAND_QUERY, "A", "I"},
+	}
+	for _, arg := range args {
+		code := run(arg, strings.NewReader(input), &bytes.Buffer{}, &bytes.Buffer{})
+		if code != EXIT_USAGE_ERROR {
+			t.Fatal("Invalid arguments", arg, "should be rejected, but returned exit code", code)
+		}
+	}
+}
+
+/*
+Tests the 'import' subcommand by reconstructing statements from tabular output generated by the command-line
+tool, including reporting of rows that cannot be reconstructed and rejection of invalid input.
+*/
+func TestRunImport(t *testing.T) {
+
+	input := "1\tFarmers must sell or buy.\tA(farmer) D(must) I((sell [XOR] buy))\n" +
+		"2\tA(farmer) D(may) I(sell) Cac{A(council) I(approves)}\n"
+
+	table := bytes.Buffer{}
+	code := run([]string{"-type", "csv", "-extended", "-original", "all"}, strings.NewReader(input), &table, &bytes.Buffer{})
+	if code != EXIT_SUCCESS {
+		t.Fatal("Conversion should succeed, but returned exit code", code)
+	}
+
+	stdout := bytes.Buffer{}
+	stderr := bytes.Buffer{}
+	code = run([]string{COMMAND_IMPORT}, strings.NewReader(table.String()), &stdout, &stderr)
+	if code != EXIT_SUCCESS {
+		t.Fatal("Import should succeed, but returned exit code", code, "Error output:", stderr.String())
+	}
+	expectedOutput := "1\tFarmers must sell or buy.\tA(farmer) D(must) I((sell [XOR] buy))\n" +
+		"2\tA(farmer) D(may) I(sell) Cac{A(council) I(approves)}\n"
+	if stdout.String() != expectedOutput {
+		t.Fatal("Import output is wrong:\n", stdout.String())
+	}
+
+	// Repeated values cannot be reconstructed
+	invalidTable := strings.Replace(table.String(), "|buy|", "|sell|", 1)
+	stderr.Reset()
+	code = run([]string{COMMAND_IMPORT}, strings.NewReader(invalidTable), &bytes.Buffer{}, &stderr)
+	if code != EXIT_PARSING_ERROR || !strings.Contains(stderr.String(), "Error in statement '1'") {
+		t.Fatal("Non-reconstructable statement should be reported (exit code", code, "), but stderr was:", stderr.String())
+	}
+
+	code = run([]string{COMMAND_IMPORT}, strings.NewReader("A(farmer) I(sell)\n"), &bytes.Buffer{}, &bytes.Buffer{})
+	if code != EXIT_USAGE_ERROR {
+		t.Fatal("Input without tabular header should be rejected, but returned exit code", code)
+	}
+}
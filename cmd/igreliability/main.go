@@ -0,0 +1,189 @@
+package main
+
+import (
+	"IG-Parser/core/config"
+	"IG-Parser/core/endpoints"
+	"IG-Parser/core/reliability"
+	"IG-Parser/core/tree"
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/*
+This file is the main entry point for the inter-coder reliability analysis as a command-line tool, which computes
+the agreement of coders that have encoded the same statements (see core/reliability).
+
+Each input file holds the codings of one coder (named after the file, excluding its extension), with one statement
+per line in the input format of the command-line version of IG Parser ('ID<TAB>IG Script' or
+'ID<TAB>Original Statement<TAB>IG Script'). Codings are aligned by statement ID. Empty lines and comment lines
+(starting with '#') are ignored.
+*/
+
+// Exit codes
+const EXIT_SUCCESS = 0
+const EXIT_PARSING_ERROR = 1
+const EXIT_USAGE_ERROR = 2
+const EXIT_IO_ERROR = 3
+
+// Name used for stdout in output flag
+const STDIO = "-"
+
+// Separator between columns of input lines
+const INPUT_SEPARATOR = "\t"
+
+// Prefix for comment lines in input
+const INPUT_COMMENT_PREFIX = "#"
+
+/*
+Main entry point for inter-coder reliability analysis.
+*/
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+/*
+Runs the reliability analysis with the given arguments and streams, and returns the exit code (EXIT_SUCCESS,
+EXIT_PARSING_ERROR if any coding could not be parsed, EXIT_USAGE_ERROR for invalid arguments or input,
+EXIT_IO_ERROR if input or output files could not be accessed).
+*/
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+
+	flags := flag.NewFlagSet("igreliability", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "IG Parser "+config.IG_PARSER_VERSION+" - Inter-coder reliability of IG Script-encoded statements")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Usage: igreliability [options] <coder file> <coder file> [<coder file> ...]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Each file holds the codings of one coder (named after the file) with one statement per line as")
+		fmt.Fprintln(stderr, "'ID<TAB>IG Script' or 'ID<TAB>Original Statement<TAB>IG Script'. Empty lines and lines starting with '"+
+			INPUT_COMMENT_PREFIX+"' are ignored.")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Options:")
+		flags.PrintDefaults()
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Exit codes: 0 (success), 1 (parsing error), 2 (invalid arguments or input), 3 (I/O error)")
+	}
+
+	output := flags.String("output", STDIO, "Output file ('"+STDIO+"' for stdout)")
+	format := flags.String("format", reliability.OUTPUT_FORMAT_CSV, "Output format ("+strings.Join(reliability.OUTPUT_FORMATS, ", ")+")")
+
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return EXIT_SUCCESS
+		}
+		return EXIT_USAGE_ERROR
+	}
+	if flags.NArg() < 2 {
+		fmt.Fprintln(stderr, "At least two coder files are required")
+		flags.Usage()
+		return EXIT_USAGE_ERROR
+	}
+	validFormat := false
+	for _, f := range reliability.OUTPUT_FORMATS {
+		validFormat = validFormat || f == *format
+	}
+	if !validFormat {
+		fmt.Fprintln(stderr, "Invalid output format '"+*format+"'")
+		return EXIT_USAGE_ERROR
+	}
+
+	// Read codings of all coders
+	codings := []reliability.Coding{}
+	coders := map[string]string{}
+	for _, filename := range flags.Args() {
+		coder := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+		if previous, ok := coders[coder]; ok {
+			fmt.Fprintln(stderr, "Files '"+previous+"' and '"+filename+"' refer to the same coder '"+coder+"'")
+			return EXIT_USAGE_ERROR
+		}
+		coders[coder] = filename
+		f, err := os.Open(filename)
+		if err != nil {
+			fmt.Fprintln(stderr, "Error opening input file:", err.Error())
+			return EXIT_IO_ERROR
+		}
+		coderCodings, err := readCodings(f, coder)
+		f.Close()
+		if err != nil {
+			fmt.Fprintln(stderr, "Invalid input in file '"+filename+"':", err.Error())
+			return EXIT_USAGE_ERROR
+		}
+		codings = append(codings, coderCodings...)
+	}
+
+	// Analyze codings
+	result, parsingErr := endpoints.AnalyzeReliability(codings, *format, "")
+	if parsingErr.ErrorCode != tree.PARSING_NO_ERROR {
+		msg := parsingErr.ErrorCode
+		if parsingErr.ErrorMessage != "" {
+			msg += " - " + parsingErr.ErrorMessage
+		}
+		fmt.Fprintln(stderr, msg)
+		if parsingErr.ErrorCode == tree.PARSING_ERROR_DUPLICATE_CODING {
+			return EXIT_USAGE_ERROR
+		}
+		return EXIT_PARSING_ERROR
+	}
+	if !strings.HasSuffix(result, "\n") {
+		result += "\n"
+	}
+
+	// Write output
+	writer := stdout
+	if *output != STDIO {
+		f, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(stderr, "Error creating output file:", err.Error())
+			return EXIT_IO_ERROR
+		}
+		defer f.Close()
+		writer = f
+	}
+	if _, err := io.WriteString(writer, result); err != nil {
+		fmt.Fprintln(stderr, "Error writing output:", err.Error())
+		return EXIT_IO_ERROR
+	}
+	return EXIT_SUCCESS
+}
+
+/*
+Reads the codings of a given coder from the given reader, with one statement per line as 'ID<TAB>IG Script' or
+'ID<TAB>Original Statement<TAB>IG Script'. Returns error if lines are malformed.
+*/
+func readCodings(reader io.Reader, coder string) ([]reliability.Coding, error) {
+
+	codings := []reliability.Coding{}
+	scanner := bufio.NewScanner(reader)
+	// Allow for long statements (default token size is 64KB)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(strings.TrimSpace(line), INPUT_COMMENT_PREFIX) {
+			continue
+		}
+		columns := strings.Split(line, INPUT_SEPARATOR)
+		if len(columns) != 2 && len(columns) != 3 {
+			return nil, fmt.Errorf("line %d: expected 2 or 3 tab-separated columns (ID, optional original statement, "+
+				"IG Script), but found %d", lineNo, len(columns))
+		}
+		id := strings.TrimSpace(columns[0])
+		if id == "" {
+			return nil, fmt.Errorf("line %d: missing statement ID", lineNo)
+		}
+		codings = append(codings, reliability.Coding{StmtId: id, Coder: coder,
+			Statement: strings.TrimSpace(columns[len(columns)-1])})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return codings, nil
+}
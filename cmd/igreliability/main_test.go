@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+/*
+Writes coder files with given content to a temporary directory and returns their paths (in order of file names).
+*/
+func writeCoderFiles(t *testing.T, files map[string]string) []string {
+	dir := t.TempDir()
+	paths := []string{}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal("Error writing coder file:", err)
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+/*
+Tests the analysis of coder files in CSV and JSON format, with coders named after files.
+*/
+func TestRunReliability(t *testing.T) {
+
+	paths := writeCoderFiles(t, map[string]string{
+		"alice.txt": "# Coder 1\n1\tFarmers must sell.\tA(farmer) D(must) I(sell)\n2\tA(council) I(approves)\n",
+		"bob.txt":   "1\tA(farmer) D(may) I(sell)\n\n2\tA(council) I(approves) Cac(annually)\n",
+	})
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	code := run(paths, stdout, stderr)
+	if code != EXIT_SUCCESS {
+		t.Fatal("Analysis should succeed, but returned", code, stderr.String())
+	}
+	if !strings.HasPrefix(stdout.String(), "Scope,Statement ID,Component,Dimension") ||
+		!strings.Contains(stdout.String(), "statement,2,,presence,3,0.6667,") {
+		t.Fatal("CSV report is incorrect:\n" + stdout.String())
+	}
+
+	stdout.Reset()
+	code = run(append([]string{"-format", "json"}, paths...), stdout, stderr)
+	if code != EXIT_SUCCESS || !strings.Contains(stdout.String(), "\"coders\": [\n    \"alice\",\n    \"bob\"\n  ]") {
+		t.Fatal("JSON report is incorrect:\n" + stdout.String())
+	}
+}
+
+/*
+Tests the rejection of invalid arguments and unparseable codings.
+*/
+func TestRunReliabilityErrors(t *testing.T) {
+
+	paths := writeCoderFiles(t, map[string]string{
+		"alice.txt": "1\tA(farmer) I(sell)\n",
+		"bob.txt":   "1\tA(farmer) I(sell\n",
+	})
+
+	if code := run(paths[:1], &bytes.Buffer{}, &bytes.Buffer{}); code != EXIT_USAGE_ERROR {
+		t.Fatal("Single coder file should be rejected, but returned", code)
+	}
+	if code := run(append([]string{"-format", "xlsx"}, paths...), &bytes.Buffer{}, &bytes.Buffer{}); code != EXIT_USAGE_ERROR {
+		t.Fatal("Invalid output format should be rejected, but returned", code)
+	}
+	if code := run([]string{paths[0], paths[0]}, &bytes.Buffer{}, &bytes.Buffer{}); code != EXIT_USAGE_ERROR {
+		t.Fatal("Repeated coder should be rejected, but returned", code)
+	}
+	stderr := &bytes.Buffer{}
+	if code := run(paths, &bytes.Buffer{}, stderr); code != EXIT_PARSING_ERROR ||
+		!strings.Contains(stderr.String(), "(coder 'bob')") {
+		t.Fatal("Unparseable coding should be reported, but returned", code, stderr.String())
+	}
+}
@@ -0,0 +1,302 @@
+package anchoring
+
+import (
+	"IG-Parser/core/coverage"
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+This file contains the anchoring of parsed statements to the texts they have been derived from. For each leaf node
+with primitive content, the position of its content in the IG Script input, and where it can be located, in the
+Original Statement is determined and stored in the node (see tree.Node.IGScriptSpan and tree.Node.OriginalStatementSpan).
+
+Leaf content is located in the IG Script input within the component it has been parsed from (considering the
+nesting of components, e.g., 'A' within 'Cac{...}'). Content shared across statements extrapolated from component
+pairs is anchored to the same position. Positions in the Original Statement are derived from the alignment of the
+text content of IG Script input and Original Statement (see coverage.MatchWords()), and span the words of the
+Original Statement aligned with words of the leaf content (excluding inferred content in brackets).
+*/
+
+// Component header (e.g., 'Bdir1,p[annotation]') immediately preceding component content (i.e., parenthesis or brace),
+// and preceded by beginning of statement or character other than letters or digits
+var componentHeaderRegex = regexp.MustCompile("(^|[^\\p{L}\\p{N}])(" + parser.COMPONENT_HEADER_SYNTAX + ")[" +
+	regexp.QuoteMeta(parser.LEFT_PARENTHESIS+parser.LEFT_BRACE) + "]")
+
+// Separator of component symbols in nesting paths of positions (e.g., 'Cac/A')
+const pathSeparator = "/"
+
+/*
+Leaf node anchored in IG Script input (and potentially Original Statement).
+*/
+type AnchoredLeaf struct {
+	// Leaf node (holding positions, see tree.Node.IGScriptSpan and tree.Node.OriginalStatementSpan)
+	Node *tree.Node
+	// Component symbol of leaf (e.g., 'Bdir,p')
+	Component string
+	// Component symbols of components embedding the nested statements the leaf is contained in (outermost first)
+	Path []string
+}
+
+/*
+Anchors leaf nodes of statements returned by parser.ParseStatement() to the IG Script input they have been parsed
+from and the Original Statement (optional). Positions are stored in the leaf nodes; leaves whose content cannot be
+located retain nil positions. Returns all leaf nodes with primitive content in order of occurrence in the IG Script
+input (leaves that could not be located last), with leaves shared across statements extrapolated from component
+pairs only being returned once.
+*/
+func AnchorStatements(stmts []*tree.Node, igScript string, originalStatement string) []AnchoredLeaf {
+
+	a := anchorer{
+		input: igScript,
+		// Parser treats line breaks and tabs as whitespace (retaining byte offsets)
+		normalized: strings.NewReplacer("\r", " ", "\n", " ", "\t", " ").Replace(igScript),
+		original:   originalStatement,
+		claimed:    map[int]bool{},
+		visited:    map[*tree.Node]bool{},
+	}
+	a.contexts = contentContexts(a.normalized)
+	if strings.TrimSpace(originalStatement) != "" {
+		a.matches = coverage.MatchWords(originalStatement, igScript)
+	}
+
+	for _, stmt := range stmts {
+		a.anchorNode(stmt, "", []string{})
+	}
+
+	sort.SliceStable(a.leaves, func(i, j int) bool {
+		left := a.leaves[i].Node.IGScriptSpan
+		right := a.leaves[j].Node.IGScriptSpan
+		if left == nil || right == nil {
+			return left != nil
+		}
+		return left.Offset < right.Offset
+	})
+	return a.leaves
+}
+
+/*
+Holds the state of the anchoring of statements.
+*/
+type anchorer struct {
+	// IG Script input
+	input string
+	// IG Script input with normalized whitespace (as parsed)
+	normalized string
+	// Original Statement
+	original string
+	// Nesting path of components for each byte offset of IG Script input (e.g., 'Cac/A')
+	contexts []string
+	// Offsets of IG Script input leaf content has been anchored to
+	claimed map[int]bool
+	// Aligned words of IG Script input and Original Statement
+	matches []coverage.WordMatch
+	// Visited leaf nodes (to prevent repeated anchoring of private nodes)
+	visited map[*tree.Node]bool
+	// Anchored leaves
+	leaves []AnchoredLeaf
+}
+
+/*
+Anchors leaf nodes of a given node, including child nodes, nested statements and private nodes.
+Takes the component symbol inherited from the statement component (for nodes without component type)
+and the path of components embedding the nested statements the node is contained in.
+*/
+func (a *anchorer) anchorNode(node *tree.Node, symbol string, path []string) {
+	if node == nil {
+		return
+	}
+	if node.GetComponentName() != "" {
+		symbol = node.GetComponentName()
+	}
+	switch entry := node.Entry.(type) {
+	case string:
+		if strings.TrimSpace(entry) != "" && !a.visited[node] {
+			a.visited[node] = true
+			a.anchorLeaf(node, entry, symbol, path)
+		}
+	case *tree.Statement:
+		if entry != nil {
+			nestedPath := extendPath(path, symbol)
+			for _, comp := range entry.Components() {
+				a.anchorNode(comp.Node, comp.Symbol, nestedPath)
+			}
+		}
+	case []*tree.Node:
+		for _, pairNode := range entry {
+			a.anchorNode(pairNode, symbol, path)
+		}
+	}
+	for _, privateNode := range node.PrivateNodeLinks {
+		a.anchorNode(privateNode, symbol, path)
+	}
+	a.anchorNode(node.Left, symbol, path)
+	a.anchorNode(node.Right, symbol, path)
+}
+
+/*
+Locates the content of a leaf node in the IG Script input and the Original Statement.
+Occurrences of the content within the component nesting of the leaf are preferred over occurrences in other
+components of the same type (e.g., in other nested statements), and occurrences not yet anchored to other
+leaves are preferred over already anchored ones (which applies to leaves shared across component pairs).
+*/
+func (a *anchorer) anchorLeaf(node *tree.Node, content string, symbol string, path []string) {
+
+	a.leaves = append(a.leaves, AnchoredLeaf{Node: node, Component: symbol, Path: path})
+
+	context := strings.Join(extendPath(path, symbol), pathSeparator)
+	candidates := findOccurrences(a.normalized, content)
+	idx := -1
+	for _, criterion := range []struct {
+		exactContext bool
+		claimed      bool
+	}{{true, false}, {true, true}, {false, false}, {false, true}} {
+		for _, candidate := range candidates {
+			if a.claimed[candidate] != criterion.claimed {
+				continue
+			}
+			if (criterion.exactContext && a.contexts[candidate] == context) ||
+				(!criterion.exactContext && lastElement(a.contexts[candidate]) == symbol) {
+				idx = candidate
+				break
+			}
+		}
+		if idx != -1 {
+			break
+		}
+	}
+	if idx == -1 {
+		Println("Could not anchor content '" + content + "' of component " + context)
+		return
+	}
+	a.claimed[idx] = true
+	span := tree.NewSourceSpan(a.input, idx, idx+len(content))
+	node.IGScriptSpan = &span
+
+	// Determine extent of aligned words in Original Statement
+	start, end := -1, -1
+	for _, match := range a.matches {
+		if match.Encoded.Offset < span.Offset || match.Encoded.EndOffset > span.EndOffset {
+			continue
+		}
+		if start == -1 || match.Original.Offset < start {
+			start = match.Original.Offset
+		}
+		if match.Original.EndOffset > end {
+			end = match.Original.EndOffset
+		}
+	}
+	if start != -1 {
+		originalSpan := tree.NewSourceSpan(a.original, start, end)
+		node.OriginalStatementSpan = &originalSpan
+	}
+}
+
+/*
+Determines the nesting path of components (e.g., 'Cac/A') for each byte offset of the IG Script input,
+based on the component headers preceding parentheses and braces. Parentheses and braces without header
+(e.g., combinations) inherit the component of the enclosing content. Content of brackets (e.g., annotations,
+logical operators) does not affect the nesting.
+*/
+func contentContexts(input string) []string {
+
+	// Component symbols of headers indexed by offset of succeeding parenthesis or brace
+	headers := map[int]string{}
+	for pos := 0; pos < len(input); {
+		match := componentHeaderRegex.FindStringSubmatchIndex(input[pos:])
+		if match == nil {
+			break
+		}
+		// Group 2 holds header, group 3 the component identifier (without suffix and annotation)
+		header := input[pos+match[4] : pos+match[5]]
+		if idx := strings.Index(header, parser.LEFT_BRACKET); idx != -1 {
+			header = header[:idx]
+		}
+		symbol := input[pos+match[6] : pos+match[7]]
+		if strings.HasSuffix(header, tree.PROPERTY_SYNTAX_SUFFIX) && !strings.HasSuffix(symbol, tree.PROPERTY_SYNTAX_SUFFIX) {
+			symbol += tree.PROPERTY_SYNTAX_SUFFIX
+		}
+		headers[pos+match[5]] = symbol
+		pos += match[5]
+	}
+
+	contexts := make([]string, len(input))
+	stack := []string{""}
+	bracketLevel := 0
+	for i := 0; i < len(input); i++ {
+		switch char := string(input[i]); {
+		case char == parser.LEFT_BRACKET:
+			bracketLevel++
+		case char == parser.RIGHT_BRACKET && bracketLevel > 0:
+			bracketLevel--
+		case bracketLevel > 0:
+			// Ignore content of brackets
+		case char == parser.LEFT_PARENTHESIS || char == parser.LEFT_BRACE:
+			context := stack[len(stack)-1]
+			if symbol, ok := headers[i]; ok && lastElement(context) != symbol {
+				if context != "" {
+					context += pathSeparator
+				}
+				context += symbol
+			}
+			stack = append(stack, context)
+		case (char == parser.RIGHT_PARENTHESIS || char == parser.RIGHT_BRACE) && len(stack) > 1:
+			stack = stack[:len(stack)-1]
+		}
+		contexts[i] = stack[len(stack)-1]
+	}
+	return contexts
+}
+
+/*
+Returns the byte offsets of all occurrences of content in input that are neither preceded nor succeeded
+by letters or digits (i.e., occurrences that do not form part of other words).
+*/
+func findOccurrences(input string, content string) []int {
+	occurrences := []int{}
+	for offset := 0; offset < len(input); {
+		idx := strings.Index(input[offset:], content)
+		if idx == -1 {
+			break
+		}
+		idx += offset
+		before, _ := utf8.DecodeLastRuneInString(input[:idx])
+		after, _ := utf8.DecodeRuneInString(input[idx+len(content):])
+		if !isWordRune(before) && !isWordRune(after) {
+			occurrences = append(occurrences, idx)
+		}
+		offset = idx + 1
+	}
+	return occurrences
+}
+
+/*
+Indicates whether rune is a letter or digit.
+*/
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+/*
+Returns copy of path extended with given component symbol, unless the symbol is empty or corresponds to
+the last element of the path (e.g., for combinations of nested statements such as 'Cac{Cac{...} [XOR] Cac{...}}').
+*/
+func extendPath(path []string, symbol string) []string {
+	extended := append([]string{}, path...)
+	if symbol != "" && (len(path) == 0 || path[len(path)-1] != symbol) {
+		extended = append(extended, symbol)
+	}
+	return extended
+}
+
+/*
+Returns last element of nesting path (e.g., 'A' for 'Cac/A').
+*/
+func lastElement(context string) string {
+	return context[strings.LastIndex(context, pathSeparator)+1:]
+}
@@ -0,0 +1,77 @@
+package anchoring
+
+import (
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"testing"
+)
+
+/*
+Parses given statement and anchors it to the given IG Script input and Original Statement.
+*/
+func anchorStatement(t *testing.T, original string, input string) []AnchoredLeaf {
+	stmts, err := parser.ParseStatement(input)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Parsing of '"+input+"' should not fail. Error:", err)
+	}
+	return AnchorStatements(stmts, input, original)
+}
+
+/*
+Tests the anchoring of identical content in different components and nested statements to the respective occurrence.
+*/
+func TestAnchorIdenticalContentInDifferentComponents(t *testing.T) {
+
+	input := "Cac{A(farmer) I(sells) Bdir(farmer)} A(farmer) D(must) I(register)"
+
+	expected := map[string]int{"Cac/A": 6, "Cac/Bdir": 28, "A": 39}
+	for _, leaf := range anchorStatement(t, "", input) {
+		if leaf.Node.Entry != "farmer" {
+			continue
+		}
+		context := leaf.Component
+		if len(leaf.Path) > 0 {
+			context = leaf.Path[0] + pathSeparator + context
+		}
+		if leaf.Node.IGScriptSpan == nil || leaf.Node.IGScriptSpan.Offset != expected[context] {
+			t.Fatal("Content of", context, "is anchored incorrectly:", leaf.Node.IGScriptSpan)
+		}
+		delete(expected, context)
+	}
+	if len(expected) != 0 {
+		t.Fatal("Not all leaves have been anchored:", expected)
+	}
+}
+
+/*
+Tests the anchoring of content shared across component pairs, private properties and combinations, as well as
+the positions in the Original Statement (where content repeated in the encoding is aligned with the occurrence
+in the Original Statement in order of the statement, i.e., the second occurrence of 'goods').
+*/
+func TestAnchorOriginalStatement(t *testing.T) {
+
+	original := "Certified farmers must not sell or buy goods."
+	input := "A1,p(certified) A1(Farmers) D(must) {I([NOT] sell) Bdir([organic] goods) [XOR] I(buy) Bdir([organic] goods)}"
+
+	leaves := anchorStatement(t, original, input)
+	expected := []struct {
+		text     string
+		original string
+	}{{"certified", "Certified"}, {"Farmers", "farmers"}, {"must", "must"},
+		{"sell", "sell"}, {"[organic] goods", ""}, {"buy", "buy"}, {"[organic] goods", "goods"}}
+	if len(leaves) != len(expected) {
+		t.Fatal("Number of anchored leaves is incorrect:", len(leaves))
+	}
+	for i, leaf := range leaves {
+		if leaf.Node.IGScriptSpan == nil || leaf.Node.IGScriptSpan.Text != expected[i].text {
+			t.Fatal("Leaf", i, "is anchored incorrectly in IG Script input:", leaf.Node.IGScriptSpan)
+		}
+		if expected[i].original == "" {
+			if leaf.Node.OriginalStatementSpan != nil {
+				t.Fatal("Leaf", i, "should not be anchored in Original Statement:", leaf.Node.OriginalStatementSpan)
+			}
+		} else if leaf.Node.OriginalStatementSpan == nil || leaf.Node.OriginalStatementSpan.Text != expected[i].original {
+			t.Fatal("Leaf", i, "is anchored incorrectly in Original Statement:", leaf.Node.OriginalStatementSpan)
+		}
+	}
+}
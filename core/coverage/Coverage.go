@@ -0,0 +1,343 @@
+package coverage
+
+import (
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+This file contains the coverage analysis, which aligns the text content of an IG Script-encoded statement with the
+original statement it encodes. It identifies words of the original statement that have not been encoded in any
+component, as well as text of the encoded statement that does not appear in the original statement (e.g., typos
+or paraphrases), and determines the share of words of the original statement that are encoded.
+
+The text content of the encoded statement excludes component symbols (including suffices), brackets (i.e.,
+annotations, logical operators and inferred content such as 'A([implicit] actor)'), parentheses and braces.
+Words are compared case-insensitively and without surrounding punctuation. Words are aligned in order of
+occurrence first (longest common subsequence), before remaining words are matched irrespective of their
+position (e.g., for components encoded in different order than in the original statement). Words of the
+original statement that remain unmatched thereafter are considered encoded if they correspond to logical
+operators of the encoded statement (e.g., 'not' for 'I([NOT] comply)').
+*/
+
+// Words of original statement represented by logical operators
+var operatorWords = map[string]string{
+	tree.AND_BRACKETS: "and",
+	tree.OR_BRACKETS:  "or",
+	tree.XOR_BRACKETS: "or",
+	tree.NOT_BRACKETS: "not",
+}
+
+// Component header (e.g., 'Bdir1,p[annotation]') immediately preceding component content (i.e., parenthesis or brace),
+// and preceded by beginning of statement or character other than letters or digits
+var componentHeaderRegex = regexp.MustCompile("(^|[^\\p{L}\\p{N}])(" + parser.COMPONENT_HEADER_SYNTAX + ")[" +
+	regexp.QuoteMeta(parser.LEFT_PARENTHESIS+parser.LEFT_BRACE) + "]")
+
+/*
+Result of coverage analysis.
+*/
+type Result struct {
+	// Number of words in original statement
+	OriginalWordCount int
+	// Number of words of original statement encoded in encoded statement
+	EncodedWordCount int
+	// Percentage of words of original statement encoded in encoded statement (0-100)
+	Coverage float64
+	// Words of original statement not encoded in any component (with position in original statement)
+	UnencodedWords []tree.SourceSpan
+	// Words of encoded statement not contained in original statement (with position in encoded statement)
+	UnmatchedWords []tree.SourceSpan
+}
+
+/*
+Indicates whether the encoded statement fully covers the original statement without deviations
+(i.e., neither unencoded nor unmatched words).
+*/
+func (r Result) IsComplete() bool {
+	return len(r.UnencodedWords) == 0 && len(r.UnmatchedWords) == 0
+}
+
+/*
+Pair of aligned words of encoded statement and original statement.
+*/
+type WordMatch struct {
+	// Word of encoded statement (with position in encoded statement)
+	Encoded tree.SourceSpan
+	// Corresponding word of original statement (with position in original statement)
+	Original tree.SourceSpan
+}
+
+/*
+Word of a statement, holding its normalized form (for comparison) and its byte offsets in the statement.
+*/
+type word struct {
+	normalized string
+	offset     int
+	endOffset  int
+}
+
+/*
+Aligns text content of encoded statement with original statement and returns the result of the analysis.
+If the original statement does not contain words, coverage is reported as 100 percent.
+*/
+func Analyze(originalStatement string, codedStmt string) Result {
+
+	originalWords := extractWords(originalStatement)
+	codedText, operators := extractText(codedStmt)
+	codedWords := extractWords(codedText)
+	Println("Original words:", originalWords)
+	Println("Encoded words:", codedWords)
+	Println("Logical operators:", operators)
+
+	matchedOriginal, codedMatches := align(originalWords, codedWords, operators)
+
+	result := Result{OriginalWordCount: len(originalWords), UnencodedWords: []tree.SourceSpan{}, UnmatchedWords: []tree.SourceSpan{}}
+	for i, w := range originalWords {
+		if matchedOriginal[i] {
+			result.EncodedWordCount++
+		} else {
+			result.UnencodedWords = append(result.UnencodedWords, tree.NewSourceSpan(originalStatement, w.offset, w.endOffset))
+		}
+	}
+	for i, w := range codedWords {
+		if codedMatches[i] == -1 {
+			result.UnmatchedWords = append(result.UnmatchedWords, tree.NewSourceSpan(codedStmt, w.offset, w.endOffset))
+		}
+	}
+	result.Coverage = 100
+	if result.OriginalWordCount > 0 {
+		result.Coverage = float64(result.EncodedWordCount) * 100 / float64(result.OriginalWordCount)
+	}
+	return result
+}
+
+/*
+Aligns text content of encoded statement with original statement (see #Analyze()) and returns the aligned
+pairs of words in order of occurrence in the encoded statement. Words matched against logical operators
+are not included, since they do not correspond to text content of the encoded statement.
+*/
+func MatchWords(originalStatement string, codedStmt string) []WordMatch {
+
+	originalWords := extractWords(originalStatement)
+	codedText, operators := extractText(codedStmt)
+	codedWords := extractWords(codedText)
+
+	_, codedMatches := align(originalWords, codedWords, operators)
+
+	matches := []WordMatch{}
+	for j, i := range codedMatches {
+		if i == -1 {
+			continue
+		}
+		matches = append(matches, WordMatch{
+			Encoded:  tree.NewSourceSpan(codedStmt, codedWords[j].offset, codedWords[j].endOffset),
+			Original: tree.NewSourceSpan(originalStatement, originalWords[i].offset, originalWords[i].endOffset)})
+	}
+	return matches
+}
+
+/*
+Returns the text content of an encoded statement, with IG Script syntax (component headers, brackets,
+parentheses and braces) replaced by whitespace. The positions of the text content remain unchanged.
+Further returns the words represented by logical operators contained in the statement (see operatorWords).
+*/
+func extractText(codedStmt string) (string, []string) {
+
+	text := []byte(codedStmt)
+
+	// Blank component headers (prior to brackets, since headers may include annotations).
+	// Search continues immediately after each header, since the succeeding parenthesis or brace
+	// may precede the next header (e.g., 'Cac{A(actor) ...}').
+	for pos := 0; pos < len(codedStmt); {
+		match := componentHeaderRegex.FindStringSubmatchIndex(codedStmt[pos:])
+		if match == nil {
+			break
+		}
+		// Group 2 holds header (excluding preceding character and succeeding parenthesis or brace)
+		blank(text, pos+match[4], pos+match[5])
+		pos += match[5]
+	}
+
+	// Blank brackets and their content (considering nested brackets), and collect logical operators
+	operators := []string{}
+	level := 0
+	start := 0
+	for i, b := range text {
+		switch string(b) {
+		case parser.LEFT_BRACKET:
+			if level == 0 {
+				start = i
+			}
+			level++
+		case parser.RIGHT_BRACKET:
+			if level > 0 {
+				level--
+				if level == 0 {
+					if operator, ok := operatorWords[string(text[start:i+1])]; ok {
+						operators = append(operators, operator)
+					}
+					blank(text, start, i+1)
+				}
+			}
+		}
+	}
+	if level > 0 {
+		// Blank unterminated bracket
+		blank(text, start, len(text))
+	}
+
+	// Blank parentheses and braces
+	for i, b := range text {
+		switch string(b) {
+		case parser.LEFT_PARENTHESIS, parser.RIGHT_PARENTHESIS, parser.LEFT_BRACE, parser.RIGHT_BRACE:
+			text[i] = ' '
+		}
+	}
+
+	return string(text), operators
+}
+
+/*
+Replaces bytes between given offsets (start inclusive, end exclusive) with whitespace.
+*/
+func blank(text []byte, start int, end int) {
+	for i := start; i < end; i++ {
+		text[i] = ' '
+	}
+}
+
+/*
+Splits text into words (separated by whitespace), removing leading and trailing characters other than letters
+and digits (e.g., punctuation or quotation marks). Words only consisting of such characters are ignored.
+*/
+func extractWords(text string) []word {
+	words := []word{}
+	start := -1
+	for i, r := range text + " " {
+		if !unicode.IsSpace(r) {
+			if start == -1 {
+				start = i
+			}
+			continue
+		}
+		if start != -1 {
+			if w, ok := trimWord(text, start, i); ok {
+				words = append(words, w)
+			}
+			start = -1
+		}
+	}
+	return words
+}
+
+/*
+Trims characters other than letters and digits from both ends of the word between given offsets,
+and returns the word alongside an indication whether it contains letters or digits.
+*/
+func trimWord(text string, start int, end int) (word, bool) {
+	for start < end {
+		r, size := utf8.DecodeRuneInString(text[start:end])
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			break
+		}
+		start += size
+	}
+	for end > start {
+		r, size := utf8.DecodeLastRuneInString(text[start:end])
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			break
+		}
+		end -= size
+	}
+	if start == end {
+		return word{}, false
+	}
+	return word{normalized: normalize(text[start:end]), offset: start, endOffset: end}, true
+}
+
+/*
+Normalizes word for comparison (lower case, uniform apostrophes).
+*/
+func normalize(w string) string {
+	return strings.ToLower(strings.ReplaceAll(w, "’", "'"))
+}
+
+/*
+Aligns words of original and encoded statement. Words are first aligned in order of occurrence (longest common
+subsequence), before remaining words are matched irrespective of their position, and finally against the words
+represented by logical operators. Returns indications whether the individual words of the original statement
+have been matched, and the indices of the words of the original statement the individual words of the encoded
+statement have been matched with (or -1 if unmatched).
+*/
+func align(original []word, coded []word, operators []string) ([]bool, []int) {
+
+	matchedOriginal := make([]bool, len(original))
+	codedMatches := make([]int, len(coded))
+	for j := range codedMatches {
+		codedMatches[j] = -1
+	}
+
+	// Lengths of longest common subsequences of suffices of both word sequences
+	lcs := make([][]int, len(original)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(coded)+1)
+	}
+	for i := len(original) - 1; i >= 0; i-- {
+		for j := len(coded) - 1; j >= 0; j-- {
+			if original[i].normalized == coded[j].normalized {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	// Trace common subsequence
+	for i, j := 0, 0; i < len(original) && j < len(coded); {
+		if original[i].normalized == coded[j].normalized {
+			matchedOriginal[i] = true
+			codedMatches[j] = i
+			i++
+			j++
+		} else if lcs[i+1][j] >= lcs[i][j+1] {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	// Match remaining words irrespective of position
+	remaining := map[string][]int{}
+	for j, w := range coded {
+		if codedMatches[j] == -1 {
+			remaining[w.normalized] = append(remaining[w.normalized], j)
+		}
+	}
+	for i, w := range original {
+		if matchedOriginal[i] || len(remaining[w.normalized]) == 0 {
+			continue
+		}
+		matchedOriginal[i] = true
+		codedMatches[remaining[w.normalized][0]] = i
+		remaining[w.normalized] = remaining[w.normalized][1:]
+	}
+
+	// Match remaining words against logical operators
+	remainingOperators := map[string]int{}
+	for _, operator := range operators {
+		remainingOperators[operator]++
+	}
+	for i, w := range original {
+		if !matchedOriginal[i] && remainingOperators[w.normalized] > 0 {
+			matchedOriginal[i] = true
+			remainingOperators[w.normalized]--
+		}
+	}
+
+	return matchedOriginal, codedMatches
+}
@@ -0,0 +1,89 @@
+package coverage
+
+import (
+	"IG-Parser/core/tree"
+	"reflect"
+	"testing"
+)
+
+/*
+Returns text of given spans.
+*/
+func texts(spans []tree.SourceSpan) []string {
+	result := []string{}
+	for _, span := range spans {
+		result = append(result, span.Text)
+	}
+	return result
+}
+
+/*
+Tests full coverage of original statement, including nested statements, inferred content, logical operators
+and components encoded in different order than in the original statement.
+*/
+func TestAnalyzeCompleteCoverage(t *testing.T) {
+
+	original := "Once policy comes into force, the Program Manager must not approve or deny applications (as specified in Act A) promptly."
+	coded := "Cac{E(policy) F(comes into force)} A(Program Manager) Cex(promptly) D(must) I([NOT] {approve [XOR] deny}) " +
+		"Bdir1,p[ref=act](as specified in Act A) Bdir1(applications) [stmt=example] A,p([implicit] the)"
+
+	result := Analyze(original, coded)
+	if !reflect.DeepEqual(texts(result.UnencodedWords), []string{"Once"}) {
+		t.Fatal("Only 'Once' should be unencoded, but unencoded words were", texts(result.UnencodedWords))
+	}
+	if len(result.UnmatchedWords) != 0 {
+		t.Fatal("All encoded words should be matched, but unmatched words were", texts(result.UnmatchedWords))
+	}
+	if result.OriginalWordCount != 20 || result.EncodedWordCount != 19 {
+		t.Fatal("Word counts are incorrect:", result.OriginalWordCount, result.EncodedWordCount)
+	}
+}
+
+/*
+Tests reporting of unencoded and unmatched words (e.g., typos), including their positions.
+*/
+func TestAnalyzeDeviations(t *testing.T) {
+
+	original := "Farmers must label their produce with the colour code."
+	coded := "A(Farmers) D(must) I(label) Bdir(produce) Cex(with the color code)"
+
+	result := Analyze(original, coded)
+	if !reflect.DeepEqual(texts(result.UnencodedWords), []string{"their", "colour"}) {
+		t.Fatal("Unencoded words are incorrect:", texts(result.UnencodedWords))
+	}
+	if !reflect.DeepEqual(texts(result.UnmatchedWords), []string{"color"}) {
+		t.Fatal("Unmatched words are incorrect:", texts(result.UnmatchedWords))
+	}
+	if result.UnencodedWords[1].Offset != 42 || result.UnmatchedWords[0].Column != 56 {
+		t.Fatal("Positions of words are incorrect:", result.UnencodedWords[1], result.UnmatchedWords[0])
+	}
+	if result.OriginalWordCount != 9 || result.EncodedWordCount != 7 || result.IsComplete() {
+		t.Fatal("Word counts are incorrect:", result.OriginalWordCount, result.EncodedWordCount)
+	}
+}
+
+/*
+Tests coverage for empty original statement.
+*/
+func TestAnalyzeEmptyOriginal(t *testing.T) {
+
+	result := Analyze("", "A(farmer) I(sells)")
+	if result.Coverage != 100 || !reflect.DeepEqual(texts(result.UnmatchedWords), []string{"farmer", "sells"}) {
+		t.Fatal("Coverage of empty original statement is incorrect:", result)
+	}
+}
+
+/*
+Tests the pairs of aligned words, excluding words matched against logical operators.
+*/
+func TestMatchWords(t *testing.T) {
+
+	matches := MatchWords("Farmers must not sell goods.", "A(farmers) D(must) I([NOT] sell) Bdir(goods)")
+	if len(matches) != 4 {
+		t.Fatal("Number of aligned words is incorrect:", matches)
+	}
+	if matches[0].Encoded.Text != "farmers" || matches[0].Original.Text != "Farmers" ||
+		matches[3].Encoded.Offset != 38 || matches[3].Original.Offset != 22 {
+		t.Fatal("Aligned words are incorrect:", matches)
+	}
+}
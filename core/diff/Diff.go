@@ -0,0 +1,623 @@
+package diff
+
+import (
+	"IG-Parser/core/tree"
+	"strings"
+)
+
+/*
+This file contains the structural comparison (diff) of two encodings of the same statement, e.g., encodings of
+different coders, or revisions of an encoding. The parsed statement trees are aligned component by component, with
+components identified by their path (i.e., their symbol, prefixed by the symbols of the components embedding the
+nested statements they are contained in, e.g., 'Cac/A' for Attributes in a nested Activation Condition). Within
+components, the operands of combinations (with operands linked via the same logical operator considered as one
+sequence, e.g., 'sell', 'buy' and 'trade' in '((sell [AND] buy) [AND] trade)') are aligned by content (longest
+common subsequence), before remaining operands are compared in order of occurrence.
+
+The differences are returned as edit script, i.e., a sequence of edits transforming the base encoding into the
+revised encoding. Edits cover the following kinds of elements (see KIND_* constants):
+- Components: components added to or removed from a statement.
+- Content: changed content of leaf nodes, as well as operands added to or removed from combinations.
+- Operators: changed logical operators, as well as operators added or removed (e.g., 'sell' revised as 'sell [XOR] buy').
+- Shared elements: shared elements added to or removed from combinations, or moved from one side to the other.
+- Nesting: primitive content revised as nested statement or vice versa (e.g., 'Cac(...)' revised as 'Cac{...}').
+- Annotations: changed annotations of components, combinations and statements.
+Edits hold the nodes they concern, which allows for the marking of those for visual output (see MarkNodes()).
+*/
+
+// Operations of edits (also used as diff status of nodes, see tree.Node.DiffStatus)
+const OPERATION_ADDED = "added"
+const OPERATION_REMOVED = "removed"
+const OPERATION_CHANGED = "changed"
+
+// Kinds of elements affected by edits
+const KIND_COMPONENT = "component"
+const KIND_CONTENT = "content"
+const KIND_OPERATOR = "operator"
+const KIND_SHARED_ELEMENT = "shared element"
+const KIND_NESTING = "nesting"
+const KIND_ANNOTATION = "annotation"
+
+// Separator of component symbols in paths (e.g., 'Cac/A')
+const PATH_SEPARATOR = "/"
+
+// Path element of combinations of statements extrapolated from component pairs (e.g., 'Cac/{}')
+const COMPONENT_PAIRS = "{}"
+
+// Prefixes indicating the side of shared elements in values of edits (e.g., 'left: fresh')
+const SHARED_LEFT = "left: "
+const SHARED_RIGHT = "right: "
+
+/*
+Individual edit of the edit script transforming the base encoding into the revised encoding.
+*/
+type Edit struct {
+	// Operation (see OPERATION_* constants)
+	Operation string
+	// Kind of element affected by edit (see KIND_* constants)
+	Kind string
+	// Path of component the element belongs to (e.g., 'Cac/A'), or empty string for the statement itself
+	Path string
+	// Value in base encoding (empty for added elements)
+	Old string
+	// Value in revised encoding (empty for removed elements)
+	New string
+	// Node holding element in base encoding (nil for added elements)
+	BaseNode *tree.Node
+	// Node holding element in revised encoding (nil for removed elements)
+	RevisedNode *tree.Node
+}
+
+/*
+Returns human-readable representation of edit (e.g., "changed content in Cac/A: 'farmer' -> 'citizen'").
+*/
+func (e Edit) String() string {
+	path := e.Path
+	if path == "" {
+		path = "statement"
+	}
+	out := e.Operation + " " + e.Kind + " in " + path + ": "
+	switch e.Operation {
+	case OPERATION_ADDED:
+		return out + "'" + e.New + "'"
+	case OPERATION_REMOVED:
+		return out + "'" + e.Old + "'"
+	}
+	return out + "'" + e.Old + "' -> '" + e.New + "'"
+}
+
+/*
+Compares two encodings of a statement, each given as node returned by parser.ParseStatement() (i.e., node embedding
+the statement, or node combining statements extrapolated from component pairs). Returns the edit script transforming
+the base encoding into the revised encoding, which is empty if both encodings are structurally equivalent.
+*/
+func Compare(base *tree.Node, revised *tree.Node) []Edit {
+	d := differ{edits: []Edit{}, compared: map[nodePair]bool{}}
+	d.compareNodes(base, revised, "", true)
+	Println("Edit script:", d.edits)
+	return d.edits
+}
+
+/*
+Marks nodes concerned by given edits with their diff status (see tree.Node.DiffStatus), which is included in
+the visual output (see tree.Node.PrintNodeTree()). Added and removed components and content are marked as added
+or removed (including all nodes they contain), and nodes with added or removed logical operators as added or
+removed (excluding their operands). Nodes concerned by any other edit are marked as changed. Nodes concerned by
+multiple edits retain the status of the first edit.
+*/
+func MarkNodes(edits []Edit) {
+	for _, edit := range edits {
+		switch {
+		case edit.Operation != OPERATION_CHANGED && (edit.Kind == KIND_COMPONENT || edit.Kind == KIND_CONTENT):
+			markSubtree(edit.BaseNode, edit.Operation)
+			markSubtree(edit.RevisedNode, edit.Operation)
+		case edit.Operation != OPERATION_CHANGED && edit.Kind == KIND_OPERATOR:
+			mark(edit.BaseNode, edit.Operation)
+			mark(edit.RevisedNode, edit.Operation)
+		default:
+			mark(edit.BaseNode, OPERATION_CHANGED)
+			mark(edit.RevisedNode, OPERATION_CHANGED)
+		}
+	}
+}
+
+/*
+Marks node with given diff status, unless it is already marked.
+*/
+func mark(n *tree.Node, status string) {
+	if n != nil && n.DiffStatus == "" {
+		n.DiffStatus = status
+	}
+}
+
+/*
+Marks node and all nodes it contains (including nested statements and linked private nodes) with given diff status.
+*/
+func markSubtree(n *tree.Node, status string) {
+	if n == nil {
+		return
+	}
+	mark(n, status)
+	switch entry := n.Entry.(type) {
+	case *tree.Statement:
+		for _, comp := range entry.Components() {
+			markSubtree(comp.Node, status)
+		}
+	case []*tree.Node:
+		for _, node := range entry {
+			markSubtree(node, status)
+		}
+	}
+	for _, node := range n.PrivateNodeLinks {
+		markSubtree(node, status)
+	}
+	markSubtree(n.Left, status)
+	markSubtree(n.Right, status)
+}
+
+/*
+Pair of aligned nodes of base and revised encoding (either of which is nil if the other one has no counterpart).
+*/
+type nodePair struct {
+	base    *tree.Node
+	revised *tree.Node
+}
+
+/*
+Holds the edit script during comparison, as well as the pairs of nodes compared so far (since nodes of components
+shared across statements extrapolated from component pairs are contained in multiple statements).
+*/
+type differ struct {
+	edits    []Edit
+	compared map[nodePair]bool
+}
+
+/*
+Appends edit to edit script.
+*/
+func (d *differ) add(operation string, kind string, path string, old string, new string, base *tree.Node, revised *tree.Node) {
+	d.edits = append(d.edits, Edit{Operation: operation, Kind: kind, Path: path, Old: old, New: new,
+		BaseNode: base, RevisedNode: revised})
+}
+
+/*
+Compares aligned nodes of base and revised encoding. Nodes on statement level (statementLevel) embed statements
+or combine statements extrapolated from component pairs, with path holding the prefix for the components of the
+embedded statements (e.g., 'Cac/'). All other nodes belong to components, with path holding the component path.
+*/
+func (d *differ) compareNodes(base *tree.Node, revised *tree.Node, path string, statementLevel bool) {
+	pair := nodePair{base: base, revised: revised}
+	if d.compared[pair] {
+		return
+	}
+	d.compared[pair] = true
+
+	editPath := path
+	if statementLevel {
+		editPath = strings.TrimSuffix(path, PATH_SEPARATOR)
+	}
+	if annotations(base) != annotations(revised) {
+		d.add(operation(annotations(base), annotations(revised)), KIND_ANNOTATION, editPath,
+			annotations(base), annotations(revised), base, revised)
+	}
+
+	if base.IsCombination() || revised.IsCombination() {
+		if statementLevel {
+			editPath = path + COMPONENT_PAIRS
+		}
+		d.compareCombinations(base, revised, path, editPath, statementLevel)
+		return
+	}
+
+	if statementLevel {
+		switch baseEntry := base.Entry.(type) {
+		case *tree.Statement:
+			if revisedEntry, ok := revised.Entry.(*tree.Statement); ok {
+				d.compareStatements(baseEntry, revisedEntry, path)
+				return
+			}
+		case []*tree.Node:
+			// Nodes embedding statements extrapolated from component pairs
+			if revisedEntry, ok := revised.Entry.([]*tree.Node); ok {
+				d.compareAligned(baseEntry, revisedEntry, path, path+COMPONENT_PAIRS, true, KIND_CONTENT)
+				return
+			}
+		}
+	}
+	d.compareLeaves(base, revised, editPath)
+}
+
+/*
+Compares nodes of which at least one is a combination. Reports changed logical operators and shared elements,
+and compares the aligned operands (see operands()). Edits are reported for the given edit path, whereas path
+and statementLevel are passed on to the comparison of operands (see #compareNodes()).
+*/
+func (d *differ) compareCombinations(base *tree.Node, revised *tree.Node, path string, editPath string, statementLevel bool) {
+	baseOperator := operator(base)
+	revisedOperator := operator(revised)
+	if baseOperator != revisedOperator {
+		d.add(operation(baseOperator, revisedOperator), KIND_OPERATOR, editPath, baseOperator, revisedOperator,
+			nodeIfPopulated(base, baseOperator), nodeIfPopulated(revised, revisedOperator))
+	}
+	d.compareSharedElements(base, revised, editPath)
+	d.compareAligned(operands(base), operands(revised), path, editPath, statementLevel, KIND_CONTENT)
+}
+
+/*
+Aligns given nodes of base and revised encoding (see align()) and compares aligned nodes (see #compareNodes()).
+Nodes without counterpart are reported as added or removed elements of the given kind.
+*/
+func (d *differ) compareAligned(base []*tree.Node, revised []*tree.Node, path string, editPath string, statementLevel bool, kind string) {
+	for _, pair := range align(base, revised) {
+		switch {
+		case pair.base == nil:
+			d.add(OPERATION_ADDED, kind, editPath, "", value(pair.revised), nil, pair.revised)
+		case pair.revised == nil:
+			d.add(OPERATION_REMOVED, kind, editPath, value(pair.base), "", pair.base, nil)
+		default:
+			d.compareNodes(pair.base, pair.revised, path, statementLevel)
+		}
+	}
+}
+
+/*
+Compares leaf nodes of components (holding primitive content, nested statements or component pairs),
+including the private nodes linked to them. Leaf nodes holding different types of entries are reported
+as changed nesting (e.g., primitive content revised as nested statement).
+*/
+func (d *differ) compareLeaves(base *tree.Node, revised *tree.Node, path string) {
+	switch baseEntry := base.Entry.(type) {
+	case string:
+		if revisedEntry, ok := revised.Entry.(string); ok {
+			if normalize(baseEntry) != normalize(revisedEntry) {
+				d.add(OPERATION_CHANGED, KIND_CONTENT, path, baseEntry, revisedEntry, base, revised)
+			}
+			d.comparePrivateNodes(base, revised, path)
+			return
+		}
+	case *tree.Statement:
+		if revisedEntry, ok := revised.Entry.(*tree.Statement); ok {
+			d.compareStatements(baseEntry, revisedEntry, path+PATH_SEPARATOR)
+			return
+		}
+	case []*tree.Node:
+		if revisedEntry, ok := revised.Entry.([]*tree.Node); ok {
+			d.compareAligned(baseEntry, revisedEntry, path+PATH_SEPARATOR, path+PATH_SEPARATOR+COMPONENT_PAIRS, true, KIND_CONTENT)
+			return
+		}
+	}
+	d.add(OPERATION_CHANGED, KIND_NESTING, path, value(base), value(revised), base, revised)
+}
+
+/*
+Compares the private nodes (e.g., private properties) linked to given leaf nodes. Private nodes are
+identified by their component symbol, with the path of the component they are linked to serving as prefix.
+*/
+func (d *differ) comparePrivateNodes(base *tree.Node, revised *tree.Node, path string) {
+	prefix := path[:strings.LastIndex(path, PATH_SEPARATOR)+1]
+	symbols := []string{}
+	basePrivate := map[string][]*tree.Node{}
+	revisedPrivate := map[string][]*tree.Node{}
+	for _, node := range base.PrivateNodeLinks {
+		if node != nil {
+			symbols = appendIfNotContained(symbols, node.GetComponentName())
+			basePrivate[node.GetComponentName()] = append(basePrivate[node.GetComponentName()], node)
+		}
+	}
+	for _, node := range revised.PrivateNodeLinks {
+		if node != nil {
+			symbols = appendIfNotContained(symbols, node.GetComponentName())
+			revisedPrivate[node.GetComponentName()] = append(revisedPrivate[node.GetComponentName()], node)
+		}
+	}
+	for _, symbol := range symbols {
+		d.compareAligned(basePrivate[symbol], revisedPrivate[symbol], prefix+symbol, prefix+symbol, false, KIND_COMPONENT)
+	}
+}
+
+/*
+Compares the components of given statements, using the given prefix for component paths (e.g., 'Cac/').
+Components revised from primitive into nested form (or vice versa) are compared with each other, and
+hence reported as changed nesting (e.g., 'Cac(...)' revised as 'Cac{...}').
+*/
+func (d *differ) compareStatements(base *tree.Statement, revised *tree.Statement, prefix string) {
+
+	// Component nodes per symbol in primitive (index 0) and nested form (index 1)
+	type forms struct {
+		base    [2]*tree.Node
+		revised [2]*tree.Node
+	}
+	symbols := []string{}
+	components := map[string]*forms{}
+	revisedComponents := revised.Components()
+	for i, comp := range base.Components() {
+		f, ok := components[comp.Symbol]
+		if !ok {
+			f = &forms{}
+			components[comp.Symbol] = f
+			symbols = append(symbols, comp.Symbol)
+		}
+		form := 0
+		if comp.Complex {
+			form = 1
+		}
+		f.base[form] = populated(comp.Node)
+		f.revised[form] = populated(revisedComponents[i].Node)
+	}
+
+	for _, symbol := range symbols {
+		f := components[symbol]
+		path := prefix + symbol
+		if f.base[0] != nil && f.base[1] == nil && f.revised[0] == nil && f.revised[1] != nil {
+			d.compareComponents(f.base[0], f.revised[1], path)
+			continue
+		}
+		if f.base[0] == nil && f.base[1] != nil && f.revised[0] != nil && f.revised[1] == nil {
+			d.compareComponents(f.base[1], f.revised[0], path)
+			continue
+		}
+		d.compareComponents(f.base[0], f.revised[0], path)
+		d.compareComponents(f.base[1], f.revised[1], path)
+	}
+}
+
+/*
+Compares root nodes of components, reporting added or removed components.
+*/
+func (d *differ) compareComponents(base *tree.Node, revised *tree.Node, path string) {
+	switch {
+	case base == nil && revised == nil:
+	case base == nil:
+		d.add(OPERATION_ADDED, KIND_COMPONENT, path, "", value(revised), nil, revised)
+	case revised == nil:
+		d.add(OPERATION_REMOVED, KIND_COMPONENT, path, value(base), "", base, nil)
+	default:
+		d.compareNodes(base, revised, path, false)
+	}
+}
+
+/*
+Compares shared elements of given nodes, reporting shared elements added, removed, or moved from
+the left to the right side of a combination (or vice versa).
+*/
+func (d *differ) compareSharedElements(base *tree.Node, revised *tree.Node, path string) {
+	baseLeft, baseRight := sharedElements(base.SharedLeft), sharedElements(base.SharedRight)
+	revisedLeft, revisedRight := sharedElements(revised.SharedLeft), sharedElements(revised.SharedRight)
+
+	for _, element := range baseLeft {
+		if contains(revisedLeft, element) {
+			continue
+		}
+		if contains(revisedRight, element) {
+			d.add(OPERATION_CHANGED, KIND_SHARED_ELEMENT, path, SHARED_LEFT+element, SHARED_RIGHT+element, base, revised)
+		} else {
+			d.add(OPERATION_REMOVED, KIND_SHARED_ELEMENT, path, SHARED_LEFT+element, "", base, revised)
+		}
+	}
+	for _, element := range baseRight {
+		if contains(revisedRight, element) {
+			continue
+		}
+		if contains(revisedLeft, element) {
+			d.add(OPERATION_CHANGED, KIND_SHARED_ELEMENT, path, SHARED_RIGHT+element, SHARED_LEFT+element, base, revised)
+		} else {
+			d.add(OPERATION_REMOVED, KIND_SHARED_ELEMENT, path, SHARED_RIGHT+element, "", base, revised)
+		}
+	}
+	for _, element := range revisedLeft {
+		if !contains(baseLeft, element) && !contains(baseRight, element) {
+			d.add(OPERATION_ADDED, KIND_SHARED_ELEMENT, path, "", SHARED_LEFT+element, base, revised)
+		}
+	}
+	for _, element := range revisedRight {
+		if !contains(baseLeft, element) && !contains(baseRight, element) {
+			d.add(OPERATION_ADDED, KIND_SHARED_ELEMENT, path, "", SHARED_RIGHT+element, base, revised)
+		}
+	}
+}
+
+/*
+Aligns nodes of base and revised encoding by content (see signature()). Nodes are aligned in order of
+occurrence (longest common subsequence), before remaining nodes in between aligned nodes are paired in
+order of occurrence. Returns the pairs in order of occurrence, with nodes without counterpart paired with nil.
+*/
+func align(base []*tree.Node, revised []*tree.Node) []nodePair {
+
+	baseSignatures := make([]string, len(base))
+	for i, node := range base {
+		baseSignatures[i] = signature(node)
+	}
+	revisedSignatures := make([]string, len(revised))
+	for j, node := range revised {
+		revisedSignatures[j] = signature(node)
+	}
+
+	// Lengths of longest common subsequences of suffices of both sequences
+	lcs := make([][]int, len(base)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(revised)+1)
+	}
+	for i := len(base) - 1; i >= 0; i-- {
+		for j := len(revised) - 1; j >= 0; j-- {
+			if baseSignatures[i] == revisedSignatures[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	pairs := []nodePair{}
+	// Nodes without counterpart in common subsequence since last aligned pair
+	gapBase := []*tree.Node{}
+	gapRevised := []*tree.Node{}
+	closeGap := func() {
+		for k := 0; k < len(gapBase) || k < len(gapRevised); k++ {
+			pair := nodePair{}
+			if k < len(gapBase) {
+				pair.base = gapBase[k]
+			}
+			if k < len(gapRevised) {
+				pair.revised = gapRevised[k]
+			}
+			pairs = append(pairs, pair)
+		}
+		gapBase = []*tree.Node{}
+		gapRevised = []*tree.Node{}
+	}
+	for i, j := 0, 0; i < len(base) || j < len(revised); {
+		if i < len(base) && j < len(revised) && baseSignatures[i] == revisedSignatures[j] {
+			closeGap()
+			pairs = append(pairs, nodePair{base: base[i], revised: revised[j]})
+			i++
+			j++
+		} else if j == len(revised) || (i < len(base) && lcs[i+1][j] >= lcs[i][j+1]) {
+			gapBase = append(gapBase, base[i])
+			i++
+		} else {
+			gapRevised = append(gapRevised, revised[j])
+			j++
+		}
+	}
+	closeGap()
+
+	return pairs
+}
+
+/*
+Returns the operands of a combination, with nested combinations linked via the same logical operator (and
+neither holding shared elements nor annotations) being resolved into their operands (e.g., 'sell', 'buy' and
+'trade' for '((sell [AND] buy) [AND] trade)'). Unary negations hold a single operand. Nodes other than
+combinations are returned as single operand.
+*/
+func operands(n *tree.Node) []*tree.Node {
+	if !n.IsCombination() {
+		return []*tree.Node{n}
+	}
+	if n.IsUnaryNegation() {
+		return []*tree.Node{n.Right}
+	}
+	var resolve func(node *tree.Node) []*tree.Node
+	resolve = func(node *tree.Node) []*tree.Node {
+		if node.IsCombination() && node.LogicalOperator == n.LogicalOperator && !node.IsUnaryNegation() &&
+			len(sharedElements(node.SharedLeft)) == 0 && len(sharedElements(node.SharedRight)) == 0 && annotations(node) == "" {
+			return append(resolve(node.Left), resolve(node.Right)...)
+		}
+		return []*tree.Node{node}
+	}
+	return append(resolve(n.Left), resolve(n.Right)...)
+}
+
+/*
+Returns logical operator of combination, or empty string for nodes other than combinations.
+*/
+func operator(n *tree.Node) string {
+	if n.IsCombination() {
+		return n.LogicalOperator
+	}
+	return ""
+}
+
+/*
+Returns operation for a value in base and revised encoding, with empty values indicating absence.
+*/
+func operation(old string, new string) string {
+	switch {
+	case old == "":
+		return OPERATION_ADDED
+	case new == "":
+		return OPERATION_REMOVED
+	}
+	return OPERATION_CHANGED
+}
+
+/*
+Returns node if value it holds is populated, or nil otherwise.
+*/
+func nodeIfPopulated(n *tree.Node, value string) *tree.Node {
+	if value == "" {
+		return nil
+	}
+	return n
+}
+
+/*
+Returns node if it is populated, or nil otherwise.
+*/
+func populated(n *tree.Node) *tree.Node {
+	if n == nil || n.IsEmptyOrNilNode() {
+		return nil
+	}
+	return n
+}
+
+/*
+Returns annotations held by node itself (i.e., not inherited from parent nodes), or empty string if none.
+*/
+func annotations(n *tree.Node) string {
+	if n == nil || n.Annotations == nil {
+		return ""
+	}
+	if annotations, ok := n.Annotations.(string); ok {
+		return strings.TrimSpace(annotations)
+	}
+	return ""
+}
+
+/*
+Returns non-empty shared elements.
+*/
+func sharedElements(elements []string) []string {
+	result := []string{}
+	for _, element := range elements {
+		if strings.TrimSpace(element) != "" {
+			result = append(result, normalize(element))
+		}
+	}
+	return result
+}
+
+/*
+Returns IG Script representation of node content (excluding annotations of the node itself) used in edits.
+*/
+func value(n *tree.Node) string {
+	return strings.TrimSpace(n.Stringify())
+}
+
+/*
+Returns representation of node content used for alignment (see align()).
+*/
+func signature(n *tree.Node) string {
+	return normalize(n.Stringify())
+}
+
+/*
+Normalizes content for comparison (i.e., collapses whitespace).
+*/
+func normalize(content string) string {
+	return strings.Join(strings.Fields(content), " ")
+}
+
+/*
+Indicates whether value is contained in given values.
+*/
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Appends value to given values if not already contained.
+*/
+func appendIfNotContained(values []string, value string) []string {
+	if contains(values, value) {
+		return values
+	}
+	return append(values, value)
+}
@@ -0,0 +1,137 @@
+package diff
+
+import (
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"testing"
+)
+
+/*
+Parses given encodings and returns the edit script transforming the base encoding into the revised encoding.
+*/
+func compareEncodings(t *testing.T, base string, revised string) []Edit {
+	baseStmts, err := parser.ParseStatement(base)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Parsing of '"+base+"' should not fail. Error:", err)
+	}
+	revisedStmts, err := parser.ParseStatement(revised)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Parsing of '"+revised+"' should not fail. Error:", err)
+	}
+	return Compare(baseStmts[0], revisedStmts[0])
+}
+
+/*
+Returns string representations of given edits.
+*/
+func stringify(edits []Edit) []string {
+	result := []string{}
+	for _, edit := range edits {
+		result = append(result, edit.String())
+	}
+	return result
+}
+
+/*
+Tests the edit scripts for the different kinds of differences between encodings.
+*/
+func TestCompare(t *testing.T) {
+
+	cases := []struct {
+		base     string
+		revised  string
+		expected []string
+	}{
+		{"A(farmer) D(must) I(sell) Bdir(goods)", "A(farmer) D(must) I(sell) Bdir(goods)", []string{}},
+		{"A(farmer) D(must) I(sell)", "A(farmer) I(sell) Cac(at night)", []string{
+			"removed component in D: 'must'",
+			"added component in Cac: 'at night'"}},
+		{"A(farmer) I(sell) Bdir(goods)", "A(farmer) I(sell) Bdir(fresh goods)", []string{
+			"changed content in Bdir: 'goods' -> 'fresh goods'"}},
+		{"A(farmer) I((sell [AND] buy) [AND] trade)", "A(farmer) I((sell [AND] trade))", []string{
+			"removed content in I: 'buy'"}},
+		{"A(farmer) I((sell [AND] buy))", "A(farmer) I((sell [XOR] (buy [AND] trade)))", []string{
+			"changed operator in I: 'AND' -> 'XOR'",
+			"added operator in I: 'AND'",
+			"added content in I: 'trade'"}},
+		{"A(farmer) I(sell)", "A(farmer) I([NOT] sell)", []string{
+			"added operator in I: 'NOT'"}},
+		{"A(farmer) I((sell [AND] deliver) goods)", "A(farmer) I(goods (sell [AND] deliver))", []string{
+			"changed shared element in I: 'right: goods' -> 'left: goods'"}},
+		{"A(farmer) I((sell [AND] deliver) goods)", "A(farmer) I(fresh (sell [AND] deliver))", []string{
+			"removed shared element in I: 'right: goods'",
+			"added shared element in I: 'left: fresh'"}},
+		{"A(farmer) I(sell) Cac(when inspected)", "A(farmer) I(sell) Cac{A(inspector) I(inspects)}", []string{
+			"changed nesting in Cac: 'when inspected' -> 'A(inspector) I(inspects)'"}},
+		{"A(farmer) I(sell) Cac{A(inspector) I(inspects) Bdir(goods)}", "A(farmer) I(sell) Cac{A(official) I(inspects)}", []string{
+			"changed content in Cac/A: 'inspector' -> 'official'",
+			"removed component in Cac/Bdir: 'goods'"}},
+		{"A[role=seller](farmer) I(sell) [stmt=1]", "A[role=buyer](farmer) I(sell)", []string{
+			"removed annotation in statement: '[stmt=1]'",
+			"changed annotation in A: '[role=seller]' -> '[role=buyer]'"}},
+		{"A(farmer) I(sell) Bdir1,p(fresh) Bdir1(goods)", "A(farmer) I(sell) Bdir1,p(organic) Bdir1(goods)", []string{
+			"changed content in Bdir,p: 'fresh' -> 'organic'"}},
+		{"A(farmer) {I(sell) [XOR] I(buy)}", "A(citizen) {I(sell) [AND] I(buy)}", []string{
+			"changed operator in {}: 'XOR' -> 'AND'",
+			"changed content in A: 'farmer' -> 'citizen'"}},
+	}
+
+	for _, c := range cases {
+		edits := stringify(compareEncodings(t, c.base, c.revised))
+		if len(edits) != len(c.expected) {
+			t.Fatal("Comparison of '"+c.base+"' and '"+c.revised+"' should produce", c.expected, "but produced", edits)
+		}
+		for i := range edits {
+			if edits[i] != c.expected[i] {
+				t.Fatal("Comparison of '"+c.base+"' and '"+c.revised+"' should produce", c.expected, "but produced", edits)
+			}
+		}
+	}
+}
+
+/*
+Tests the marking of nodes concerned by edits.
+*/
+func TestMarkNodes(t *testing.T) {
+
+	edits := compareEncodings(t, "A(farmer) D(must) I(sell) Bdir(goods)", "A(farmer) D(may) I((sell [XOR] buy)) Cac{A(inspector) I(inspects)}")
+	MarkNodes(edits)
+
+	expected := map[string]string{
+		"must":  OPERATION_CHANGED,
+		"may":   OPERATION_CHANGED,
+		"goods": OPERATION_REMOVED,
+		"buy":   OPERATION_ADDED,
+		"XOR":   OPERATION_ADDED,
+		"sell":  "",
+	}
+	nodes := map[string]*tree.Node{}
+	for _, edit := range edits {
+		for _, node := range []*tree.Node{edit.BaseNode, edit.RevisedNode} {
+			if node == nil {
+				continue
+			}
+			if node.HasPrimitiveEntry() {
+				nodes[node.Entry.(string)] = node
+			} else if node.IsCombination() {
+				nodes[node.LogicalOperator] = node
+				nodes[node.Left.Entry.(string)] = node.Left
+			}
+		}
+	}
+	for content, status := range expected {
+		if nodes[content] == nil || nodes[content].DiffStatus != status {
+			t.Fatal("Node '"+content+"' should be marked as '"+status+"', but was", nodes[content])
+		}
+	}
+
+	// Nodes of nested statements of added components are marked as added
+	for _, edit := range edits {
+		if edit.Path == tree.ACTIVATION_CONDITION {
+			stmt := edit.RevisedNode.Entry.(*tree.Statement)
+			if stmt.Attributes.DiffStatus != OPERATION_ADDED {
+				t.Fatal("Nested component should be marked as added, but was", stmt.Attributes.DiffStatus)
+			}
+		}
+	}
+}
@@ -1,15 +1,19 @@
 package endpoints
 
 import (
+	"IG-Parser/core/exporter/brat"
+	"IG-Parser/core/exporter/json"
+	"IG-Parser/core/exporter/standoff"
 	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/exporter/xml"
 	"IG-Parser/core/parser"
 	"IG-Parser/core/tree"
 )
 
 /*
 This file contains the application endpoints that integrate the core parsing features, as well as file/output
-handling. Both can be invoked with IG Script-encoded institutional statements to produce either tabular or
-visual output for downstream processing, serving as endpoints for the use by specific applications, such as
+handling. All can be invoked with IG Script-encoded institutional statements to produce tabular, visual,
+JSON, XML, stand-off or brat output for downstream processing, serving as endpoints for the use by specific applications, such as
 web applications, console tools, etc.
 */
 
@@ -19,26 +23,27 @@ Arguments include the IGScript-annotated statement, statement ID based on which
 the nature of the output type (see TabularOutputGeneratorConfig #OUTPUT_TYPE_CSV, #OUTPUT_TYPE_GOOGLE_SHEETS)
 and a filename for the output. If the filename is empty, no output will be written. The parameter overwrite
 indicates whether the target file will be overwritten upon repeated write.
-If printHeaders is set, the output includes the header row.
+Options (opts) control the output generation (e.g., dynamic output, IG Extended output, annotations, header row).
 printOriginalStatement specifies the inclusion of the original statement input in the generated output (see options in tabular.ORIGINAL_STATEMENT_INCLUSION_OPTIONS).
 printIgScriptInput specifies the inclusion of the original IG Script input in the generated output (see options in tabular.IG_SCRIPT_INCLUSION_OPTIONS).
 Returns tabular output as string, and error (defaults to tree.PARSING_NO_ERROR).
 */
-func ConvertIGScriptToTabularOutput(originalStatement string, statement string, stmtId string, outputType string, filename string, overwrite bool, printHeaders bool, printOriginalStatement string, printIgScriptInput string) ([]tabular.TabularOutputResult, tree.ParsingError) {
+func ConvertIGScriptToTabularOutput(originalStatement string, statement string, stmtId string, outputType string, filename string, overwrite bool, opts tree.Options, printOriginalStatement string, printIgScriptInput string) ([]tabular.TabularOutputResult, tree.ParsingError) {
 
 	// Use separator specified by default
 	separator := tabular.CellSeparator
 
 	Println(" Step: Parse input statement")
-	// Explicitly activate printing of shared elements
-	tabular.SetIncludeSharedElementsInTabularOutput(true)
 
 	// Clean input from potential cell separator character (separately performed for original statement and
 	// IG Script statement potentially included in output)
+	input := statement
 	statement = tabular.CleanInput(statement, separator)
 
 	// Parse IGScript statement into tree
 	stmts, err := parser.ParseStatement(statement)
+	// Relate positions of offending content to uncleaned input
+	err = tabular.RelocateErrorSpans(err, input, separator)
 	if err.ErrorCode != tree.PARSING_NO_ERROR && err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
 		return nil, err
 	}
@@ -47,8 +52,8 @@ func ConvertIGScriptToTabularOutput(originalStatement string, statement string,
 
 	// Run composite generation and return output and error. Will write file if filename != ""
 	results := tabular.GenerateTabularOutputFromParsedStatements(stmts, stmts[0].Annotations,
-		originalStatement, statement, stmtId, filename, overwrite, tree.AGGREGATE_IMPLICIT_LINKAGES,
-		separator, outputType, printHeaders, printOriginalStatement, printIgScriptInput)
+		originalStatement, statement, stmtId, filename, overwrite, opts,
+		separator, outputType, opts.IncludeHeaders, printOriginalStatement, printIgScriptInput)
 	for _, res := range results {
 		if res.Error.ErrorCode != tree.PARSING_NO_ERROR && res.Error.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
 			return results, res.Error
@@ -63,19 +68,89 @@ func ConvertIGScriptToTabularOutput(originalStatement string, statement string,
 
 }
 
+/*
+Consumes multiple statement records (statement ID, Original Statement, IG Script-encoded statement) as input and
+produces a single tabular output with consistent schema across all statements (i.e., a single header row, and,
+in dynamic output mode, the union of columns across all statements).
+Statements that cannot be parsed are reported in the returned result (StatementErrors) and omitted from the output
+without aborting the processing of the remaining statements. Warnings (e.g., potentially non-parsed content) are
+reported alongside errors, but the corresponding statements are included in the output.
+Further arguments correspond to #ConvertIGScriptToTabularOutput, with the header row included based on opts.IncludeHeaders.
+Returns combined tabular output, alongside per-statement errors and potential error concerning the overall output
+generation (CorpusTabularOutputResult.Error, defaults to tree.PARSING_NO_ERROR).
+*/
+func ConvertIGScriptCorpusToTabularOutput(records []tabular.StatementRecord, outputType string, filename string, overwrite bool, opts tree.Options, printOriginalStatement string, printIgScriptInput string) tabular.CorpusTabularOutputResult {
+
+	// Use separator specified by default
+	separator := tabular.CellSeparator
+
+	// Successfully parsed statement records and corresponding results
+	parsedRecords := []tabular.StatementRecord{}
+	parsedResults := [][]tabular.TabularOutputResult{}
+	// Errors and warnings for individual statements
+	stmtErrors := []tabular.StatementRecordError{}
+
+	for _, record := range records {
+		Println(" Step: Parse input statement", record.Id)
+
+		// Clean input from potential cell separator character
+		input := record.IgScript
+		record.IgScript = tabular.CleanInput(record.IgScript, separator)
+
+		// Parse IGScript statement into tree
+		stmts, err := parser.ParseStatement(record.IgScript)
+		// Relate positions of offending content to uncleaned input
+		err = tabular.RelocateErrorSpans(err, input, separator)
+		if err.ErrorCode != tree.PARSING_NO_ERROR && err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+			stmtErrors = append(stmtErrors, tabular.StatementRecordError{Id: record.Id, Error: err})
+			continue
+		}
+
+		// Generate tabular output structure per statement (merged into combined output below)
+		results := tabular.GenerateTabularOutputFromParsedStatements(stmts, stmts[0].Annotations,
+			record.OriginalStatement, record.IgScript, record.Id, "", true, opts,
+			separator, outputType, false, printOriginalStatement, printIgScriptInput)
+		failed := false
+		for _, res := range results {
+			if res.Error.ErrorCode != tree.PARSING_NO_ERROR && res.Error.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+				stmtErrors = append(stmtErrors, tabular.StatementRecordError{Id: record.Id, Error: res.Error})
+				failed = true
+				break
+			}
+		}
+		if failed {
+			continue
+		}
+		if err.ErrorCode == tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+			// Report warning, but retain statement
+			stmtErrors = append(stmtErrors, tabular.StatementRecordError{Id: record.Id, Error: err})
+		}
+
+		parsedRecords = append(parsedRecords, record)
+		parsedResults = append(parsedResults, results)
+	}
+
+	Println(" Step: Generate combined tabular output")
+	result := tabular.GenerateCorpusTabularOutput(parsedRecords, parsedResults, separator, outputType, filename,
+		overwrite, opts.IncludeHeaders, printOriginalStatement, printIgScriptInput)
+	result.StatementErrors = stmtErrors
+
+	Println("  - Output generation complete.")
+
+	return result
+}
+
 /*
 Consumes statement as input and produces outfile reflecting visual tree structure consumable by D3.
 Arguments include the IGScript-annotated statement, statement ID (currently not used in visualization),
 and a filename for the output. If the filename is empty, no output will be written.
+Options (opts) control the output generation (e.g., flat or binary printing, annotations, Degree of Variability).
 Returns Visual tree structure as string, and error (defaults to tree.PARSING_NO_ERROR).
 */
-func ConvertIGScriptToVisualTree(statement string, stmtId string, filename string) (string, tree.ParsingError) {
+func ConvertIGScriptToVisualTree(statement string, stmtId string, filename string, opts tree.Options) (string, tree.ParsingError) {
 
 	Println(" Step: Parse input statement")
 
-	// Explicitly activate printing of shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(true)
-
 	// Parse IGScript statement into tree
 	stmts, err := parser.ParseStatement(statement)
 	// Print output in case there is no error, or in case there are only potentially missing elements
@@ -87,8 +162,7 @@ func ConvertIGScriptToVisualTree(statement string, stmtId string, filename strin
 
 	// Prepare visual output for nodes
 	Println(" Step: Generate visual output structure (combined statements)")
-	output, err2 := stmts[0].PrintNodeTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(),
-		tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
 	if err2.ErrorCode != tree.TREE_NO_ERROR {
 		return output, tree.ParsingError{ErrorCode: tree.PARSING_ERROR_EMBEDDED_NODE_ERROR, ErrorMessage: err2.ErrorMessage}
 	}
@@ -110,3 +184,255 @@ func ConvertIGScriptToVisualTree(statement string, stmtId string, filename strin
 
 	return output, err
 }
+
+/*
+Consumes statement as input and produces JSON output reflecting the complete parsed statement tree structure
+(see json.Schema() for the corresponding JSON Schema).
+Arguments include the IGScript-annotated statement, statement ID (included in output if not empty),
+and a filename for the output. If the filename is empty, no output will be written.
+Returns JSON output as string, and error (defaults to tree.PARSING_NO_ERROR).
+*/
+func ConvertIGScriptToJSON(statement string, stmtId string, filename string) (string, tree.ParsingError) {
+
+	Println(" Step: Parse input statement")
+
+	// Parse IGScript statement into tree
+	stmts, err := parser.ParseStatement(statement)
+	// Print output in case there is no error, or in case there are only potentially missing elements
+	if err.ErrorCode != tree.PARSING_NO_ERROR && err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		return "", err
+	}
+
+	Println("    - Parsed statement:", stmts)
+
+	// Prepare JSON output for nodes
+	Println(" Step: Generate JSON output")
+	output, err2 := json.GenerateJSONOutputFromParsedStatements(stmts, stmtId, statement)
+	if err2.ErrorCode != tree.PARSING_NO_ERROR {
+		return "", err2
+	}
+
+	Println("  - Generated JSON output:", output)
+
+	Println("  - Output generation complete.")
+
+	if filename != "" {
+		Println("  - Writing to file ...")
+
+		err3 := tabular.WriteToFile(filename, output, true)
+		if err3 != nil {
+			Println("  - Problems when writing file "+filename+", Error:", err3)
+		}
+
+		Println("  - Writing completed.")
+	}
+
+	return output, err
+}
+
+/*
+Consumes statement as input and produces XML output reflecting the parsed statement tree structure
+(see xml.Schema() for the corresponding XML Schema).
+Arguments include the IGScript-annotated statement, statement ID (included in output if not empty),
+and a filename for the output. If the filename is empty, no output will be written.
+Returns XML output as string, and error (defaults to tree.PARSING_NO_ERROR).
+*/
+func ConvertIGScriptToXML(statement string, stmtId string, filename string) (string, tree.ParsingError) {
+
+	Println(" Step: Parse input statement")
+
+	// Parse IGScript statement into tree
+	stmts, err := parser.ParseStatement(statement)
+	// Print output in case there is no error, or in case there are only potentially missing elements
+	if err.ErrorCode != tree.PARSING_NO_ERROR && err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		return "", err
+	}
+
+	Println("    - Parsed statement:", stmts)
+
+	// Prepare XML output for nodes
+	Println(" Step: Generate XML output")
+	output, err2 := xml.GenerateXMLOutputFromParsedStatements(stmts, stmtId, statement)
+	if err2.ErrorCode != tree.PARSING_NO_ERROR {
+		return "", err2
+	}
+
+	Println("  - Generated XML output:", output)
+
+	Println("  - Output generation complete.")
+
+	if filename != "" {
+		Println("  - Writing to file ...")
+
+		err3 := tabular.WriteToFile(filename, output, true)
+		if err3 != nil {
+			Println("  - Problems when writing file "+filename+", Error:", err3)
+		}
+
+		Println("  - Writing completed.")
+	}
+
+	return output, err
+}
+
+/*
+Consumes original and coded statements as input and produces stand-off output listing the content of all components
+alongside its positions (character offsets) in the IG Script input and the Original Statement (see core/anchoring).
+Arguments include the original statement (optional; positions in the Original Statement are omitted if empty),
+the IGScript-annotated statement, statement ID (included in output if not empty), and a filename for the output.
+If the filename is empty, no output will be written.
+Returns stand-off output as JSON string, and error (defaults to tree.PARSING_NO_ERROR).
+*/
+func ConvertIGScriptToStandoff(originalStatement string, statement string, stmtId string, filename string) (string, tree.ParsingError) {
+
+	Println(" Step: Parse input statement")
+
+	// Parse IGScript statement into tree
+	stmts, err := parser.ParseStatement(statement)
+	// Print output in case there is no error, or in case there are only potentially missing elements
+	if err.ErrorCode != tree.PARSING_NO_ERROR && err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		return "", err
+	}
+
+	Println("    - Parsed statement:", stmts)
+
+	// Prepare stand-off output for nodes
+	Println(" Step: Generate stand-off output")
+	output, err2 := standoff.GenerateStandoffOutputFromParsedStatements(stmts, stmtId, statement, originalStatement)
+	if err2.ErrorCode != tree.PARSING_NO_ERROR {
+		return "", err2
+	}
+
+	Println("  - Generated stand-off output:", output)
+
+	Println("  - Output generation complete.")
+
+	if filename != "" {
+		Println("  - Writing to file ...")
+
+		err3 := tabular.WriteToFile(filename, output, true)
+		if err3 != nil {
+			Println("  - Problems when writing file "+filename+", Error:", err3)
+		}
+
+		Println("  - Writing completed.")
+	}
+
+	return output, err
+}
+
+/*
+Consumes Original Statement (optional) and IG Script-encoded statement as input and produces brat stand-off
+annotations, with the Original Statement (or the IG Script input if no Original Statement is provided) as annotated text.
+Returns annotated text (content of .txt file), annotations (content of .ann file), and error (defaults to
+tree.PARSING_NO_ERROR). Content that cannot be located in the Original Statement is omitted from the annotations
+and reported as warning (tree.PARSING_WARNING_NON_ANCHORED_CONTENT).
+*/
+func ConvertIGScriptToBrat(originalStatement string, statement string) (string, string, tree.ParsingError) {
+
+	Println(" Step: Parse input statement")
+
+	// Parse IGScript statement into tree
+	stmts, err := parser.ParseStatement(statement)
+	if err.ErrorCode != tree.PARSING_NO_ERROR && err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		return "", "", err
+	}
+
+	Println(" Step: Generate brat output")
+	output, err2 := brat.GenerateBratOutputFromParsedStatements(stmts, statement, originalStatement)
+	if err2.ErrorCode != tree.PARSING_NO_ERROR && err2.ErrorCode != tree.PARSING_WARNING_NON_ANCHORED_CONTENT {
+		return "", "", err2
+	}
+	if err2.ErrorCode != tree.PARSING_NO_ERROR {
+		err = err2
+	}
+
+	Println("  - Generated brat annotations:", output.Annotations)
+
+	return output.Text, output.Annotations, err
+}
+
+/*
+Consumes brat stand-off annotations (annotated text and content of .ann file) and reconstructs the corresponding
+IG Script-encoded statement. The reconstructed statement is parsed to ensure its validity.
+Returns IG Script-encoded statement, and error (defaults to tree.PARSING_NO_ERROR).
+*/
+func ConvertBratToIGScript(text string, annotations string) (string, tree.ParsingError) {
+
+	Println(" Step: Parse brat annotations")
+	node, err := brat.ParseBratInput(text, annotations)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return "", err
+	}
+
+	statement := node.StringifyStatement()
+	Println("  - Reconstructed statement:", statement)
+
+	Println(" Step: Validate reconstructed statement")
+	if _, err = parser.ParseStatement(statement); err.ErrorCode != tree.PARSING_NO_ERROR {
+		return "", err
+	}
+
+	return statement, err
+}
+
+/*
+Consumes tabular output generated by IG Parser (CSV or Google Sheets format, including header row, based on the
+static output schema) and reconstructs the IG Script-encoded statements it has been generated from (see
+tabular.ParseTabularInput()). Reconstructed statements are parsed to ensure their validity.
+Returns the reconstructed statement records (e.g., for use with #ConvertIGScriptCorpusToTabularOutput), errors for
+individual statements that could not be reconstructed, and error concerning the overall input (defaults to
+tree.PARSING_NO_ERROR).
+*/
+func ConvertTabularToIGScript(input string) ([]tabular.StatementRecord, []tabular.StatementRecordError, tree.ParsingError) {
+
+	Println(" Step: Parse tabular input")
+	result := tabular.ParseTabularInput(input)
+	if result.Error.ErrorCode != tree.PARSING_NO_ERROR {
+		return nil, nil, result.Error
+	}
+
+	records := []tabular.StatementRecord{}
+	stmtErrors := result.StatementErrors
+	for _, stmt := range result.Statements {
+		statement := stmt.Statement.StringifyStatement()
+		Println("  - Reconstructed statement", stmt.Id, ":", statement)
+
+		Println(" Step: Validate reconstructed statement", stmt.Id)
+		if _, err := parser.ParseStatement(statement); err.ErrorCode != tree.PARSING_NO_ERROR {
+			stmtErrors = append(stmtErrors, tabular.StatementRecordError{Id: stmt.Id, Error: err})
+			continue
+		}
+		records = append(records, tabular.StatementRecord{Id: stmt.Id, OriginalStatement: stmt.OriginalStatement, IgScript: statement})
+	}
+
+	return records, stmtErrors, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Parses an IG Script-encoded statement and expands it into atomic statements (see tree.GenerateAtomicStatements()),
+with IDs derived from the given statement ID, logical linkages to sibling atomic statements and references to the
+statements they have been expanded from. Options (opts) control the aggregation of implicitly linked component
+instances and the collapsing of adjacent conjunctions in linkages.
+Returns atomic statements and error (defaults to tree.PARSING_NO_ERROR).
+*/
+func ExpandIGScriptToAtomicStatements(statement string, stmtId string, opts tree.Options) ([]tree.AtomicStatement, tree.ParsingError) {
+
+	Println(" Step: Parse input statement")
+
+	// Parse IGScript statement into tree
+	stmts, err := parser.ParseStatement(statement)
+	if err.ErrorCode != tree.PARSING_NO_ERROR && err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		return nil, err
+	}
+
+	Println(" Step: Expand atomic statements")
+	atomicStmts, err := tree.GenerateAtomicStatements(stmts[0], stmtId, opts)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return nil, err
+	}
+
+	Println("  - Expansion complete.")
+
+	return atomicStmts, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
@@ -5,7 +5,9 @@ import (
 	"IG-Parser/core/tree"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -24,7 +26,7 @@ func TestValidStatementGoogleSheets(t *testing.T) {
 		"Bdir(approved (certified production and [AND] handling operations and [AND] accredited certifying agents)) " +
 		"Cex(for compliance with the (Act or [XOR] regulations in this part))."
 
-	_, err := ConvertIGScriptToTabularOutput(originalStatement, text, "650", tabular.OUTPUT_TYPE_GOOGLE_SHEETS, "", true, true, tabular.ORIGINAL_STATEMENT_OUTPUT_NONE, tabular.IG_SCRIPT_OUTPUT_NONE)
+	_, err := ConvertIGScriptToTabularOutput(originalStatement, text, "650", tabular.OUTPUT_TYPE_GOOGLE_SHEETS, "", true, tree.DefaultOptions(), tabular.ORIGINAL_STATEMENT_OUTPUT_NONE, tabular.IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
 		t.Fatal("Statement parsing should not fail. Error:", err)
 	}
@@ -51,7 +53,7 @@ func TestValidStatementNestingGoogleSheets(t *testing.T) {
 		// This is the essential line
 		"Cac{A(Program Manager) I(has gained) Bdir(competence)}"
 
-	_, err := ConvertIGScriptToTabularOutput(originalStatement, text, "650", tabular.OUTPUT_TYPE_GOOGLE_SHEETS, "", true, true, tabular.ORIGINAL_STATEMENT_OUTPUT_NONE, tabular.IG_SCRIPT_OUTPUT_NONE)
+	_, err := ConvertIGScriptToTabularOutput(originalStatement, text, "650", tabular.OUTPUT_TYPE_GOOGLE_SHEETS, "", true, tree.DefaultOptions(), tabular.ORIGINAL_STATEMENT_OUTPUT_NONE, tabular.IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
 		t.Fatal("Statement parsing should not fail. Error:", err)
 	}
@@ -78,7 +80,7 @@ func TestValidStatementWithCellSeparatorCSV(t *testing.T) {
 		"Cac{A(Program Manager) I(has gained) Bdir(competence)}"
 
 	// Perform the conversion
-	results, err := ConvertIGScriptToTabularOutput(originalStatement, text, "650", tabular.OUTPUT_TYPE_CSV, "", true, true, tabular.ORIGINAL_STATEMENT_OUTPUT_FIRST_ENTRY, tabular.IG_SCRIPT_OUTPUT_FIRST_ENTRY)
+	results, err := ConvertIGScriptToTabularOutput(originalStatement, text, "650", tabular.OUTPUT_TYPE_CSV, "", true, tree.DefaultOptions(), tabular.ORIGINAL_STATEMENT_OUTPUT_FIRST_ENTRY, tabular.IG_SCRIPT_OUTPUT_FIRST_ENTRY)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Statement parsing should not fail")
 	}
@@ -126,12 +128,131 @@ func TestInvalidAttributeStatementGoogleSheets(t *testing.T) {
 		")" +
 		"Cex(for compliance with the (Act or [XOR] regulations in this part))."
 
-	_, err := ConvertIGScriptToTabularOutput(originalStatement, text, "650", tabular.OUTPUT_TYPE_GOOGLE_SHEETS, "", true, true, tabular.ORIGINAL_STATEMENT_OUTPUT_NONE, tabular.IG_SCRIPT_OUTPUT_NONE)
+	_, err := ConvertIGScriptToTabularOutput(originalStatement, text, "650", tabular.OUTPUT_TYPE_GOOGLE_SHEETS, "", true, tree.DefaultOptions(), tabular.ORIGINAL_STATEMENT_OUTPUT_NONE, tabular.IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode == tree.PARSING_NO_ERROR {
 		t.Fatal("Statement parsing should produce error")
 	}
 }
 
+/*
+Tests that error positions refer to the input prior to removal of line breaks and cell separators.
+*/
+func TestErrorPositionWithLineBreaksAndCellSeparator(t *testing.T) {
+
+	text := "A(fa|rmer)\r\nD(must)) I(comply)"
+
+	_, err := ConvertIGScriptToTabularOutput("", text, "650", tabular.OUTPUT_TYPE_CSV, "", true, tree.DefaultOptions(), tabular.ORIGINAL_STATEMENT_OUTPUT_NONE, tabular.IG_SCRIPT_OUTPUT_NONE)
+	if err.ErrorCode != tree.PARSING_ERROR_IMBALANCED_PARENTHESES {
+		t.Fatal("Statement parsing should produce error "+tree.PARSING_ERROR_IMBALANCED_PARENTHESES+", but returned", err)
+	}
+	if len(err.ErrorSpans) != 1 {
+		t.Fatal("Error should contain single span, but contains:", err.ErrorSpans)
+	}
+	span := err.ErrorSpans[0]
+	if span.Offset != 19 || span.EndOffset != 20 || span.Line != 2 || span.Column != 8 || span.Text != ")" {
+		t.Fatal("Position of excessive closing parenthesis is incorrect:", span)
+	}
+}
+
+// CORPUS-LEVEL TABULAR OUTPUT
+
+/*
+Tests combined CSV output for multiple statements in dynamic output mode, including merged header and
+reporting of invalid statements.
+*/
+func TestCorpusDynamicOutputCSV(t *testing.T) {
+
+	// Output options
+	opts := tree.DefaultOptions()
+	// Activate dynamic output
+	opts.SetDynamicOutput(true)
+
+	records := []tabular.StatementRecord{
+		{Id: "1", OriginalStatement: "Farmers must sell or buy goods and wares if licensed.",
+			IgScript: "A(farmer) D(must) I((sell [XOR] buy)) Bdir(goods) Bdir(wares) Cac{A(farmer) I(has) Bdir(license)}"},
+		{Id: "2", OriginalStatement: "Invalid statement", IgScript: "A(farmer I(sell)"},
+		{Id: "3", OriginalStatement: "Certifiers inspect regularly.", IgScript: "A(certifier) I(inspect) Cex(regularly)"},
+	}
+
+	result := ConvertIGScriptCorpusToTabularOutput(records, tabular.OUTPUT_TYPE_CSV, "", true, opts,
+		tabular.ORIGINAL_STATEMENT_OUTPUT_FIRST_ENTRY, tabular.IG_SCRIPT_OUTPUT_NONE)
+	if result.Error.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Output generation should not fail. Error:", result.Error)
+	}
+
+	if len(result.StatementErrors) != 1 || result.StatementErrors[0].Id != "2" ||
+		result.StatementErrors[0].Error.ErrorCode != tree.PARSING_ERROR_IMBALANCED_PARENTHESES {
+		t.Fatal("Invalid statement should be reported. Reported errors:", result.StatementErrors)
+	}
+
+	// Read reference file
+	content, err2 := os.ReadFile("TestOutputCorpusDynamicCSV.test")
+	if err2 != nil {
+		t.Fatal("Error attempting to read test text input. Error: ", err2.Error())
+	}
+
+	// Extract expected output
+	expectedOutput := string(content)
+
+	// Compare to actual output
+	if result.Output != expectedOutput {
+		fmt.Println("Produced output:\n", result.Output)
+		fmt.Println("Expected output:\n", expectedOutput)
+		err3 := tabular.WriteToFile("errorOutput.error", result.Output, true)
+		if err3 != nil {
+			t.Fatal("Error attempting to read test text input. Error: ", err3.Error())
+		}
+		t.Fatal("Output generation is wrong for given input statement. Wrote output to 'errorOutput.error'")
+	}
+}
+
+/*
+Tests combined Google Sheets output for multiple statements in static output mode (single header row).
+*/
+func TestCorpusStaticOutputGoogleSheets(t *testing.T) {
+
+	records := []tabular.StatementRecord{
+		{Id: "1", IgScript: "A(farmer) D(must) I(comply)"},
+		{Id: "2", IgScript: "A(certifier) I(inspect) Cex(regularly) (unparsed content)"},
+	}
+
+	result := ConvertIGScriptCorpusToTabularOutput(records, tabular.OUTPUT_TYPE_GOOGLE_SHEETS, "", true, tree.DefaultOptions(),
+		tabular.ORIGINAL_STATEMENT_OUTPUT_NONE, tabular.IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	if result.Error.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Output generation should not fail. Error:", result.Error)
+	}
+
+	// Warning is reported, but statement retained
+	if len(result.StatementErrors) != 1 || result.StatementErrors[0].Id != "2" ||
+		result.StatementErrors[0].Error.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		t.Fatal("Warning should be reported for second statement. Reported errors:", result.StatementErrors)
+	}
+
+	if len(result.StatementMap) != 2 {
+		t.Fatal("Output should contain two atomic statements, but contains", len(result.StatementMap))
+	}
+
+	// Read reference file
+	content, err2 := os.ReadFile("TestOutputCorpusStaticGoogleSheets.test")
+	if err2 != nil {
+		t.Fatal("Error attempting to read test text input. Error: ", err2.Error())
+	}
+
+	// Extract expected output
+	expectedOutput := string(content)
+
+	// Compare to actual output
+	if result.Output != expectedOutput {
+		fmt.Println("Produced output:\n", result.Output)
+		fmt.Println("Expected output:\n", expectedOutput)
+		err3 := tabular.WriteToFile("errorOutput.error", result.Output, true)
+		if err3 != nil {
+			t.Fatal("Error attempting to read test text input. Error: ", err3.Error())
+		}
+		t.Fatal("Output generation is wrong for given input statement. Wrote output to 'errorOutput.error'")
+	}
+}
+
 // VISUAL OUTPUT
 
 /*
@@ -144,7 +265,7 @@ func TestValidStatementVisualTree(t *testing.T) {
 		"Bdir(approved (certified production and [AND] handling operations and [AND] accredited certifying agents)) " +
 		"Cex(for compliance with the (Act or [XOR] regulations in this part))."
 
-	_, err := ConvertIGScriptToVisualTree(text, "650", "")
+	_, err := ConvertIGScriptToVisualTree(text, "650", "", tree.DefaultOptions())
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Statement parsing should not fail")
 	}
@@ -160,7 +281,7 @@ func TestValidStatementVisualTreeWarning(t *testing.T) {
 		"Bdir(approved (certified production and [AND] handling operations and [AND] accredited certifying agents)) " +
 		"Cex(for compliance with the (Act or [XOR] regulations in this part))."
 
-	_, err := ConvertIGScriptToVisualTree(text, "650", "")
+	_, err := ConvertIGScriptToVisualTree(text, "650", "", tree.DefaultOptions())
 	if err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
 		t.Fatal("Statement parsing should warn about missing content, but returned error: ", err)
 	}
@@ -182,7 +303,7 @@ func TestValidStatementNestingVisualTree(t *testing.T) {
 		// This is the essential line
 		"Cac{A(Program Manager) I(has gained) Bdir(competence)}"
 
-	_, err := ConvertIGScriptToVisualTree(text, "650", "")
+	_, err := ConvertIGScriptToVisualTree(text, "650", "", tree.DefaultOptions())
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Statement parsing should not fail, but returned error: ", err)
 	}
@@ -202,7 +323,7 @@ func TestValidStatementNestingVisualTreeWarning(t *testing.T) {
 		// This is the essential line
 		"Cac{A(Program Manager) I(has gained) Bdir(competence)}"
 
-	_, err := ConvertIGScriptToVisualTree(text, "650", "")
+	_, err := ConvertIGScriptToVisualTree(text, "650", "", tree.DefaultOptions())
 	if err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
 		t.Fatal("Statement parsing should warn about missing content, but returned error: ", err)
 	}
@@ -225,7 +346,7 @@ func TestInvalidAttributeStatementVisualTree(t *testing.T) {
 		")" +
 		"Cex(for compliance with the (Act or [XOR] regulations in this part))."
 
-	_, err := ConvertIGScriptToVisualTree(text, "650", "")
+	_, err := ConvertIGScriptToVisualTree(text, "650", "", tree.DefaultOptions())
 	if err.ErrorCode == tree.PARSING_NO_ERROR {
 		t.Fatal("Statement parsing should produce error")
 	}
@@ -244,11 +365,317 @@ func TestDegreeOfVariabilityVisualTree(t *testing.T) {
 		")" +
 		"Cex(for compliance with the (Act [XOR] regulations in this part))."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Activate degree of variability in output
-	tabular.SetIncludeDegreeOfVariability(true)
+	opts.IncludeDegreeOfVariability = true
 
-	_, err := ConvertIGScriptToVisualTree(text, "650", "")
+	_, err := ConvertIGScriptToVisualTree(text, "650", "", opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Statement parsing should not fail")
 	}
 }
+
+// JSON OUTPUT
+
+/*
+Tests basic valid statement with nesting for JSON output.
+*/
+func TestValidStatementNestingJSON(t *testing.T) {
+	text := "A(National Organic Program's Program Manager), Cex(on behalf of the Secretary), " +
+		"D(may) " +
+		"I(inspect and), I(sustain (review [AND] (refresh [AND] drink))) " +
+		"Cac{A(Program Manager) I(has gained) Bdir(competence)}"
+
+	output, err := ConvertIGScriptToJSON(text, "650", "")
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Statement parsing should not fail, but returned error: ", err)
+	}
+
+	if !strings.Contains(output, "\"statementId\": \"650\"") ||
+		!strings.Contains(output, "\"activationConditionComplex\": {") {
+		t.Fatal("JSON output does not contain expected elements. Output:", output)
+	}
+}
+
+/*
+Tests invalid attribute combinations for JSON output.
+*/
+func TestInvalidAttributeStatementJSON(t *testing.T) {
+
+	// Statement with invalid attribute combination
+	text := "A((certifying agent [AND] borrower [OR] wife)) M(may) I(investigate) " +
+		"Cex(for compliance with the (Act or [XOR] regulations in this part))."
+
+	output, err := ConvertIGScriptToJSON(text, "650", "")
+	if err.ErrorCode == tree.PARSING_NO_ERROR {
+		t.Fatal("Statement parsing should produce error")
+	}
+	if output != "" {
+		t.Fatal("Erroneous statement should not produce output, but returned:", output)
+	}
+}
+
+// XML OUTPUT
+
+/*
+Tests basic valid statement with nesting for XML output.
+*/
+func TestValidStatementNestingXML(t *testing.T) {
+	text := "A(National Organic Program's Program Manager), Cex(on behalf of the Secretary), " +
+		"D(may) " +
+		"I(inspect and), I(sustain (review [AND] (refresh [AND] drink))) " +
+		"Cac{A(Program Manager) I(has gained) Bdir(competence)}"
+
+	output, err := ConvertIGScriptToXML(text, "650", "")
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Statement parsing should not fail, but returned error: ", err)
+	}
+
+	if !strings.Contains(output, "statementId=\"650\"") ||
+		!strings.Contains(output, "<activationConditionComplex componentType=\"Cac\">") {
+		t.Fatal("XML output does not contain expected elements. Output:", output)
+	}
+}
+
+/*
+Tests invalid attribute combinations for XML output.
+*/
+func TestInvalidAttributeStatementXML(t *testing.T) {
+
+	// Statement with invalid attribute combination
+	text := "A((certifying agent [AND] borrower [OR] wife)) M(may) I(investigate) " +
+		"Cex(for compliance with the (Act or [XOR] regulations in this part))."
+
+	output, err := ConvertIGScriptToXML(text, "650", "")
+	if err.ErrorCode == tree.PARSING_NO_ERROR {
+		t.Fatal("Statement parsing should produce error")
+	}
+	if output != "" {
+		t.Fatal("Erroneous statement should not produce output, but returned:", output)
+	}
+}
+
+// STAND-OFF OUTPUT
+
+/*
+Tests stand-off output for statement with Original Statement, as well as rejection of unparseable statements.
+*/
+func TestValidStatementStandoff(t *testing.T) {
+
+	original := "Farmers must submit reports, if requested."
+	text := "A(Farmers) D(must) I(submit) Bdir(reports) Cac{I(if requested)}"
+
+	output, err := ConvertIGScriptToStandoff(original, text, "650", "")
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Statement parsing should not fail, but returned error: ", err)
+	}
+
+	if !strings.Contains(output, "\"statementId\": \"650\"") ||
+		!strings.Contains(output, "\"originalStatementSpan\": {\n        \"start\": 29,\n        \"end\": 41,\n        \"text\": \"if requested\"") {
+		t.Fatal("Stand-off output does not contain expected elements. Output:", output)
+	}
+
+	output, err = ConvertIGScriptToStandoff(original, "A(Farmers) D(must) I(submit", "650", "")
+	if err.ErrorCode != tree.PARSING_ERROR_IMBALANCED_PARENTHESES || output != "" {
+		t.Fatal("Erroneous statement should not produce output, but returned:", err, output)
+	}
+}
+
+/*
+Tests conversion of statements into brat annotations and reconstruction of IG Script from those.
+*/
+func TestValidStatementBrat(t *testing.T) {
+
+	original := "Farmers must submit reports, if requested."
+	text := "A(Farmers) D(must) I(submit) Bdir(reports) Cac{I(if requested)}"
+
+	txt, ann, err := ConvertIGScriptToBrat(original, text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Statement parsing should not fail, but returned error: ", err)
+	}
+	if txt != original || !strings.Contains(ann, "T5\tActivation_Condition 29 41\tif requested") {
+		t.Fatal("Brat output does not contain expected elements. Output:", ann)
+	}
+
+	statement, err := ConvertBratToIGScript(txt, ann)
+	if err.ErrorCode != tree.PARSING_NO_ERROR || statement != text {
+		t.Fatal("Reconstructed statement should be '"+text+"', but returned:", statement, err)
+	}
+
+	_, err = ConvertBratToIGScript(txt, "T1\tUnknown 0 7\tFarmers")
+	if err.ErrorCode != tree.PARSING_ERROR_INVALID_BRAT_INPUT {
+		t.Fatal("Invalid annotations should be rejected, but returned:", err)
+	}
+}
+
+/*
+Tests the reconstruction of IG Script from tabular output, including rejection of invalid tables.
+*/
+func TestValidStatementTabularImport(t *testing.T) {
+
+	original := "Farmers must submit or file reports, if requested."
+	text := "A(Farmers) D(must) I((submit [XOR] file)) Bdir(reports) Cac{I(if requested)}"
+
+	results, err := ConvertIGScriptToTabularOutput(original, text, "123", tabular.OUTPUT_TYPE_GOOGLE_SHEETS, "", true,
+		tree.DefaultOptions(), tabular.ORIGINAL_STATEMENT_OUTPUT_FIRST_ENTRY, tabular.IG_SCRIPT_OUTPUT_NONE)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Statement parsing should not fail, but returned error: ", err)
+	}
+
+	records, stmtErrors, err := ConvertTabularToIGScript(results[0].Output)
+	if err.ErrorCode != tree.PARSING_NO_ERROR || len(stmtErrors) != 0 || len(records) != 1 {
+		t.Fatal("Import of tabular output should not fail, but returned:", err, stmtErrors)
+	}
+	if records[0].Id != "123" || records[0].OriginalStatement != original || records[0].IgScript != text {
+		t.Fatal("Reconstructed statement should be '"+text+"', but returned:", records[0])
+	}
+
+	_, _, err = ConvertTabularToIGScript("Statement ID|Unknown|\n'1|farmer|\n")
+	if err.ErrorCode != tree.PARSING_ERROR_INVALID_TABULAR_INPUT {
+		t.Fatal("Invalid table should be rejected, but returned:", err)
+	}
+}
+
+/*
+Tests the expansion of statements into atomic statements, including IDs, structured logical linkages (corresponding
+to the linkages in tabular output) and references to the statements atomic statements are expanded from.
+*/
+func TestAtomicStatementExpansion(t *testing.T) {
+
+	text := "A((farmer [XOR] trader)) D(must) I((sell [OR] lease)) Cac{A(council) I(approves)}"
+
+	atomicStmts, err := ExpandIGScriptToAtomicStatements(text, "650", tree.DefaultOptions())
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Expansion should not fail, but returned error:", err)
+	}
+
+	expectedStmts := []string{
+		"A(farmer) D(must) I(sell) Cac{A(council) I(approves)}",
+		"A(farmer) D(must) I(lease) Cac{A(council) I(approves)}",
+		"A(trader) D(must) I(sell) Cac{A(council) I(approves)}",
+		"A(trader) D(must) I(lease) Cac{A(council) I(approves)}",
+	}
+	if len(atomicStmts) != len(expectedStmts) {
+		t.Fatal("Expansion should return", len(expectedStmts), "atomic statements, but returned", len(atomicStmts))
+	}
+	for i, atomicStmt := range atomicStmts {
+		if atomicStmt.ID != "650."+strconv.Itoa(i+1) || atomicStmt.ParentID != "650" {
+			t.Fatal("Atomic statement has wrong IDs:", atomicStmt.ID, atomicStmt.ParentID)
+		}
+		if atomicStmt.Statement.Stringify() != expectedStmts[i] {
+			t.Fatal("Atomic statement should be '"+expectedStmts[i]+"', but was", atomicStmt.Statement.Stringify())
+		}
+	}
+	expectedLinkages := fmt.Sprint([]tree.AtomicStatementLinkage{
+		{TargetID: "650.2", Component: tree.AIM, Operators: []string{tree.OR}},
+		{TargetID: "650.3", Component: tree.ATTRIBUTES, Operators: []string{tree.XOR}},
+		{TargetID: "650.4", Component: tree.ATTRIBUTES, Operators: []string{tree.XOR}},
+		{TargetID: "650.4", Component: tree.AIM, Operators: []string{tree.OR}},
+	})
+	if fmt.Sprint(atomicStmts[0].Linkages) != expectedLinkages {
+		t.Fatal("Linkages of atomic statement are wrong:", atomicStmts[0].Linkages)
+	}
+
+	// Parsed statement remains unmodified
+	if atomicStmts[0].Parent.StringifyStatement() != text {
+		t.Fatal("Expansion should not modify parsed statement, but returned", atomicStmts[0].Parent.StringifyStatement())
+	}
+
+	// Component pair combinations are extrapolated into separate statements linked at statement level
+	atomicStmts, err = ExpandIGScriptToAtomicStatements("{A(council) I(approves) [XOR] A(state) I((permits [OR] licenses))}", "650", tree.DefaultOptions())
+	if err.ErrorCode != tree.PARSING_NO_ERROR || len(atomicStmts) != 3 {
+		t.Fatal("Expansion of component pairs should return three atomic statements, but returned", atomicStmts, err)
+	}
+	if atomicStmts[0].ID != "650.1" || atomicStmts[1].ID != "650.2.1" || atomicStmts[1].ParentID != "650.2" ||
+		fmt.Sprint(atomicStmts[0].Linkages) != "[{650.2.1  [XOR]} {650.2.2  [XOR]}]" ||
+		fmt.Sprint(atomicStmts[1].Linkages) != "[{650.2.2 I [OR]} {650.1  [XOR]}]" {
+		t.Fatal("Atomic statements of component pairs are wrong:", atomicStmts)
+	}
+}
+
+// CONCURRENCY
+
+/*
+Tests concurrent conversion of statements with differing output options, ensuring that the output of each
+goroutine corresponds to the sequentially generated output for the respective options (run with -race).
+*/
+func TestConcurrentConversionWithDifferentOptions(t *testing.T) {
+
+	text := "A,p(certified) A(agent) D(must) I((inspect [AND] review)) Bdir,p(organic) Bdir(farms) " +
+		"Cac{A(farmer) I(apply)} Cex(for compliance with the (Act [XOR] regulations))."
+
+	// Option variants for tabular output
+	tabularOpts := []tree.Options{tree.DefaultOptions(), tree.DefaultOptions(), tree.DefaultOptions(), tree.DefaultOptions()}
+	tabularOpts[0].SetDynamicOutput(true)
+	tabularOpts[1].IGExtendedOutput = false
+	tabularOpts[1].IncludeHeaders = false
+	tabularOpts[2].IncludeAnnotations = true
+	tabularOpts[3].SetDynamicOutput(true)
+	tabularOpts[3].IncludeSharedElementsInTabularOutput = false
+
+	// Option variants for visual output
+	visualOpts := []tree.Options{tree.DefaultOptions(), tree.DefaultOptions(), tree.DefaultOptions()}
+	visualOpts[0].FlatPrinting = true
+	visualOpts[1].BinaryPrinting = true
+	visualOpts[1].IncludeDegreeOfVariability = true
+	visualOpts[2].MoveActivationConditionsToFront = true
+	visualOpts[2].IncludeSharedElementsInVisualOutput = false
+
+	// Generate reference output sequentially
+	convertTabular := func(opts tree.Options) string {
+		results, err := ConvertIGScriptToTabularOutput("", text, "123", tabular.OUTPUT_TYPE_CSV, "", true, opts, tabular.ORIGINAL_STATEMENT_OUTPUT_NONE, tabular.IG_SCRIPT_OUTPUT_NONE)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			t.Error("Tabular conversion should not fail. Error:", err)
+		}
+		output := ""
+		for _, res := range results {
+			output += res.Output
+		}
+		return output
+	}
+	convertVisual := func(opts tree.Options) string {
+		output, err := ConvertIGScriptToVisualTree(text, "123", "", opts)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			t.Error("Visual conversion should not fail. Error:", err)
+		}
+		return output
+	}
+	expectedTabular := []string{}
+	for _, opts := range tabularOpts {
+		expectedTabular = append(expectedTabular, convertTabular(opts))
+	}
+	expectedVisual := []string{}
+	for _, opts := range visualOpts {
+		expectedVisual = append(expectedVisual, convertVisual(opts))
+	}
+
+	// Ensure that options variants actually produce differing output
+	if expectedTabular[0] == expectedTabular[1] || expectedVisual[0] == expectedVisual[1] {
+		t.Fatal("Option variants should produce differing output.")
+	}
+
+	// Run conversions concurrently and compare with reference output
+	wg := sync.WaitGroup{}
+	for i := 0; i < 2; i++ {
+		for j := range tabularOpts {
+			wg.Add(1)
+			go func(j int) {
+				defer wg.Done()
+				if output := convertTabular(tabularOpts[j]); output != expectedTabular[j] {
+					t.Error("Concurrently generated tabular output differs for option variant", j, "Output:", output)
+				}
+			}(j)
+		}
+		for j := range visualOpts {
+			wg.Add(1)
+			go func(j int) {
+				defer wg.Done()
+				if output := convertVisual(visualOpts[j]); output != expectedVisual[j] {
+					t.Error("Concurrently generated visual output differs for option variant", j, "Output:", output)
+				}
+			}(j)
+		}
+	}
+	wg.Wait()
+}
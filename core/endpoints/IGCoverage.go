@@ -0,0 +1,34 @@
+package endpoints
+
+import (
+	"IG-Parser/core/coverage"
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+)
+
+/*
+This file contains the application endpoint for the coverage analysis of IG Script-encoded institutional statements
+against the original statements they encode (see core/coverage).
+*/
+
+/*
+Consumes original statement and encoded statement as input and aligns the text content of both.
+Returns the result of the coverage analysis (i.e., coverage percentage, unencoded and unmatched words), and the
+parsing error (defaults to tree.PARSING_NO_ERROR). Statements that cannot be parsed are not analyzed, whereas the
+analysis proceeds in case of parsing warnings (e.g., potentially non-parsed content), which are returned alongside
+the result.
+*/
+func AnalyzeCoverage(originalStatement string, statement string) (coverage.Result, tree.ParsingError) {
+
+	Println(" Step: Parse input statement")
+	_, err := parser.ParseStatement(statement)
+	if err.ErrorCode != tree.PARSING_NO_ERROR && err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		return coverage.Result{}, err
+	}
+
+	Println(" Step: Analyze coverage of original statement")
+	result := coverage.Analyze(originalStatement, statement)
+	Println("  - Coverage results:", result)
+
+	return result, err
+}
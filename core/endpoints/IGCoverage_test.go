@@ -0,0 +1,25 @@
+package endpoints
+
+import (
+	"IG-Parser/core/tree"
+	"testing"
+)
+
+/*
+Tests coverage analysis of parseable statement, as well as rejection of unparseable statements.
+*/
+func TestAnalyzeCoverage(t *testing.T) {
+
+	result, err := AnalyzeCoverage("Farmers must not sell their goods.", "A(Farmers) D(must) I([NOT] sell) Bdir(goods)")
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Coverage analysis should not fail. Error:", err)
+	}
+	if len(result.UnencodedWords) != 1 || result.UnencodedWords[0].Text != "their" || len(result.UnmatchedWords) != 0 {
+		t.Fatal("Coverage analysis should only report 'their' as unencoded, but returned", result)
+	}
+
+	_, err = AnalyzeCoverage("Farmers must sell.", "A(Farmers) D(must) I(sell")
+	if err.ErrorCode != tree.PARSING_ERROR_IMBALANCED_PARENTHESES {
+		t.Fatal("Coverage analysis of unparseable statement should fail, but returned", err)
+	}
+}
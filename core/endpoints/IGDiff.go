@@ -0,0 +1,109 @@
+package endpoints
+
+import (
+	"IG-Parser/core/diff"
+	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+)
+
+/*
+This file contains the application endpoints for the structural comparison of two encodings of a statement,
+e.g., encodings of different coders, or revisions of an encoding (see core/diff).
+*/
+
+// Prefixes of error messages indicating the encoding that cannot be parsed
+const DIFF_ERROR_PREFIX_BASE = "Base encoding: "
+const DIFF_ERROR_PREFIX_REVISED = "Revised encoding: "
+
+/*
+Consumes two IG Script-encoded versions of a statement (base and revised encoding) and returns the edit script
+transforming the base encoding into the revised encoding (see diff.Compare()). Returns the parsing error of the
+first encoding that cannot be parsed (with its message indicating the encoding), or parsing warnings (e.g.,
+potentially non-parsed content) alongside the edit script. Otherwise returns tree.PARSING_NO_ERROR.
+*/
+func DiffIGScript(baseStatement string, revisedStatement string) ([]diff.Edit, tree.ParsingError) {
+
+	base, revised, err := parseEncodings(baseStatement, revisedStatement)
+	if base == nil {
+		return nil, err
+	}
+
+	Println(" Step: Compare encodings")
+	edits := diff.Compare(base, revised)
+	Println("  - Edit script:", edits)
+
+	return edits, err
+}
+
+/*
+Consumes two IG Script-encoded versions of a statement (base and revised encoding) and produces the visual output
+(see ConvertIGScriptToVisualTree()) holding the trees of both encodings (see tree.PrintDiffTree()), with nodes added,
+removed or changed in the revised encoding being marked (see diff.MarkNodes()). Takes options controlling the
+visual output, and a filename for the output. If the filename is empty, no output will be written.
+Returns the visual output alongside the edit script (see #DiffIGScript()), and the error (defaults to tree.PARSING_NO_ERROR).
+*/
+func ConvertIGScriptDiffToVisualTree(baseStatement string, revisedStatement string, filename string, opts tree.Options) (string, []diff.Edit, tree.ParsingError) {
+
+	base, revised, err := parseEncodings(baseStatement, revisedStatement)
+	if base == nil {
+		return "", nil, err
+	}
+
+	Println(" Step: Compare encodings")
+	edits := diff.Compare(base, revised)
+	diff.MarkNodes(edits)
+	Println("  - Edit script:", edits)
+
+	Println(" Step: Generate visual output structure (diff)")
+	output, err2 := tree.PrintDiffTree(base, revised, opts)
+	if err2.ErrorCode != tree.TREE_NO_ERROR {
+		return output, edits, tree.ParsingError{ErrorCode: tree.PARSING_ERROR_EMBEDDED_NODE_ERROR, ErrorMessage: err2.ErrorMessage}
+	}
+
+	Println("  - Generated visual tree:", output)
+
+	if filename != "" {
+		Println("  - Writing to file ...")
+
+		err3 := tabular.WriteToFile(filename, output, true)
+		if err3 != nil {
+			Println("  - Problems when writing file "+filename+", Error:", err3)
+		}
+
+		Println("  - Writing completed.")
+	}
+
+	return output, edits, err
+}
+
+/*
+Parses base and revised encoding of a statement, and returns the nodes embedding the parsed statements
+(nil if either encoding cannot be parsed), alongside the parsing error (see #DiffIGScript()).
+*/
+func parseEncodings(baseStatement string, revisedStatement string) (*tree.Node, *tree.Node, tree.ParsingError) {
+
+	Println(" Step: Parse base encoding")
+	baseStmts, err := parser.ParseStatement(baseStatement)
+	if err.ErrorCode != tree.PARSING_NO_ERROR && err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		err.ErrorMessage = DIFF_ERROR_PREFIX_BASE + err.ErrorMessage
+		return nil, nil, err
+	}
+
+	Println(" Step: Parse revised encoding")
+	revisedStmts, err2 := parser.ParseStatement(revisedStatement)
+	if err2.ErrorCode != tree.PARSING_NO_ERROR && err2.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		err2.ErrorMessage = DIFF_ERROR_PREFIX_REVISED + err2.ErrorMessage
+		return nil, nil, err2
+	}
+
+	// Return parsing warning of either encoding (if any)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		err.ErrorMessage = DIFF_ERROR_PREFIX_BASE + err.ErrorMessage
+		return baseStmts[0], revisedStmts[0], err
+	}
+	if err2.ErrorCode != tree.PARSING_NO_ERROR {
+		err2.ErrorMessage = DIFF_ERROR_PREFIX_REVISED + err2.ErrorMessage
+	}
+	return baseStmts[0], revisedStmts[0], err2
+}
@@ -0,0 +1,36 @@
+package endpoints
+
+import (
+	"IG-Parser/core/diff"
+	"IG-Parser/core/tree"
+	"strings"
+	"testing"
+)
+
+/*
+Tests the comparison of encodings and the marking of nodes in visual diff output, as well as the
+indication of the encoding that cannot be parsed.
+*/
+func TestDiffIGScript(t *testing.T) {
+
+	edits, err := DiffIGScript("A(farmer) D(must) I(sell)", "A(farmer) D(may) I(sell)")
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Comparison should not fail. Error:", err)
+	}
+	if len(edits) != 1 || edits[0].Operation != diff.OPERATION_CHANGED || edits[0].Path != tree.DEONTIC {
+		t.Fatal("Comparison should report changed Deontic, but reported", edits)
+	}
+
+	output, edits, err := ConvertIGScriptDiffToVisualTree("A(farmer) I(sell)", "A(farmer) I(sell) Bdir(goods)", "", tree.DefaultOptions())
+	if err.ErrorCode != tree.PARSING_NO_ERROR || len(edits) != 1 {
+		t.Fatal("Comparison should report added component, but returned", err, edits)
+	}
+	if !strings.Contains(output, "{\"name\": \"goods\", \"comp\": \"Bdir\", \"level\": 1, \"diff\": \"added\"}") {
+		t.Fatal("Visual output should mark added component, but was:\n", output)
+	}
+
+	_, err = DiffIGScript("A(farmer) I(sell)", "A(farmer) I(sell")
+	if err.ErrorCode != tree.PARSING_ERROR_IMBALANCED_PARENTHESES || !strings.HasPrefix(err.ErrorMessage, DIFF_ERROR_PREFIX_REVISED) {
+		t.Fatal("Comparison should indicate revised encoding as unparseable, but returned", err)
+	}
+}
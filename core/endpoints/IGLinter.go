@@ -0,0 +1,34 @@
+package endpoints
+
+import (
+	"IG-Parser/core/linter"
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+)
+
+/*
+This file contains the application endpoint for the linting of IG Script-encoded institutional statements
+against coding guidelines (see core/linter).
+*/
+
+/*
+Consumes statement as input and checks it against the rules enabled in the given linter configuration.
+Returns violations of coding guidelines as diagnostics (with severity tree.SEVERITY_WARNING or tree.SEVERITY_INFO),
+and the parsing error (defaults to tree.PARSING_NO_ERROR). Statements that cannot be parsed are not linted,
+whereas linting proceeds in case of parsing warnings (e.g., potentially non-parsed content), which are returned
+alongside the violations.
+*/
+func LintIGScript(statement string, config linter.Config) ([]tree.Diagnostic, tree.ParsingError) {
+
+	Println(" Step: Parse input statement")
+	stmts, err := parser.ParseStatement(statement)
+	if err.ErrorCode != tree.PARSING_NO_ERROR && err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		return nil, err
+	}
+
+	Println(" Step: Lint parsed statement")
+	diagnostics := linter.Lint(stmts, config)
+	Println("  - Lint results:", diagnostics)
+
+	return diagnostics, err
+}
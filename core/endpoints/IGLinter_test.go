@@ -0,0 +1,26 @@
+package endpoints
+
+import (
+	"IG-Parser/core/linter"
+	"IG-Parser/core/tree"
+	"testing"
+)
+
+/*
+Tests linting of statement with parsing warning, as well as rejection of unparseable statements.
+*/
+func TestLintIGScript(t *testing.T) {
+
+	diagnostics, err := LintIGScript("A(farmer) D(must) M(may) I(comply) (remark)", linter.DefaultConfig())
+	if err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		t.Fatal("Linting should return parsing warning, but returned", err)
+	}
+	if len(diagnostics) != 1 || diagnostics[0].ErrorCode != linter.RULE_DEONTIC_WITH_MODAL {
+		t.Fatal("Linting should report Deontic combined with Modal, but reported", diagnostics)
+	}
+
+	diagnostics, err = LintIGScript("A(farmer) D(must) I(comply", linter.DefaultConfig())
+	if err.ErrorCode != tree.PARSING_ERROR_IMBALANCED_PARENTHESES || diagnostics != nil {
+		t.Fatal("Linting of unparseable statement should fail, but returned", err, diagnostics)
+	}
+}
@@ -0,0 +1,57 @@
+package endpoints
+
+import (
+	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/query"
+	"IG-Parser/core/tree"
+)
+
+/*
+This file contains the application endpoint for structural queries over IG Script-encoded statements
+(see core/query).
+*/
+
+/*
+Consumes IG Script-encoded statements (identified by statement ID) and evaluates the given query against them
+(e.g., 'A[content*=farmer] & Cac:nested'). Returns the matching statements alongside the selected elements in
+the given output format (see query.OUTPUT_FORMATS), which is written to the given file unless the filename is
+empty. Statements that cannot be parsed are excluded from the query and listed in the result. Returns
+tree.PARSING_ERROR_INVALID_QUERY for syntactically invalid queries, and tree.PARSING_ERROR_INVALID_OUTPUT_TYPE
+for unknown output formats. Otherwise returns tree.PARSING_NO_ERROR.
+*/
+func QueryStatements(records []query.Record, queryString string, format string, filename string) (string, tree.ParsingError) {
+
+	Println(" Step: Parse query")
+	q, err := query.Parse(queryString)
+	if err != nil {
+		return "", tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_QUERY, ErrorMessage: err.Error()}
+	}
+	if format != query.OUTPUT_FORMAT_CSV && format != query.OUTPUT_FORMAT_JSON {
+		return "", tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_OUTPUT_TYPE,
+			ErrorMessage: "Invalid output format for query result: '" + format + "'"}
+	}
+
+	Println(" Step: Evaluate query")
+	result := query.Search(records, q)
+
+	Println(" Step: Generate query result")
+	output := ""
+	if format == query.OUTPUT_FORMAT_CSV {
+		output, err = query.GenerateCSV(result)
+	} else {
+		output, err = query.GenerateJSON(result)
+	}
+	if err != nil {
+		return "", tree.ParsingError{ErrorCode: tree.PARSING_ERROR_WRITE,
+			ErrorMessage: "Error when generating query result: " + err.Error()}
+	}
+
+	if filename != "" {
+		Println("  - Writing to file ...")
+		if err2 := tabular.WriteToFile(filename, output, true); err2 != nil {
+			Println("  - Problems when writing file "+filename+", Error:", err2)
+		}
+	}
+
+	return output, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
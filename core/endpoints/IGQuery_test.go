@@ -0,0 +1,37 @@
+package endpoints
+
+import (
+	"IG-Parser/core/query"
+	"IG-Parser/core/tree"
+	"strings"
+	"testing"
+)
+
+/*
+Tests the evaluation of queries, as well as rejection of invalid queries and unknown output formats.
+*/
+func TestQueryStatements(t *testing.T) {
+
+	records := []query.Record{
+		{StmtId: "1", Statement: "A(farmer) D(must) I((sell [XOR] buy))"},
+		{StmtId: "2", Statement: "A(farmer) D(may) I(sell) Cac{A(council) I(approves)}"},
+	}
+
+	output, err := QueryStatements(records, "D[content=may] & Cac:has(A)", query.OUTPUT_FORMAT_CSV, "")
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Query should not fail. Error:", err)
+	}
+	if !strings.Contains(output, "2,D,D,may,,,0") || strings.Contains(output, "\n1,") {
+		t.Fatal("Result should only contain statement 2, but was:\n", output)
+	}
+
+	_, err = QueryStatements(records, "D[content=may", query.OUTPUT_FORMAT_CSV, "")
+	if err.ErrorCode != tree.PARSING_ERROR_INVALID_QUERY {
+		t.Fatal("Invalid query should be rejected, but returned", err)
+	}
+
+	_, err = QueryStatements(records, "D", "xlsx", "")
+	if err.ErrorCode != tree.PARSING_ERROR_INVALID_OUTPUT_TYPE {
+		t.Fatal("Unknown output format should be rejected, but returned", err)
+	}
+}
@@ -0,0 +1,55 @@
+package endpoints
+
+import (
+	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/reliability"
+	"IG-Parser/core/tree"
+)
+
+/*
+This file contains the application endpoint for the inter-coder reliability analysis of statements
+encoded by multiple coders (see core/reliability).
+*/
+
+/*
+Consumes codings of statements by multiple coders (identified by statement ID and coder) and computes the agreement
+of coders per statement, per component and across all statements. Returns the report in the given output format
+(see reliability.OUTPUT_FORMATS), which is written to the given file unless the filename is empty.
+Returns the error of the first coding that cannot be parsed, tree.PARSING_ERROR_DUPLICATE_CODING for repeated
+codings of a statement by the same coder, or tree.PARSING_ERROR_INVALID_OUTPUT_TYPE for unknown output formats.
+Otherwise returns tree.PARSING_NO_ERROR.
+*/
+func AnalyzeReliability(codings []reliability.Coding, format string, filename string) (string, tree.ParsingError) {
+
+	Println(" Step: Analyze agreement of coders")
+	report, err := reliability.Analyze(codings)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return "", err
+	}
+
+	Println(" Step: Generate reliability report")
+	output := ""
+	var err2 error
+	switch format {
+	case reliability.OUTPUT_FORMAT_CSV:
+		output, err2 = reliability.GenerateCSV(report)
+	case reliability.OUTPUT_FORMAT_JSON:
+		output, err2 = reliability.GenerateJSON(report)
+	default:
+		return "", tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_OUTPUT_TYPE,
+			ErrorMessage: "Invalid output format for reliability report: '" + format + "'"}
+	}
+	if err2 != nil {
+		return "", tree.ParsingError{ErrorCode: tree.PARSING_ERROR_WRITE,
+			ErrorMessage: "Error when generating reliability report: " + err2.Error()}
+	}
+
+	if filename != "" {
+		Println("  - Writing to file ...")
+		if err3 := tabular.WriteToFile(filename, output, true); err3 != nil {
+			Println("  - Problems when writing file "+filename+", Error:", err3)
+		}
+	}
+
+	return output, err
+}
@@ -0,0 +1,32 @@
+package endpoints
+
+import (
+	"IG-Parser/core/reliability"
+	"IG-Parser/core/tree"
+	"strings"
+	"testing"
+)
+
+/*
+Tests the generation of reliability reports, as well as rejection of unknown output formats.
+*/
+func TestAnalyzeReliability(t *testing.T) {
+
+	codings := []reliability.Coding{
+		{StmtId: "1", Coder: "alice", Statement: "A(farmer) D(must) I(sell)"},
+		{StmtId: "1", Coder: "bob", Statement: "A(farmer) D(may) I(sell)"},
+	}
+
+	output, err := AnalyzeReliability(codings, reliability.OUTPUT_FORMAT_CSV, "")
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Reliability analysis should not fail. Error:", err)
+	}
+	if !strings.Contains(output, "statement,1,,content,3,0.6667,") {
+		t.Fatal("Report should contain content agreement for statement, but was:\n", output)
+	}
+
+	_, err = AnalyzeReliability(codings, "xlsx", "")
+	if err.ErrorCode != tree.PARSING_ERROR_INVALID_OUTPUT_TYPE {
+		t.Fatal("Unknown output format should be rejected, but returned", err)
+	}
+}
@@ -0,0 +1,52 @@
+package endpoints
+
+import (
+	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/statistics"
+	"IG-Parser/core/tree"
+)
+
+/*
+This file contains the application endpoint for the corpus statistics of IG Script-encoded statements
+(see core/statistics).
+*/
+
+/*
+Consumes IG Script-encoded statements (identified by statement ID) and determines the measures per statement
+(e.g., degree of variability, nesting depth, number of atomic statements), as well as their distributions and
+frequencies across all statements. Returns the report in the given output format (see statistics.OUTPUT_FORMATS),
+which is written to the given file unless the filename is empty. Statements that cannot be parsed are excluded
+from the analysis and listed in the report. Returns tree.PARSING_ERROR_INVALID_OUTPUT_TYPE for unknown output
+formats. Otherwise returns tree.PARSING_NO_ERROR.
+*/
+func AnalyzeStatistics(records []statistics.Record, format string, filename string) (string, tree.ParsingError) {
+
+	Println(" Step: Determine corpus statistics")
+	report := statistics.Analyze(records)
+
+	Println(" Step: Generate statistics report")
+	output := ""
+	var err error
+	switch format {
+	case statistics.OUTPUT_FORMAT_CSV:
+		output, err = statistics.GenerateCSV(report)
+	case statistics.OUTPUT_FORMAT_JSON:
+		output, err = statistics.GenerateJSON(report)
+	default:
+		return "", tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_OUTPUT_TYPE,
+			ErrorMessage: "Invalid output format for statistics report: '" + format + "'"}
+	}
+	if err != nil {
+		return "", tree.ParsingError{ErrorCode: tree.PARSING_ERROR_WRITE,
+			ErrorMessage: "Error when generating statistics report: " + err.Error()}
+	}
+
+	if filename != "" {
+		Println("  - Writing to file ...")
+		if err2 := tabular.WriteToFile(filename, output, true); err2 != nil {
+			Println("  - Problems when writing file "+filename+", Error:", err2)
+		}
+	}
+
+	return output, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
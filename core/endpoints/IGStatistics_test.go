@@ -0,0 +1,32 @@
+package endpoints
+
+import (
+	"IG-Parser/core/statistics"
+	"IG-Parser/core/tree"
+	"strings"
+	"testing"
+)
+
+/*
+Tests the generation of statistics reports, as well as rejection of unknown output formats.
+*/
+func TestAnalyzeStatistics(t *testing.T) {
+
+	records := []statistics.Record{
+		{StmtId: "1", Statement: "A(farmer) D(must) I((sell [XOR] buy))"},
+		{StmtId: "2", Statement: "A(farmer) D(may) I(sell) Cac{A(council) I(approves)}"},
+	}
+
+	output, err := AnalyzeStatistics(records, statistics.OUTPUT_FORMAT_CSV, "")
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Statistics should not fail. Error:", err)
+	}
+	if !strings.Contains(output, "corpus,,atomic statements,total,3,") || !strings.Contains(output, "statement,2,nesting depth,,1,") {
+		t.Fatal("Report should contain atomic statements and nesting depth, but was:\n", output)
+	}
+
+	_, err = AnalyzeStatistics(records, "xlsx", "")
+	if err.ErrorCode != tree.PARSING_ERROR_INVALID_OUTPUT_TYPE {
+		t.Fatal("Unknown output format should be rejected, but returned", err)
+	}
+}
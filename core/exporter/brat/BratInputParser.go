@@ -0,0 +1,397 @@
+package brat
+
+import (
+	"IG-Parser/core/tree"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+This file contains the import of brat stand-off annotations (.txt and .ann files) into the tree structures
+of IG-Parser (see BratStructs.go for the representation of statements). Entities are assigned to the nested
+statement of the smallest entity of a nestable component (e.g., Activation Condition) containing them, and
+component instances of the same type that are not linked by relations are combined by implicit linkage (bAND).
+*/
+
+/*
+Entity parsed from annotation file.
+*/
+type inputEntity struct {
+	// Entity identifier (e.g., 'T1')
+	id string
+	// Numeric part of identifier (for ordering of entities with identical span)
+	number int
+	symbol string
+	// Character offsets of entity (from start of first fragment to end of last fragment)
+	start int
+	end   int
+	// Text of entity fragments (joined by whitespace)
+	text        string
+	negated     bool
+	suffix      string
+	annotations string
+	// Index of enclosing nested statement entity (-1 for top-level statement)
+	parent int
+	// Indicates whether entity embeds nested statement
+	nested bool
+}
+
+/*
+Combination of entities linked by relations (or individual entity).
+*/
+type term struct {
+	// Index of entity (for individual entities)
+	entity   int
+	operator string
+	left     *term
+	right    *term
+}
+
+/*
+Returns the index of the first entity in the given term.
+*/
+func (t *term) first() int {
+	if t.left != nil {
+		return t.left.first()
+	}
+	return t.entity
+}
+
+/*
+Holds the state of the import of brat annotations.
+*/
+type importer struct {
+	entities []*inputEntity
+	// Terms entities are contained in (by entity index)
+	terms []*term
+}
+
+/*
+Parses brat annotations (content of .ann file) for the given annotated text (content of .txt file) and returns
+node embedding the corresponding statement. Lines other than entities, relations and attributes (e.g., notes)
+are ignored. Returns tree.PARSING_ERROR_INVALID_BRAT_INPUT for malformed annotations and annotations that cannot
+be represented in IG Script (e.g., nested statements in Attributes), or tree.PARSING_NO_ERROR in case of success.
+*/
+func ParseBratInput(text string, annotations string) (*tree.Node, tree.ParsingError) {
+
+	imp := importer{}
+	ids := map[string]int{}
+	runes := []rune(text)
+	relationLines := []string{}
+
+	// Parse entities first, since relations and attributes refer to them
+	lines := strings.Split(strings.ReplaceAll(annotations, "\r\n", "\n"), "\n")
+	for _, line := range lines {
+		if strings.HasPrefix(line, ENTITY_ID_PREFIX) {
+			ent, err := parseEntity(line, runes)
+			if err.ErrorCode != tree.PARSING_NO_ERROR {
+				return nil, err
+			}
+			if _, ok := ids[ent.id]; ok {
+				return nil, invalidInput("Duplicate entity identifier '" + ent.id + "'")
+			}
+			ids[ent.id] = len(imp.entities)
+			imp.entities = append(imp.entities, ent)
+			imp.terms = append(imp.terms, &term{entity: len(imp.entities) - 1})
+		}
+	}
+	for _, line := range lines {
+		switch {
+		case strings.HasPrefix(line, RELATION_ID_PREFIX):
+			relationLines = append(relationLines, line)
+		case strings.HasPrefix(line, ATTRIBUTE_ID_PREFIX), strings.HasPrefix(line, MODIFICATION_ID_PREFIX):
+			if err := imp.parseAttribute(line, ids); err.ErrorCode != tree.PARSING_NO_ERROR {
+				return nil, err
+			}
+		}
+	}
+	Println("Parsed entities:", len(imp.entities))
+
+	if err := imp.assignParents(); err.ErrorCode != tree.PARSING_NO_ERROR {
+		return nil, err
+	}
+
+	// Apply relations in order of identifiers (inner combinations precede outer ones)
+	sort.SliceStable(relationLines, func(i, j int) bool {
+		return idNumber(strings.Fields(relationLines[i])[0]) < idNumber(strings.Fields(relationLines[j])[0])
+	})
+	for _, line := range relationLines {
+		if err := imp.applyRelation(line, ids); err.ErrorCode != tree.PARSING_NO_ERROR {
+			return nil, err
+		}
+	}
+
+	stmt, err := imp.buildStatement(-1)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return nil, err
+	}
+	return &tree.Node{Entry: stmt}, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Parses entity line (e.g., 'T1	Attributes 0 7	farmer'), with text of entity extracted from annotated text.
+*/
+func parseEntity(line string, runes []rune) (*inputEntity, tree.ParsingError) {
+	fields := strings.Split(line, FIELD_SEPARATOR)
+	if len(fields) < 2 {
+		return nil, invalidInput("Malformed entity '" + line + "'")
+	}
+	typeAndOffsets := strings.SplitN(fields[1], ARGUMENT_SEPARATOR, 2)
+	if len(typeAndOffsets) != 2 {
+		return nil, invalidInput("Malformed entity '" + line + "'")
+	}
+	symbol, ok := componentSymbol(typeAndOffsets[0])
+	if !ok {
+		return nil, invalidInput("Unknown entity type '" + typeAndOffsets[0] + "' in entity '" + fields[0] + "'")
+	}
+	ent := &inputEntity{id: fields[0], number: idNumber(fields[0]), symbol: symbol, start: -1, parent: -1}
+	texts := []string{}
+	for _, fragment := range strings.Split(typeAndOffsets[1], FRAGMENT_SEPARATOR) {
+		offsets := strings.Fields(fragment)
+		if len(offsets) != 2 {
+			return nil, invalidInput("Malformed offsets in entity '" + fields[0] + "'")
+		}
+		start, err1 := strconv.Atoi(offsets[0])
+		end, err2 := strconv.Atoi(offsets[1])
+		if err1 != nil || err2 != nil || start < 0 || start >= end || end > len(runes) {
+			return nil, invalidInput("Invalid offsets in entity '" + fields[0] + "'")
+		}
+		if ent.start == -1 || start < ent.start {
+			ent.start = start
+		}
+		if end > ent.end {
+			ent.end = end
+		}
+		texts = append(texts, string(runes[start:end]))
+	}
+	ent.text = strings.Join(strings.Fields(strings.Join(texts, " ")), " ")
+	return ent, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Parses attribute line (e.g., 'A1	role T1 enforcer') and attaches negation, suffix or annotation to the
+referenced entity.
+*/
+func (imp *importer) parseAttribute(line string, ids map[string]int) tree.ParsingError {
+	fields := strings.Split(line, FIELD_SEPARATOR)
+	if len(fields) < 2 {
+		return invalidInput("Malformed attribute '" + line + "'")
+	}
+	args := strings.Fields(fields[1])
+	if len(args) < 2 || len(args) > 3 {
+		return invalidInput("Malformed attribute '" + line + "'")
+	}
+	idx, ok := ids[args[1]]
+	if !ok {
+		return invalidInput("Attribute '" + fields[0] + "' refers to unknown entity '" + args[1] + "'")
+	}
+	ent := imp.entities[idx]
+	switch {
+	case args[0] == ATTRIBUTE_NEGATED:
+		ent.negated = true
+	case args[0] == ATTRIBUTE_SUFFIX && len(args) == 3:
+		ent.suffix = args[2]
+	case len(args) == 3:
+		ent.annotations += tree.LEFT_BRACKET + args[0] + "=" + args[2] + tree.RIGHT_BRACKET
+	default:
+		ent.annotations += tree.LEFT_BRACKET + args[0] + tree.RIGHT_BRACKET
+	}
+	return tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Assigns entities to the smallest enclosing entity of a nestable component, which thereby embeds a nested statement.
+Of entities with identical span, the entity with the lower identifier is considered the enclosing one.
+*/
+func (imp *importer) assignParents() tree.ParsingError {
+	for i, ent := range imp.entities {
+		for j, candidate := range imp.entities {
+			if i == j || !nestable(candidate.symbol) || !encloses(candidate, ent) {
+				continue
+			}
+			if ent.parent == -1 || encloses(imp.entities[ent.parent], candidate) {
+				ent.parent = j
+			}
+		}
+	}
+	for _, ent := range imp.entities {
+		if ent.parent != -1 {
+			imp.entities[ent.parent].nested = true
+		}
+	}
+	for _, ent := range imp.entities {
+		if !ent.nested && (&tree.Statement{}).ComponentField(ent.symbol, false) == nil {
+			return invalidInput("Entity '" + ent.id + "' of type '" + EntityType(ent.symbol) +
+				"' does not contain nested statement")
+		}
+	}
+	return tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Indicates whether the first entity encloses the second one.
+*/
+func encloses(outer *inputEntity, inner *inputEntity) bool {
+	if outer.start > inner.start || outer.end < inner.end {
+		return false
+	}
+	if outer.start == inner.start && outer.end == inner.end {
+		return outer.number < inner.number
+	}
+	return true
+}
+
+/*
+Parses relation line (e.g., 'R1	XOR Arg1:T3 Arg2:T4') and combines the terms containing both arguments.
+*/
+func (imp *importer) applyRelation(line string, ids map[string]int) tree.ParsingError {
+	fields := strings.Split(line, FIELD_SEPARATOR)
+	if len(fields) < 2 {
+		return invalidInput("Malformed relation '" + line + "'")
+	}
+	args := strings.Fields(fields[1])
+	if len(args) != 3 || !strings.HasPrefix(args[1], RELATION_ARGUMENT_1) || !strings.HasPrefix(args[2], RELATION_ARGUMENT_2) {
+		return invalidInput("Malformed relation '" + line + "'")
+	}
+	if !isRelationType(args[0]) {
+		return invalidInput("Unknown relation type '" + args[0] + "' in relation '" + fields[0] + "'")
+	}
+	left, ok1 := ids[strings.TrimPrefix(args[1], RELATION_ARGUMENT_1)]
+	right, ok2 := ids[strings.TrimPrefix(args[2], RELATION_ARGUMENT_2)]
+	if !ok1 || !ok2 {
+		return invalidInput("Relation '" + fields[0] + "' refers to unknown entity")
+	}
+	leftTerm, rightTerm := imp.terms[left], imp.terms[right]
+	if leftTerm == rightTerm {
+		return invalidInput("Relation '" + fields[0] + "' links entities that are already combined")
+	}
+	leftEntity, rightEntity := imp.entities[left], imp.entities[right]
+	if leftEntity.symbol != rightEntity.symbol || leftEntity.parent != rightEntity.parent || leftEntity.nested != rightEntity.nested {
+		return invalidInput("Relation '" + fields[0] + "' links entities of different components or statements")
+	}
+	combined := &term{entity: -1, operator: args[0], left: leftTerm, right: rightTerm}
+	for i, t := range imp.terms {
+		if t == leftTerm || t == rightTerm {
+			imp.terms[i] = combined
+		}
+	}
+	return tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Builds statement embedded in the entity with the given index (or the top-level statement for index -1).
+Component instances that are not combined by relations are linked by implicit linkage (bAND) in order of the text.
+*/
+func (imp *importer) buildStatement(parent int) (*tree.Statement, tree.ParsingError) {
+
+	// Collect terms of statement in order of text
+	terms := []*term{}
+	for i, ent := range imp.entities {
+		if ent.parent != parent {
+			continue
+		}
+		known := false
+		for _, t := range terms {
+			known = known || t == imp.terms[i]
+		}
+		if !known {
+			terms = append(terms, imp.terms[i])
+		}
+	}
+	sort.SliceStable(terms, func(i, j int) bool {
+		return imp.entities[terms[i].first()].start < imp.entities[terms[j].first()].start
+	})
+
+	stmt := &tree.Statement{}
+	for _, t := range terms {
+		ent := imp.entities[t.first()]
+		node, err := imp.buildTerm(t)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			return nil, err
+		}
+		field := stmt.ComponentField(ent.symbol, ent.nested)
+		if field == nil {
+			return nil, invalidInput("Entity '" + ent.id + "' of type '" + EntityType(ent.symbol) +
+				"' cannot contain nested statement")
+		}
+		if *field == nil {
+			*field = node
+			continue
+		}
+		combined, nodeErr := tree.Combine(*field, node, tree.SAND_BETWEEN_COMPONENTS)
+		if nodeErr.ErrorCode != tree.TREE_NO_ERROR {
+			return nil, invalidInput(nodeErr.ErrorMessage)
+		}
+		*field = combined
+	}
+	return stmt, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Builds node for given term, including nested statements of the entities it contains.
+*/
+func (imp *importer) buildTerm(t *term) (*tree.Node, tree.ParsingError) {
+	if t.left == nil {
+		return imp.buildEntity(t.entity)
+	}
+	left, err := imp.buildTerm(t.left)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return nil, err
+	}
+	right, err := imp.buildTerm(t.right)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return nil, err
+	}
+	combined, nodeErr := tree.Combine(left, right, t.operator)
+	if nodeErr.ErrorCode != tree.TREE_NO_ERROR {
+		return nil, invalidInput(nodeErr.ErrorMessage)
+	}
+	return combined, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Builds node for entity with given index, holding either the entity text or the embedded nested statement.
+Negated entities are wrapped in unary negation nodes.
+*/
+func (imp *importer) buildEntity(idx int) (*tree.Node, tree.ParsingError) {
+	ent := imp.entities[idx]
+	node := &tree.Node{ComponentType: ent.symbol, Entry: ent.text}
+	if ent.nested {
+		stmt, err := imp.buildStatement(idx)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			return nil, err
+		}
+		node.Entry = stmt
+	}
+	if ent.suffix != "" {
+		node.Suffix = ent.suffix
+	}
+	if ent.annotations != "" {
+		node.Annotations = ent.annotations
+	}
+	if ent.negated {
+		negation := &tree.Node{LogicalOperator: tree.NOT, ComponentType: ent.symbol}
+		negation.InsertRightNode(node)
+		node = negation
+	}
+	return node, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Returns numeric part of identifier (e.g., 12 for 'T12'), or -1 if identifier does not contain number.
+*/
+func idNumber(id string) int {
+	number, err := strconv.Atoi(strings.TrimLeft(id, ENTITY_ID_PREFIX+RELATION_ID_PREFIX+ATTRIBUTE_ID_PREFIX+MODIFICATION_ID_PREFIX))
+	if err != nil {
+		return -1
+	}
+	return number
+}
+
+/*
+Returns parsing error indicating invalid brat input with given message.
+*/
+func invalidInput(message string) tree.ParsingError {
+	return tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_BRAT_INPUT, ErrorMessage: message}
+}
@@ -0,0 +1,63 @@
+package brat
+
+import (
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"testing"
+)
+
+/*
+Tests the reconstruction of IG Script from exported brat annotations.
+*/
+func TestBratRoundTrip(t *testing.T) {
+
+	inputs := map[string]string{
+		"A1[role=enforcer](Program Manager) D(may) I((suspension [XOR] revocation)) Bdir(certification) " +
+			"Cac{A(Program Manager) I(([NOT] receive)) Bdir(response)}": "The Program Manager may initiate suspension or " +
+			"revocation of certification if the Program Manager does not receive a response.",
+		"A(farmer) D(must) I(sell) Cac{Cac{A(council) I(approves)} [XOR] Cac{A(state) I(permits)}}": "The farmer must sell " +
+			"when council approves or state permits.",
+		"A(farmer) D(must) I(sell) I(deliver) Bdir(goods)": "",
+	}
+
+	for text, original := range inputs {
+		stmts, err := parser.ParseStatement(text)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			t.Fatal("Error during parsing of statement", err.Error())
+		}
+		output, err := GenerateBratOutputFromParsedStatements(stmts, text, original)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			t.Fatal("Error during brat output generation:", err.Error())
+		}
+
+		node, err := ParseBratInput(output.Text, output.Annotations)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			t.Fatal("Import of brat annotations should not fail. Error:", err.Error())
+		}
+		if node.StringifyStatement() != text {
+			t.Fatal("Imported statement should be '"+text+"', but was", node.StringifyStatement())
+		}
+	}
+}
+
+/*
+Tests the rejection of annotations that cannot be represented in IG Script.
+*/
+func TestBratInputInvalidAnnotations(t *testing.T) {
+
+	text := "The farmer must sell goods."
+	annotations := []string{
+		"T1\tUnknown 4 10\tfarmer",
+		"T1\tAttributes 4 100\tfarmer",
+		"T1\tAttributes 4 10\tfarmer\nT2\tAim 16 20\tsell\nR1\tXOR Arg1:T1 Arg2:T2",
+		"T1\tAttributes 4 10\tfarmer\nT2\tAttributes 4 10\tfarmer\nR1\tAND Arg1:T1 Arg2:T2\nR2\tOR Arg1:T1 Arg2:T2",
+		"T1\tOr_else 16 20\tsell",
+		"T1\tAim 16 20\tsell\nA1\tNegated T2",
+	}
+
+	for _, ann := range annotations {
+		if _, err := ParseBratInput(text, ann); err.ErrorCode != tree.PARSING_ERROR_INVALID_BRAT_INPUT {
+			t.Fatal("Import of invalid annotations should fail:", ann)
+		}
+	}
+}
@@ -0,0 +1,345 @@
+package brat
+
+import (
+	"IG-Parser/core/anchoring"
+	"IG-Parser/core/tree"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+This file contains the generation of brat stand-off output (.txt and .ann files) from parsed statements
+(see BratStructs.go for the representation of statements).
+*/
+
+/*
+Generates brat output for statements returned by parser.ParseStatement(). The annotated text is the Original
+Statement, or the IG Script input if no Original Statement is provided. Component content is anchored to the
+annotated text (see anchoring.AnchorStatements()); content that cannot be located in the Original Statement
+is omitted and reported as warning (tree.PARSING_WARNING_NON_ANCHORED_CONTENT) with the omitted content as
+ignored elements.
+Returns brat output, and error (defaults to tree.PARSING_NO_ERROR).
+*/
+func GenerateBratOutputFromParsedStatements(stmts []*tree.Node, igScriptInput string, originalStatement string) (BratOutput, tree.ParsingError) {
+
+	e := exporter{
+		useOriginal: strings.TrimSpace(originalStatement) != "",
+		ids:         map[*tree.Node]int{},
+		nonAnchored: []string{},
+	}
+	e.text = igScriptInput
+	if e.useOriginal {
+		e.text = originalStatement
+	}
+
+	anchoring.AnchorStatements(stmts, igScriptInput, originalStatement)
+	for _, stmt := range stmts {
+		e.exportNode(stmt, "")
+	}
+
+	output := BratOutput{Text: e.text, Annotations: e.annotations()}
+	Println("Generated brat annotations:", output.Annotations)
+
+	if len(e.nonAnchored) > 0 {
+		return output, tree.ParsingError{ErrorCode: tree.PARSING_WARNING_NON_ANCHORED_CONTENT,
+			ErrorMessage:         "Component content could not be located in the Original Statement and has been omitted.",
+			ErrorIgnoredElements: e.nonAnchored}
+	}
+	return output, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Entity prior to the assignment of its identifier.
+*/
+type entity struct {
+	// Entity type (see #EntityType())
+	entityType string
+	// Character offset of entity start
+	start int
+	// Character offset of entity end (exclusive)
+	end int
+}
+
+/*
+Relation between entities (referenced by index) prior to the assignment of entity identifiers.
+*/
+type relation struct {
+	relationType string
+	arg1         int
+	arg2         int
+}
+
+/*
+Attribute of entity (referenced by index) prior to the assignment of entity identifiers.
+*/
+type attribute struct {
+	name   string
+	entity int
+	value  string
+}
+
+/*
+Holds the state of the generation of brat output.
+*/
+type exporter struct {
+	// Annotated text
+	text string
+	// Indicates whether annotated text is Original Statement (as opposed to IG Script input)
+	useOriginal bool
+	entities    []entity
+	relations   []relation
+	attributes  []attribute
+	// Indices of entities generated for leaf nodes (to prevent repeated export of nodes shared across component pairs)
+	ids map[*tree.Node]int
+	// Content that could not be located in the annotated text
+	nonAnchored []string
+}
+
+/*
+Generates entities, relations and attributes for a given node, including child nodes, nested statements and
+private nodes. Takes the component symbol inherited from the statement component (for nodes without component type).
+Returns indices of all entities generated for the node.
+*/
+func (e *exporter) exportNode(node *tree.Node, symbol string) []int {
+	if node == nil {
+		return nil
+	}
+	if node.GetComponentName() != "" {
+		symbol = node.GetComponentName()
+	}
+	if idx, ok := e.ids[node]; ok {
+		return []int{idx}
+	}
+
+	result := []int{}
+	switch entry := node.Entry.(type) {
+	case string:
+		span := node.IGScriptSpan
+		if e.useOriginal {
+			span = node.OriginalStatementSpan
+		}
+		if span == nil {
+			e.nonAnchored = append(e.nonAnchored, entry)
+			break
+		}
+		idx := e.addEntity(symbol, span.RuneOffset, span.RuneEndOffset)
+		e.ids[node] = idx
+		e.addAnnotations(idx, node)
+		result = append(result, idx)
+	case *tree.Statement:
+		if entry == nil {
+			break
+		}
+		inner := []int{}
+		for _, comp := range entry.Components() {
+			inner = appendIndices(inner, e.exportNode(comp.Node, comp.Symbol))
+		}
+		if symbol == "" || len(inner) == 0 {
+			// Statement that is not nested in component (e.g., top-level statement)
+			result = inner
+			break
+		}
+		start, end := e.entities[inner[0]].start, e.entities[inner[0]].end
+		for _, idx := range inner {
+			if e.entities[idx].start < start {
+				start = e.entities[idx].start
+			}
+			if e.entities[idx].end > end {
+				end = e.entities[idx].end
+			}
+		}
+		idx := e.addEntity(symbol, start, end)
+		e.ids[node] = idx
+		e.addAnnotations(idx, node)
+		result = append([]int{idx}, inner...)
+	case []*tree.Node:
+		for _, pairNode := range entry {
+			result = appendIndices(result, e.exportNode(pairNode, symbol))
+		}
+	case nil:
+		if node.IsUnaryNegation() {
+			result = e.exportNode(node.Right, symbol)
+			if len(result) > 0 {
+				e.attributes = append(e.attributes, attribute{name: ATTRIBUTE_NEGATED, entity: e.first(result, nil)})
+			}
+			break
+		}
+		left := e.exportNode(node.Left, symbol)
+		right := e.exportNode(node.Right, symbol)
+		// Combinations of extrapolated statements (i.e., component pairs) outside of components are not represented
+		if symbol != "" && isRelationType(node.LogicalOperator) && len(left) > 0 && len(right) > 0 {
+			e.relations = append(e.relations, relation{relationType: node.LogicalOperator,
+				arg1: e.first(left, right), arg2: e.first(right, left)})
+		}
+		result = appendIndices(left, right)
+		if len(result) > 0 {
+			// Annotations of combinations are attached to first operand
+			e.addAnnotations(e.first(result, nil), node)
+		}
+	}
+
+	for _, privateNode := range node.PrivateNodeLinks {
+		result = appendIndices(result, e.exportNode(privateNode, ""))
+	}
+	return result
+}
+
+/*
+Adds entity for given component and returns its index.
+*/
+func (e *exporter) addEntity(symbol string, start int, end int) int {
+	e.entities = append(e.entities, entity{entityType: EntityType(symbol), start: start, end: end})
+	return len(e.entities) - 1
+}
+
+/*
+Adds suffix and annotations of given node as attributes of the entity with the given index.
+*/
+func (e *exporter) addAnnotations(idx int, node *tree.Node) {
+	if suffix, ok := node.Suffix.(string); ok && suffix != "" {
+		e.attributes = append(e.attributes, attribute{name: ATTRIBUTE_SUFFIX, entity: idx, value: sanitize(suffix)})
+	}
+	annotations, ok := node.Annotations.(string)
+	if !ok {
+		return
+	}
+	for _, annotation := range tree.SplitAnnotations(annotations) {
+		if key, value, ok := tree.SplitAnnotationKeyValue(annotation); ok {
+			e.attributes = append(e.attributes, attribute{name: sanitize(key), entity: idx, value: sanitize(value)})
+		} else {
+			e.attributes = append(e.attributes, attribute{name: sanitize(annotation), entity: idx})
+		}
+	}
+}
+
+/*
+Returns the index of the first entity (in order of the annotated text, with enclosing entities preceding enclosed ones)
+amongst the given entities, excluding entities contained in the second collection (e.g., content shared across
+component pairs), unless all entities are excluded.
+*/
+func (e *exporter) first(indices []int, exclude []int) int {
+	candidates := []int{}
+	for _, idx := range indices {
+		if !containsIndex(exclude, idx) {
+			candidates = append(candidates, idx)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = indices
+	}
+	result := candidates[0]
+	for _, idx := range candidates[1:] {
+		if e.entities[idx].start < e.entities[result].start ||
+			(e.entities[idx].start == e.entities[result].start && e.entities[idx].end > e.entities[result].end) {
+			result = idx
+		}
+	}
+	return result
+}
+
+/*
+Generates content of .ann file, with entity identifiers assigned in order of the annotated text. Entities spanning
+the same text are ordered from outer to inner entity (i.e., nested statements precede their content).
+*/
+func (e *exporter) annotations() string {
+
+	order := make([]int, len(e.entities))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		left, right := e.entities[order[i]], e.entities[order[j]]
+		if left.start != right.start {
+			return left.start < right.start
+		}
+		if left.end != right.end {
+			return left.end > right.end
+		}
+		// Entities of nested statements are generated after their content
+		return order[i] > order[j]
+	})
+	ids := make([]string, len(e.entities))
+	for i, idx := range order {
+		ids[idx] = ENTITY_ID_PREFIX + strconv.Itoa(i+1)
+	}
+
+	runes := []rune(e.text)
+	lines := []string{}
+	for _, idx := range order {
+		ent := e.entities[idx]
+		lines = append(lines, ids[idx]+FIELD_SEPARATOR+ent.entityType+ARGUMENT_SEPARATOR+
+			fragments(runes, ent.start, ent.end)+FIELD_SEPARATOR+
+			strings.Join(strings.Fields(string(runes[ent.start:ent.end])), " "))
+	}
+	for i, rel := range e.relations {
+		lines = append(lines, RELATION_ID_PREFIX+strconv.Itoa(i+1)+FIELD_SEPARATOR+rel.relationType+ARGUMENT_SEPARATOR+
+			RELATION_ARGUMENT_1+ids[rel.arg1]+ARGUMENT_SEPARATOR+RELATION_ARGUMENT_2+ids[rel.arg2])
+	}
+	for i, attr := range e.attributes {
+		line := ATTRIBUTE_ID_PREFIX + strconv.Itoa(i+1) + FIELD_SEPARATOR + attr.name + ARGUMENT_SEPARATOR + ids[attr.entity]
+		if attr.value != "" {
+			line += ARGUMENT_SEPARATOR + attr.value
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+/*
+Returns character offsets of entity as fragments (e.g., '0 5'), with separate fragments for each line
+of entities spanning line breaks (e.g., '0 5;6 10'), since brat does not support line breaks in entities.
+*/
+func fragments(runes []rune, start int, end int) string {
+	result := []string{}
+	fragmentStart := start
+	for i := start; i <= end; i++ {
+		if i == end || runes[i] == '\n' {
+			if i > fragmentStart {
+				result = append(result, fmt.Sprint(fragmentStart)+ARGUMENT_SEPARATOR+fmt.Sprint(i))
+			}
+			fragmentStart = i + 1
+		}
+	}
+	return strings.Join(result, FRAGMENT_SEPARATOR)
+}
+
+/*
+Indicates whether logical operator is represented as relation type.
+*/
+func isRelationType(operator string) bool {
+	for _, relationType := range RELATION_TYPES {
+		if relationType == operator {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Appends indices not already contained in the given slice.
+*/
+func appendIndices(indices []int, additional []int) []int {
+	for _, idx := range additional {
+		if !containsIndex(indices, idx) {
+			indices = append(indices, idx)
+		}
+	}
+	return indices
+}
+
+/*
+Indicates whether index is contained in slice.
+*/
+func containsIndex(indices []int, idx int) bool {
+	for _, v := range indices {
+		if v == idx {
+			return true
+		}
+	}
+	return false
+}
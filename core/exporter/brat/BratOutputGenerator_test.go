@@ -0,0 +1,78 @@
+package brat
+
+import (
+	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"fmt"
+	"os"
+	"testing"
+)
+
+/*
+Tests brat output for statement with component combinations, annotations, suffices, negations and nested statements.
+*/
+func TestBratOutputBasicStatement(t *testing.T) {
+
+	original := "The Program Manager may initiate suspension or revocation of certification if the Program Manager does not receive a response."
+	text := "A1[role=enforcer](Program Manager) D(may) I(initiate (suspension [XOR] revocation)) Bdir(certification) " +
+		"Cac{A(Program Manager) I([NOT] receive) Bdir(response)}"
+
+	stmts, err := parser.ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during parsing of statement", err.Error())
+	}
+
+	output, err := GenerateBratOutputFromParsedStatements(stmts, text, original)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during brat output generation:", err.Error())
+	}
+	if output.Text != original {
+		t.Fatal("Annotated text should be Original Statement, but was:", output.Text)
+	}
+
+	// Read reference file
+	content, err2 := os.ReadFile("TestOutputBratBasicStatement.test")
+	if err2 != nil {
+		t.Fatal("Error attempting to read test text input. Error: ", err2.Error())
+	}
+
+	// Extract expected output
+	expectedOutput := string(content)
+
+	// Compare to actual output
+	if output.Annotations != expectedOutput {
+		fmt.Println("Produced output:\n", output.Annotations)
+		fmt.Println("Expected output:\n", expectedOutput)
+		err3 := tabular.WriteToFile("errorOutput.error", output.Annotations, true)
+		if err3 != nil {
+			t.Fatal("Error attempting to read test text input. Error: ", err3.Error())
+		}
+		t.Fatal("Output generation is wrong for given input statement. Wrote output to 'errorOutput.error'")
+	}
+}
+
+/*
+Tests the omission of content that cannot be located in the Original Statement.
+*/
+func TestBratOutputNonAnchoredContent(t *testing.T) {
+
+	text := "A(farmer) D(must) I(sell) Bdir(vegetables)"
+
+	stmts, err := parser.ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during parsing of statement", err.Error())
+	}
+
+	output, err := GenerateBratOutputFromParsedStatements(stmts, text, "The farmer must sell goods.")
+	if err.ErrorCode != tree.PARSING_WARNING_NON_ANCHORED_CONTENT {
+		t.Fatal("Output generation should warn about non-anchored content, but returned", err.Error())
+	}
+	if len(err.ErrorIgnoredElements) != 1 || err.ErrorIgnoredElements[0] != "vegetables" {
+		t.Fatal("Omitted content should be reported, but was", err.ErrorIgnoredElements)
+	}
+	expected := "T1\tAttributes 4 10\tfarmer\nT2\tDeontic 11 15\tmust\nT3\tAim 16 20\tsell\n"
+	if output.Annotations != expected {
+		t.Fatal("Output should only contain located content, but was:\n", output.Annotations)
+	}
+}
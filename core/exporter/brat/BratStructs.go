@@ -0,0 +1,95 @@
+package brat
+
+import (
+	"IG-Parser/core/tree"
+	"strings"
+)
+
+/*
+This file contains the data structures and conventions for the interoperability with brat (and tools supporting
+its stand-off format, such as INCEpTION). Annotations are represented as follows:
+- Component content is represented as entity (e.g., 'T1	Attributes 0 7	farmer'), with the component name as entity
+  type (with underscores instead of whitespace, e.g., 'Direct_Object'). Nested statements are represented as entity
+  of the embedding component (e.g., 'Activation_Condition') spanning the entities of the nested statement.
+- Logical combinations are represented as relations between the first operands of either side of the combination
+  (e.g., 'R1	XOR Arg1:T3 Arg2:T4'). Relations are applied in order of their identifiers, i.e., inner combinations
+  precede outer ones.
+- Annotations of components are represented as attributes (e.g., 'A1	role T1 enforcer' for '[role=enforcer]',
+  or 'A2	condition T1' for '[condition]'), with whitespace in annotations replaced by underscores.
+  Further attributes indicate negations ('Negated') and suffices ('Suffix', e.g., for private properties).
+Elements shared across combinations (e.g., 'initiate' in 'I(initiate (suspension [XOR] revocation))') and the linkage
+of private properties are not represented, and are hence not restored during import.
+*/
+
+// Prefixes of identifiers of entities, relations and attributes
+const ENTITY_ID_PREFIX = "T"
+const RELATION_ID_PREFIX = "R"
+const ATTRIBUTE_ID_PREFIX = "A"
+
+// Prefix of legacy attribute (modification) identifiers (treated like attributes during import)
+const MODIFICATION_ID_PREFIX = "M"
+
+// Attribute indicating negation of component content (or nested statement)
+const ATTRIBUTE_NEGATED = "Negated"
+
+// Attribute holding the suffix of a component (e.g., '1' for 'A1')
+const ATTRIBUTE_SUFFIX = "Suffix"
+
+// Separator of fields in annotation lines
+const FIELD_SEPARATOR = "\t"
+
+// Separator of fragments of discontinuous entities (e.g., 'T1	Aim 0 4;10 15	text')
+const FRAGMENT_SEPARATOR = ";"
+
+// Separator of relation type and arguments
+const ARGUMENT_SEPARATOR = " "
+
+// Prefixes of relation arguments
+const RELATION_ARGUMENT_1 = "Arg1:"
+const RELATION_ARGUMENT_2 = "Arg2:"
+
+// Logical operators represented as relation types (negations are represented as attributes)
+var RELATION_TYPES = []string{tree.AND, tree.OR, tree.XOR}
+
+/*
+Stand-off output in brat format, consisting of the annotated text (.txt file) and the annotations (.ann file).
+*/
+type BratOutput struct {
+	// Annotated text (content of .txt file)
+	Text string
+	// Annotations (content of .ann file)
+	Annotations string
+}
+
+/*
+Returns entity type for a given component symbol (e.g., 'Direct_Object_Property' for 'Bdir,p').
+*/
+func EntityType(symbol string) string {
+	return strings.ReplaceAll(tree.IGComponentSymbolNameMap[symbol], " ", "_")
+}
+
+/*
+Returns component symbol for a given entity type (see #EntityType()), and indication whether entity type is known.
+*/
+func componentSymbol(entityType string) (string, bool) {
+	for _, comp := range (&tree.Statement{}).Components() {
+		if EntityType(comp.Symbol) == entityType {
+			return comp.Symbol, true
+		}
+	}
+	return "", false
+}
+
+/*
+Indicates whether component can embed nested statements.
+*/
+func nestable(symbol string) bool {
+	return (&tree.Statement{}).ComponentField(symbol, true) != nil
+}
+
+/*
+Replaces whitespace in attribute names and values with underscores (since brat separates fields by whitespace).
+*/
+func sanitize(value string) string {
+	return strings.Join(strings.Fields(value), "_")
+}
@@ -0,0 +1,341 @@
+package json
+
+import (
+	"IG-Parser/core/tree"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+/*
+This file contains the reconstruction of parsed statements from JSON input conforming to the JSON Schema
+(see IGStatementSchema.json), e.g., as generated by GenerateJSONOutputFromParsedStatements() or produced by external
+tools. The reconstructed statements correspond to the output of parser.ParseStatement(), including parent linkages,
+private node linkages and nested statements, and can hence be passed to the output generators (e.g.,
+tabular.GenerateTabularOutputFromParsedStatements()) without reparsing of IG Script input. Nodes referenced by ID
+are resolved once the entire input has been reconstructed, such that they retain their identity.
+*/
+
+/*
+Keeps track of reconstructed nodes carrying an ID, and of references to those to be resolved after reconstruction.
+*/
+type jsonNodeReferences struct {
+	// Reconstructed nodes by ID
+	nodes map[string]*tree.Node
+	// References to be resolved
+	pending []jsonNodeReference
+}
+
+/*
+Reference to a node by ID, alongside the field the referenced node is to be assigned to, and the parent node
+the referenced node is to be linked to (nil for private nodes and root nodes of components).
+*/
+type jsonNodeReference struct {
+	id     string
+	target **tree.Node
+	parent *tree.Node
+}
+
+/*
+Parses JSON input into statement trees as returned by parser.ParseStatement().
+Returns parsed statements, the decoded JSON document (e.g., to retrieve statement ID and IG Script input),
+and error (defaults to tree.PARSING_NO_ERROR).
+*/
+func ParseJSONInput(input string) ([]*tree.Node, *JSONDocument, tree.ParsingError) {
+
+	doc := &JSONDocument{}
+
+	// Reject properties not specified in schema
+	decoder := json.NewDecoder(strings.NewReader(input))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(doc); err != nil {
+		return nil, nil, tree.ParsingError{ErrorCode: tree.PARSING_ERROR_JSON_DECODING,
+			ErrorMessage: "Error when decoding JSON input: " + err.Error()}
+	}
+
+	stmts, err := ParseJSONDocument(doc)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return nil, nil, err
+	}
+
+	return stmts, doc, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Reconstructs statement trees (as returned by parser.ParseStatement()) from a decoded JSON document.
+Returns parsed statements, and error (defaults to tree.PARSING_NO_ERROR).
+*/
+func ParseJSONDocument(doc *JSONDocument) ([]*tree.Node, tree.ParsingError) {
+
+	// Only accept documents whose major schema version corresponds to the supported one
+	if strings.Split(doc.SchemaVersion, ".")[0] != strings.Split(SCHEMA_VERSION, ".")[0] {
+		return nil, tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_JSON_INPUT,
+			ErrorMessage: "Unsupported schema version '" + doc.SchemaVersion + "' (Supported version: " + SCHEMA_VERSION + ")."}
+	}
+
+	if len(doc.Statements) == 0 {
+		return nil, tree.ParsingError{ErrorCode: tree.PARSING_ERROR_EMPTY_STATEMENT,
+			ErrorMessage: "JSON input does not contain any statement."}
+	}
+
+	refs := &jsonNodeReferences{nodes: map[string]*tree.Node{}}
+	stmts := make([]*tree.Node, len(doc.Statements))
+	for i, jsonNode := range doc.Statements {
+		if jsonNode == nil {
+			return nil, tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_JSON_INPUT,
+				ErrorMessage: "JSON input contains empty statement."}
+		}
+		if err := parseJSONNode(jsonNode, nil, &stmts[i], refs); err.ErrorCode != tree.PARSING_NO_ERROR {
+			return nil, err
+		}
+	}
+
+	if err := refs.resolve(); err.ErrorCode != tree.PARSING_NO_ERROR {
+		return nil, err
+	}
+
+	for _, stmt := range stmts {
+		linkStatementCollections(stmt)
+	}
+
+	return stmts, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Reconstructs node from JSON representation, including child nodes, nested statements and private nodes, assigns
+it to the given target field, and links it to the given parent node (nil for root nodes of statements, components
+and private nodes). Child nodes are linked to the reconstructed node; nodes embedded in statement collections
+(i.e., statements extrapolated from component pairs) are linked to the parent of the reconstructed node (as done
+during parsing). References to nodes by ID are recorded for resolution after reconstruction. Assigns nil for nil input.
+*/
+func parseJSONNode(jsonNode *JSONNode, parent *tree.Node, target **tree.Node, refs *jsonNodeReferences) tree.ParsingError {
+
+	if jsonNode == nil {
+		*target = nil
+		return tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+	}
+
+	if err := validateJSONNode(jsonNode); err.ErrorCode != tree.PARSING_NO_ERROR {
+		return err
+	}
+
+	// Record reference for later resolution
+	if jsonNode.Ref != "" {
+		refs.pending = append(refs.pending, jsonNodeReference{id: jsonNode.Ref, target: target, parent: parent})
+		return tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+	}
+
+	node := &tree.Node{
+		Parent:          parent,
+		ComponentType:   jsonNode.ComponentType,
+		LogicalOperator: jsonNode.LogicalOperator,
+		SharedLeft:      jsonNode.SharedLeft,
+		SharedRight:     jsonNode.SharedRight,
+	}
+	*target = node
+	// Only assign suffix and annotations if present (since nil values are treated as absent throughout)
+	if jsonNode.Suffix != "" {
+		node.Suffix = jsonNode.Suffix
+	}
+	if jsonNode.Annotations != "" {
+		node.Annotations = jsonNode.Annotations
+	}
+	for _, v := range jsonNode.ElementOrder {
+		node.ElementOrder = append(node.ElementOrder, v)
+	}
+
+	// Register node for resolution of references
+	if jsonNode.Id != "" {
+		if _, ok := refs.nodes[jsonNode.Id]; ok {
+			return tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_JSON_INPUT,
+				ErrorMessage: "Duplicate node ID '" + jsonNode.Id + "'."}
+		}
+		refs.nodes[jsonNode.Id] = node
+	}
+
+	// Reconstruct child nodes
+	if err := parseJSONNode(jsonNode.Left, node, &node.Left, refs); err.ErrorCode != tree.PARSING_NO_ERROR {
+		return err
+	}
+	if err := parseJSONNode(jsonNode.Right, node, &node.Right, refs); err.ErrorCode != tree.PARSING_NO_ERROR {
+		return err
+	}
+
+	// Reconstruct entry
+	switch {
+	case jsonNode.Entry != nil:
+		node.Entry = *jsonNode.Entry
+	case jsonNode.Statement != nil:
+		stmt, err := parseJSONStatement(jsonNode.Statement, refs)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			return err
+		}
+		node.Entry = stmt
+	case jsonNode.Statements != nil:
+		stmtNodes := make([]*tree.Node, len(jsonNode.Statements))
+		for i, v := range jsonNode.Statements {
+			if err := parseJSONNode(v, parent, &stmtNodes[i], refs); err.ErrorCode != tree.PARSING_NO_ERROR {
+				return err
+			}
+		}
+		node.Entry = stmtNodes
+	}
+
+	// Reconstruct private nodes (disconnected from tree structure)
+	if len(jsonNode.PrivateNodes) > 0 {
+		node.PrivateNodeLinks = make([]*tree.Node, len(jsonNode.PrivateNodes))
+		for i, v := range jsonNode.PrivateNodes {
+			if err := parseJSONNode(v, nil, &node.PrivateNodeLinks[i], refs); err.ErrorCode != tree.PARSING_NO_ERROR {
+				return err
+			}
+		}
+	}
+
+	return tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Reconstructs statement (including all components) from JSON representation.
+*/
+func parseJSONStatement(jsonStmt *JSONStatement, refs *jsonNodeReferences) (*tree.Statement, tree.ParsingError) {
+
+	stmt := &tree.Statement{}
+
+	for _, field := range statementFields(stmt, jsonStmt) {
+		if err := parseJSONNode(*field.jsonNode, nil, field.node, refs); err.ErrorCode != tree.PARSING_NO_ERROR {
+			return nil, err
+		}
+	}
+
+	return stmt, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Resolves references to nodes by ID in order of appearance. Referenced nodes are linked to the parent of the
+respective reference (if any), such that, as during parsing, nodes shared across extrapolated statements are linked
+to the last node they have been combined with.
+*/
+func (refs *jsonNodeReferences) resolve() tree.ParsingError {
+	for _, ref := range refs.pending {
+		node, ok := refs.nodes[ref.id]
+		if !ok {
+			return tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_JSON_INPUT,
+				ErrorMessage: "Reference to unknown node ID '" + ref.id + "'."}
+		}
+		*ref.target = node
+		if ref.parent != nil {
+			node.Parent = ref.parent
+		}
+	}
+	return tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Links nodes holding statement collections (i.e., statements extrapolated from component pairs) to the root of the
+component pair combination they are part of (as done during parsing), including component pair combinations in
+nested statements.
+*/
+func linkStatementCollections(node *tree.Node) {
+	if node == nil {
+		return
+	}
+	if isStatementCollectionCombination(node) {
+		for _, leaf := range tree.Flatten(node.GetLeafNodes(true)) {
+			if leaf != node {
+				leaf.Parent = node
+			}
+			for _, v := range leaf.Entry.([]*tree.Node) {
+				linkStatementCollections(v)
+			}
+		}
+		return
+	}
+	linkStatementCollections(node.Left)
+	linkStatementCollections(node.Right)
+	if stmt, ok := node.Entry.(*tree.Statement); ok {
+		for _, field := range statementFields(stmt, &JSONStatement{}) {
+			linkStatementCollections(*field.node)
+		}
+	}
+}
+
+/*
+Indicates whether the given node is a combination of statement collections (or a statement collection itself).
+*/
+func isStatementCollectionCombination(node *tree.Node) bool {
+	if node == nil {
+		return false
+	}
+	if node.IsLeafNode() {
+		_, ok := node.Entry.([]*tree.Node)
+		return ok
+	}
+	// Unary negation only holds right child
+	if node.Left == nil {
+		return isStatementCollectionCombination(node.Right)
+	}
+	return isStatementCollectionCombination(node.Left) && isStatementCollectionCombination(node.Right)
+}
+
+/*
+Validates structural consistency of a given JSON node (not considering its children), i.e., whether it is either
+a leaf node carrying exactly one kind of entry, or a combination with valid logical operator and child nodes.
+*/
+func validateJSONNode(jsonNode *JSONNode) tree.ParsingError {
+
+	// References must not carry further content
+	if jsonNode.Ref != "" {
+		if !reflect.DeepEqual(*jsonNode, JSONNode{Ref: jsonNode.Ref}) {
+			return tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_JSON_INPUT,
+				ErrorMessage: "Node referencing node '" + jsonNode.Ref + "' must not carry further content."}
+		}
+		return tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+	}
+
+	entries := 0
+	if jsonNode.Entry != nil {
+		entries++
+	}
+	if jsonNode.Statement != nil {
+		entries++
+	}
+	if jsonNode.Statements != nil {
+		entries++
+		for _, v := range jsonNode.Statements {
+			if v == nil || (v.Statement == nil && v.Ref == "") {
+				return tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_JSON_INPUT,
+					ErrorMessage: "Statement collection contains element without statement entry."}
+			}
+		}
+	}
+	if entries > 1 {
+		return tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_JSON_INPUT,
+			ErrorMessage: "Node carries more than one of entry, statement and statements."}
+	}
+
+	if jsonNode.LogicalOperator == "" {
+		if jsonNode.Left != nil || jsonNode.Right != nil {
+			return tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_JSON_INPUT,
+				ErrorMessage: "Node with child nodes lacks logical operator."}
+		}
+		return tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+	}
+
+	validOperator, _ := tree.StringInSlice(jsonNode.LogicalOperator, append([]string{tree.SAND_BETWEEN_COMPONENTS,
+		tree.SAND_WITHIN_COMPONENTS}, tree.IGLogicalOperators...))
+	if !validOperator {
+		return tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_JSON_INPUT,
+			ErrorMessage: "Invalid logical operator '" + jsonNode.LogicalOperator + "'."}
+	}
+	if entries > 0 {
+		return tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_JSON_INPUT,
+			ErrorMessage: "Combination (operator '" + jsonNode.LogicalOperator + "') must not carry entry."}
+	}
+	// Negations may be unary (i.e., only have right operand)
+	if jsonNode.Right == nil || (jsonNode.Left == nil && jsonNode.LogicalOperator != tree.NOT) {
+		return tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_JSON_INPUT,
+			ErrorMessage: "Combination (operator '" + jsonNode.LogicalOperator + "') lacks operand(s)."}
+	}
+
+	return tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
@@ -0,0 +1,277 @@
+package json
+
+import (
+	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+/*
+Tests whether statements reconstructed from JSON output produce the same tabular, visual and JSON output
+as the originally parsed statements, and whether the tree linkages (parents, private nodes) are preserved.
+*/
+func TestJSONInputRoundTrip(t *testing.T) {
+
+	for _, text := range []string{
+		"A[role=enforcer](certifier) A1,p(accredited) A1(inspector) D(must) " +
+			"I(inspect (operations [AND] ([NOT] facilities)) regularly) Bdir(produce) " +
+			"Cac1[condition]{A(operator) I(applies)} [statement-level annotation]",
+		"A(actor) D(may) {I(sell) Bdir(goods) [XOR] I(buy) Bdir(services)} " +
+			"Cac{Cac{A(seller) I(is registered)} [OR] Cac{A(buyer) I(is licensed)}}",
+		"A,p(National Organic Program's) A(Program Manager), Cex(on behalf of the Secretary), D(may) " +
+			"I(inspect [AND] (review [XOR] (refrain from reviewing [AND] suspend))) " +
+			"Bdir,p(approved) Bdir((certified production [OR] handling operations) [AND] accredited certifying agents) " +
+			"Cex(for compliance with the (Act [XOR] regulations in this part)) " +
+			"Cac{Cac{A(Program Manager) I(suspects) Bdir(violations)} [AND] Cac{A(Program Manager) I([NOT] reports) Bdir(violations)}}",
+		"A1(farmer) A1,p(certified) A2(operator) I((inspect [OR] certify) produce) " +
+			"Cac{A(actor2) I(aim2)} Cac{A(actor3) I(aim3)} O{A(enforcer) D(must) I(sanction)}",
+		"E(Program Manager) F(is) P((approved [AND] certified)) " +
+			"Cac{E(Program Manager) F(is) P(qualified)}",
+		"Bdir1((left [OR] right)) Bdir1,p((private [AND] public)) I(x)",
+		"A(actor) D(may) Cac(shared condition) {I(sell) Cac(local condition) [XOR] {I(buy) [AND] I(lease) Bdir(property)}}",
+		"[statement-level annotation0] A[actor1](actor) I(act) [statement-level annotation1] Bdir[object1Annotation](object) " +
+			"Cac[condition]{ { {[inner statement2] A(actor2) I(act2) Bdir[object2Annotation](object2) [XOR] " +
+			"A(actor3) I(act3)[inner statement3] Bdir[object3Annotation](object3)} [AND] " +
+			"A(actor4) I(act4) Bdir[object4Annotation](object4) [inner statement4] } } [statement-level annotation2] " +
+			"Cac[condition1]{ A(actor5) I(act5) [inner statement5] Bdir(object5) }",
+	} {
+
+		stmts, err := parser.ParseStatement(text)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			t.Fatal("Error during parsing of statement", err.Error())
+		}
+
+		output, err := GenerateJSONOutputFromParsedStatements(stmts, "123", text)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			t.Fatal("Error during JSON output generation:", err.Error())
+		}
+
+		importedStmts, doc, err := ParseJSONInput(output)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			t.Fatal("Error during parsing of JSON input:", err.Error())
+		}
+
+		if doc.StatementId != "123" || doc.IgScript != text {
+			t.Fatal("Statement metadata has not been retained. Statement ID:", doc.StatementId, "IG Script:", doc.IgScript)
+		}
+
+		// Check for consistent tree structure
+		if len(importedStmts) != len(stmts) {
+			t.Fatal("Wrong number of reconstructed statements:", len(importedStmts))
+		}
+		correspondence := map[*tree.Node]*tree.Node{}
+		for i := range stmts {
+			if msg := compareNodes(stmts[i], importedStmts[i], correspondence); msg != "" {
+				t.Fatal("Reconstructed tree structure differs for statement '"+text+"':", msg)
+			}
+		}
+		if msg := compareParents(correspondence); msg != "" {
+			t.Fatal("Reconstructed tree structure differs for statement '"+text+"':", msg)
+		}
+
+		// Check IG Script output (relying on shared identity of private nodes and components shared across component pairs)
+		if original, reconstructed := stmts[0].StringifyStatement(), importedStmts[0].StringifyStatement(); original != reconstructed {
+			t.Fatal("IG Script output of reconstructed statement differs. Original:", original, "Reconstructed:", reconstructed)
+		}
+
+		// Check JSON output
+		output2, err := GenerateJSONOutputFromParsedStatements(importedStmts, "123", text)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			t.Fatal("Error during JSON output generation:", err.Error())
+		}
+		if output != output2 {
+			t.Fatal("JSON output of reconstructed statement differs. Original:\n", output, "\nReconstructed:\n", output2)
+		}
+
+		// Check tabular output
+		if original, reconstructed := generateTabularOutput(stmts, text), generateTabularOutput(importedStmts, text); original != reconstructed {
+			t.Fatal("Tabular output of reconstructed statement differs. Original:\n", original, "\nReconstructed:\n", reconstructed)
+		}
+
+		// Check visual output (only applicable for non-extrapolated statements)
+		if s, ok := stmts[0].Entry.(*tree.Statement); ok {
+			opts := tree.DefaultOptions()
+			original, err1 := s.PrintTree(nil, opts, 0)
+			if err1.ErrorCode != tree.TREE_NO_ERROR {
+				t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
+			}
+			reconstructed, err1 := importedStmts[0].Entry.(*tree.Statement).PrintTree(nil, opts, 0)
+			if err1.ErrorCode != tree.TREE_NO_ERROR {
+				t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
+			}
+			if original.String() != reconstructed.String() {
+				t.Fatal("Visual output of reconstructed statement differs. Original:\n", original.String(), "\nReconstructed:\n", reconstructed.String())
+			}
+		}
+	}
+}
+
+/*
+Tests whether the order of non-shared elements held by nodes is retained when reconstructing statements from JSON output.
+*/
+func TestJSONInputElementOrder(t *testing.T) {
+
+	stmts, err := parser.ParseStatement("A(actor) I((sell [XOR] buy))")
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during parsing of statement", err.Error())
+	}
+	aim := stmts[0].Entry.(*tree.Statement).Aim
+	aim.InsertNonSharedValues("sell")
+	aim.InsertNonSharedValues("buy")
+
+	output, err := GenerateJSONOutputFromParsedStatements(stmts, "", "")
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during JSON output generation:", err.Error())
+	}
+
+	importedStmts, _, err := ParseJSONInput(output)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during parsing of JSON input:", err.Error())
+	}
+
+	if order := importedStmts[0].Entry.(*tree.Statement).Aim.ElementOrder; !reflect.DeepEqual(order, []interface{}{"sell", "buy"}) {
+		t.Fatal("Element order has not been retained:", order)
+	}
+}
+
+/*
+Tests rejection of JSON input that does not conform to the schema or does not represent a valid tree structure.
+*/
+func TestJSONInputInvalid(t *testing.T) {
+
+	for _, v := range []struct {
+		input     string
+		errorCode string
+	}{
+		{`{"schemaVersion": "1.0.0", "statements": [`, tree.PARSING_ERROR_JSON_DECODING},
+		{`{"schemaVersion": "1.0.0", "statements": [{"entry": "x", "unknown": 1}]}`, tree.PARSING_ERROR_JSON_DECODING},
+		{`{"schemaVersion": "2.0.0", "statements": [{"statement": {}}]}`, tree.PARSING_ERROR_INVALID_JSON_INPUT},
+		{`{"schemaVersion": "1.0.0", "statements": []}`, tree.PARSING_ERROR_EMPTY_STATEMENT},
+		{`{"schemaVersion": "1.0.0", "statements": [{"statement": {"aim": {"entry": "x", "statement": {}}}}]}`, tree.PARSING_ERROR_INVALID_JSON_INPUT},
+		{`{"schemaVersion": "1.0.0", "statements": [{"statement": {"aim": {"logicalOperator": "AND", "right": {"entry": "x"}}}}]}`, tree.PARSING_ERROR_INVALID_JSON_INPUT},
+		{`{"schemaVersion": "1.0.0", "statements": [{"statement": {"aim": {"logicalOperator": "NAND", "left": {"entry": "x"}, "right": {"entry": "y"}}}}]}`, tree.PARSING_ERROR_INVALID_JSON_INPUT},
+		{`{"schemaVersion": "1.0.0", "statements": [{"statement": {"aim": {"left": {"entry": "x"}, "right": {"entry": "y"}}}}]}`, tree.PARSING_ERROR_INVALID_JSON_INPUT},
+		{`{"schemaVersion": "1.0.0", "statements": [{"logicalOperator": "XOR", "left": {"statements": [{"entry": "x"}]}, "right": {"statements": []}}]}`, tree.PARSING_ERROR_INVALID_JSON_INPUT},
+		{`{"schemaVersion": "1.0.0", "statements": [{"statement": {"aim": {"entry": "x", "privateNodes": [{"ref": "n1"}]}}}]}`, tree.PARSING_ERROR_INVALID_JSON_INPUT},
+		{`{"schemaVersion": "1.0.0", "statements": [{"statement": {"aim": {"id": "n1", "entry": "x"}, "deontic": {"id": "n1", "entry": "y"}}}]}`, tree.PARSING_ERROR_INVALID_JSON_INPUT},
+		{`{"schemaVersion": "1.0.0", "statements": [{"statement": {"aim": {"id": "n1", "entry": "x"}, "deontic": {"ref": "n1", "entry": "y"}}}]}`, tree.PARSING_ERROR_INVALID_JSON_INPUT},
+	} {
+		_, _, err := ParseJSONInput(v.input)
+		if err.ErrorCode != v.errorCode {
+			t.Fatal("Wrong error for input", v.input, "- Expected:", v.errorCode, "Actual:", err.ErrorCode, err.ErrorMessage)
+		}
+	}
+
+	// Unary negation is valid
+	_, _, err := ParseJSONInput(`{"schemaVersion": "1.0.0", "statements": [{"statement": {"aim": {"logicalOperator": "NOT", "right": {"entry": "x"}}}}]}`)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Unary negation has not been accepted:", err.ErrorMessage)
+	}
+}
+
+/*
+Checks parent linkages of all corresponding nodes identified by #compareNodes(). Returns description of the first
+identified difference, or empty string if all parent linkages correspond.
+*/
+func compareParents(correspondence map[*tree.Node]*tree.Node) string {
+	for original, reconstructed := range correspondence {
+		if original.Parent == nil && reconstructed.Parent != nil {
+			return "Reconstructed node has parent, but original does not. Node: " + original.String()
+		}
+		if original.Parent != nil && correspondence[original.Parent] != reconstructed.Parent {
+			return "Parent linkage differs for node " + original.String()
+		}
+	}
+	return ""
+}
+
+/*
+Generates tabular output (Google Sheets format) for given statements.
+*/
+func generateTabularOutput(stmts []*tree.Node, text string) string {
+	// Output options
+	opts := tree.DefaultOptions()
+	results := tabular.GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, ";", tabular.OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, tabular.ORIGINAL_STATEMENT_OUTPUT_NONE, tabular.IG_SCRIPT_OUTPUT_NONE)
+	output := strings.Builder{}
+	for _, v := range results {
+		output.WriteString(v.Output + v.Error.ErrorCode)
+	}
+	return output.String()
+}
+
+/*
+Recursively compares original and reconstructed nodes with respect to content and linkages. The given map
+keeps track of corresponding nodes in order to check the identity of nodes referenced multiple times (e.g., private
+nodes), as well as parent linkages (see #compareParents()). Returns description of the first identified difference,
+or empty string if the nodes correspond.
+*/
+func compareNodes(original *tree.Node, reconstructed *tree.Node, correspondence map[*tree.Node]*tree.Node) string {
+
+	if original == nil || reconstructed == nil {
+		if original != reconstructed {
+			return fmt.Sprint("Node presence differs. Original: ", original, ", Reconstructed: ", reconstructed)
+		}
+		return ""
+	}
+	if existing, ok := correspondence[original]; ok && existing != reconstructed {
+		return "Node referenced multiple times has been reconstructed as separate nodes. Node: " + original.String()
+	}
+	correspondence[original] = reconstructed
+
+	if original.ComponentType != reconstructed.ComponentType || original.LogicalOperator != reconstructed.LogicalOperator ||
+		!reflect.DeepEqual(original.SharedLeft, reconstructed.SharedLeft) || !reflect.DeepEqual(original.SharedRight, reconstructed.SharedRight) ||
+		!reflect.DeepEqual(original.ElementOrder, reconstructed.ElementOrder) ||
+		stringifyValue(original.Suffix) != stringifyValue(reconstructed.Suffix) ||
+		stringifyValue(original.Annotations) != stringifyValue(reconstructed.Annotations) {
+		return "Node content differs. Original: " + original.String() + ", Reconstructed: " + reconstructed.String()
+	}
+
+	if msg := compareNodes(original.Left, reconstructed.Left, correspondence); msg != "" {
+		return msg
+	}
+	if msg := compareNodes(original.Right, reconstructed.Right, correspondence); msg != "" {
+		return msg
+	}
+	if len(original.PrivateNodeLinks) != len(reconstructed.PrivateNodeLinks) {
+		return "Number of private nodes differs for node " + original.String()
+	}
+	for i := range original.PrivateNodeLinks {
+		if msg := compareNodes(original.PrivateNodeLinks[i], reconstructed.PrivateNodeLinks[i], correspondence); msg != "" {
+			return msg
+		}
+	}
+
+	switch entry := original.Entry.(type) {
+	case *tree.Statement:
+		reconstructedStmt, ok := reconstructed.Entry.(*tree.Statement)
+		if !ok {
+			return "Reconstructed node does not contain statement. Node: " + reconstructed.String()
+		}
+		reconstructedFields := statementFields(reconstructedStmt, &JSONStatement{})
+		for i, field := range statementFields(entry, &JSONStatement{}) {
+			if msg := compareNodes(*field.node, *reconstructedFields[i].node, correspondence); msg != "" {
+				return msg
+			}
+		}
+	case []*tree.Node:
+		reconstructedNodes, ok := reconstructed.Entry.([]*tree.Node)
+		if !ok || len(reconstructedNodes) != len(entry) {
+			return "Reconstructed node does not contain corresponding statement collection. Node: " + reconstructed.String()
+		}
+		for i := range entry {
+			if msg := compareNodes(entry[i], reconstructedNodes[i], correspondence); msg != "" {
+				return msg
+			}
+		}
+	default:
+		if !reflect.DeepEqual(original.Entry, reconstructed.Entry) {
+			return "Entry differs. Original: " + original.String() + ", Reconstructed: " + reconstructed.String()
+		}
+	}
+
+	return ""
+}
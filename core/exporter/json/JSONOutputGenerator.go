@@ -0,0 +1,235 @@
+package json
+
+import (
+	"IG-Parser/core/tree"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+/*
+This file contains the generation of JSON output from parsed statements. In contrast to the visual output
+(see tree.Node#PrintNodeTree()), the output reflects the complete statement tree structure, including shared
+elements, suffices, annotations, private nodes and nested statements. The output conforms to the JSON Schema
+provided in IGStatementSchema.json (see #Schema()). Nodes referenced multiple times in the tree (e.g., private
+nodes linked to multiple nodes) are only represented once and otherwise referenced by ID, retaining their identity.
+*/
+
+// Prefix of IDs assigned to nodes referenced multiple times
+const NODE_ID_PREFIX = "n"
+
+/*
+Keeps track of nodes referenced multiple times in statement trees, which are represented once (carrying an ID)
+and otherwise referenced by their ID.
+*/
+type jsonNodeIds struct {
+	// Number of references per node
+	references map[*tree.Node]int
+	// IDs of nodes already represented in the output
+	ids map[*tree.Node]string
+}
+
+/*
+Counts references to all nodes of the given statements (including nested statements and private nodes).
+*/
+func newJSONNodeIds(stmts []*tree.Node) *jsonNodeIds {
+	ids := &jsonNodeIds{references: map[*tree.Node]int{}, ids: map[*tree.Node]string{}}
+	for _, stmt := range stmts {
+		ids.countReferences(stmt)
+	}
+	return ids
+}
+
+/*
+Counts references to given node and, upon first reference, to nodes reachable from it.
+*/
+func (ids *jsonNodeIds) countReferences(node *tree.Node) {
+	if node == nil {
+		return
+	}
+	ids.references[node]++
+	if ids.references[node] > 1 {
+		return
+	}
+	ids.countReferences(node.Left)
+	ids.countReferences(node.Right)
+	switch entry := node.Entry.(type) {
+	case *tree.Statement:
+		for _, field := range statementFields(entry, &JSONStatement{}) {
+			ids.countReferences(*field.node)
+		}
+	case []*tree.Node:
+		for _, v := range entry {
+			ids.countReferences(v)
+		}
+	}
+	for _, v := range node.PrivateNodeLinks {
+		ids.countReferences(v)
+	}
+}
+
+/*
+Generates JSON output for statements returned by parser.ParseStatement(). The statement ID and IG Script input
+are optional and only included in the output if not empty.
+Returns indented JSON output, and error (defaults to tree.PARSING_NO_ERROR).
+*/
+func GenerateJSONOutputFromParsedStatements(stmts []*tree.Node, stmtId string, igScriptInput string) (string, tree.ParsingError) {
+
+	doc, err := GenerateJSONDocument(stmts, stmtId, igScriptInput)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return "", err
+	}
+
+	// Encode without escaping of HTML-specific symbols (e.g., <, >, &), since those are common in statements
+	buffer := bytes.Buffer{}
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err2 := encoder.Encode(doc); err2 != nil {
+		return "", tree.ParsingError{ErrorCode: tree.PARSING_ERROR_JSON_ENCODING,
+			ErrorMessage: "Error when encoding JSON output: " + err2.Error()}
+	}
+
+	return buffer.String(), tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Generates JSON document structure for statements returned by parser.ParseStatement() (e.g., for embedding
+into other data structures prior to encoding).
+Returns JSON document, and error (defaults to tree.PARSING_NO_ERROR).
+*/
+func GenerateJSONDocument(stmts []*tree.Node, stmtId string, igScriptInput string) (*JSONDocument, tree.ParsingError) {
+
+	doc := &JSONDocument{
+		Schema:        SCHEMA_ID,
+		SchemaVersion: SCHEMA_VERSION,
+		StatementId:   stmtId,
+		IgScript:      igScriptInput,
+		Statements:    []*JSONNode{},
+	}
+
+	ids := newJSONNodeIds(stmts)
+	for _, stmt := range stmts {
+		jsonNode, err := generateJSONNode(stmt, ids)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			return nil, err
+		}
+		doc.Statements = append(doc.Statements, jsonNode)
+	}
+
+	return doc, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Generates JSON representation of a given node, including all child nodes, nested statements and private nodes.
+Nodes already represented in the output are referenced by ID. Returns nil for nil nodes.
+*/
+func generateJSONNode(node *tree.Node, ids *jsonNodeIds) (*JSONNode, tree.ParsingError) {
+
+	if node == nil {
+		return nil, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+	}
+
+	// Reference nodes that have already been represented
+	if id, ok := ids.ids[node]; ok {
+		return &JSONNode{Ref: id}, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+	}
+
+	jsonNode := &JSONNode{
+		ComponentType:   node.ComponentType,
+		LogicalOperator: node.LogicalOperator,
+		SharedLeft:      node.SharedLeft,
+		SharedRight:     node.SharedRight,
+		Suffix:          stringifyValue(node.Suffix),
+		Annotations:     stringifyValue(node.Annotations),
+	}
+	for _, v := range node.ElementOrder {
+		jsonNode.ElementOrder = append(jsonNode.ElementOrder, stringifyValue(v))
+	}
+
+	// Assign ID to nodes referenced multiple times
+	if ids.references[node] > 1 {
+		jsonNode.Id = NODE_ID_PREFIX + strconv.Itoa(len(ids.ids)+1)
+		ids.ids[node] = jsonNode.Id
+	}
+
+	// Convert entry based on type
+	switch entry := node.Entry.(type) {
+	case nil:
+		// Combination or empty node
+	case string:
+		jsonNode.Entry = &entry
+	case *tree.Statement:
+		stmt, err := generateJSONStatement(entry, ids)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			return nil, err
+		}
+		jsonNode.Statement = stmt
+	case []*tree.Node:
+		for _, v := range entry {
+			stmtNode, err := generateJSONNode(v, ids)
+			if err.ErrorCode != tree.PARSING_NO_ERROR {
+				return nil, err
+			}
+			jsonNode.Statements = append(jsonNode.Statements, stmtNode)
+		}
+	default:
+		return nil, tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_TYPE_JSON_OUTPUT,
+			ErrorMessage: "Invalid entry type " + reflect.TypeOf(node.Entry).String() + " during JSON output generation."}
+	}
+
+	// Convert child nodes
+	var err tree.ParsingError
+	jsonNode.Left, err = generateJSONNode(node.Left, ids)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return nil, err
+	}
+	jsonNode.Right, err = generateJSONNode(node.Right, ids)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return nil, err
+	}
+
+	// Convert private nodes
+	for _, v := range node.PrivateNodeLinks {
+		privateNode, err := generateJSONNode(v, ids)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			return nil, err
+		}
+		jsonNode.PrivateNodes = append(jsonNode.PrivateNodes, privateNode)
+	}
+
+	return jsonNode, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Generates JSON representation of a given statement (including all components).
+*/
+func generateJSONStatement(stmt *tree.Statement, ids *jsonNodeIds) (*JSONStatement, tree.ParsingError) {
+
+	jsonStmt := &JSONStatement{}
+
+	for _, field := range statementFields(stmt, jsonStmt) {
+		jsonNode, err := generateJSONNode(*field.node, ids)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			return nil, err
+		}
+		*field.jsonNode = jsonNode
+	}
+
+	return jsonStmt, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Converts suffix or annotation value (generally string) into string. Returns empty string for nil values.
+*/
+func stringifyValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	if str, ok := value.(string); ok {
+		return str
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,181 @@
+package json
+
+import (
+	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+/*
+Tests JSON output for statement with component combinations, shared elements, suffices, annotations,
+private properties and nested statements.
+*/
+func TestJSONOutputBasicStatement(t *testing.T) {
+
+	text := "A[role=enforcer](certifier) A1,p(accredited) A1(inspector) D(must) " +
+		"I(inspect (operations [AND] ([NOT] facilities)) regularly) Bdir(produce) " +
+		"Cac1[condition]{A(operator) I(applies)} [statement-level annotation]"
+
+	testJSONOutput(t, text, "TestOutputJSONBasicStatement.test")
+}
+
+/*
+Tests JSON output for component pairs (i.e., combinations of extrapolated statements) and nested statement combinations.
+*/
+func TestJSONOutputComponentPairsAndNestedCombinations(t *testing.T) {
+
+	text := "A(actor) D(may) {I(sell) Bdir(goods) [XOR] I(buy) Bdir(services)} " +
+		"Cac{Cac{A(seller) I(is registered)} [OR] Cac{A(buyer) I(is licensed)}}"
+
+	testJSONOutput(t, text, "TestOutputJSONComponentPairsAndNestedCombinations.test")
+}
+
+/*
+Tests JSON output for private properties linked to multiple component nodes, which are represented once and
+otherwise referenced by ID.
+*/
+func TestJSONOutputPrivatePropertyCombinations(t *testing.T) {
+
+	text := "Bdir1((left [OR] right)) Bdir1,p((private [AND] public)) I(x)"
+
+	testJSONOutput(t, text, "TestOutputJSONPrivatePropertyCombinations.test")
+}
+
+/*
+Parses given IG Script input and compares generated JSON output with the content of the given file.
+*/
+func testJSONOutput(t *testing.T, text string, filename string) {
+
+	stmts, err := parser.ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during parsing of statement", err.Error())
+	}
+
+	output, err := GenerateJSONOutputFromParsedStatements(stmts, "123", text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during JSON output generation:", err.Error())
+	}
+
+	// Read reference file
+	content, err2 := os.ReadFile(filename)
+	if err2 != nil {
+		t.Fatal("Error attempting to read test text input. Error: ", err2.Error())
+	}
+
+	// Extract expected output
+	expectedOutput := string(content)
+
+	// Compare to actual output
+	if output != expectedOutput {
+		fmt.Println("Produced output:\n", output)
+		fmt.Println("Expected output:\n", expectedOutput)
+		err3 := tabular.WriteToFile("errorOutput.error", output, true)
+		if err3 != nil {
+			t.Fatal("Error attempting to read test text input. Error: ", err3.Error())
+		}
+		t.Fatal("Output generation is wrong for given input statement. Wrote output to 'errorOutput.error'")
+	}
+
+	// Check generated output against property definitions in schema
+	var generic map[string]interface{}
+	if err4 := json.Unmarshal([]byte(output), &generic); err4 != nil {
+		t.Fatal("Generated output is not valid JSON:", err4)
+	}
+	if msg := checkPropertiesAgainstSchema(generic, "#"); msg != "" {
+		t.Fatal(msg)
+	}
+}
+
+/*
+Tests whether the JSON Schema reflects all fields of the JSON structures and all components of tree.Statement.
+*/
+func TestJSONSchemaConsistency(t *testing.T) {
+
+	var schemaMap map[string]interface{}
+	if err := json.Unmarshal([]byte(Schema()), &schemaMap); err != nil {
+		t.Fatal("Schema is not valid JSON:", err)
+	}
+
+	if !strings.Contains(Schema(), "\""+SCHEMA_VERSION+"\"") {
+		t.Fatal("Schema does not reflect schema version " + SCHEMA_VERSION)
+	}
+
+	if schemaMap["$id"] != SCHEMA_ID {
+		t.Fatal("Schema ID does not correspond to " + SCHEMA_ID)
+	}
+
+	for structType, path := range map[reflect.Type]string{
+		reflect.TypeOf(JSONDocument{}):  "#",
+		reflect.TypeOf(JSONNode{}):      "#/$defs/node",
+		reflect.TypeOf(JSONStatement{}): "#/$defs/statement",
+	} {
+		properties := schemaProperties(path)
+		if len(properties) != structType.NumField() {
+			t.Fatal("Number of properties in schema definition", path, "does not correspond to fields in", structType.Name())
+		}
+		for i := 0; i < structType.NumField(); i++ {
+			name := strings.Split(structType.Field(i).Tag.Get("json"), ",")[0]
+			if _, ok := properties[name]; !ok {
+				t.Fatal("Field", name, "of", structType.Name(), "is not defined in schema definition", path)
+			}
+		}
+	}
+
+	// Ensure that all statement components are covered
+	if reflect.TypeOf(tree.Statement{}).NumField() != reflect.TypeOf(JSONStatement{}).NumField() {
+		t.Fatal("JSON statement does not reflect all components of tree.Statement")
+	}
+}
+
+/*
+Returns properties defined for given schema path (either root (#) or definition (e.g., #/$defs/node)).
+*/
+func schemaProperties(path string) map[string]interface{} {
+	var schemaMap map[string]interface{}
+	json.Unmarshal([]byte(Schema()), &schemaMap)
+	if path != "#" {
+		schemaMap = schemaMap["$defs"].(map[string]interface{})[strings.TrimPrefix(path, "#/$defs/")].(map[string]interface{})
+	}
+	return schemaMap["properties"].(map[string]interface{})
+}
+
+/*
+Recursively checks whether the keys of the given JSON object are defined in the schema definition identified by path.
+Returns message describing violation, or empty string if all keys are defined.
+*/
+func checkPropertiesAgainstSchema(object map[string]interface{}, path string) string {
+	properties := schemaProperties(path)
+	for key, value := range object {
+		property, ok := properties[key]
+		if !ok {
+			return "Property " + key + " is not defined in schema definition " + path
+		}
+		// Determine referenced definition (directly or via array items)
+		ref, _ := property.(map[string]interface{})["$ref"].(string)
+		if items, ok := property.(map[string]interface{})["items"].(map[string]interface{}); ok {
+			ref, _ = items["$ref"].(string)
+		}
+		if ref == "" {
+			continue
+		}
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if msg := checkPropertiesAgainstSchema(v, ref); msg != "" {
+				return msg
+			}
+		case []interface{}:
+			for _, element := range v {
+				if msg := checkPropertiesAgainstSchema(element.(map[string]interface{}), ref); msg != "" {
+					return msg
+				}
+			}
+		}
+	}
+	return ""
+}
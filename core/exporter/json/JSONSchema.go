@@ -0,0 +1,17 @@
+package json
+
+import (
+	_ "embed"
+)
+
+// JSON Schema specifying the structure of the generated JSON output
+//
+//go:embed IGStatementSchema.json
+var schema string
+
+/*
+Returns the JSON Schema (see IGStatementSchema.json) the generated JSON output conforms to.
+*/
+func Schema() string {
+	return schema
+}
@@ -0,0 +1,153 @@
+package json
+
+import (
+	"IG-Parser/core/tree"
+)
+
+/*
+This file contains the data structures for the JSON representation of parsed statements.
+The structure mirrors tree.Statement and tree.Node and is specified in the accompanying JSON Schema (IGStatementSchema.json).
+*/
+
+// Version of the JSON Schema the generated output conforms to (to be incremented upon structural changes)
+const SCHEMA_VERSION = "1.0.0"
+
+// Identifier of the JSON Schema the generated output conforms to
+const SCHEMA_ID = "https://github.com/chrfrantz/IG-Parser/blob/main/core/exporter/json/IGStatementSchema.json"
+
+/*
+Top-level JSON document holding the parsed statement(s) alongside metadata.
+*/
+type JSONDocument struct {
+	// Reference to JSON Schema
+	Schema string `json:"$schema"`
+	// Version of JSON Schema
+	SchemaVersion string `json:"schemaVersion"`
+	// Statement ID as provided by user (optional)
+	StatementId string `json:"statementId,omitempty"`
+	// IG Script input the statements have been parsed from (optional)
+	IgScript string `json:"igScript,omitempty"`
+	// Parsed statements (as returned by parser.ParseStatement())
+	Statements []*JSONNode `json:"statements"`
+}
+
+/*
+JSON representation of tree.Node. Leaf nodes carry either entry (primitive value), statement (nested statement),
+or statements (statements extrapolated from component pairs). Combinations carry logicalOperator, left and right
+(with left omitted for unary negations). Nodes referenced multiple times in the tree (e.g., private nodes linked to
+multiple nodes, or components shared across statements extrapolated from component pairs) are only represented
+once (carrying id), and otherwise referenced by that ID (carrying ref only).
+*/
+type JSONNode struct {
+	// ID of node referenced multiple times
+	Id string `json:"id,omitempty"`
+	// Reference to node represented elsewhere (by ID)
+	Ref string `json:"ref,omitempty"`
+	// Component type (as assigned during parsing; may be inherited from parent nodes if empty)
+	ComponentType string `json:"componentType,omitempty"`
+	// Logical operator linking left and right child nodes
+	LogicalOperator string `json:"logicalOperator,omitempty"`
+	// Left child node
+	Left *JSONNode `json:"left,omitempty"`
+	// Right child node
+	Right *JSONNode `json:"right,omitempty"`
+	// Primitive entry
+	Entry *string `json:"entry,omitempty"`
+	// Nested statement entry
+	Statement *JSONStatement `json:"statement,omitempty"`
+	// Statement collection entry (extrapolated from component pairs)
+	Statements []*JSONNode `json:"statements,omitempty"`
+	// Elements shared across left and right children (left side)
+	SharedLeft []string `json:"sharedLeft,omitempty"`
+	// Elements shared across left and right children (right side)
+	SharedRight []string `json:"sharedRight,omitempty"`
+	// Non-shared elements in order of addition
+	ElementOrder []string `json:"elementOrder,omitempty"`
+	// Suffix
+	Suffix string `json:"suffix,omitempty"`
+	// Annotations
+	Annotations string `json:"annotations,omitempty"`
+	// Private nodes linked to this node (e.g., private properties)
+	PrivateNodes []*JSONNode `json:"privateNodes,omitempty"`
+}
+
+/*
+JSON representation of tree.Statement. Each field corresponds to the equally named field in tree.Statement.
+*/
+type JSONStatement struct {
+	// Regulative Statement
+	Attributes                    *JSONNode `json:"attributes,omitempty"`
+	AttributesPropertySimple      *JSONNode `json:"attributesPropertySimple,omitempty"`
+	AttributesPropertyComplex     *JSONNode `json:"attributesPropertyComplex,omitempty"`
+	Deontic                       *JSONNode `json:"deontic,omitempty"`
+	Aim                           *JSONNode `json:"aim,omitempty"`
+	DirectObject                  *JSONNode `json:"directObject,omitempty"`
+	DirectObjectComplex           *JSONNode `json:"directObjectComplex,omitempty"`
+	DirectObjectPropertySimple    *JSONNode `json:"directObjectPropertySimple,omitempty"`
+	DirectObjectPropertyComplex   *JSONNode `json:"directObjectPropertyComplex,omitempty"`
+	IndirectObject                *JSONNode `json:"indirectObject,omitempty"`
+	IndirectObjectComplex         *JSONNode `json:"indirectObjectComplex,omitempty"`
+	IndirectObjectPropertySimple  *JSONNode `json:"indirectObjectPropertySimple,omitempty"`
+	IndirectObjectPropertyComplex *JSONNode `json:"indirectObjectPropertyComplex,omitempty"`
+
+	// Constitutive Statement
+	ConstitutedEntity                     *JSONNode `json:"constitutedEntity,omitempty"`
+	ConstitutedEntityPropertySimple       *JSONNode `json:"constitutedEntityPropertySimple,omitempty"`
+	ConstitutedEntityPropertyComplex      *JSONNode `json:"constitutedEntityPropertyComplex,omitempty"`
+	Modal                                 *JSONNode `json:"modal,omitempty"`
+	ConstitutiveFunction                  *JSONNode `json:"constitutiveFunction,omitempty"`
+	ConstitutingProperties                *JSONNode `json:"constitutingProperties,omitempty"`
+	ConstitutingPropertiesComplex         *JSONNode `json:"constitutingPropertiesComplex,omitempty"`
+	ConstitutingPropertiesPropertySimple  *JSONNode `json:"constitutingPropertiesPropertySimple,omitempty"`
+	ConstitutingPropertiesPropertyComplex *JSONNode `json:"constitutingPropertiesPropertyComplex,omitempty"`
+
+	// Shared Components
+	ActivationConditionSimple  *JSONNode `json:"activationConditionSimple,omitempty"`
+	ActivationConditionComplex *JSONNode `json:"activationConditionComplex,omitempty"`
+	ExecutionConstraintSimple  *JSONNode `json:"executionConstraintSimple,omitempty"`
+	ExecutionConstraintComplex *JSONNode `json:"executionConstraintComplex,omitempty"`
+	OrElse                     *JSONNode `json:"orElse,omitempty"`
+}
+
+/*
+Associates a component field of tree.Statement with the corresponding field of JSONStatement.
+*/
+type statementField struct {
+	node     **tree.Node
+	jsonNode **JSONNode
+}
+
+/*
+Returns the mapping between all component fields of the given tree.Statement and JSONStatement.
+*/
+func statementFields(stmt *tree.Statement, jsonStmt *JSONStatement) []statementField {
+	return []statementField{
+		{&stmt.Attributes, &jsonStmt.Attributes},
+		{&stmt.AttributesPropertySimple, &jsonStmt.AttributesPropertySimple},
+		{&stmt.AttributesPropertyComplex, &jsonStmt.AttributesPropertyComplex},
+		{&stmt.Deontic, &jsonStmt.Deontic},
+		{&stmt.Aim, &jsonStmt.Aim},
+		{&stmt.DirectObject, &jsonStmt.DirectObject},
+		{&stmt.DirectObjectComplex, &jsonStmt.DirectObjectComplex},
+		{&stmt.DirectObjectPropertySimple, &jsonStmt.DirectObjectPropertySimple},
+		{&stmt.DirectObjectPropertyComplex, &jsonStmt.DirectObjectPropertyComplex},
+		{&stmt.IndirectObject, &jsonStmt.IndirectObject},
+		{&stmt.IndirectObjectComplex, &jsonStmt.IndirectObjectComplex},
+		{&stmt.IndirectObjectPropertySimple, &jsonStmt.IndirectObjectPropertySimple},
+		{&stmt.IndirectObjectPropertyComplex, &jsonStmt.IndirectObjectPropertyComplex},
+		{&stmt.ConstitutedEntity, &jsonStmt.ConstitutedEntity},
+		{&stmt.ConstitutedEntityPropertySimple, &jsonStmt.ConstitutedEntityPropertySimple},
+		{&stmt.ConstitutedEntityPropertyComplex, &jsonStmt.ConstitutedEntityPropertyComplex},
+		{&stmt.Modal, &jsonStmt.Modal},
+		{&stmt.ConstitutiveFunction, &jsonStmt.ConstitutiveFunction},
+		{&stmt.ConstitutingProperties, &jsonStmt.ConstitutingProperties},
+		{&stmt.ConstitutingPropertiesComplex, &jsonStmt.ConstitutingPropertiesComplex},
+		{&stmt.ConstitutingPropertiesPropertySimple, &jsonStmt.ConstitutingPropertiesPropertySimple},
+		{&stmt.ConstitutingPropertiesPropertyComplex, &jsonStmt.ConstitutingPropertiesPropertyComplex},
+		{&stmt.ActivationConditionSimple, &jsonStmt.ActivationConditionSimple},
+		{&stmt.ActivationConditionComplex, &jsonStmt.ActivationConditionComplex},
+		{&stmt.ExecutionConstraintSimple, &jsonStmt.ExecutionConstraintSimple},
+		{&stmt.ExecutionConstraintComplex, &jsonStmt.ExecutionConstraintComplex},
+		{&stmt.OrElse, &jsonStmt.OrElse},
+	}
+}
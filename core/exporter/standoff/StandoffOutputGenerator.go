@@ -0,0 +1,96 @@
+package standoff
+
+import (
+	"IG-Parser/core/anchoring"
+	"IG-Parser/core/tree"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+/*
+This file contains the generation of stand-off output from parsed statements, listing the content of all
+leaf nodes alongside its positions in the IG Script input and the Original Statement (see core/anchoring).
+*/
+
+// Prefix of annotation identifiers (followed by running number, e.g., T1)
+const ANNOTATION_ID_PREFIX = "T"
+
+/*
+Generates stand-off output for statements returned by parser.ParseStatement(). Anchors the leaf nodes of the
+statements to the IG Script input they have been parsed from and the Original Statement (optional, in which
+case positions in the Original Statement are omitted). The statement ID is only included if not empty.
+Returns indented JSON output, and error (defaults to tree.PARSING_NO_ERROR).
+*/
+func GenerateStandoffOutputFromParsedStatements(stmts []*tree.Node, stmtId string, igScriptInput string, originalStatement string) (string, tree.ParsingError) {
+
+	doc := GenerateStandoffDocument(stmts, stmtId, igScriptInput, originalStatement)
+
+	// Encode without escaping of HTML-specific symbols (e.g., <, >, &), since those are common in statements
+	buffer := bytes.Buffer{}
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return "", tree.ParsingError{ErrorCode: tree.PARSING_ERROR_JSON_ENCODING,
+			ErrorMessage: "Error when encoding stand-off output: " + err.Error()}
+	}
+
+	return buffer.String(), tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Generates stand-off document structure for statements returned by parser.ParseStatement() (see
+#GenerateStandoffOutputFromParsedStatements()), e.g., for embedding into other data structures prior to encoding.
+*/
+func GenerateStandoffDocument(stmts []*tree.Node, stmtId string, igScriptInput string, originalStatement string) *StandoffDocument {
+
+	doc := &StandoffDocument{
+		StatementId:       stmtId,
+		IgScript:          igScriptInput,
+		OriginalStatement: originalStatement,
+		Annotations:       []StandoffAnnotation{},
+	}
+
+	for _, leaf := range anchoring.AnchorStatements(stmts, igScriptInput, originalStatement) {
+		annotation := StandoffAnnotation{
+			Id:                    ANNOTATION_ID_PREFIX + strconv.Itoa(len(doc.Annotations)+1),
+			Component:             leaf.Component,
+			ComponentName:         tree.IGComponentSymbolNameMap[leaf.Component],
+			Path:                  leaf.Path,
+			Text:                  fmt.Sprint(leaf.Node.Entry),
+			Suffix:                stringifyValue(leaf.Node.Suffix),
+			Annotations:           stringifyValue(leaf.Node.Annotations),
+			IgScriptSpan:          convertSpan(leaf.Node.IGScriptSpan),
+			OriginalStatementSpan: convertSpan(leaf.Node.OriginalStatementSpan),
+		}
+		Println("Anchored content:", annotation)
+		doc.Annotations = append(doc.Annotations, annotation)
+	}
+
+	return doc
+}
+
+/*
+Converts source span into stand-off span (with character offsets). Returns nil for nil spans.
+*/
+func convertSpan(span *tree.SourceSpan) *StandoffSpan {
+	if span == nil {
+		return nil
+	}
+	return &StandoffSpan{Start: span.RuneOffset, End: span.RuneEndOffset, Text: span.Text}
+}
+
+/*
+Converts suffix or annotation value (generally string) into string. Returns empty string for nil values.
+*/
+func stringifyValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	if str, ok := value.(string); ok {
+		return str
+	}
+	return fmt.Sprint(value)
+}
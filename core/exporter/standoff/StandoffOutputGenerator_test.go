@@ -0,0 +1,70 @@
+package standoff
+
+import (
+	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"fmt"
+	"os"
+	"testing"
+)
+
+/*
+Tests stand-off output for statement with private properties, component combinations, component pairs,
+annotations, inferred content and nested statements, including positions in the Original Statement.
+*/
+func TestStandoffOutputBasicStatement(t *testing.T) {
+
+	original := "Certified inspectors may inspect or review farms and facilities, if the operator applies."
+	text := "A1,p(Certified) A1[role=enforcer](inspectors) D(may) {I(inspect) Bdir([organic] farms) [XOR] I(review) Bdir(facilities)} " +
+		"Cac{A(the operator) I(applies)}"
+
+	testStandoffOutput(t, original, text, "TestOutputStandoffBasicStatement.test")
+}
+
+/*
+Tests stand-off output without Original Statement for multi-line input with non-ASCII characters
+(with positions in characters as opposed to bytes).
+*/
+func TestStandoffOutputWithoutOriginalStatement(t *testing.T) {
+
+	text := "A(Bürgermeister)\nD(muss)\nI(prüfen)"
+
+	testStandoffOutput(t, "", text, "TestOutputStandoffWithoutOriginalStatement.test")
+}
+
+/*
+Parses given IG Script input and compares generated stand-off output with the content of the given file.
+*/
+func testStandoffOutput(t *testing.T, original string, text string, filename string) {
+
+	stmts, err := parser.ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during parsing of statement", err.Error())
+	}
+
+	output, err := GenerateStandoffOutputFromParsedStatements(stmts, "123", text, original)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during stand-off output generation:", err.Error())
+	}
+
+	// Read reference file
+	content, err2 := os.ReadFile(filename)
+	if err2 != nil {
+		t.Fatal("Error attempting to read test text input. Error: ", err2.Error())
+	}
+
+	// Extract expected output
+	expectedOutput := string(content)
+
+	// Compare to actual output
+	if output != expectedOutput {
+		fmt.Println("Produced output:\n", output)
+		fmt.Println("Expected output:\n", expectedOutput)
+		err3 := tabular.WriteToFile("errorOutput.error", output, true)
+		if err3 != nil {
+			t.Fatal("Error attempting to read test text input. Error: ", err3.Error())
+		}
+		t.Fatal("Output generation is wrong for given input statement. Wrote output to 'errorOutput.error'")
+	}
+}
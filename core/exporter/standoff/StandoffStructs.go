@@ -0,0 +1,57 @@
+package standoff
+
+/*
+This file contains the data structures for the stand-off representation of parsed statements, which refers to
+the content of components by position in the IG Script input and the Original Statement (as opposed to copies
+of the content, as in the JSON output, see core/exporter/json).
+*/
+
+/*
+Top-level stand-off document holding the annotated texts and the anchored component content.
+*/
+type StandoffDocument struct {
+	// Statement ID as provided by user (optional)
+	StatementId string `json:"statementId,omitempty"`
+	// IG Script input the positions in igScriptSpan refer to
+	IgScript string `json:"igScript"`
+	// Original Statement the positions in originalStatementSpan refer to (optional)
+	OriginalStatement string `json:"originalStatement,omitempty"`
+	// Component content in order of occurrence in IG Script input
+	Annotations []StandoffAnnotation `json:"annotations"`
+}
+
+/*
+Content of an individual leaf node (i.e., atomic component content) with its positions.
+*/
+type StandoffAnnotation struct {
+	// Identifier of annotation (e.g., T1)
+	Id string `json:"id"`
+	// Component symbol (e.g., Bdir,p)
+	Component string `json:"component"`
+	// Component name (e.g., Direct Object Property)
+	ComponentName string `json:"componentName"`
+	// Component symbols of components embedding the nested statements the content is contained in (outermost first)
+	Path []string `json:"path,omitempty"`
+	// Content as parsed
+	Text string `json:"text"`
+	// Suffix of component (e.g., 1 for A1)
+	Suffix string `json:"suffix,omitempty"`
+	// Annotations of component
+	Annotations string `json:"annotations,omitempty"`
+	// Position of content in IG Script input (omitted if not located)
+	IgScriptSpan *StandoffSpan `json:"igScriptSpan,omitempty"`
+	// Position of content in Original Statement (omitted if not located)
+	OriginalStatementSpan *StandoffSpan `json:"originalStatementSpan,omitempty"`
+}
+
+/*
+Position of content in text, with character (i.e., rune) offsets, which are zero-based and exclusive at the end.
+*/
+type StandoffSpan struct {
+	// Character offset of span start
+	Start int `json:"start"`
+	// Character offset of span end (exclusive)
+	End int `json:"end"`
+	// Content of span
+	Text string `json:"text"`
+}
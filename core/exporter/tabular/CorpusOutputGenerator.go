@@ -0,0 +1,133 @@
+package tabular
+
+import (
+	"IG-Parser/core/tree"
+	"strings"
+)
+
+/*
+This file contains the corpus-level tabular output generation, i.e., the combination of
+the tabular output of multiple statements into a single table with consistent schema.
+*/
+
+/*
+Generates combined tabular output for multiple statements based on a merged header across all statements.
+Takes the statement records (providing Original Statement and IG Script input for optional inclusion in output),
+and the tabular output results generated for each record (see #GenerateTabularOutputFromParsedStatements),
+with both slices being index-aligned. Results with errors are expected to be filtered by the caller.
+In static output mode (see tree.Options.DynamicOutput), all results share the schema provided by #GetStaticTabularOutputSchema().
+In dynamic output mode, the column set is the union of all statement-specific columns (which reflect the component
+frequencies per statement), with columns ordered as they occur in the individual statements.
+Allows for specification of separator, output type (#OUTPUT_TYPE_GOOGLE_SHEETS or #OUTPUT_TYPE_CSV), output file
+(not written if empty), overwriting of existing files, as well as inclusion of a single header row (printHeaders),
+Original Statement (printOriginalStatement) and IG Script input (printIgScriptInput) in the output.
+*/
+func GenerateCorpusTabularOutput(records []StatementRecord, results [][]TabularOutputResult, separator string, outputType string, filename string, overwrite bool, printHeaders bool, printOriginalStatement string, printIgScriptInput string) CorpusTabularOutputResult {
+
+	result := CorpusTabularOutputResult{Error: tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}}
+
+	if len(records) != len(results) {
+		result.Error = tree.ParsingError{ErrorCode: tree.PARSING_ERROR_UNEXPECTED_ERROR,
+			ErrorMessage: "Number of statement records and tabular output results does not match."}
+		return result
+	}
+
+	// Merge headers across all results
+	result.HeaderSymbols, result.HeaderNames = mergeHeaders(results)
+
+	// Builder for combined output
+	builder := strings.Builder{}
+	// Header row is only printed for first output entry
+	headerPrinted := false
+
+	for i, record := range records {
+		// Clean input in preparation for output (as done for individual statements)
+		originalStatement := CleanInput(record.OriginalStatement, separator)
+		igScriptInput := CleanInput(record.IgScript, separator)
+
+		for _, res := range results[i] {
+			var output string
+			var err tree.ParsingError
+			switch outputType {
+			case OUTPUT_TYPE_GOOGLE_SHEETS:
+				output, err = generateGoogleSheetsOutput(res.StatementMap, originalStatement, igScriptInput,
+					result.HeaderSymbols, result.HeaderNames, separator, "", true, printHeaders && !headerPrinted,
+					printOriginalStatement, printIgScriptInput)
+			case OUTPUT_TYPE_CSV:
+				output, err = generateCSVOutput(res.StatementMap, originalStatement, igScriptInput,
+					result.HeaderSymbols, result.HeaderNames, separator, "", true, printHeaders && !headerPrinted,
+					printOriginalStatement, printIgScriptInput)
+			default:
+				result.Error = tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_OUTPUT_TYPE, ErrorMessage: "Invalid output type specified. Should be Google Sheets or CSV."}
+				return result
+			}
+			if err.ErrorCode != tree.PARSING_NO_ERROR {
+				result.Error = err
+				return result
+			}
+			headerPrinted = true
+			builder.WriteString(output)
+			result.StatementMap = append(result.StatementMap, res.StatementMap...)
+		}
+	}
+
+	result.Output = builder.String()
+
+	// Write file
+	if filename != "" {
+		err := WriteToFile(filename, result.Output, overwrite)
+		if err != nil {
+			result.Error = tree.ParsingError{ErrorCode: tree.PARSING_ERROR_WRITE, ErrorMessage: err.Error()}
+		}
+	}
+
+	return result
+}
+
+/*
+Merges header symbols and associated names of multiple tabular output results into a single header.
+Symbols not yet contained in the merged header are inserted ahead of the next symbol of the respective
+result that is already contained in the merged header (or appended if none exists), thus retaining the
+column order of the individual results (e.g., component columns precede logical linkage columns).
+Returns merged header symbols and corresponding names.
+*/
+func mergeHeaders(results [][]TabularOutputResult) ([]string, []string) {
+
+	symbols := []string{}
+	names := []string{}
+
+	for _, stmtResults := range results {
+		for _, res := range stmtResults {
+			for i, symbol := range res.HeaderSymbols {
+				if indexOf(symbols, symbol) != -1 {
+					continue
+				}
+				// Determine position of next symbol of given result already contained in merged header
+				position := len(symbols)
+				for _, successor := range res.HeaderSymbols[i+1:] {
+					if idx := indexOf(symbols, successor); idx != -1 {
+						position = idx
+						break
+					}
+				}
+				// Insert new symbol (and name) at identified position
+				symbols = append(symbols[:position], append([]string{symbol}, symbols[position:]...)...)
+				names = append(names[:position], append([]string{res.HeaderNames[i]}, names[position:]...)...)
+			}
+		}
+	}
+
+	return symbols, names
+}
+
+/*
+Returns the index of a given value in a string slice, or -1 if not contained.
+*/
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,34 @@
+package tabular
+
+import (
+	"reflect"
+	"testing"
+)
+
+/*
+Tests merging of headers across multiple results, including retention of column order of individual results.
+*/
+func TestMergeHeaders(t *testing.T) {
+
+	results := [][]TabularOutputResult{
+		{{HeaderSymbols: []string{"Statement ID", "A", "I", "Bdir", "Logical Linkage (Statements)"},
+			HeaderNames: []string{"Statement ID", "Attributes", "Aim", "Direct Object", "Logical Linkage (Statements)"}}},
+		{{HeaderSymbols: []string{"Statement ID", "A", "D", "I", "Cex", "Logical Linkage (Statements)"},
+			HeaderNames: []string{"Statement ID", "Attributes", "Deontic", "Aim", "Execution Constraint", "Logical Linkage (Statements)"}},
+			{HeaderSymbols: []string{"Statement ID", "A", "I", "Bdir_1", "Bdir_2"},
+				HeaderNames: []string{"Statement ID", "Attributes", "Aim", "Direct Object_1", "Direct Object_2"}}},
+	}
+
+	symbols, names := mergeHeaders(results)
+
+	expectedSymbols := []string{"Statement ID", "A", "D", "I", "Bdir", "Cex", "Logical Linkage (Statements)", "Bdir_1", "Bdir_2"}
+	expectedNames := []string{"Statement ID", "Attributes", "Deontic", "Aim", "Direct Object", "Execution Constraint", "Logical Linkage (Statements)", "Direct Object_1", "Direct Object_2"}
+
+	if !reflect.DeepEqual(symbols, expectedSymbols) {
+		t.Fatal("Merged header symbols are incorrect:", symbols)
+	}
+
+	if !reflect.DeepEqual(names, expectedNames) {
+		t.Fatal("Merged header names are incorrect:", names)
+	}
+}
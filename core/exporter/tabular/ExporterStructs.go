@@ -15,3 +15,37 @@ type TabularOutputResult struct {
 	HeaderNames   []string
 	Error         tree.ParsingError
 }
+
+/*
+Input record for corpus-level tabular output generation, holding statement ID,
+Original Statement (optional) and IG Script-encoded statement of an individual statement.
+*/
+type StatementRecord struct {
+	Id                string
+	OriginalStatement string
+	IgScript          string
+}
+
+/*
+Error or warning associated with an individual statement record (identified by ID) during
+corpus-level tabular output generation.
+*/
+type StatementRecordError struct {
+	Id    string
+	Error tree.ParsingError
+}
+
+/*
+The CorpusTabularOutputResult contains the combined tabular output for multiple statements
+based on a single merged header (see tabular.GenerateCorpusTabularOutput()), alongside
+statement-specific errors and warnings (StatementErrors). Statements with errors are omitted
+from the output. Error captures errors concerning the overall output generation (e.g., file writing).
+*/
+type CorpusTabularOutputResult struct {
+	Output          string
+	StatementMap    []map[string]string
+	HeaderSymbols   []string
+	HeaderNames     []string
+	StatementErrors []StatementRecordError
+	Error           tree.ParsingError
+}
@@ -4,6 +4,7 @@ import (
 	"IG-Parser/core/shared"
 	"IG-Parser/core/tree"
 	"regexp"
+	"strings"
 )
 
 /*
@@ -60,6 +61,35 @@ func moveElementToLastPosition(elementToMove string, arrayToModify []string, add
 	return arrayToModify
 }
 
+/*
+Returns negation prefix (e.g., '[NOT] ') if the given node is negated, i.e., operand of unary negation or right
+operand of binary negation (e.g., 'certify' in '(inspect [NOT] certify)'), or an empty string otherwise. The prefix is
+repeated for each negation the node is operand of (e.g., '[NOT] [NOT] certify' for '(inspect [NOT] ([NOT] certify))').
+*/
+func getNegationPrefix(node *tree.Node) string {
+	prefix := ""
+	for node.Parent != nil && node.Parent.LogicalOperator == tree.NOT && node.Parent.Right == node {
+		prefix += logicalCombinationLeft + tree.NOT + logicalCombinationRight + " "
+		node = node.Parent
+	}
+	return prefix
+}
+
+/*
+Returns the logical operator linking the given node to its neighbour in a combination.
+Skips unary negations, since those do not link nodes.
+*/
+func getLinkingLogicalOperator(node *tree.Node) string {
+	parent := node.Parent
+	for parent != nil && parent.IsUnaryNegation() {
+		parent = parent.Parent
+	}
+	if parent == nil {
+		return ""
+	}
+	return parent.LogicalOperator
+}
+
 /*
 Generic function to clean input in preparation for tabular output
 (substituting line breaks, removing cell separator symbols).
@@ -76,6 +106,57 @@ func CleanInput(input string, separator string) string {
 	return input
 }
 
+/*
+Maps the positions of offending content in parsing errors (see tree.ParsingError.ErrorSpans) determined
+for input cleaned using #CleanInput() to the corresponding positions in the original (uncleaned) input,
+e.g., to account for removed line breaks. Returns the error with adjusted positions.
+*/
+func RelocateErrorSpans(err tree.ParsingError, input string, separator string) tree.ParsingError {
+
+	if len(err.ErrorSpans) == 0 {
+		return err
+	}
+
+	// Start and end offsets in original input for each byte of cleaned input
+	starts := []int{}
+	ends := []int{}
+	for i := 0; i < len(input); {
+		switch {
+		case strings.HasPrefix(input[i:], "\r\n"):
+			// Line break replaced by single whitespace
+			starts = append(starts, i)
+			ends = append(ends, i+2)
+			i += 2
+		case separator != "" && strings.HasPrefix(input[i:], separator):
+			// Removed separator
+			i += len(separator)
+		default:
+			starts = append(starts, i)
+			ends = append(ends, i+1)
+			i++
+		}
+	}
+
+	spans := []tree.SourceSpan{}
+	for _, span := range err.ErrorSpans {
+		if !span.IsLocated() || span.EndOffset > len(starts) {
+			continue
+		}
+		start := len(input)
+		if span.Offset < len(starts) {
+			start = starts[span.Offset]
+		}
+		end := start
+		if span.EndOffset > span.Offset {
+			end = ends[span.EndOffset-1]
+		}
+		spans = append(spans, tree.NewSourceSpan(input, start, end))
+	}
+	err.ErrorSpans = spans
+
+	return err
+}
+
 /*
 Performs output-specific modification of value prior to inclusion in output.
 Returns modified value to be used in corresponding output.
@@ -0,0 +1,387 @@
+package tabular
+
+import (
+	"IG-Parser/core/tree"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+This file contains the import of tabular output generated by IG Parser (CSV or Google Sheets format, see
+TabularOutputGenerator.go) based on the static output schema (see GetStaticTabularOutputSchema()). Rows are grouped
+into statements based on their IDs and logical linkages, before the statement trees are reconstructed from the
+component values and logical linkages of the rows (see TabularInputReconstruction.go).
+*/
+
+/*
+Statement reconstructed from tabular input.
+*/
+type TabularInputStatement struct {
+	// Statement ID (from which the IDs of the statement's rows have been derived)
+	Id string
+	// Original Statement (if contained in input)
+	OriginalStatement string
+	// Node embedding the reconstructed statement (or combination of extrapolated statements for component pairs)
+	Statement *tree.Node
+}
+
+/*
+The TabularInputResult contains the statements reconstructed from tabular input (see tabular.ParseTabularInput()),
+alongside errors for statements that could not be reconstructed (StatementErrors). Error captures errors
+concerning the overall input (e.g., missing header row or unknown columns).
+*/
+type TabularInputResult struct {
+	Statements      []TabularInputStatement
+	StatementErrors []StatementRecordError
+	Error           tree.ParsingError
+}
+
+/*
+Row read from tabular input.
+*/
+type inputRow struct {
+	id       string
+	original string
+	// Cell values by column symbol (e.g., 'A', 'Cac-Ref' or 'A (Annotation)')
+	values map[string]string
+	links  []linkage
+}
+
+// Kinds of logical linkage expressions in tabular output
+const (
+	// Linkage between component values (e.g., '[XOR].I.[650.2]')
+	linkageComponents = iota
+	// Linkage between extrapolated statements of component pairs (e.g., '[XOR].[650.2]')
+	linkagePairs
+	// Linkage between nested statements of component-level nesting (e.g., '[XOR][{650}.2]')
+	linkageNested
+)
+
+/*
+Logical linkage expression read from linkage columns.
+*/
+type linkage struct {
+	kind      int
+	operators []string
+	// Component symbol (only for linkage between component values)
+	symbol  string
+	targets []string
+}
+
+// Logical linkage expression (operators, optional component symbol and target row IDs, see linkage)
+var linkageExpression = regexp.MustCompile(`\[([A-Za-z ]+)\](\.(?:([^.\[\]]+)\.)?)?\[([^\]]*)\]`)
+
+// Range of row IDs in linkage targets (e.g., '650.4-6')
+var linkageTargetRange = regexp.MustCompile(`^(.*\.)(\d+)-(\d+)$`)
+
+// Logical operators permissible in linkage expressions
+var linkageOperators = []string{tree.AND, tree.OR, tree.XOR, tree.NOT, tree.SAND_BETWEEN_COMPONENTS, tree.SAND_WITHIN_COMPONENTS}
+
+// Prefix, infix (preceding the separator) and suffix of rows in Google Sheets output (e.g., '=SPLIT("...|..."; "|")')
+const googleSheetsRowPrefix = "=SPLIT(\""
+const googleSheetsRowInfix = "\"; \""
+const googleSheetsRowSuffix = "\")"
+
+/*
+Parses tabular output generated by IG Parser (CSV or Google Sheets format, including header row) based on the static
+output schema and reconstructs the statements it has been generated from. Rows of a statement (including the rows of
+its nested statements) need to be contiguous. Columns holding information derived from the encoding (IG Script,
+statement type and complexity measures) are ignored. Statements that cannot be reconstructed (e.g., due to logical
+linkages that are not retained in tabular output) are reported in the result (StatementErrors). Returns
+tree.PARSING_ERROR_INVALID_TABULAR_INPUT as overall error if the input does not correspond to tabular output of
+the static schema (e.g., missing header row or unknown columns), or tree.PARSING_NO_ERROR otherwise.
+*/
+func ParseTabularInput(input string) TabularInputResult {
+
+	result := TabularInputResult{Statements: []TabularInputStatement{}, StatementErrors: []StatementRecordError{}}
+
+	rows, err := readTable(input)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		result.Error = err
+		return result
+	}
+	groups, err := groupRows(rows)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		result.Error = err
+		return result
+	}
+
+	for _, group := range groups {
+		stmt, err := reconstructGroup(group)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			Println("Statement", stmt.Id, "could not be reconstructed:", err)
+			result.StatementErrors = append(result.StatementErrors, StatementRecordError{Id: stmt.Id, Error: err})
+			continue
+		}
+		result.Statements = append(result.Statements, stmt)
+	}
+	result.Error = tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+
+	return result
+}
+
+/*
+Reads rows from tabular input (CSV or Google Sheets format, with separator determined from header row), and assigns
+cell values to the symbols of the corresponding columns. Logical linkage columns are read jointly, since linkage
+expressions may contain the separator (e.g., ';').
+*/
+func readTable(input string) ([]*inputRow, tree.ParsingError) {
+
+	lines := []string{}
+	for _, line := range strings.Split(strings.ReplaceAll(input, "\r\n", "\n"), "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, strings.TrimSpace(line))
+		}
+	}
+	if len(lines) == 0 {
+		return nil, invalidTabularInput("Input does not contain any rows")
+	}
+
+	// Split rows into cells
+	googleSheets := strings.HasPrefix(lines[0], googleSheetsRowPrefix)
+	separator := ""
+	if !googleSheets && strings.HasPrefix(lines[0], stmtIdColHeader) {
+		// Separator follows first column header
+		_, size := utf8.DecodeRuneInString(lines[0][len(stmtIdColHeader):])
+		separator = lines[0][len(stmtIdColHeader) : len(stmtIdColHeader)+size]
+	}
+	table := [][]string{}
+	for i, line := range lines {
+		if googleSheets {
+			infix := strings.LastIndex(line, googleSheetsRowInfix)
+			if !strings.HasPrefix(line, googleSheetsRowPrefix) || !strings.HasSuffix(line, googleSheetsRowSuffix) ||
+				infix < len(googleSheetsRowPrefix) {
+				return nil, invalidTabularInput("Row " + strconv.Itoa(i+1) + " does not correspond to Google Sheets output")
+			}
+			separator = line[infix+len(googleSheetsRowInfix) : len(line)-len(googleSheetsRowSuffix)]
+			line = line[len(googleSheetsRowPrefix):infix]
+		}
+		if separator == "" {
+			return nil, invalidTabularInput("Input does not start with header row (first column '" + stmtIdColHeader + "')")
+		}
+		cells := strings.Split(line, separator)
+		// Rows terminate with separator
+		if len(cells) > 1 && cells[len(cells)-1] == "" {
+			cells = cells[:len(cells)-1]
+		}
+		table = append(table, cells)
+	}
+
+	// Determine column symbols based on header row
+	header := table[0]
+	if strings.TrimSpace(header[0]) != stmtIdColHeader {
+		return nil, invalidTabularInput("Input does not start with header row (first column '" + stmtIdColHeader + "')")
+	}
+	columns := make([]string, len(header))
+	firstLinkage := -1
+	lastLinkage := -1
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		switch {
+		case name == logLinkColHeaderStmts || name == logLinkColHeaderComps:
+			if firstLinkage == -1 {
+				firstLinkage = i
+			}
+			lastLinkage = i
+		case derivedColumn(name):
+			// Not considered for reconstruction
+		default:
+			columns[i] = columnSymbol(name)
+			if columns[i] == "" {
+				return nil, invalidTabularInput("Unknown column '" + name + "' (only tabular output based on the static schema can be imported)")
+			}
+		}
+	}
+
+	rows := []*inputRow{}
+	for i, cells := range table[1:] {
+		// Number of additional cells due to separators contained in linkage expressions
+		offset := len(cells) - len(header)
+		if offset < 0 || offset > 0 && firstLinkage == -1 {
+			return nil, invalidTabularInput("Row " + strconv.Itoa(i+2) + " holds " + strconv.Itoa(len(cells)) +
+				" cells, but header row holds " + strconv.Itoa(len(header)) + " columns")
+		}
+		row := &inputRow{values: map[string]string{}}
+		for j, symbol := range columns {
+			if symbol == "" {
+				continue
+			}
+			if firstLinkage != -1 && j > lastLinkage {
+				j += offset
+			}
+			value := strings.TrimSpace(cells[j])
+			switch symbol {
+			case stmtIdColHeader:
+				row.id = strings.TrimPrefix(value, stmtIdPrefix)
+			default:
+				// Revert duplication of leading quotes in Google Sheets output (see performOutputSpecificAdjustments())
+				if googleSheets && strings.HasPrefix(value, "''") {
+					value = value[1:]
+				}
+				if symbol == stmtOriginalStatementHeader {
+					row.original = value
+				} else {
+					row.values[symbol] = value
+				}
+			}
+		}
+		if row.id == "" {
+			return nil, invalidTabularInput("Row " + strconv.Itoa(i+2) + " does not hold statement ID")
+		}
+		if firstLinkage != -1 {
+			links, err := parseLinkage(strings.Join(cells[firstLinkage:lastLinkage+1+offset], separator), separator)
+			if err.ErrorCode != tree.PARSING_NO_ERROR {
+				err.ErrorMessage = "Row " + strconv.Itoa(i+2) + ": " + err.ErrorMessage
+				return nil, err
+			}
+			row.links = links
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Returns the column symbol (i.e., the key used in statement maps of tabular output, e.g., 'A', 'Cac-Ref' or
+'A (Annotation)') for a given column name of the static output schema, or an empty string for unknown columns.
+*/
+func columnSymbol(name string) string {
+	if name == stmtIdColHeader || name == stmtOriginalStatementHeader {
+		return name
+	}
+	for symbol := range GetStaticTabularOutputSchema(true) {
+		if tree.IGComponentSymbolNameMap[symbol] == name {
+			return symbol
+		}
+	}
+	return ""
+}
+
+/*
+Indicates whether column holds information derived from the encoding (i.e., IG Script input, statement type
+and complexity measures), which is not considered for the reconstruction of statements.
+*/
+func derivedColumn(name string) bool {
+	switch name {
+	case stmtIgScriptHeader, stmtTypeColHeader, complexityDoVColHeader, complexityNestingDepthColHeader, complexityNestedStmtsColHeader:
+		return true
+	}
+	return strings.HasPrefix(name, complexityOptionsColHeaderPrefix+" (") ||
+		strings.HasPrefix(name, complexityComponentColHeaderPrefix+" (")
+}
+
+/*
+Parses logical linkage expressions contained in linkage columns (see linkage), with ranges of target IDs
+(e.g., '650.4-6') expanded into individual IDs.
+*/
+func parseLinkage(value string, separator string) ([]linkage, tree.ParsingError) {
+
+	// Ensure that content apart from linkage expressions is limited to separators
+	if strings.Trim(linkageExpression.ReplaceAllString(value, ""), logicalOperatorSeparator+logicalOperatorStmtRefSeparator+separator+" ") != "" {
+		return nil, invalidTabularInput("Invalid logical linkage '" + value + "'")
+	}
+
+	links := []linkage{}
+	for _, match := range linkageExpression.FindAllStringSubmatch(value, -1) {
+		link := linkage{kind: linkageNested, operators: strings.Fields(match[1]), symbol: match[3]}
+		if match[3] != "" {
+			link.kind = linkageComponents
+		} else if match[2] != "" {
+			link.kind = linkagePairs
+		}
+		for _, operator := range link.operators {
+			if valid, _ := tree.StringInSlice(operator, linkageOperators); !valid {
+				return nil, invalidTabularInput("Invalid logical operator '" + operator + "' in logical linkage '" + match[0] + "'")
+			}
+		}
+		for _, target := range strings.Split(match[4], logicalOperatorStmtRefSeparator) {
+			target = strings.TrimSpace(target)
+			bounds := linkageTargetRange.FindStringSubmatch(target)
+			if bounds == nil {
+				if target != "" {
+					link.targets = append(link.targets, target)
+				}
+				continue
+			}
+			from, _ := strconv.Atoi(bounds[2])
+			to, _ := strconv.Atoi(bounds[3])
+			for k := from; k <= to; k++ {
+				link.targets = append(link.targets, bounds[1]+strconv.Itoa(k))
+			}
+		}
+		if len(link.targets) == 0 {
+			return nil, invalidTabularInput("Logical linkage '" + match[0] + "' does not reference any statement")
+		}
+		links = append(links, link)
+	}
+	return links, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Groups rows into statements. Rows of nested statements (i.e., with IDs starting with '{') are assigned to the
+preceding statement, whereas other rows are assigned to the preceding statement if linked to any of its rows
+(i.e., atomic statements extrapolated from the same statement).
+*/
+func groupRows(rows []*inputRow) ([][]*inputRow, tree.ParsingError) {
+	groups := [][]*inputRow{}
+	for _, row := range rows {
+		nested := strings.HasPrefix(row.id, componentNestedLeft)
+		if len(groups) > 0 && (nested || linkedToGroup(groups[len(groups)-1], row)) {
+			groups[len(groups)-1] = append(groups[len(groups)-1], row)
+			continue
+		}
+		if nested {
+			return nil, invalidTabularInput("Row of nested statement '" + row.id + "' does not follow the rows of its parent statement")
+		}
+		groups = append(groups, []*inputRow{row})
+	}
+	return groups, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Indicates whether the given row references, is referenced by, or references the same rows as any row of the given
+group (apart from rows of nested statements).
+*/
+func linkedToGroup(group []*inputRow, row *inputRow) bool {
+	for _, other := range group {
+		if strings.HasPrefix(other.id, componentNestedLeft) {
+			continue
+		}
+		if references(row, other.id) || references(other, row.id) {
+			return true
+		}
+		for _, link := range row.links {
+			for _, target := range link.targets {
+				if references(other, target) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+/*
+Indicates whether the linkages of the given row reference the row with the given ID, either directly or via the
+extrapolated statement it is part of (e.g., '650.1' for row '650.1.2').
+*/
+func references(row *inputRow, id string) bool {
+	for _, link := range row.links {
+		for _, target := range link.targets {
+			if target == id || strings.HasPrefix(id, target+stmtIdSeparator) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+/*
+Returns parsing error indicating invalid tabular input with given message.
+*/
+func invalidTabularInput(message string) tree.ParsingError {
+	return tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_TABULAR_INPUT, ErrorMessage: message}
+}
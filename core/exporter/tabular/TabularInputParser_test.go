@@ -0,0 +1,147 @@
+package tabular
+
+import (
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"strings"
+	"testing"
+)
+
+/*
+Generates tabular output (including header row) for a given statement.
+*/
+func generateTabularInput(t *testing.T, original string, text string, stmtId string, outputType string, opts tree.Options) string {
+	stmts, err := parser.ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during parsing of statement", text, err.Error())
+	}
+	results := GenerateTabularOutputFromParsedStatements(stmts, stmts[0].Annotations, original, text, stmtId, "", true,
+		opts, CellSeparator, outputType, true, ORIGINAL_STATEMENT_OUTPUT_FIRST_ENTRY, IG_SCRIPT_OUTPUT_NONE)
+	output := ""
+	for _, res := range results {
+		if res.Error.ErrorCode != tree.PARSING_NO_ERROR {
+			t.Fatal("Error during tabular output generation for statement", text, res.Error.Error())
+		}
+		output += res.Output
+	}
+	return output
+}
+
+/*
+Tests the reconstruction of IG Script from tabular output (CSV and Google Sheets format), including combinations,
+nested statements and their combinations, private properties, component pairs and annotations. Statements
+mapped to an empty string are expected to be reconstructed as is.
+*/
+func TestTabularRoundTrip(t *testing.T) {
+
+	inputs := map[string]string{
+		"A(farmer) D(must) I((inspect [XOR] review)) Bdir(crops) Cac{A(inspector) I(certifies) Bdir(farm)}":           "",
+		"A(farmer) D(must) I(inspect) Cac{Cac{A(council) I(approves)} [XOR] Cac{A(state) I(permits)}}":                "",
+		"A(farmer) I(inspect) Bdir1((apples [OR] pears)) Bdir1,p(fresh) Bdir2(bananas) Bdir2,p(ripe) Bdir,p(organic)": "",
+		// Shared components of component pairs are reconstructed as part of each item
+		"{A(council) I(approves) [XOR] A(state) I(permits)} Cac{A(farmer) I(applies)}": "{A(council) I(approves) " +
+			"Cac{A(farmer) I(applies)} [XOR] A(state) I(permits) Cac{A(farmer) I(applies)}}",
+		// Surrounding text of combination is folded into its values
+		"A(farmer) I(trade) Cex(for (Sellers [AND] Buyers) states)":                                                         "A(farmer) I(trade) Cex((for Sellers states [AND] for Buyers states))",
+		"A(farmer) I(inspect) Cac[ctx=condition]{A(council) I(approves)} Cac{A(state) I(permits)} [stmt=provision]":         "",
+		"A[role=owner](farmer) I(inspect) Bdir((apples [AND] pears)) Bdir(bananas)":                                         "",
+		"A(farmer) I(inspect) Bdir(crops) Bdir,p(fresh) Bdir,p{A(council) I(certified)} Cac{[NOT] A(council) I(objects)}":   "",
+		"A((farmer [XOR] (trader [AND] producer))) D((must [OR] may)) I((([NOT] sell) [AND] buy)) Cex((daily [OR] weekly))": "",
+		// Right operands of binary negations carry negation prefix in tabular output
+		"A(farmer) I((inspect [NOT] certify)) Bdir((crops [NOT] ([NOT] livestock)))":                 "",
+		"A(farmer) I(inspect) Cac{Cac{A(council) I(approves)} [NOT] Cac{[NOT] A(state) I(objects)}}": "",
+		"E(entity) E,p(local) M(must) F(be) P((certified [XOR] registered)) P,p(organic)":            "",
+	}
+
+	opts := tree.DefaultOptions()
+	opts.IncludeAnnotations = true
+
+	for _, outputType := range []string{OUTPUT_TYPE_CSV, OUTPUT_TYPE_GOOGLE_SHEETS} {
+		for text, expected := range inputs {
+			if expected == "" {
+				expected = text
+			}
+			output := generateTabularInput(t, "Original statement", text, "650", outputType, opts)
+
+			result := ParseTabularInput(output)
+			if result.Error.ErrorCode != tree.PARSING_NO_ERROR || len(result.StatementErrors) != 0 {
+				t.Fatal("Import of tabular output should not fail for statement", text, "Error:", result.Error, result.StatementErrors)
+			}
+			if len(result.Statements) != 1 || result.Statements[0].Id != "650" ||
+				result.Statements[0].OriginalStatement != "Original statement" {
+				t.Fatal("Import should return statement with ID and original statement, but returned", result.Statements)
+			}
+			script := result.Statements[0].Statement.StringifyStatement()
+			if script != expected {
+				t.Fatal("Imported statement should be '"+expected+"', but was", script)
+			}
+		}
+	}
+}
+
+/*
+Tests the reconstruction of multiple statements from corpus output with custom separator.
+*/
+func TestTabularInputCorpus(t *testing.T) {
+
+	defer func() { CellSeparator = "|" }()
+	CellSeparator = ";"
+
+	opts := tree.DefaultOptions()
+	output := generateTabularInput(t, "", "A(farmer) D(must) I((sell [XOR] buy))", "1", OUTPUT_TYPE_CSV, opts)
+	second := generateTabularInput(t, "", "A(council) I(approves) Cac{A(farmer) I(applies)}", "2", OUTPUT_TYPE_CSV, opts)
+	output += second[strings.Index(second, "\n")+1:]
+
+	result := ParseTabularInput(output)
+	if result.Error.ErrorCode != tree.PARSING_NO_ERROR || len(result.StatementErrors) != 0 || len(result.Statements) != 2 {
+		t.Fatal("Import of corpus should return two statements, but returned", result)
+	}
+	if result.Statements[0].Id != "1" || result.Statements[0].Statement.StringifyStatement() != "A(farmer) D(must) I((sell [XOR] buy))" ||
+		result.Statements[1].Id != "2" || result.Statements[1].Statement.StringifyStatement() != "A(council) I(approves) Cac{A(farmer) I(applies)}" {
+		t.Fatal("Imported statements are incorrect:", result.Statements)
+	}
+}
+
+/*
+Tests the rejection of tabular input that does not correspond to tabular output of IG Parser, or cannot be
+reconstructed as IG Script.
+*/
+func TestTabularInputInvalid(t *testing.T) {
+
+	opts := tree.DefaultOptions()
+
+	invalidTables := []string{
+		"",
+		"A(farmer) I(sell)\n",
+		"Statement ID|Attributes|Unknown Column|\n'1|farmer|x|\n",
+		"Statement ID|Attributes|Aim|\n'1|farmer|\n",
+	}
+	for _, table := range invalidTables {
+		if result := ParseTabularInput(table); result.Error.ErrorCode != tree.PARSING_ERROR_INVALID_TABULAR_INPUT {
+			t.Fatal("Import of invalid table should fail:", table)
+		}
+	}
+
+	// Repeated values cannot be distinguished
+	output := generateTabularInput(t, "", "A(farmer) I((sell [XOR] buy))", "1", OUTPUT_TYPE_CSV, opts)
+	result := ParseTabularInput(strings.Replace(output, "|buy|", "|sell|", 1))
+	if len(result.StatementErrors) != 1 || result.StatementErrors[0].Error.ErrorCode != tree.PARSING_ERROR_INVALID_TABULAR_INPUT {
+		t.Fatal("Import of repeated values should fail, but returned", result)
+	}
+
+	// Linkages of further combinations within a component (here '[OR]') are not retained in tabular output
+	output = generateTabularInput(t, "", "A(farmer) I(trade) Cex(for (Sellers [AND] Buyers) from (Northern [OR] Southern) states)", "1", OUTPUT_TYPE_CSV, opts)
+	result = ParseTabularInput(output)
+	if len(result.StatementErrors) != 1 || result.StatementErrors[0].Error.ErrorCode != tree.PARSING_ERROR_INVALID_TABULAR_INPUT ||
+		!strings.Contains(result.StatementErrors[0].Error.ErrorMessage, "combine multiple combinations") {
+		t.Fatal("Import of component with multiple combinations should fail, but returned", result)
+	}
+
+	// IG Core output does not contain nested statements
+	opts.IGExtendedOutput = false
+	output = generateTabularInput(t, "", "A(farmer) I(sell) Cac{A(council) I(approves)}", "1", OUTPUT_TYPE_CSV, opts)
+	result = ParseTabularInput(output)
+	if len(result.StatementErrors) != 1 || result.StatementErrors[0].Error.ErrorCode != tree.PARSING_ERROR_INVALID_TABULAR_INPUT {
+		t.Fatal("Import of IG Core output should fail, but returned", result)
+	}
+}
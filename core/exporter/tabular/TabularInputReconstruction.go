@@ -0,0 +1,897 @@
+package tabular
+
+import (
+	"IG-Parser/core/tree"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+This file contains the reconstruction of statement trees from the rows of tabular input (see TabularInputParser.go).
+Combinations are reconstructed from the logical linkages between rows (i.e., the logical operators on the path between
+component values, nested statements or extrapolated statements in the statement tree, see tree.FindLogicalLinkage()).
+Information that is not retained in tabular output (e.g., shared elements, which are folded into component values, or
+annotations of private properties) cannot be recovered. Linkages that cannot be reconstructed unambiguously (e.g.,
+implicit linkages within components (wAND)) are reported as errors. This includes components holding multiple
+combinations (e.g., combinations sharing surrounding text, as in 'for (Sellers [AND] Buyers) from (Northern [OR]
+Southern) states'), since tabular output only retains the logical linkages of the component's first combination.
+*/
+
+// Prefix of negated component values and nested statement references (e.g., '[NOT] value', see getNegationPrefix())
+const negationPrefix = logicalCombinationLeft + tree.NOT + logicalCombinationRight + " "
+
+/*
+Holds the rows of a statement (including the rows of its nested statements) during reconstruction.
+*/
+type statementImporter struct {
+	rows []*inputRow
+}
+
+/*
+Component instance (i.e., individual component value or combination of values) reconstructed from tabular input.
+*/
+type componentInstance struct {
+	node *tree.Node
+	// Cell values of the instance's elements
+	values []string
+}
+
+/*
+Element of a combination during reconstruction, i.e., individual node or combination of nodes (referenced by index).
+*/
+type combination struct {
+	node    *tree.Node
+	members []int
+}
+
+/*
+Reconstructs statement from the given group of rows (i.e., rows of a statement and its nested statements).
+Returns the reconstructed statement alongside its ID and Original Statement.
+*/
+func reconstructGroup(group []*inputRow) (TabularInputStatement, tree.ParsingError) {
+
+	imp := statementImporter{rows: group}
+	result := TabularInputStatement{Id: group[0].id}
+	rows := []*inputRow{}
+	for _, row := range group {
+		if !strings.HasPrefix(row.id, componentNestedLeft) {
+			rows = append(rows, row)
+			if result.OriginalStatement == "" {
+				result.OriginalStatement = row.original
+			}
+		}
+	}
+
+	// Determine statement ID, i.e., ID of individual row, or longest common prefix of row IDs (e.g., '650' for '650.1' and '650.2')
+	if len(rows) > 1 {
+		prefix := strings.Split(rows[0].id, stmtIdSeparator)
+		for _, row := range rows[1:] {
+			segments := strings.Split(row.id, stmtIdSeparator)
+			n := 0
+			for n < len(prefix) && n < len(segments) && prefix[n] == segments[n] {
+				n++
+			}
+			prefix = prefix[:n]
+		}
+		if len(prefix) == 0 {
+			return result, invalidTabularInput("Rows '" + rows[0].id + "' to '" + rows[len(rows)-1].id + "' are linked, but do not share statement ID")
+		}
+		result.Id = strings.Join(prefix, stmtIdSeparator)
+	}
+
+	node, err := imp.reconstruct(result.Id, rows)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return result, err
+	}
+	result.Statement = node
+
+	return result, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Returns the rows of the statement with the given ID, i.e., rows with the given ID or IDs derived from it (e.g.,
+'{650}.1.2' for '{650}.1'), excluding rows of statements nested in it.
+*/
+func (imp *statementImporter) statementRows(id string) []*inputRow {
+	rows := []*inputRow{}
+	for _, row := range imp.rows {
+		if row.id == id || strings.HasPrefix(row.id, id+stmtIdSeparator) {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+/*
+Reconstructs the statement with the given ID from its rows. Statements whose rows are linked as extrapolated
+statements (i.e., component pairs) are reconstructed as combination of the extrapolated statements.
+*/
+func (imp *statementImporter) reconstruct(id string, rows []*inputRow) (*tree.Node, tree.ParsingError) {
+
+	paired := false
+	for _, row := range rows {
+		for _, link := range row.links {
+			paired = paired || link.kind == linkagePairs
+		}
+	}
+	if !paired {
+		return imp.reconstructStatement(rows)
+	}
+
+	// Assign rows to extrapolated statements (e.g., '650.2' for rows '650.2' and '650.2.1')
+	ids := []string{}
+	stmtRows := map[string][]*inputRow{}
+	for _, row := range rows {
+		if !strings.HasPrefix(row.id, id+stmtIdSeparator) {
+			return nil, invalidTabularInput("Row '" + row.id + "' cannot be assigned to extrapolated statement of statement '" + id + "'")
+		}
+		stmtId := id + stmtIdSeparator + strings.SplitN(strings.TrimPrefix(row.id, id+stmtIdSeparator), stmtIdSeparator, 2)[0]
+		if _, ok := stmtRows[stmtId]; !ok {
+			ids = append(ids, stmtId)
+		}
+		stmtRows[stmtId] = append(stmtRows[stmtId], row)
+	}
+
+	nodes := []*tree.Node{}
+	paths := make([][][]string, len(ids))
+	for i, stmtId := range ids {
+		stmt, err := imp.reconstructStatement(stmtRows[stmtId])
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			return nil, err
+		}
+		// Extrapolated statements are embedded in node collection (as generated by the parser)
+		nodes = append(nodes, &tree.Node{Entry: []*tree.Node{stmt}})
+		paths[i] = make([][]string, len(ids))
+		for _, row := range stmtRows[stmtId] {
+			for _, link := range row.links {
+				if link.kind != linkagePairs {
+					continue
+				}
+				for _, target := range link.targets {
+					j := indexOf(ids, target)
+					if j == -1 || j == i {
+						return nil, invalidTabularInput("Row '" + row.id + "' is linked to unknown extrapolated statement '" + target + "'")
+					}
+					if err := setPath(paths[i], j, link.operators, "extrapolated statements of statement '"+id+"'"); err.ErrorCode != tree.PARSING_NO_ERROR {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+
+	root, _, err := combine(nodes, nodes, paths, false, "extrapolated statements of statement '"+id+"'")
+	return root, err
+}
+
+/*
+Reconstructs atomic statement (i.e., statement without component pairs) from its rows, and returns the node embedding
+it (including statement-level annotations). Properties are reconstructed following the components they relate to,
+so that private properties can be linked to the corresponding component instances.
+*/
+func (imp *statementImporter) reconstructStatement(rows []*inputRow) (*tree.Node, tree.ParsingError) {
+
+	stmt := &tree.Statement{}
+	node := &tree.Node{Entry: stmt}
+
+	annotations, err := uniformValue(rows, tree.STATEMENT_ANNOTATION)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return nil, err
+	}
+	if annotations != "" {
+		node.Annotations = annotations
+	}
+
+	// Component instances by symbol (for the assignment of private properties)
+	instances := map[string][]*componentInstance{}
+	for _, properties := range []bool{false, true} {
+		for _, comp := range stmt.Components() {
+			if strings.HasSuffix(comp.Symbol, tree.PROPERTY_SYNTAX_SUFFIX) != properties {
+				continue
+			}
+			column := comp.Symbol
+			if comp.Complex {
+				column += tree.REF_SUFFIX
+			}
+			values := []string{}
+			for _, row := range rows {
+				values = append(values, row.values[column])
+			}
+			if properties {
+				values, err = imp.extractPrivateProperties(rows, comp, values, instances[strings.TrimSuffix(comp.Symbol, tree.PROPERTY_SYNTAX_SUFFIX)])
+				if err.ErrorCode != tree.PARSING_NO_ERROR {
+					return nil, err
+				}
+			}
+			var root *tree.Node
+			if comp.Complex {
+				root, err = imp.reconstructNestedComponent(comp.Symbol, values)
+			} else {
+				root, instances[comp.Symbol], err = imp.reconstructComponent(rows, comp.Symbol, values)
+			}
+			if err.ErrorCode != tree.PARSING_NO_ERROR {
+				return nil, err
+			}
+			if root != nil {
+				*stmt.ComponentField(comp.Symbol, comp.Complex) = root
+			}
+		}
+	}
+
+	return node, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Reconstructs primitive component from the given cell values of the statement's rows, based on the logical linkages
+between the rows. Returns the component node and the component instances it consists of (i.e., combinations linked
+by implicit linkage (bAND)), or nil if the component is not populated. Annotations are assigned to component instances,
+and thus need to correspond for all values of an instance.
+*/
+func (imp *statementImporter) reconstructComponent(rows []*inputRow, symbol string, values []string) (*tree.Node, []*componentInstance, tree.ParsingError) {
+
+	description := "component '" + symbol + "'"
+	distinct := []string{}
+	annotations := []string{}
+	for i, value := range values {
+		if value == "" {
+			continue
+		}
+		idx := indexOf(distinct, value)
+		if idx == -1 {
+			distinct = append(distinct, value)
+			annotations = append(annotations, rows[i].values[symbol+tree.ANNOTATION])
+		} else if annotations[idx] != rows[i].values[symbol+tree.ANNOTATION] {
+			return nil, nil, invalidTabularInput("Diverging annotations for value '" + value + "' of " + description)
+		}
+	}
+	if len(distinct) == 0 {
+		return nil, nil, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+	}
+
+	nodes := []*tree.Node{}
+	leaves := []*tree.Node{}
+	for _, value := range distinct {
+		node, leaf := valueNode(value, symbol)
+		nodes = append(nodes, node)
+		leaves = append(leaves, leaf)
+	}
+
+	// Determine logical linkages between values based on linkages between rows
+	paths := make([][][]string, len(distinct))
+	for i := range paths {
+		paths[i] = make([][]string, len(distinct))
+	}
+	for i, row := range rows {
+		if values[i] == "" {
+			continue
+		}
+		source := indexOf(distinct, values[i])
+		for _, link := range row.links {
+			if link.kind != linkageComponents || link.symbol != symbol {
+				continue
+			}
+			targets := []string{}
+			for _, target := range link.targets {
+				idx := -1
+				for j, other := range rows {
+					if other.id == target {
+						idx = j
+					}
+				}
+				if idx == -1 {
+					return nil, nil, invalidTabularInput("Row '" + row.id + "' is linked to unknown row '" + target + "'")
+				}
+				if indexOf(targets, values[idx]) == -1 {
+					targets = append(targets, values[idx])
+				}
+			}
+			if len(targets) > 1 {
+				// Linked rows vary in further combination of component, whose linkages are not retained in tabular output
+				return nil, nil, invalidTabularInput("Values of " + description + " in row '" + row.id + "' combine multiple " +
+					"combinations (e.g., combinations sharing surrounding text, such as 'for (Sellers [AND] Buyers) from " +
+					"(Northern [OR] Southern) states'), whose logical linkages are not retained in tabular output")
+			}
+			if targets[0] == values[i] {
+				return nil, nil, invalidTabularInput("Logical linkage '" + strings.Join(link.operators, " ") + "' of " + description +
+					" in row '" + row.id + "' cannot be attributed to individual value (e.g., due to repeated values)")
+			}
+			if err := setPath(paths[source], indexOf(distinct, targets[0]), link.operators, description); err.ErrorCode != tree.PARSING_NO_ERROR {
+				return nil, nil, err
+			}
+		}
+	}
+
+	root, combinations, err := combine(nodes, leaves, paths, false, description)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return nil, nil, err
+	}
+	removeBinaryNegationPrefixes(nodes)
+
+	instances := []*componentInstance{}
+	for _, comb := range combinations {
+		instance := &componentInstance{node: comb.node}
+		annotation := annotations[comb.members[0]]
+		for _, member := range comb.members {
+			if annotations[member] != annotation {
+				return nil, nil, invalidTabularInput("Annotations of individual values of combination in " + description + " cannot be represented")
+			}
+			instance.values = append(instance.values, distinct[member])
+		}
+		if annotation != "" {
+			comb.node.Annotations = annotation
+		}
+		instances = append(instances, instance)
+	}
+
+	return root, instances, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Reconstructs nested component from the given cell values of the statement's rows, which reference the nested statements
+(e.g., '{650}.1,[NOT] {650}.2'). Nested statements are combined based on the logical linkages between them, or linked
+as separate component instances in the absence of linkages. Returns nil if the component is not populated.
+*/
+func (imp *statementImporter) reconstructNestedComponent(symbol string, values []string) (*tree.Node, tree.ParsingError) {
+
+	description := "nested statements of component '" + symbol + "'"
+	cell := ""
+	for i, value := range values {
+		if i > 0 && value != cell {
+			return nil, invalidTabularInput("Diverging references to " + description + " ('" + cell + "', '" + value + "')")
+		}
+		cell = value
+	}
+	if cell == "" {
+		return nil, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+	}
+
+	ids := []string{}
+	nodes := []*tree.Node{}
+	leaves := []*tree.Node{}
+	for _, item := range splitCellValues(cell) {
+		node, leaf, err := imp.nestedNode(item, symbol)
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			return nil, err
+		}
+		id, _ := trimNegationPrefixes(item)
+		ids = append(ids, id)
+		nodes = append(nodes, node)
+		leaves = append(leaves, leaf)
+	}
+
+	// Determine logical linkages between nested statements (only considering statements of this component)
+	paths := make([][][]string, len(ids))
+	linked := false
+	for i, id := range ids {
+		paths[i] = make([][]string, len(ids))
+		for _, row := range imp.statementRows(id) {
+			for _, link := range row.links {
+				if link.kind != linkageNested {
+					continue
+				}
+				for _, target := range link.targets {
+					if j := indexOf(ids, target); j != -1 && j != i {
+						linked = true
+						if err := setPath(paths[i], j, link.operators, description); err.ErrorCode != tree.PARSING_NO_ERROR {
+							return nil, err
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if !linked {
+		return implicitlyLinked(nodes)
+	}
+	root, _, err := combine(nodes, leaves, paths, true, description)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return nil, err
+	}
+	removeBinaryNegationPrefixes(nodes)
+	return root, err
+}
+
+/*
+Separates private property values (e.g., 'fresh' in 'Bdir1(apples) Bdir1,p(fresh) Bdir2(pears)') from the given cell
+values of a property column, and links them to the corresponding instances of the component they relate to (owners).
+Values are considered private if present in all rows of exactly one owner instance, but not in rows of other instances.
+Returns the cell values holding the remaining (i.e., shared) property values.
+*/
+func (imp *statementImporter) extractPrivateProperties(rows []*inputRow, comp tree.StatementComponent, values []string, owners []*componentInstance) ([]string, tree.ParsingError) {
+
+	// Private properties require multiple component instances
+	if len(owners) < 2 {
+		return values, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+	}
+	owner := strings.TrimSuffix(comp.Symbol, tree.PROPERTY_SYNTAX_SUFFIX)
+
+	items := make([][]string, len(values))
+	distinct := []string{}
+	for i, value := range values {
+		if value == "" {
+			continue
+		}
+		items[i] = splitCellValues(value)
+		for _, item := range items[i] {
+			if indexOf(distinct, item) == -1 {
+				distinct = append(distinct, item)
+			}
+		}
+	}
+
+	privates := make([][]string, len(owners))
+	for _, item := range distinct {
+		// Owner values of rows holding property value
+		ownerValues := []string{}
+		for i, row := range rows {
+			if indexOf(items[i], item) != -1 && indexOf(ownerValues, row.values[owner]) == -1 {
+				ownerValues = append(ownerValues, row.values[owner])
+			}
+		}
+		instance := -1
+		for k, inst := range owners {
+			if len(inst.values) == len(ownerValues) && containsAll(inst.values, ownerValues) {
+				instance = k
+			}
+		}
+		if instance == -1 {
+			// Shared values are present for all instances
+			if !ownsAll(owners, ownerValues) {
+				return nil, invalidTabularInput("Value '" + item + "' of component '" + comp.Symbol + "' cannot be assigned to instance of component '" + owner + "'")
+			}
+			continue
+		}
+		for i, row := range rows {
+			if indexOf(ownerValues, row.values[owner]) != -1 && indexOf(items[i], item) == -1 {
+				return nil, invalidTabularInput("Value '" + item + "' of component '" + comp.Symbol + "' is not consistently assigned to instance of component '" + owner + "'")
+			}
+		}
+		privates[instance] = append(privates[instance], item)
+	}
+
+	remaining := make([]string, len(values))
+	private := []string{}
+	for _, items := range privates {
+		private = append(private, items...)
+	}
+	if len(private) == 0 {
+		return values, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+	}
+	for i := range values {
+		shared := []string{}
+		for _, item := range items[i] {
+			if indexOf(private, item) == -1 {
+				shared = append(shared, item)
+			}
+		}
+		remaining[i] = strings.Join(shared, cellValueSeparator)
+	}
+
+	// Link private nodes to owner instances, which are distinguished by suffix
+	for k, inst := range owners {
+		suffix := strconv.Itoa(k + 1)
+		inst.node.Suffix = suffix
+		for _, item := range privates[k] {
+			var node *tree.Node
+			if comp.Complex {
+				var err tree.ParsingError
+				node, _, err = imp.nestedNode(item, comp.Symbol)
+				if err.ErrorCode != tree.PARSING_NO_ERROR {
+					return nil, err
+				}
+			} else {
+				node, _ = valueNode(item, comp.Symbol)
+			}
+			node.Suffix = suffix
+			inst.node.PrivateNodeLinks = append(inst.node.PrivateNodeLinks, node)
+		}
+	}
+
+	return remaining, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Reconstructs nested statement referenced by the given cell value (e.g., '{650}.1' or '[NOT] {650}.1') for the
+component with the given symbol. Returns the node for the nested statement (wrapped in unary negation node if negated),
+and the node embedding the statement.
+*/
+func (imp *statementImporter) nestedNode(value string, symbol string) (*tree.Node, *tree.Node, tree.ParsingError) {
+	id, negations := trimNegationPrefixes(value)
+	if !strings.HasPrefix(id, componentNestedLeft) {
+		return nil, nil, invalidTabularInput("Reference '" + value + "' of component '" + symbol +
+			"' does not refer to nested statement (only IG Extended output can be imported)")
+	}
+	rows := imp.statementRows(id)
+	if len(rows) == 0 {
+		return nil, nil, invalidTabularInput("Nested statement '" + id + "' referenced by component '" + symbol + "' is not contained in input")
+	}
+	leaf, err := imp.reconstruct(id, rows)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return nil, nil, err
+	}
+	leaf.ComponentType = symbol
+	return negate(leaf, negations, symbol), leaf, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Creates node for component value, with negated values (e.g., '[NOT] value') wrapped in unary negation node
+(for each negation prefix). Returns the created node and the leaf node holding the value.
+*/
+func valueNode(value string, symbol string) (*tree.Node, *tree.Node) {
+	entry, negations := trimNegationPrefixes(value)
+	leaf := &tree.Node{ComponentType: symbol, Entry: entry}
+	return negate(leaf, negations, symbol), leaf
+}
+
+/*
+Removes negation prefixes from the given value (e.g., '[NOT] [NOT] value'), and returns the remaining value
+alongside the number of removed prefixes.
+*/
+func trimNegationPrefixes(value string) (string, int) {
+	negations := 0
+	for strings.HasPrefix(value, negationPrefix) {
+		value = strings.TrimPrefix(value, negationPrefix)
+		negations++
+	}
+	return value, negations
+}
+
+/*
+Wraps the given node in the given number of unary negation nodes, and returns the resulting node.
+*/
+func negate(node *tree.Node, negations int, symbol string) *tree.Node {
+	for i := 0; i < negations; i++ {
+		negation := &tree.Node{LogicalOperator: tree.NOT, ComponentType: symbol}
+		negation.InsertRightNode(node)
+		node = negation
+	}
+	return node
+}
+
+/*
+Removes the unary negation reconstructed from the negation prefix of right operands of binary negations (e.g.,
+'[NOT] certify' for '(inspect [NOT] certify)', see getNegationPrefix()), since the prefix reflects the binary negation.
+*/
+func removeBinaryNegationPrefixes(nodes []*tree.Node) {
+	for _, node := range nodes {
+		parent := node.Parent
+		if node.IsUnaryNegation() && parent != nil && parent.LogicalOperator == tree.NOT && !parent.Left.IsNil() && parent.Right == node {
+			parent.Right = node.Right
+			node.Right.Parent = parent
+		}
+	}
+}
+
+/*
+Reconstructs the combination of the given nodes from the logical linkages between them, i.e., the logical operators on
+the path from each node to every other node (paths[i][j], as determined by tree.FindLogicalLinkage() for the given leaf
+nodes). Nodes are combined bottom-up, starting with nodes directly linked to each other. Conjunctions are aggregated
+across nesting levels (since adjacent conjunctions are collapsed in tabular output), and separated into component
+instances if linked by implicit linkage (bAND), which is only possible at the top level. For nested statements (nested),
+conjunctions at the top level are represented as separate component instances. The reconstructed combination is
+verified to reproduce the given linkages. Returns the combined node alongside the component instances it consists of,
+or tree.PARSING_ERROR_INVALID_TABULAR_INPUT if the linkages cannot be reconstructed unambiguously.
+*/
+func combine(nodes []*tree.Node, leaves []*tree.Node, paths [][][]string, nested bool, description string) (*tree.Node, []*combination, tree.ParsingError) {
+
+	elements := []*combination{}
+	for i, node := range nodes {
+		elements = append(elements, &combination{node: node, members: []int{i}})
+	}
+	links := map[*combination]map[*combination][]string{}
+	for i, from := range elements {
+		links[from] = map[*combination][]string{}
+		for j, to := range elements {
+			if i == j {
+				continue
+			}
+			if paths[i][j] == nil {
+				return nil, nil, invalidTabularInput("Missing logical linkage between " + description)
+			}
+			if contained, _ := tree.StringInSlice(tree.SAND_WITHIN_COMPONENTS, paths[i][j]); contained {
+				return nil, nil, invalidTabularInput("Implicit linkage (" + tree.SAND_WITHIN_COMPONENTS + ") between " + description + " cannot be reconstructed")
+			}
+			links[from][to] = paths[i][j]
+		}
+	}
+
+	instances := elements
+	for len(elements) > 1 {
+		group, operator := adjacentElements(elements, links)
+		if group == nil {
+			return nil, nil, invalidTabularInput("Logical linkages between " + description + " cannot be reconstructed")
+		}
+		var merged *combination
+		var err tree.ParsingError
+		if operator != tree.AND {
+			merged, err = join(group, operator)
+			instances = []*combination{merged}
+		} else {
+			// Separate conjunctions into component instances
+			parts := []*combination{}
+			for _, instance := range separateInstances(group, links, nested && len(group) == len(elements)) {
+				part, err := join(instance, tree.AND)
+				if err.ErrorCode != tree.PARSING_NO_ERROR {
+					return nil, nil, err
+				}
+				parts = append(parts, part)
+			}
+			if len(parts) > 1 && len(group) != len(elements) {
+				return nil, nil, invalidTabularInput("Implicit linkage (" + tree.SAND_BETWEEN_COMPONENTS + ") within combination of " + description + " cannot be represented")
+			}
+			merged, err = join(parts, tree.SAND_BETWEEN_COMPONENTS)
+			instances = []*combination{merged}
+			if len(parts) > 1 {
+				instances = parts
+			}
+		}
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			return nil, nil, err
+		}
+
+		// Derive linkages of combined element from first element of the group by removing the combining operator
+		remaining := []*combination{merged}
+		links[merged] = map[*combination][]string{}
+		for _, other := range elements {
+			if containsElement(group, other) {
+				continue
+			}
+			outgoing := links[group[0]][other]
+			incoming := links[other][group[0]]
+			if len(outgoing) < 2 || len(incoming) < 2 {
+				return nil, nil, invalidTabularInput("Logical linkages between " + description + " cannot be reconstructed")
+			}
+			links[merged][other] = outgoing[1:]
+			links[other][merged] = incoming[:len(incoming)-1]
+			remaining = append(remaining, other)
+		}
+		sort.SliceStable(remaining, func(i, j int) bool {
+			return remaining[i].members[0] < remaining[j].members[0]
+		})
+		elements = remaining
+	}
+
+	// Verify that reconstructed combination reproduces linkages
+	for i := range leaves {
+		for j := range leaves {
+			if i == j {
+				continue
+			}
+			_, ops, nodeErr := tree.FindLogicalLinkage(leaves[i], leaves[j])
+			if nodeErr.ErrorCode != tree.TREE_NO_ERROR {
+				return nil, nil, invalidTabularInput(nodeErr.ErrorMessage)
+			}
+			if nested {
+				// Separate component instances of nested statements are linked by AND
+				for k := range ops {
+					if ops[k] == tree.SAND_BETWEEN_COMPONENTS {
+						ops[k] = tree.AND
+					}
+				}
+			}
+			collapsed := tree.CollapseAdjacentOperators(ops, []string{tree.AND, tree.SAND_BETWEEN_COMPONENTS, tree.SAND_WITHIN_COMPONENTS})
+			if !equalOperators(ops, paths[i][j]) && !equalOperators(collapsed, paths[i][j]) {
+				return nil, nil, invalidTabularInput("Logical linkages between " + description + " cannot be reconstructed unambiguously")
+			}
+		}
+	}
+
+	return elements[0].node, instances, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Identifies elements to be combined next, i.e., two elements directly linked by the same operator (other than AND),
+or the group of elements directly linked to each other by conjunctions (AND or bAND). Returns the elements alongside
+the combining operator (AND for conjunctions), or nil if no elements are directly linked.
+*/
+func adjacentElements(elements []*combination, links map[*combination]map[*combination][]string) ([]*combination, string) {
+	for _, conjunction := range []bool{false, true} {
+		for a := range elements {
+			for b := a + 1; b < len(elements); b++ {
+				operator := directLink(links, elements[a], elements[b])
+				if operator == "" || (operator == tree.AND) != conjunction {
+					continue
+				}
+				group := []*combination{elements[a], elements[b]}
+				if !conjunction {
+					return group, operator
+				}
+				for _, other := range elements {
+					if containsElement(group, other) {
+						continue
+					}
+					linked := true
+					for _, member := range group {
+						linked = linked && directLink(links, member, other) == tree.AND
+					}
+					if linked {
+						group = append(group, other)
+					}
+				}
+				sort.SliceStable(group, func(i, j int) bool {
+					return group[i].members[0] < group[j].members[0]
+				})
+				return group, tree.AND
+			}
+		}
+	}
+	return nil, ""
+}
+
+/*
+Returns the operator directly linking two elements in both directions (with AND representing conjunctions, i.e.,
+AND and bAND), or an empty string if elements are not directly linked.
+*/
+func directLink(links map[*combination]map[*combination][]string, a *combination, b *combination) string {
+	outgoing := links[a][b]
+	incoming := links[b][a]
+	if len(outgoing) != 1 || len(incoming) != 1 {
+		return ""
+	}
+	if isConjunction(outgoing[0]) && isConjunction(incoming[0]) {
+		return tree.AND
+	}
+	if outgoing[0] != incoming[0] {
+		return ""
+	}
+	return outgoing[0]
+}
+
+/*
+Separates conjunctively linked elements into component instances, i.e., groups of elements linked by AND (as opposed
+to implicit linkage (bAND) in either direction). If separate is true, each element forms an individual instance.
+*/
+func separateInstances(group []*combination, links map[*combination]map[*combination][]string, separate bool) [][]*combination {
+	instances := [][]*combination{}
+	assigned := map[*combination]int{}
+	for _, element := range group {
+		idx := -1
+		for _, member := range group {
+			if k, ok := assigned[member]; ok && !separate &&
+				links[element][member][0] != tree.SAND_BETWEEN_COMPONENTS && links[member][element][0] != tree.SAND_BETWEEN_COMPONENTS {
+				idx = k
+				break
+			}
+		}
+		if idx == -1 {
+			idx = len(instances)
+			instances = append(instances, []*combination{})
+		}
+		instances[idx] = append(instances[idx], element)
+		assigned[element] = idx
+	}
+	return instances
+}
+
+/*
+Combines the given elements in order using the given logical operator.
+*/
+func join(elements []*combination, operator string) (*combination, tree.ParsingError) {
+	result := &combination{node: elements[0].node, members: append([]int{}, elements[0].members...)}
+	for _, element := range elements[1:] {
+		node, err := tree.Combine(result.node, element.node, operator)
+		if err.ErrorCode != tree.TREE_NO_ERROR {
+			return nil, invalidTabularInput(err.ErrorMessage)
+		}
+		result.node = node
+		result.members = append(result.members, element.members...)
+	}
+	sort.Ints(result.members)
+	return result, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Combines the given nodes as separate component instances (i.e., by implicit linkage (bAND)).
+*/
+func implicitlyLinked(nodes []*tree.Node) (*tree.Node, tree.ParsingError) {
+	root := nodes[0]
+	for _, node := range nodes[1:] {
+		combined, err := tree.Combine(root, node, tree.SAND_BETWEEN_COMPONENTS)
+		if err.ErrorCode != tree.TREE_NO_ERROR {
+			return nil, invalidTabularInput(err.ErrorMessage)
+		}
+		root = combined
+	}
+	return root, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Records the logical operators on the path to the target with the given index, and ensures consistency with
+previously recorded operators (e.g., from other rows holding the same value).
+*/
+func setPath(paths [][]string, target int, operators []string, description string) tree.ParsingError {
+	if paths[target] != nil && !equalOperators(paths[target], operators) {
+		return invalidTabularInput("Inconsistent logical linkages between " + description)
+	}
+	paths[target] = operators
+	return tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Returns the value of the given column if it is identical across the given rows, or error otherwise.
+*/
+func uniformValue(rows []*inputRow, column string) (string, tree.ParsingError) {
+	for _, row := range rows[1:] {
+		if row.values[column] != rows[0].values[column] {
+			return "", invalidTabularInput("Diverging values for column '" + tree.IGComponentSymbolNameMap[column] +
+				"' in rows '" + rows[0].id + "' and '" + row.id + "'")
+		}
+	}
+	return rows[0].values[column], tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Splits cell holding multiple values (e.g., '{650}.1,[NOT] {650}.2'). Separators followed by whitespace are considered
+part of the values.
+*/
+func splitCellValues(cell string) []string {
+	values := []string{}
+	start := 0
+	for i := 0; i < len(cell); i++ {
+		if cell[i:i+1] == cellValueSeparator && (i+1 == len(cell) || cell[i+1] != ' ') {
+			values = append(values, strings.TrimSpace(cell[start:i]))
+			start = i + 1
+		}
+	}
+	return append(values, strings.TrimSpace(cell[start:]))
+}
+
+/*
+Indicates whether the given operator is a conjunction (AND or bAND).
+*/
+func isConjunction(operator string) bool {
+	return operator == tree.AND || operator == tree.SAND_BETWEEN_COMPONENTS
+}
+
+/*
+Indicates whether the given operator sequences are identical.
+*/
+func equalOperators(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+Indicates whether the given element is contained in the given elements.
+*/
+func containsElement(elements []*combination, element *combination) bool {
+	for _, e := range elements {
+		if e == element {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Indicates whether all given values are contained in the given slice.
+*/
+func containsAll(slice []string, values []string) bool {
+	for _, value := range values {
+		if indexOf(slice, value) == -1 {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+Indicates whether the given owner values comprise the values of all given component instances.
+*/
+func ownsAll(owners []*componentInstance, values []string) bool {
+	for _, owner := range owners {
+		if !containsAll(values, owner.values) {
+			return false
+		}
+	}
+	return true
+}
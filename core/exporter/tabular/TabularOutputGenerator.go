@@ -53,6 +53,18 @@ const componentNestedRight = parser.RIGHT_BRACE
 // Column identifier for Statement ID
 const stmtIdColHeader = "Statement ID"
 
+// Column identifier for statement type (see tree.Statement.Classify())
+const stmtTypeColHeader = "Statement Type"
+
+// Column identifiers for complexity measures of statement (see tree.Statement.CalculateComplexity())
+const complexityDoVColHeader = "Degree of Variability"
+const complexityNestingDepthColHeader = "Nesting Depth"
+const complexityNestedStmtsColHeader = "Nested Statements"
+
+// Prefixes of column identifiers for options and complexity of individual components (e.g., 'Options (A)', 'Complexity (A)')
+const complexityOptionsColHeaderPrefix = "Options"
+const complexityComponentColHeaderPrefix = "Complexity"
+
 // Column identifier for Original Statement input
 const stmtOriginalStatementHeader = "Original Statement"
 
@@ -79,7 +91,7 @@ type IdentifiedStmt struct {
 
 /*
 Generates array of statement maps corresponding to identified elements format. Includes parsing of nested statements.
-Consider the specification of INCLUDE_SHARED_ELEMENTS_IN_TABULAR_OUTPUT variable to indicate whether shared elements
+Consider the specification of Options.IncludeSharedElementsInTabularOutput to indicate whether shared elements
 are to be included in output.
 Input:
   - Atomic statements with corresponding node references [statement][node references]
@@ -94,13 +106,14 @@ Input:
   - outputType allows for the specification of target output type to introduce necessary preprocessing as part of the matrix generation (e.g., prefixing quotes).
     Valid output types are defined in TabularOutputGeneratorConfig (e.g., #OUTPUT_TYPE_GOOGLE_SHEETS, etc.)
   - printHeaders indicates whether header row is included in output.
+  - opts holds the options controlling the output generation (e.g., dynamic vs. static output).
 
 Output:
 - Array of statement entry maps (i.e., values for each component in given statement, i.e., [statement]map[component]componentValue)
 - Array of header symbols (used for component linkage references)
 - Array of header symbols names (for human-readable header construction)
 */
-func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmtLogicalLinks string, componentFrequency map[string]int, logicalLinks []map[*tree.Node][]string, stmtId string, headerSeparator string, outputType string, printHeaders bool) ([]map[string]string, []string, []string, tree.ParsingError) {
+func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmtLogicalLinks string, componentFrequency map[string]int, logicalLinks []map[*tree.Node][]string, stmtId string, headerSeparator string, outputType string, printHeaders bool, opts tree.Options) ([]map[string]string, []string, []string, tree.ParsingError) {
 
 	if headerSeparator == "" {
 		return nil, nil, nil, tree.ParsingError{ErrorCode: tree.PARSING_ERROR_MISSING_SEPARATOR_VALUE,
@@ -114,7 +127,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 
 	sepErr := tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
 
-	if ProduceDynamicOutput() {
+	if opts.DynamicOutput {
 		// Generate headers based on parsed statement input
 		if componentFrequency != nil && len(componentFrequency) != 0 {
 			// Iterate through header frequencies and create header row
@@ -134,7 +147,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 		Println("Providing output based on fixed structure")
 
 		// Iterate through header frequencies and create header row
-		_, headerSymbols, headerSymbolsNames, sepErr = generateHeaderRow("", GetStaticTabularOutputSchema(), headerSeparator)
+		_, headerSymbols, headerSymbolsNames, sepErr = generateHeaderRow("", GetStaticTabularOutputSchema(opts.IncludeAnnotations), headerSeparator)
 		if sepErr.ErrorCode != tree.PARSING_NO_ERROR {
 			return nil, nil, nil, sepErr
 		}
@@ -180,7 +193,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 		logicalValue := ""
 
 		// Include statement-level annotations if activated and existing in input
-		if include_ANNOTATIONS && annotations != nil {
+		if opts.IncludeAnnotations && annotations != nil {
 			entryMap[tree.STATEMENT_ANNOTATION] = annotations.(string)
 		}
 		// Iterate over component index (i.e., column) covering conventional components
@@ -197,18 +210,19 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 				leftString := ""
 				rightString := ""
 				// If shared elements are to be included (based on configuration, extract those ...)
-				if IncludeSharedElementsInTabularOutput() {
+				if opts.IncludeSharedElementsInTabularOutput {
 					// Prepare left and right shared elements by stringifying
-					leftString = shared.StringifySlices(statement[componentIdx].GetSharedLeft())
+					leftString = shared.StringifySlices(statement[componentIdx].GetSharedLeftForMode(opts.SharedElementInheritanceMode))
 					// ... but don't append whitespace just yet - depends on matching of shared strings later
-					rightString = shared.StringifySlices(statement[componentIdx].GetSharedRight())
+					rightString = shared.StringifySlices(statement[componentIdx].GetSharedRightForMode(opts.SharedElementInheritanceMode))
 					if rightString != "" {
 						// Add preceding whitespace
 						rightString = " " + rightString
 					}
 				}
 
-				// Prepare value for entry
+				// Prepare value for entry (including potential negation)
+				primitiveEntry := getNegationPrefix(statement[componentIdx]) + statement[componentIdx].Entry.(string)
 				entryVal := strings.Builder{}
 				// Indicates whether values within cell should be comma-separated
 				skipSeparator := false
@@ -217,7 +231,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 
 				// Check for preceding shared elements, and suppress left element if needed
 				if leftString != "" {
-					if ProduceDynamicOutput() {
+					if opts.DynamicOutput {
 						// Dynamic variant
 						// Check whether value exists in cell
 						if len(entryMap[headerSymbols[componentIdx]]) > 0 &&
@@ -225,7 +239,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 							// Suppress left shared element if identical with shared right one on existing value
 							// but add whitespace to link to previous value
 							entryVal.WriteString(" ")
-							entryVal.WriteString(statement[componentIdx].Entry.(string))
+							entryVal.WriteString(primitiveEntry)
 							entryVal.WriteString(rightString)
 							// Skip comma separation
 							skipSeparator = true
@@ -233,7 +247,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 							// Regular sharedLeft, whitespace + value sharedRight concatenation
 							entryVal.WriteString(leftString)
 							entryVal.WriteString(" ")
-							entryVal.WriteString(statement[componentIdx].Entry.(string))
+							entryVal.WriteString(primitiveEntry)
 							entryVal.WriteString(rightString)
 						}
 					} else {
@@ -244,7 +258,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 							// Suppress left shared element if identical with shared right one on existing value
 							// but add whitespace to link to previous value
 							entryVal.WriteString(" ")
-							entryVal.WriteString(statement[componentIdx].Entry.(string))
+							entryVal.WriteString(primitiveEntry)
 							entryVal.WriteString(rightString)
 							// Skip comma separation
 							skipSeparator = true
@@ -252,13 +266,13 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 							// Regular sharedLeft, whitespace + value sharedRight concatenation
 							entryVal.WriteString(leftString)
 							entryVal.WriteString(" ")
-							entryVal.WriteString(statement[componentIdx].Entry.(string))
+							entryVal.WriteString(primitiveEntry)
 							entryVal.WriteString(rightString)
 						}
 					}
 				} else {
 					// Create regular entry (without left shared value, since that will be empty)
-					entryVal.WriteString(statement[componentIdx].Entry.(string))
+					entryVal.WriteString(primitiveEntry)
 					entryVal.WriteString(rightString)
 				}
 
@@ -275,7 +289,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 
 				// ADDING ACTUAL ENTRY
 
-				if ProduceDynamicOutput() {
+				if opts.DynamicOutput {
 					// Dynamic variant
 					// Save entry value into entryMap for given statement and component column
 					if len(entryMap[headerSymbols[componentIdx]]) > 0 {
@@ -310,9 +324,16 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 				// PRIVATE NODES
 
 				// For static output, consider private nodes
-				if !ProduceDynamicOutput() && statement[componentIdx].HasPrivateNodes() {
+				if !opts.DynamicOutput && statement[componentIdx].HasPrivateNodes() {
 					for _, privateNodeValue := range statement[componentIdx].PrivateNodeLinks {
 
+						// Negated private nodes are output with negation prefix
+						privateNodeNegation := ""
+						if privateNodeValue.IsUnaryNegation() {
+							privateNodeNegation = getNegationPrefix(privateNodeValue.Right)
+							privateNodeValue = privateNodeValue.Right
+						}
+
 						// If iterated private node is of type statement (single nested statement),
 						// embed into node slice to enforce corresponding processing ...
 						if reflect.TypeOf(privateNodeValue.Entry) == reflect.TypeOf(&tree.Statement{}) {
@@ -325,7 +346,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 						if reflect.TypeOf(privateNodeValue.Entry) == reflect.TypeOf([]*tree.Node{}) {
 							for _, v := range privateNodeValue.Entry.([]*tree.Node) {
 
-								if ProduceIGExtendedOutput() {
+								if opts.IGExtendedOutput {
 									// IG Extended --> expand into nested statements referenced here, and appended as part of other nested statements
 									stmtRef := ""
 
@@ -340,7 +361,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 									componentNestedStmtsMap, nestedStatementIdx, componentNestedStmts, stmtRef = generateNewNestedStatementID(v, componentNestedStmtsMap, nestedStatementIdx, componentNestedStmts, stmtId)
 
 									// Add nested statement ID to existing value
-									existing += stmtRef
+									existing += privateNodeNegation + stmtRef
 
 									// Assign ID to reference field
 									entryMap[privateNodeValue.GetComponentName()+tree.REF_SUFFIX] = existing
@@ -355,7 +376,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 									}
 
 									// Perform application- and output-specific adjustments of private node
-									existing += performOutputSpecificAdjustments(v.StringFlat(), outputType)
+									existing += performOutputSpecificAdjustments(privateNodeNegation+v.StringFlat(), outputType)
 
 									// (Re)Assign to entry to be output
 									entryMap[privateNodeValue.GetComponentName()] = existing
@@ -375,7 +396,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 							}
 
 							// Modify actual primitive value for output
-							existing += performOutputSpecificAdjustments(privateNodeValue.Entry.(string), outputType)
+							existing += performOutputSpecificAdjustments(privateNodeNegation+privateNodeValue.Entry.(string), outputType)
 
 							// (Re)Assign to entry to be output
 							entryMap[privateNodeValue.GetComponentName()] = existing
@@ -387,7 +408,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 				// ANNOTATIONS
 
 				// For static output, consider annotations (if activated)
-				if !ProduceDynamicOutput() && IncludeAnnotations() && statement[componentIdx].HasAnnotations() {
+				if !opts.DynamicOutput && opts.IncludeAnnotations && statement[componentIdx].HasAnnotations() {
 
 					// Check for existing annotations ...
 					existing := entryMap[statement[componentIdx].GetComponentName()+tree.ANNOTATION]
@@ -416,7 +437,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 				if entryVals[0].IsCombination() {
 					Println("Detected statement combination")
 					// If combination of statements, retrieve all elements
-					combStmts := entryVals[0].GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)
+					combStmts := entryVals[0].GetLeafNodes(opts.AggregateImplicitLinkages)
 					// Flatten array and override entry values for iteration
 					entryVals = tree.Flatten(combStmts)
 					Println("Flattened combination:", entryVals)
@@ -436,13 +457,13 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 
 					idToReferenceInCell := ""
 
-					if ProduceIGExtendedOutput() {
+					if opts.IGExtendedOutput {
 
 						// Generate (and cache) new statement ID for nested statement
 						componentNestedStmtsMap, nestedStatementIdx, componentNestedStmts, idToReferenceInCell = generateNewNestedStatementID(entryVal, componentNestedStmtsMap, nestedStatementIdx, componentNestedStmts, stmtId)
 					}
 
-					if ProduceDynamicOutput() {
+					if opts.DynamicOutput {
 						// Dynamic version
 						// Save entry into entryMap for calling row
 						if entryMap[headerSymbols[componentIdx]] != "" &&
@@ -453,14 +474,14 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 							entryMap[headerSymbols[componentIdx]] += componentStmtRefSeparator
 						}
 
-						if ProduceIGExtendedOutput() {
+						if opts.IGExtendedOutput {
 							// Add nested statement reference (IG Extended)
-							entryMap[headerSymbols[componentIdx]] += idToReferenceInCell
+							entryMap[headerSymbols[componentIdx]] += getNegationPrefix(entryVal) + idToReferenceInCell
 						} else {
 							// IG Core output without nesting
 
 							// Append flat string representation of nested statements following output-specific treatment
-							actualEntry := performOutputSpecificAdjustments(entryVal.StringFlat(), outputType)
+							actualEntry := performOutputSpecificAdjustments(getNegationPrefix(entryVal)+entryVal.StringFlat(), outputType)
 							entryMap[headerSymbols[componentIdx]] += actualEntry
 
 							// Add logical operator if not last entry (and parent not empty otherwise)
@@ -468,7 +489,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 								b := strings.Builder{}
 								b.WriteString(" ")
 								b.WriteString(logicalCombinationLeft)
-								b.WriteString(entryVal.Parent.LogicalOperator)
+								b.WriteString(getLinkingLogicalOperator(entryVal))
 								b.WriteString(logicalCombinationRight)
 								b.WriteString(" ")
 								entryMap[headerSymbols[componentIdx]] += b.String()
@@ -487,17 +508,17 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 							entryMap[statement[componentIdx].GetComponentName()+tree.REF_SUFFIX] += componentStmtRefSeparator
 						}
 
-						if ProduceIGExtendedOutput() {
+						if opts.IGExtendedOutput {
 							// Add nested statement reference (IG Extended)
 
 							// Append current value in any case
-							entryMap[statement[componentIdx].GetComponentName()+tree.REF_SUFFIX] += idToReferenceInCell
+							entryMap[statement[componentIdx].GetComponentName()+tree.REF_SUFFIX] += getNegationPrefix(entryVal) + idToReferenceInCell
 						} else {
 							// IG Core output without nesting
 
 							// Perform output and application-specific modifications of output values
 							// Note: No Google-specific adaptation, since nested components are combined as part of output generation
-							actualEntry := performOutputSpecificAdjustments(entryVal.StringFlat(), "")
+							actualEntry := performOutputSpecificAdjustments(getNegationPrefix(entryVal)+entryVal.StringFlat(), "")
 
 							// Append flat string representation of nested statements
 							entryMap[statement[componentIdx].GetComponentName()+tree.REF_SUFFIX] += actualEntry
@@ -507,7 +528,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 								b := strings.Builder{}
 								b.WriteString(" ")
 								b.WriteString(logicalCombinationLeft)
-								b.WriteString(entryVal.Parent.LogicalOperator)
+								b.WriteString(getLinkingLogicalOperator(entryVal))
 								b.WriteString(logicalCombinationRight)
 								b.WriteString(" ")
 								entryMap[statement[componentIdx].GetComponentName()+tree.REF_SUFFIX] += b.String()
@@ -523,7 +544,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 
 			// Now generate logical links expression corresponding to particular entry (component index in statement instance)
 			logicalValue, errorVal = generateLogicalLinksExpressionForGivenComponentValue(logicalValue, statement,
-				componentIdx, headerSymbols, logicalLinks, stmtId)
+				componentIdx, headerSymbols, logicalLinks, stmtId, opts)
 			if errorVal.ErrorCode != tree.PARSING_NO_ERROR {
 				return nil, nil, nil, errorVal
 			}
@@ -555,7 +576,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 
 		Println("Parsing nested statement ...")
 		// Parse individual nested statements on component level in order to attach those to main output
-		nestedTabularResult := GenerateTabularOutputFromParsedStatement(val.NestedStmt, nil, "", "", val.NestedStmt.GetAnnotations(), val.ID, "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, headerSeparator, outputType, printHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+		nestedTabularResult := GenerateTabularOutputFromParsedStatement(val.NestedStmt, nil, "", "", val.NestedStmt.GetAnnotations(), val.ID, "", true, opts, headerSeparator, outputType, printHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 		if nestedTabularResult.Error.ErrorCode != tree.PARSING_NO_ERROR {
 			return nil, nil, nil, nestedTabularResult.Error
 		}
@@ -563,7 +584,7 @@ func generateStatementMatrix(stmts [][]*tree.Node, annotations interface{}, stmt
 		// Add linkages between statements (statement-level combinations)
 
 		// Determine linkages to fellow nested statements
-		stmtLinksString, err := generateLogicalLinksExpressionForStatements(val.NestedStmt, componentNestedStmts)
+		stmtLinksString, err := generateLogicalLinksExpressionForStatements(val.NestedStmt, componentNestedStmts, opts)
 		if err.ErrorCode != tree.PARSING_NO_ERROR {
 			return nil, nil, nil, err
 		}
@@ -669,7 +690,7 @@ func generateNewNestedStatementID(entryVal *tree.Node, componentNestedStmtsMap m
 /*
 Resolves all logical linkages to other statements and returns those as compound logical expression (e.g., [AND][{65}.1],[AND][{65}.2])
 */
-func generateLogicalLinksExpressionForStatements(sourceStmt *tree.Node, allNestedStmts []IdentifiedStmt) (string, tree.ParsingError) {
+func generateLogicalLinksExpressionForStatements(sourceStmt *tree.Node, allNestedStmts []IdentifiedStmt, opts tree.Options) (string, tree.ParsingError) {
 	builder := strings.Builder{}
 
 	// Iterate over all nested statements
@@ -688,7 +709,7 @@ func generateLogicalLinksExpressionForStatements(sourceStmt *tree.Node, allNeste
 				return "", tree.ParsingError{ErrorCode: tree.PARSING_ERROR_LOGICAL_EXPRESSION_GENERATION}
 			}
 			if res {
-				if CollapseOperators() {
+				if opts.CollapseOperators {
 					Println("Collapsing adjacent AND, bAND and wAND operators ...")
 					// Collapse adjacent AND operators
 					ops = tree.CollapseAdjacentOperators(ops, []string{tree.AND, tree.SAND_BETWEEN_COMPONENTS, tree.SAND_WITHIN_COMPONENTS})
@@ -921,7 +942,7 @@ Generates logical linkages string from a given statement to all other extrapolat
 Takes potentially prepopulated logical link string, source node, node array of all nodes (filters against source node) as well
 as the pregenerated map of Node-to-ID mappings (generated using #generateExtrapolatedStatementIDs).
 */
-func generateLogicalLinkageForExtrapolatedStatements(logicalExpressionString string, source *tree.Node, stmts []*tree.Node, IDs map[*tree.Node]string) (string, tree.ParsingError) {
+func generateLogicalLinkageForExtrapolatedStatements(logicalExpressionString string, source *tree.Node, stmts []*tree.Node, IDs map[*tree.Node]string, opts tree.Options) (string, tree.ParsingError) {
 
 	//logLinkString := ""
 	builder := strings.Builder{}
@@ -943,7 +964,7 @@ func generateLogicalLinkageForExtrapolatedStatements(logicalExpressionString str
 				return "", tree.ParsingError{ErrorCode: tree.PARSING_ERROR_LOGICAL_EXPRESSION_GENERATION}
 			}
 			if res {
-				if CollapseOperators() {
+				if opts.CollapseOperators {
 					Println("Collapsing adjacent AND, bAND and wAND operators ...")
 					// Collapse adjacent AND operators
 					ops = tree.CollapseAdjacentOperators(ops, []string{tree.AND, tree.SAND_BETWEEN_COMPONENTS, tree.SAND_WITHIN_COMPONENTS})
@@ -974,10 +995,10 @@ func generateLogicalLinkageForExtrapolatedStatements(logicalExpressionString str
 }
 
 /*
-Generates combined tabular output for given statements in node array.
+Generates combined tabular output for given statements in node array, based on the given options (opts).
 Uses #GenerateTabularOutputFromParsedStatement function internally.
 */
-func GenerateTabularOutputFromParsedStatements(stmts []*tree.Node, annotations interface{}, originalStatement string, igScriptInput string, stmtId string, filename string, overwrite bool, aggregateImplicitLinkages bool, separator string, outputFormat string, printHeaders bool, printOriginalStatement string, printIgScriptInput string) []TabularOutputResult {
+func GenerateTabularOutputFromParsedStatements(stmts []*tree.Node, annotations interface{}, originalStatement string, igScriptInput string, stmtId string, filename string, overwrite bool, opts tree.Options, separator string, outputFormat string, printHeaders bool, printOriginalStatement string, printIgScriptInput string) []TabularOutputResult {
 
 	// Instance holding parsed output
 	results := []TabularOutputResult{}
@@ -1008,7 +1029,7 @@ func GenerateTabularOutputFromParsedStatements(stmts []*tree.Node, annotations i
 			}
 
 			// single node: simply parse node in isolation
-			res = GenerateTabularOutputFromParsedStatement(topLevelStmt, topLevelStmts, originalStatement, igScriptInput, annotations, stmtId, filename, overwriteFile, aggregateImplicitLinkages, separator, outputFormat, printHeadersInFile, printOriginalStatement, printIgScriptInput)
+			res = GenerateTabularOutputFromParsedStatement(topLevelStmt, topLevelStmts, originalStatement, igScriptInput, annotations, stmtId, filename, overwriteFile, opts, separator, outputFormat, printHeadersInFile, printOriginalStatement, printIgScriptInput)
 			if res.Error.ErrorCode != tree.PARSING_NO_ERROR {
 				Println("Error during output generation for single statement. Statement ignored from output (Statement node: " + stmtNode.String() + ")")
 
@@ -1031,11 +1052,12 @@ Allows for specification of separator to delimit generated flat file output.
 Allows for specification of output file type (e.g., Google Sheets, CSV) based on constants #OUTPUT_TYPE_GOOGLE_SHEETS or #OUTPUT_TYPE_CSV.
 If filename is provided, the result is printed to the corresponding file.
 It is further necessary to indicate whether files should be overwritten or appended to
+Options (opts) control the output generation (e.g., dynamic vs. static output, IG Extended output, annotations).
 If printHeaders is true, the header row will be included in output.
 printOriginalStatement indicates the inclusion of Original Statement in output (for options see tabular.ORIGINAL_STATEMENT_INCLUSION_OPTIONS).
 printIgScriptInput indicates the inclusion of IG Script in output (for options see tabular.IG_SCRIPT_INCLUSION_OPTIONS).
 */
-func GenerateTabularOutputFromParsedStatement(node *tree.Node, allStmts []*tree.Node, originalStatement string, igScriptInput string, annotations interface{}, stmtId string, filename string, overwrite bool, aggregateImplicitLinkages bool, separator string, outputFormat string, printHeaders bool, printOriginalStatement string, printIgScriptInput string) TabularOutputResult {
+func GenerateTabularOutputFromParsedStatement(node *tree.Node, allStmts []*tree.Node, originalStatement string, igScriptInput string, annotations interface{}, stmtId string, filename string, overwrite bool, opts tree.Options, separator string, outputFormat string, printHeaders bool, printOriginalStatement string, printIgScriptInput string) TabularOutputResult {
 
 	// Prepopulate derived IDs for uniform access
 	derivedIDs := map[*tree.Node]string{}
@@ -1050,7 +1072,7 @@ func GenerateTabularOutputFromParsedStatement(node *tree.Node, allStmts []*tree.
 		derivedIDs = generateExtrapolatedStatementIDs(allStmts, stmtId)
 
 		// Generate strings indicating linkages between statements
-		linkString, err := generateLogicalLinkageForExtrapolatedStatements("", node, allStmts, derivedIDs)
+		linkString, err := generateLogicalLinkageForExtrapolatedStatements("", node, allStmts, derivedIDs, opts)
 		if err.ErrorCode != tree.PARSING_NO_ERROR {
 			Println("Error when generating extrapolated statement linkages: ", err)
 			return TabularOutputResult{}
@@ -1079,7 +1101,7 @@ func GenerateTabularOutputFromParsedStatement(node *tree.Node, allStmts []*tree.
 		return result
 	}
 	// Retrieve leaf arrays from generated tree (alongside frequency indications for components)
-	leafArrays, componentRefs := stmt.GenerateLeafArrays(aggregateImplicitLinkages)
+	leafArrays, componentRefs := stmt.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	Println(" Generated leaf arrays: ", leafArrays, " component: ", componentRefs)
 
@@ -1105,11 +1127,24 @@ func GenerateTabularOutputFromParsedStatement(node *tree.Node, allStmts []*tree.
 	Println(" Step: Generate tabular output")
 
 	// Prepare export to tabular output (including pre-generated annotations and logical linkage to other statements)
-	result.StatementMap, result.HeaderSymbols, result.HeaderNames, result.Error = generateStatementMatrix(res, annotations, logicalLinkageStmts, componentRefs, links, derivedIDs[node], separator, outputFormat, printHeaders)
+	result.StatementMap, result.HeaderSymbols, result.HeaderNames, result.Error = generateStatementMatrix(res, annotations, logicalLinkageStmts, componentRefs, links, derivedIDs[node], separator, outputFormat, printHeaders, opts)
 	if result.Error.ErrorCode != tree.PARSING_NO_ERROR {
 		return result
 	}
 
+	if opts.IncludeStatementType {
+		Println(" Step: Classify statement type")
+		addStatementType(&result, stmt.Classify().String(), len(res))
+	}
+
+	if opts.IncludeComplexity {
+		Println(" Step: Calculate complexity measures")
+		result.Error = addComplexity(&result, stmt, len(res))
+		if result.Error.ErrorCode != tree.PARSING_NO_ERROR {
+			return result
+		}
+	}
+
 	// Default output
 	result.Output = ""
 
@@ -1140,6 +1175,143 @@ func GenerateTabularOutputFromParsedStatement(node *tree.Node, allStmts []*tree.
 	return result
 }
 
+/*
+Adds statement type to the rows of the atomic statements generated from the statement (i.e., the given
+number of leading rows, since rows of nested statements are appended thereafter and carry their own type),
+and positions the corresponding column immediately after the Statement ID column.
+*/
+func addStatementType(result *TabularOutputResult, stmtType string, atomicStmtCount int) {
+	for i := 0; i < atomicStmtCount && i < len(result.StatementMap); i++ {
+		result.StatementMap[i][stmtTypeColHeader] = stmtType
+	}
+	// Move statement type to first position, followed by Statement ID (i.e., resulting in second position)
+	result.HeaderSymbols = moveElementToFirstPosition(stmtTypeColHeader, result.HeaderSymbols, true)
+	result.HeaderNames = moveElementToFirstPosition(stmtTypeColHeader, result.HeaderNames, true)
+	result.HeaderSymbols = moveElementToFirstPosition(stmtIdColHeader, result.HeaderSymbols, true)
+	result.HeaderNames = moveElementToFirstPosition(stmtIdColHeader, result.HeaderNames, true)
+}
+
+/*
+Adds complexity measures of the given statement to the rows of the atomic statements generated from the statement
+(i.e., the given number of leading rows, since rows of nested statements are appended thereafter and carry their own
+measures). Measures include the Degree of Variability, the nesting depth and the number of nested statements
+(across all nesting levels), as well as the number of options and complexity of each component present in the
+statement (with nested components suffixed with '-Ref', e.g., 'Options (Cac-Ref)'). The corresponding columns are
+appended to the header in the order returned by #complexityColumns().
+*/
+func addComplexity(result *TabularOutputResult, stmt *tree.Statement, atomicStmtCount int) tree.ParsingError {
+
+	values := map[string]string{complexityDoVColHeader: strconv.Itoa(stmt.CalculateComplexity().TotalStateComplexity)}
+
+	depth, count := 0, 0
+	visited := map[*tree.Node]bool{}
+	for _, comp := range stmt.Components() {
+		if comp.Node == nil || comp.Node.IsEmptyOrNilNode() {
+			continue
+		}
+		// Options and complexity of component
+		complexity, err := comp.Node.CalculateStateComplexity()
+		if err.ErrorCode != tree.TREE_NO_ERROR {
+			return tree.ParsingError{ErrorCode: err.ErrorCode, ErrorMessage: "Complexity calculation failed for component " +
+				comp.Symbol + ": " + err.ErrorMessage}
+		}
+		symbol := complexityComponentSymbol(comp)
+		values[complexityOptionsColHeaderPrefix+" ("+symbol+")"] = strconv.Itoa(comp.Node.CountLeaves())
+		values[complexityComponentColHeaderPrefix+" ("+symbol+")"] = strconv.Itoa(complexity)
+		// Nested statements embedded in component
+		nestedDepth, nestedCount := countNestedStatements(comp.Node, visited)
+		if nestedDepth > depth {
+			depth = nestedDepth
+		}
+		count += nestedCount
+	}
+	values[complexityNestingDepthColHeader] = strconv.Itoa(depth)
+	values[complexityNestedStmtsColHeader] = strconv.Itoa(count)
+
+	for i := 0; i < atomicStmtCount && i < len(result.StatementMap); i++ {
+		for column, value := range values {
+			result.StatementMap[i][column] = value
+		}
+	}
+
+	// Move complexity columns (including those of nested statements) to last positions in canonical order
+	for _, column := range complexityColumns() {
+		_, ownColumn := values[column]
+		if contained, _ := tree.StringInSlice(column, result.HeaderSymbols); ownColumn || contained {
+			result.HeaderSymbols = moveElementToLastPosition(column, result.HeaderSymbols, true)
+			result.HeaderNames = moveElementToLastPosition(column, result.HeaderNames, true)
+		}
+	}
+	return tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Returns all column identifiers for complexity measures in canonical order, i.e., statement-level measures
+followed by options and complexity of individual components (in the order of tree.Statement.Components()).
+*/
+func complexityColumns() []string {
+	columns := []string{complexityDoVColHeader, complexityNestingDepthColHeader, complexityNestedStmtsColHeader}
+	for _, comp := range (&tree.Statement{}).Components() {
+		symbol := complexityComponentSymbol(comp)
+		columns = append(columns, complexityOptionsColHeaderPrefix+" ("+symbol+")", complexityComponentColHeaderPrefix+" ("+symbol+")")
+	}
+	return columns
+}
+
+/*
+Returns the symbol identifying a given component in complexity columns, with nested (complex) components
+carrying the reference suffix (e.g., 'Cac-Ref').
+*/
+func complexityComponentSymbol(comp tree.StatementComponent) string {
+	if comp.Complex {
+		return comp.Symbol + tree.REF_SUFFIX
+	}
+	return comp.Symbol
+}
+
+/*
+Returns the nesting depth (i.e., the number of levels of nested statements) and the number of nested statements
+(across all nesting levels) embedded in the given node and its children (including linked private nodes).
+Statements in component pair combinations are counted individually.
+*/
+func countNestedStatements(node *tree.Node, visited map[*tree.Node]bool) (int, int) {
+	if node == nil || visited[node] {
+		return 0, 0
+	}
+	visited[node] = true
+
+	depth, count := 0, 0
+	// Merges depth and count of children into the values of the given node
+	merge := func(childDepth int, childCount int) {
+		if childDepth > depth {
+			depth = childDepth
+		}
+		count += childCount
+	}
+
+	switch entry := node.Entry.(type) {
+	case *tree.Statement:
+		// Nested statement, which adds a nesting level to the statements nested therein
+		for _, comp := range entry.Components() {
+			merge(countNestedStatements(comp.Node, visited))
+		}
+		depth++
+		count++
+	case []*tree.Node:
+		// Component pair combinations
+		for _, pair := range entry {
+			merge(countNestedStatements(pair, visited))
+		}
+	}
+	for _, private := range node.PrivateNodeLinks {
+		merge(countNestedStatements(private, visited))
+	}
+	merge(countNestedStatements(node.Left, visited))
+	merge(countNestedStatements(node.Right, visited))
+
+	return depth, count
+}
+
 /*
 Generates IG 2.0 header row and appends it to given string based on component frequency input. It further returns a slice
 containing header information.
@@ -1202,7 +1374,7 @@ substatement IDs used in the link references.
 It returns the link for the particular table entry.
 */
 func generateLogicalLinksExpressionForGivenComponentValue(logicalExpressionString string, statement []*tree.Node,
-	componentIdx int, headerSymbols []string, logicalLinks []map[*tree.Node][]string, stmtId string) (string, tree.ParsingError) {
+	componentIdx int, headerSymbols []string, logicalLinks []map[*tree.Node][]string, stmtId string, opts tree.Options) (string, tree.ParsingError) {
 	// Check for logical operator linkage based on index
 	linksForElement := logicalLinks[componentIdx]
 	Println("Links for element: ", linksForElement)
@@ -1231,13 +1403,13 @@ func generateLogicalLinksExpressionForGivenComponentValue(logicalExpressionStrin
 		// Sort by retrieving leaves for the given tree
 		if firstKey != nil {
 			leaves := [][]*tree.Node{}
-			if tree.AGGREGATE_IMPLICIT_LINKAGES {
+			if opts.AggregateImplicitLinkages {
 				// Retrieve actual root node, not just the one that sits below synthetic linkage
-				leaves = firstKey.GetRootNode().GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)
+				leaves = firstKey.GetRootNode().GetLeafNodes(opts.AggregateImplicitLinkages)
 				Println("Root:", firstKey.GetRootNode())
 			} else {
 				// Retrieve all nodes up to synthetic linkage
-				leaves = firstKey.GetNodeBelowSyntheticRootNode().GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)
+				leaves = firstKey.GetNodeBelowSyntheticRootNode().GetLeafNodes(opts.AggregateImplicitLinkages)
 				Println("Synthetic Root:", firstKey.GetNodeBelowSyntheticRootNode())
 			}
 
@@ -1282,7 +1454,7 @@ func generateLogicalLinksExpressionForGivenComponentValue(logicalExpressionStrin
 						return "", tree.ParsingError{ErrorCode: tree.PARSING_ERROR_LOGICAL_EXPRESSION_GENERATION}
 					}
 					if res {
-						if CollapseOperators() {
+						if opts.CollapseOperators {
 							Println("Collapsing adjacent AND, bAND and wAND operators ...")
 							// Collapse adjacent AND operators
 							ops = tree.CollapseAdjacentOperators(ops, []string{tree.AND, tree.SAND_BETWEEN_COMPONENTS, tree.SAND_WITHIN_COMPONENTS})
@@ -1299,7 +1471,7 @@ func generateLogicalLinksExpressionForGivenComponentValue(logicalExpressionStrin
 						// ... and append to logical expression column string
 						builder.WriteString(fmt.Sprint(ops))
 						// Statement component identifier
-						if ProduceDynamicOutput() {
+						if opts.DynamicOutput {
 							// Based on index or parsed input nodes
 							builder.WriteString(".")
 							builder.WriteString(headerSymbols[componentIdx])
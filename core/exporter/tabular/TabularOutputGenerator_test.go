@@ -21,18 +21,20 @@ func TestHeaderRowGenerationWithoutComponentAggregation(t *testing.T) {
 		"Bdir(approved (certified production and [AND] handling operations and [AND] accredited certifying agents)) " +
 		"Cex(for compliance with the (Act or [XOR] regulations in this part))."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -47,7 +49,7 @@ func TestHeaderRowGenerationWithoutComponentAggregation(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// This is tested in IGStatementParser_test.go as well
-	nodeArray, componentIdx := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	nodeArray, componentIdx := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	if nodeArray == nil || componentIdx == nil {
 		t.Fatal("Generated array or component header array should not be empty.")
@@ -100,16 +102,18 @@ func TestHeaderRowGenerationWithComponentAggregation(t *testing.T) {
 		"Bdir(approved (certified production and [AND] handling operations and [AND] accredited certifying agents)) " +
 		"Cex(for compliance with the (Act or [XOR] regulations in this part))."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// OVERRIDE dynamic output setting
-	tree.AGGREGATE_IMPLICIT_LINKAGES = true
+	opts.AggregateImplicitLinkages = true
 
 	stmts, err := parser.ParseStatement(text)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -123,7 +127,7 @@ func TestHeaderRowGenerationWithComponentAggregation(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// This is tested in IGStatementParser_test.go as well
-	nodeArray, componentIdx := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	nodeArray, componentIdx := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	if nodeArray == nil || componentIdx == nil {
 		t.Fatal("Generated array or component header array should not be empty.")
@@ -174,18 +178,20 @@ func TestSimpleTabularOutput(t *testing.T) {
 		"I(inspect)" +
 		"Bdir(certified production facilities) "
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 	// OVERRIDE dynamic output setting
-	tree.AGGREGATE_IMPLICIT_LINKAGES = true
+	opts.AggregateImplicitLinkages = true
 
 	// Override cell separator symbol
 	CellSeparator = ";"
@@ -204,7 +210,7 @@ func TestSimpleTabularOutput(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -232,12 +238,12 @@ func TestSimpleTabularOutput(t *testing.T) {
 	// Take separator for Google Sheets output
 	separator := ";"
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -267,18 +273,20 @@ func TestSimpleTabularOutputNoHeaders(t *testing.T) {
 		"I(inspect)" +
 		"Bdir(certified production facilities) "
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(false)
+	opts.IncludeHeaders = false
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 	// OVERRIDE dynamic output setting
-	tree.AGGREGATE_IMPLICIT_LINKAGES = true
+	opts.AggregateImplicitLinkages = true
 
 	// Override cell separator symbol
 	CellSeparator = ";"
@@ -297,7 +305,7 @@ func TestSimpleTabularOutputNoHeaders(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -325,12 +333,12 @@ func TestSimpleTabularOutputNoHeaders(t *testing.T) {
 	// Take separator for Google Sheets output
 	separator := ";"
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -360,23 +368,25 @@ func TestBasicTabularOutputCombinations(t *testing.T) {
 		"I(sustain (review [AND] (refresh [AND] drink))) " +
 		"Bdir(approved (certified production and [AND] handling operations and [AND] accredited certifying agents)) "
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -393,7 +403,7 @@ func TestBasicTabularOutputCombinations(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -418,12 +428,12 @@ func TestBasicTabularOutputCombinations(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -454,23 +464,25 @@ func TestBasicTabularOutputCombinationsNoHeaders(t *testing.T) {
 		"I(sustain (review [AND] (refresh [AND] drink))) " +
 		"Bdir(approved (certified production and [AND] handling operations and [AND] accredited certifying agents)) "
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(false)
+	opts.IncludeHeaders = false
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -487,7 +499,7 @@ func TestBasicTabularOutputCombinationsNoHeaders(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -512,12 +524,12 @@ func TestBasicTabularOutputCombinationsNoHeaders(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -547,23 +559,25 @@ func TestBasicTabularOutputImplicitAnd(t *testing.T) {
 		"I(review) I(sustain) " +
 		"Bdir(certified production facilities) "
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -580,7 +594,7 @@ func TestBasicTabularOutputImplicitAnd(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -605,12 +619,12 @@ func TestBasicTabularOutputImplicitAnd(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -641,23 +655,25 @@ func TestTabularOutputCombinationsImplicitAnd(t *testing.T) {
 		"Bdir(approved (certified production and [AND] handling operations and [AND] accredited certifying agents)) " +
 		"Cex(for compliance with the (Act or [XOR] regulations in this part))."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -674,7 +690,7 @@ func TestTabularOutputCombinationsImplicitAnd(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -699,12 +715,12 @@ func TestTabularOutputCombinationsImplicitAnd(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -736,23 +752,25 @@ func TestTabularOutputWithSharedLeftElements(t *testing.T) {
 		"Bdir(approved (certified production and [AND] handling operations and [AND] accredited certifying agents)) " +
 		"Cex(for compliance with the (Act or [XOR] regulations in this part))."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// With shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -769,7 +787,7 @@ func TestTabularOutputWithSharedLeftElements(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -794,12 +812,12 @@ func TestTabularOutputWithSharedLeftElements(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -831,23 +849,25 @@ func TestTabularOutputWithSharedRightElements(t *testing.T) {
 		"Bdir(approved (certified production and [AND] handling operations and [AND] accredited certifying agents)) " +
 		"Cex(for compliance with the (Act or [XOR] regulations in this part))."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// With shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -864,7 +884,7 @@ func TestTabularOutputWithSharedRightElements(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -889,12 +909,12 @@ func TestTabularOutputWithSharedRightElements(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -926,23 +946,25 @@ func TestTabularOutputWithSharedLeftAndRightElements(t *testing.T) {
 		"Bdir(approved (certified production and [AND] handling operations and [AND] accredited certifying agents)) " +
 		"Cex(for compliance with the (Act or [XOR] regulations in this part))."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// With shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -959,7 +981,7 @@ func TestTabularOutputWithSharedLeftAndRightElements(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -984,12 +1006,12 @@ func TestTabularOutputWithSharedLeftAndRightElements(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -1022,23 +1044,25 @@ func TestTabularOutputWithTwoLevelNestedComponent(t *testing.T) {
 		// This is the tricky lines, specifically the second Cac{}
 		"Cac{E(Program Manager) F(is) P((approved [AND] committed)) Cac{A(NOP Official) I(recognizes) Bdir(Program Manager)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -1055,7 +1079,7 @@ func TestTabularOutputWithTwoLevelNestedComponent(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -1080,12 +1104,12 @@ func TestTabularOutputWithTwoLevelNestedComponent(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -1121,23 +1145,25 @@ func TestTabularOutputWithCombinationOfSimpleAndTwoLevelNestedComponent(t *testi
 		// Complex activation condition, including two-level nesting (Cac{Cac{}})
 		"Cac{E(Program Manager) F(is) P((approved [AND] committed)) Cac{A(NOP Official) I(recognizes) Bdir(Program Manager)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -1154,7 +1180,7 @@ func TestTabularOutputWithCombinationOfSimpleAndTwoLevelNestedComponent(t *testi
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -1179,12 +1205,12 @@ func TestTabularOutputWithCombinationOfSimpleAndTwoLevelNestedComponent(t *testi
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -1219,23 +1245,25 @@ func TestTabularOutputWithCombinationOfTwoNestedComponents(t *testing.T) {
 		// Activation condition 2
 		"Cac{A(NOP Official) I(recognizes) Bdir(Program Manager)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -1252,7 +1280,7 @@ func TestTabularOutputWithCombinationOfTwoNestedComponents(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -1277,12 +1305,12 @@ func TestTabularOutputWithCombinationOfTwoNestedComponents(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -1316,23 +1344,25 @@ func TestTabularOutputWithCombinationOfThreeNestedComponents(t *testing.T) {
 		"Cac{A(NOP Official) I(recognizes) Bdir(Program Manager)}" +
 		"Cac{A(Another Official) I(complains) Bdir(Program Manager) Cex(daily)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -1349,7 +1379,7 @@ func TestTabularOutputWithCombinationOfThreeNestedComponents(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -1374,12 +1404,12 @@ func TestTabularOutputWithCombinationOfThreeNestedComponents(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -1415,23 +1445,25 @@ func TestTabularOutputWithNestedStatementCombinationsImplicitAnd(t *testing.T) {
 		// Implicitly linked nested statement
 		"Cac{A(Another Official) I(complains) Bdir(Program Manager) Cex(daily)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -1448,7 +1480,7 @@ func TestTabularOutputWithNestedStatementCombinationsImplicitAnd(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -1473,12 +1505,12 @@ func TestTabularOutputWithNestedStatementCombinationsImplicitAnd(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -1514,23 +1546,25 @@ func TestTabularOutputWithNestedStatementCombinationsImplicitAndIGCore(t *testin
 		// Implicitly linked nested statement
 		"Cac{A(Another Official) I(complains) Bdir(Program Manager) Cex(daily)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Core output
-	SetProduceIGExtendedOutput(false)
+	opts.IGExtendedOutput = false
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -1547,7 +1581,7 @@ func TestTabularOutputWithNestedStatementCombinationsImplicitAndIGCore(t *testin
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -1572,12 +1606,12 @@ func TestTabularOutputWithNestedStatementCombinationsImplicitAndIGCore(t *testin
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -1613,23 +1647,25 @@ func TestTabularOutputWithNestedStatementCombinationsXOR(t *testing.T) {
 		// should be automatically linked using AND
 		"Cac{A(Another Official) I(complains) Bdir(Program Manager) Cex(daily)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -1646,7 +1682,7 @@ func TestTabularOutputWithNestedStatementCombinationsXOR(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -1671,12 +1707,12 @@ func TestTabularOutputWithNestedStatementCombinationsXOR(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -1713,23 +1749,25 @@ func TestTabularOutputWithNestedStatementCombinationsAndComponentCombinations(t
 		// non-linked additional activation condition (should be linked by implicit AND)
 		"Cac{A(Another Official) I(complains) Bdir(Program Manager) Cex(daily)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -1746,7 +1784,7 @@ func TestTabularOutputWithNestedStatementCombinationsAndComponentCombinations(t
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -1771,12 +1809,12 @@ func TestTabularOutputWithNestedStatementCombinationsAndComponentCombinations(t
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -1816,23 +1854,25 @@ func TestTabularOutputWithNestedStatementCombinationsAndComponentCombinationsWit
 
 	fmt.Println(text)
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// With shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -1849,7 +1889,7 @@ func TestTabularOutputWithNestedStatementCombinationsAndComponentCombinationsWit
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -1874,12 +1914,12 @@ func TestTabularOutputWithNestedStatementCombinationsAndComponentCombinationsWit
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -1923,23 +1963,25 @@ func TestTabularOutputWithMultipleNestedStatementsAndSimpleComponentsAcrossDiffe
 		// non-linked additional activation condition (should be linked by implicit AND)
 		"Cac{A(Another Official) I(complains) Bdir(Program Manager) Cex(daily)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(true)
+	opts.SetDynamicOutput(true)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != false {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != false {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -1956,7 +1998,7 @@ func TestTabularOutputWithMultipleNestedStatementsAndSimpleComponentsAcrossDiffe
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Generated Component References: ", componentRefs)
 
@@ -1983,12 +2025,12 @@ func TestTabularOutputWithMultipleNestedStatementsAndSimpleComponentsAcrossDiffe
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -2032,23 +2074,25 @@ func TestStaticTabularOutputWithMultipleNestedStatementsAndSimpleComponentsAcros
 		// non-linked additional activation condition (should be linked by implicit AND)
 		"Cac{A(Another Official) I(complains) Bdir(Program Manager) Cex(daily)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -2065,7 +2109,7 @@ func TestStaticTabularOutputWithMultipleNestedStatementsAndSimpleComponentsAcros
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Generated Component References: ", componentRefs)
 
@@ -2092,12 +2136,12 @@ func TestStaticTabularOutputWithMultipleNestedStatementsAndSimpleComponentsAcros
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -2132,23 +2176,25 @@ func TestStaticTabularOutputWithMultiLevelNestingAndComponentLevelCombinations(t
 		"A([Program Manager]) D(shall) I([send]) Bdir(notification) Bdir,p(of non-compliance) to the " +
 		"Bind,p(accredited) Bind(certifying agent)."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := "|"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -2165,7 +2211,7 @@ func TestStaticTabularOutputWithMultiLevelNestingAndComponentLevelCombinations(t
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Generated Component References: ", componentRefs)
 
@@ -2192,12 +2238,12 @@ func TestStaticTabularOutputWithMultiLevelNestingAndComponentLevelCombinations(t
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -2225,23 +2271,25 @@ func TestStaticTabularOutputOrElseAnnotations(t *testing.T) {
 	text := "A(farmer) D([must]) I(submit) Bdir,p(an organic systems) Bdir(plan) Cex(by the end of the " +
 		"calendar year) O[consequence]{the A(certifier) D(may) I(suspend) the Bdir,p(farmer’s) Bdir(operating license)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Dynamic output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Activate annotations
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// No shared elements
-	SetIncludeSharedElementsInTabularOutput(false)
+	opts.IncludeSharedElementsInTabularOutput = false
 
 	// Take separator for Google Sheets output
 	separator := "|"
 
 	// Test for correct configuration for dynamic output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -2258,7 +2306,7 @@ func TestStaticTabularOutputOrElseAnnotations(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Generated Component References: ", componentRefs)
 
@@ -2285,12 +2333,12 @@ func TestStaticTabularOutputOrElseAnnotations(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -2348,23 +2396,25 @@ func TestStaticTabularOutputBasicStatement(t *testing.T) {
 		// Activation condition 2
 		"Cac{A(NOP Official) I(recognizes) Bdir(Program Manager)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// With shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -2381,7 +2431,7 @@ func TestStaticTabularOutputBasicStatement(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -2410,12 +2460,12 @@ func TestStaticTabularOutputBasicStatement(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -2451,23 +2501,25 @@ func TestStaticTabularOutputNestedCombinations(t *testing.T) {
 		// Activation condition 2
 		"Cac{A(NOP Official) I(recognizes) Bdir(Program Manager)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// With shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -2484,7 +2536,7 @@ func TestStaticTabularOutputNestedCombinations(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -2513,12 +2565,12 @@ func TestStaticTabularOutputNestedCombinations(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -2556,23 +2608,25 @@ func TestStaticTabularOutputNestedCombinationsImplicitAnd(t *testing.T) {
 		// Activation condition 3 (to be linked implicitly)
 		"Cac{A(Another official) I(does) Bdir(something else)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// With shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -2589,7 +2643,7 @@ func TestStaticTabularOutputNestedCombinationsImplicitAnd(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -2618,12 +2672,12 @@ func TestStaticTabularOutputNestedCombinationsImplicitAnd(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -2661,23 +2715,25 @@ func TestStaticTabularOutputNestedCombinationsImplicitAndIGCore(t *testing.T) {
 		// Activation condition 3 (to be linked implicitly)
 		"Cac{A(Another official) I(does) Bdir(something else)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Core output (no component-level nesting)
-	SetProduceIGExtendedOutput(false)
+	opts.IGExtendedOutput = false
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// With shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -2694,7 +2750,7 @@ func TestStaticTabularOutputNestedCombinationsImplicitAndIGCore(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -2723,12 +2779,12 @@ func TestStaticTabularOutputNestedCombinationsImplicitAndIGCore(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -2767,23 +2823,25 @@ func TestStaticTabularOutputNestedProperties(t *testing.T) {
 		// Activation condition 3 (to be linked implicitly)
 		"Cac{A(Another official) I(does) Bdir(something else)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Core output (no component-level nesting)
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// With shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -2800,7 +2858,7 @@ func TestStaticTabularOutputNestedProperties(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -2829,12 +2887,12 @@ func TestStaticTabularOutputNestedProperties(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -2866,23 +2924,25 @@ func TestStaticTabularOutputBasicStatementSharedLeftAndRightElements(t *testing.
 		"I(sustain (review [AND] (refresh [AND] drink)) rightShared) " +
 		"Cex(for compliance with the (Act or [XOR] regulations in this part) and beyond) "
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// With shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -2899,7 +2959,7 @@ func TestStaticTabularOutputBasicStatementSharedLeftAndRightElements(t *testing.
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -2928,12 +2988,12 @@ func TestStaticTabularOutputBasicStatementSharedLeftAndRightElements(t *testing.
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -2970,23 +3030,25 @@ func TestStaticTabularOutputBasicStatementSharedAndPrivateProperties(t *testing.
 		// Activation condition 2
 		"Cac{A(NOP Official) I(recognizes) Bdir(Program Manager)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// With shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -3003,7 +3065,7 @@ func TestStaticTabularOutputBasicStatementSharedAndPrivateProperties(t *testing.
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -3032,12 +3094,12 @@ func TestStaticTabularOutputBasicStatementSharedAndPrivateProperties(t *testing.
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -3065,23 +3127,25 @@ func TestStaticTabularOutputBasicStatementPrivatePropertiesOnly(t *testing.T) {
 
 	text := "Bdir1,p(organic farming) Bdir1(provisions) and Bdir2,p(improper) Bdir2(rules)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// With shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -3098,7 +3162,7 @@ func TestStaticTabularOutputBasicStatementPrivatePropertiesOnly(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -3127,12 +3191,12 @@ func TestStaticTabularOutputBasicStatementPrivatePropertiesOnly(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -3160,23 +3224,25 @@ func TestStaticTabularOutputBasicStatementMixSharedPrivatePropertyComponents(t *
 
 	text := "Bdir1((left [OR] right)) Bdir1,p((private [AND] public)) Bdir(general object) Bdir,p((shared [XOR] non-shared))"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// With shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -3193,7 +3259,7 @@ func TestStaticTabularOutputBasicStatementMixSharedPrivatePropertyComponents(t *
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -3222,12 +3288,12 @@ func TestStaticTabularOutputBasicStatementMixSharedPrivatePropertyComponents(t *
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -3265,23 +3331,25 @@ func TestStaticTabularOutputBasicStatementMixSharedPrivateAndNestedPrivateProper
 		// Activation condition 2 -- note that associated is wrongly annotated, leading to linkage to both Bdirs
 		"Cac{A(NOP Official) I(recognizes) Bdir1,p1(responsible) Bdir1(Program Manager) and Bdir,p2(associated) Bdir2(inspectors)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// With shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -3298,7 +3366,7 @@ func TestStaticTabularOutputBasicStatementMixSharedPrivateAndNestedPrivateProper
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -3327,12 +3395,12 @@ func TestStaticTabularOutputBasicStatementMixSharedPrivateAndNestedPrivateProper
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -3370,23 +3438,25 @@ func TestStaticTabularOutputBasicStatementComponentLevelIndexedProperties(t *tes
 		// Activation condition 2 - shared properties implied in this activation condition due to wrong syntax
 		"Cac{A(NOP Official) I(recognizes) Bdir,p1(responsible) Bdir1(Program Manager) and Bdir,p2(associated) Bdir2(inspectors)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -3403,7 +3473,7 @@ func TestStaticTabularOutputBasicStatementComponentLevelIndexedProperties(t *tes
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -3432,12 +3502,12 @@ func TestStaticTabularOutputBasicStatementComponentLevelIndexedProperties(t *tes
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -3475,23 +3545,25 @@ func TestStaticTabularOutputBasicStatementComponentLevelIndexedPropertiesAnnotat
 		// Activation condition 2 - shared properties implied in this activation condition
 		"Cac{A(NOP Official) I(recognizes) Bdir,p1(responsible) Bdir1(Program Manager) and Bdir,p2(associated) Bdir2(inspectors)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -3508,7 +3580,7 @@ func TestStaticTabularOutputBasicStatementComponentLevelIndexedPropertiesAnnotat
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -3537,12 +3609,12 @@ func TestStaticTabularOutputBasicStatementComponentLevelIndexedPropertiesAnnotat
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -3571,23 +3643,25 @@ func TestStaticTabularOutputBasicStatementComponentLevelNestedPrivateAndSharedPr
 
 	text := "Bdir{A1,p(first) A,p(shared) A(A1(farmer) [OR] A2(citizen))}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -3604,7 +3678,7 @@ func TestStaticTabularOutputBasicStatementComponentLevelNestedPrivateAndSharedPr
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -3633,12 +3707,12 @@ func TestStaticTabularOutputBasicStatementComponentLevelNestedPrivateAndSharedPr
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -3677,23 +3751,25 @@ func TestStaticTabularOutputBasicStatementMixedPropertiesAnnotationsDeactivated(
 		// Activation condition 2 - misspelt second property is intentional (to make it shared property)
 		"Cac{A(NOP Official) I[act=main](recognizes) Bdir1,p1(responsible) Bdir1(Program Manager) and Bdir,p1(associated) Bdir2(inspectors)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -3710,7 +3786,7 @@ func TestStaticTabularOutputBasicStatementMixedPropertiesAnnotationsDeactivated(
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -3739,12 +3815,12 @@ func TestStaticTabularOutputBasicStatementMixedPropertiesAnnotationsDeactivated(
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -3783,23 +3859,25 @@ func TestStaticTabularOutputBasicStatementMixedPropertiesAnnotationsActivated(t
 		// Activation condition 2
 		"Cac{A[type=enforcer](NOP Official) I[act=main](recognizes) Bdir1,p1(responsible) Bdir1[type=main object](Program Manager) and Bdir2,p2[type=third party](associated) Bdir2(inspectors)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -3816,7 +3894,7 @@ func TestStaticTabularOutputBasicStatementMixedPropertiesAnnotationsActivated(t
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -3845,12 +3923,12 @@ func TestStaticTabularOutputBasicStatementMixedPropertiesAnnotationsActivated(t
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -3888,23 +3966,25 @@ func TestStaticTabularOutputBasicStatementEmbeddedQuotationSymbolsGoogleSheets(t
 		// Activation condition 2
 		"Cac{A('NOP Official') I(recognizes) Bdir1,p(responsible) Bdir1(Program Manager) and Bdir2,p(associated) Bdir2(inspectors)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -3921,7 +4001,7 @@ func TestStaticTabularOutputBasicStatementEmbeddedQuotationSymbolsGoogleSheets(t
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -3950,12 +4030,12 @@ func TestStaticTabularOutputBasicStatementEmbeddedQuotationSymbolsGoogleSheets(t
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -3993,23 +4073,25 @@ func TestStaticTabularOutputBasicStatementEmbeddedQuotationSymbolsCSV(t *testing
 		// Activation condition 2
 		"Cac{A('NOP Official') I(recognizes) Bdir1,p(responsible) Bdir1(Program Manager) and Bdir2,p(associated) Bdir2(inspectors)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for CSV output
 	separator := "|"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -4026,7 +4108,7 @@ func TestStaticTabularOutputBasicStatementEmbeddedQuotationSymbolsCSV(t *testing
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -4055,12 +4137,12 @@ func TestStaticTabularOutputBasicStatementEmbeddedQuotationSymbolsCSV(t *testing
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_CSV, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_CSV, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateCSVOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateCSVOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -4097,23 +4179,25 @@ func TestStaticTabularOutputNestedStatementsAnnotations(t *testing.T) {
 		// Activation condition 2
 		"CacB[annotation2]{A[type=enforcer](NOP Official) I[act=main](recognizes) Bdir1,p1(responsible) Bdir1[type=main object](Program Manager) and Bdir2,p2[type=third party](associated) Bdir2(inspectors)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -4130,7 +4214,7 @@ func TestStaticTabularOutputNestedStatementsAnnotations(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -4159,12 +4243,12 @@ func TestStaticTabularOutputNestedStatementsAnnotations(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -4202,23 +4286,25 @@ func TestStaticTabularOutputNestedStatementCombinationAnnotations(t *testing.T)
 		// Activation condition 2
 		"CacB[annotation2]{A[type=enforcer](NOP Official) I[act=main](recognizes) Bdir1,p1(responsible) Bdir1[type=main object](Program Manager) and Bdir2,p2[type=third party](associated) Bdir2(inspectors)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -4235,7 +4321,7 @@ func TestStaticTabularOutputNestedStatementCombinationAnnotations(t *testing.T)
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -4264,12 +4350,12 @@ func TestStaticTabularOutputNestedStatementCombinationAnnotations(t *testing.T)
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -4311,23 +4397,25 @@ func TestStaticTabularOutputNestedStatementsAndCombinationMixAnnotationsGoogleSh
 		// Implicitly linked activation condition with diverse annotations
 		"CacC[ABdir]{A[type=animate](further entity) I[act=violate](violates) Bdir[entity=law](part of provisions)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -4344,7 +4432,7 @@ func TestStaticTabularOutputNestedStatementsAndCombinationMixAnnotationsGoogleSh
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -4373,12 +4461,12 @@ func TestStaticTabularOutputNestedStatementsAndCombinationMixAnnotationsGoogleSh
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -4420,23 +4508,25 @@ func TestStaticTabularOutputNestedStatementsAndCombinationMixAnnotationsCSV(t *t
 		// Implicitly linked activation condition with diverse annotations
 		"CacC[ABdir]{A[type=animate](further entity) I[act=violate](violates) Bdir[entity=law](part of provisions)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Indicates whether header row is included in output.
-	SetIncludeHeaders(true)
+	opts.IncludeHeaders = true
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -4453,7 +4543,7 @@ func TestStaticTabularOutputNestedStatementsAndCombinationMixAnnotationsCSV(t *t
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -4482,12 +4572,12 @@ func TestStaticTabularOutputNestedStatementsAndCombinationMixAnnotationsCSV(t *t
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_CSV, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_CSV, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateCSVOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateCSVOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -4516,21 +4606,23 @@ func TestStaticTabularOutputParsingOfWithinComponentLinkages(t *testing.T) {
 	// Simple output with multiple combinations within Cex
 	text := "Cex[exampleConstraint](for compliance with (left [AND] right) as well as (left1 [XOR] right1) shared) Cex(outlier)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := "|"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -4547,7 +4639,7 @@ func TestStaticTabularOutputParsingOfWithinComponentLinkages(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -4576,12 +4668,12 @@ func TestStaticTabularOutputParsingOfWithinComponentLinkages(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -4617,23 +4709,25 @@ func TestTabularOutputDefaultExample(t *testing.T) {
 		"I[act=violate](were (non-compliant [OR] violated)) Bdir[type=inanimate](organic farming provisions)} [AND] " +
 		"Cac[state]{A[role=enforcer,type=animate](Manager) I[act=terminate](has concluded) Bdir[type=activity](investigation)}}."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -4650,7 +4744,7 @@ func TestTabularOutputDefaultExample(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -4679,12 +4773,12 @@ func TestTabularOutputDefaultExample(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -4714,23 +4808,25 @@ func TestTabularOutputComplexMultilevelNesting(t *testing.T) {
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A(actor1) I(aim1) Bdir{A(actor2) I(aim2) Cac{   Cac{A(actor3) I(aim3) Bdir(something)  }   [OR]   Cac{  A(actor4) I(aim4) Bdir(something else)  }}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -4747,7 +4843,7 @@ func TestTabularOutputComplexMultilevelNesting(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -4776,12 +4872,12 @@ func TestTabularOutputComplexMultilevelNesting(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -4811,23 +4907,25 @@ func TestTabularOutputBasicComponentPairs(t *testing.T) {
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A(actor1) {I(aim1) Bdir(object1) [XOR] I(aim2) Bdir(object2)} Cac(condition)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -4839,7 +4937,7 @@ func TestTabularOutputBasicComponentPairs(t *testing.T) {
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -4880,23 +4978,25 @@ func TestTabularOutputBasicComponentPairAndWithinComponentCombinations(t *testin
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A(actor1) {I(aim1) Bdir(object1) [XOR] I(aim2) Bdir(object2)} Cac((condition1 [OR] condition2))"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -4908,7 +5008,7 @@ func TestTabularOutputBasicComponentPairAndWithinComponentCombinations(t *testin
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -4949,23 +5049,25 @@ func TestTabularOutputBasicComponentPairAndWithinComponentCombinationsAndNestedS
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A(actor1) {I(aim1) Bdir(object1) Cac{A(actor2) I(aim3)} [XOR] Cac{A(actor4) I(aim4)} I(aim2) Bdir(object2)} Bind((indirectobject1 [OR] indirectobject2))"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -4977,7 +5079,7 @@ func TestTabularOutputBasicComponentPairAndWithinComponentCombinationsAndNestedS
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -5025,23 +5127,25 @@ func TestTabularOutputStandardStatementComponentPairs(t *testing.T) {
 		"Bdir[type=inanimate](organic farming provisions)} [AND] Cac[state]{A[role=enforcer,type=animate](Manager) " +
 		"I[act=terminate](has concluded) Bdir[type=activity](investigation)}}."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -5053,7 +5157,7 @@ func TestTabularOutputStandardStatementComponentPairs(t *testing.T) {
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -5094,23 +5198,25 @@ func TestTabularOutputComplexNestedCombinationsWithoutComponentPairs(t *testing.
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "Cac{Cac{Cac{A(actor1) I(aim1) Bdir(object1)} [AND] Cac{A(actor2) I(aim2) Bdir(object2)} [AND] Cac{A(actor4) I(aim4)}} [OR] Cac{Cac{A(actor3) I(aim3) Bdir(object3)} [XOR] Cac{Cac{A(actor6) I(aim6) Bdir(object6)} [AND] Cac{Cac{A(actor7) I(aim7) Bdir(object7)} [XOR] Cac{A(actor8) I(aim8)}}}}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -5122,7 +5228,7 @@ func TestTabularOutputComplexNestedCombinationsWithoutComponentPairs(t *testing.
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -5163,23 +5269,25 @@ func TestTabularOutputComplexNestedCombinationsWithComponentPairs(t *testing.T)
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "{Cac{Cac{A(actor1) I(aim1) Bdir(object1)} [AND] Cac{A(actor2) I(aim2) Bdir(object2)} [AND] Cac{A(actor4) I(aim4)}} [OR] Cac{Cac{A(actor3) I(aim3) Bdir(object3)} [XOR] Cac{Cac{A(actor6) I(aim6) Bdir(object6)} [AND] Cac{Cac{A(actor7) I(aim7) Bdir(object7)} [XOR] Cac{A(actor8) I(aim8)}}}}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -5191,7 +5299,7 @@ func TestTabularOutputComplexNestedCombinationsWithComponentPairs(t *testing.T)
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -5232,23 +5340,25 @@ func TestTabularOutputNestedCombinationsComponentLevelNestingAndComponentPairs(t
 	// Statement with multi-level nesting, combinations as well as component pairs
 	text := "A(actor1) I(aim1) Cac{Cac{A(actor2) I(aim2)} [XOR] Cac{A(actor3) I(aim3)}} {Bdir(directobject1) Bind(indirectobject1) [OR] Bdir{ A(actor4) I(aim4) Bdir(directobject2) Cac{A(actor5) I(aim5)}} Bind(indirectobject2)} "
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -5260,7 +5370,7 @@ func TestTabularOutputNestedCombinationsComponentLevelNestingAndComponentPairs(t
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -5300,23 +5410,25 @@ func TestTabularOutputLogicalOperatorsNeighbouringStatementsComponentPairs(t *te
 
 	text := "{ A(actor1) I(aim1) [XOR] {A(actor2) I(aim2) [AND] A(actor3) I(aim3)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -5328,7 +5440,7 @@ func TestTabularOutputLogicalOperatorsNeighbouringStatementsComponentPairs(t *te
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -5368,23 +5480,25 @@ func TestTabularOutputComponentPairsInNestedComponents(t *testing.T) {
 
 	text := "A(Individuals) D(must) { I(monitor) Bdir(compliance) [AND] I(report) Bdir(violation) } Cac(in the case of (repeated offense [OR] other reasons)) O{ A(actor2) D(must) {I(enforce) Bdir(compliance) [OR] I(delegate) Bdir(enforcement)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -5396,7 +5510,7 @@ func TestTabularOutputComponentPairsInNestedComponents(t *testing.T) {
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -5436,23 +5550,25 @@ func TestTabularOutputComponentPairsInNestedComponentsIGCore(t *testing.T) {
 
 	text := "A(Individuals) D(must) { I(monitor) Bdir(compliance) [AND] I(report) Bdir(violation) } Cac(in the case of (repeated offense [OR] other reasons)) O{ A(actor2) D(must) {I(enforce) Bdir(compliance) [OR] I(delegate) Bdir(enforcement)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(false)
+	opts.IGExtendedOutput = false
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -5464,7 +5580,7 @@ func TestTabularOutputComponentPairsInNestedComponentsIGCore(t *testing.T) {
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -5505,23 +5621,25 @@ func TestTabularOutputExpandComponentCombinationsWithMissingParentheses(t *testi
 
 	text := " A(actor) D(may) {I(leftAim) Bdir(leftObject) [OR] I(rightAim) Bdir(rightObject)} Cac{ {A(actor2) I(aim2 [XOR] aim4) [XOR] A(actor3) I(aim3)} }"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -5533,7 +5651,7 @@ func TestTabularOutputExpandComponentCombinationsWithMissingParentheses(t *testi
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -5584,23 +5702,25 @@ func TestTabularOutputNestedPropertiesIncludingComponentPairsAndNestedPropertyAn
 		// nested property
 		"P1,p{where E(date) F(is defined) in the P(Gregorian calendar)}."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Define separator for output generation
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -5612,7 +5732,7 @@ func TestTabularOutputNestedPropertiesIncludingComponentPairsAndNestedPropertyAn
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT && strings.Join(err.ErrorIgnoredElements, "") == "Such   : (1) A ; (2) The ; and (3) The    ." {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -5653,23 +5773,25 @@ func TestTabularOutputWithLinebreaksInIGScriptInput(t *testing.T) {
 	// Statement with line break
 	text := "A(actor) I(aim) Bdir(object1) Bind(object2)\n Cac(condition1)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Define separator for output generation
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -5681,7 +5803,7 @@ func TestTabularOutputWithLinebreaksInIGScriptInput(t *testing.T) {
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -5722,23 +5844,25 @@ func TestTabularOutputWithNonCellSeparatorInIGScriptInput(t *testing.T) {
 	// Statement with cell separator
 	text := "A(actor) I(aim) Bdir(object1) Bind(object2)| Cac(condition1)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Define separator for output generation
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -5750,7 +5874,7 @@ func TestTabularOutputWithNonCellSeparatorInIGScriptInput(t *testing.T) {
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -5791,23 +5915,25 @@ func TestTabularOutputWithCellSeparatorInIGScriptInput(t *testing.T) {
 	// Statement with cell separator
 	text := "A(actor) I(aim) Bdir(object1) Bind(object2)| Cac(condition1)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Define separator for output generation
 	separator := "|"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -5819,7 +5945,7 @@ func TestTabularOutputWithCellSeparatorInIGScriptInput(t *testing.T) {
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -5862,23 +5988,25 @@ func TestTabularOutputWithLinebreaksInOriginalStatementInput(t *testing.T) {
 	// Coded statement (no linebreak)
 	text := "A(actor) I(aim) Bdir(object1) Bind(object2) Cac(condition1)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Define separator for output generation
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -5890,7 +6018,7 @@ func TestTabularOutputWithLinebreaksInOriginalStatementInput(t *testing.T) {
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", originalStatement, text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", originalStatement, text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -5933,23 +6061,25 @@ func TestTabularOutputWithNonCellSeparatorInOriginalStatementInput(t *testing.T)
 	// Coded statement (no cell separator)
 	text := "A(actor) I(aim) Bdir(object1) Bind(object2) Cac(condition1)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Define separator for output generation
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -5961,7 +6091,7 @@ func TestTabularOutputWithNonCellSeparatorInOriginalStatementInput(t *testing.T)
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", originalStatement, text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", originalStatement, text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -6004,23 +6134,25 @@ func TestTabularOutputWithCellSeparatorInOriginalStatementInput(t *testing.T) {
 	// Coded statement (no cell separator)
 	text := "A(actor) I(aim) Bdir(object1) Bind(object2) Cac(condition1)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Define separator for output generation
 	separator := "|"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -6032,7 +6164,7 @@ func TestTabularOutputWithCellSeparatorInOriginalStatementInput(t *testing.T) {
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", originalStatement, text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", originalStatement, text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -6075,23 +6207,25 @@ func TestTabularOutputWithLinebreaksInOriginalStatementAndIgScriptInput(t *testi
 	// Coded statement (no linebreak)
 	text := "A(actor) I(aim)\n Bdir(object1) Bind(object2)\n Cac(condition1)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Define separator for output generation
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -6103,7 +6237,7 @@ func TestTabularOutputWithLinebreaksInOriginalStatementAndIgScriptInput(t *testi
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", originalStatement, text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", originalStatement, text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -6146,23 +6280,25 @@ func TestTabularOutputWithNonCellSeparatorInOriginalStatementAndIgScriptInput(t
 	// Coded statement
 	text := "A(actor) I(aim)| Bdir(object1) Bind(object2)| Cac(condition1)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Define separator for output generation
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -6174,7 +6310,7 @@ func TestTabularOutputWithNonCellSeparatorInOriginalStatementAndIgScriptInput(t
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", originalStatement, text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", originalStatement, text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -6217,23 +6353,25 @@ func TestTabularOutputWithCellSeparatorInOriginalStatementAndIgScriptInput(t *te
 	// Coded statement
 	text := "A(actor) I(aim)| Bdir(object1) Bind(object2)| Cac(condition1)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Define separator for output generation
 	separator := "|"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -6245,7 +6383,7 @@ func TestTabularOutputWithCellSeparatorInOriginalStatementAndIgScriptInput(t *te
 		t.Fatal("Wrong statement count: ", stmts)
 	}
 
-	results := GenerateTabularOutputFromParsedStatements(stmts, "", originalStatement, text, "123", "", true, tree.AGGREGATE_IMPLICIT_LINKAGES, separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", originalStatement, text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error when generating output:", err)
 	}
@@ -6286,23 +6424,25 @@ func TestTabularOutputComplexMultilevelNestingWithIgScriptOutputSingleEntry(t *t
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A(actor1) I(aim1) Bdir{A(actor2) I(aim2) Cac{   Cac{A(actor3) I(aim3) Bdir(something)  }   [OR]   Cac{  A(actor4) I(aim4) Bdir(something else)  }}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -6319,7 +6459,7 @@ func TestTabularOutputComplexMultilevelNestingWithIgScriptOutputSingleEntry(t *t
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -6348,13 +6488,13 @@ func TestTabularOutputComplexMultilevelNestingWithIgScriptOutputSingleEntry(t *t
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_FIRST_ENTRY)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_FIRST_ENTRY)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -6384,23 +6524,25 @@ func TestTabularOutputComplexMultilevelNestingWithIgScriptOutputAllEntries(t *te
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A(actor1) I(aim1) Bdir{A(actor2) I(aim2) Cac{   Cac{A(actor3) I(aim3) Bdir(something)  }   [OR]   Cac{  A(actor4) I(aim4) Bdir(something else)  }}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -6417,7 +6559,7 @@ func TestTabularOutputComplexMultilevelNestingWithIgScriptOutputAllEntries(t *te
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -6446,13 +6588,13 @@ func TestTabularOutputComplexMultilevelNestingWithIgScriptOutputAllEntries(t *te
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -6485,23 +6627,25 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementOutputSingleE
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A(actor1) I(aim1) Bdir{A(actor2) I(aim2) Cac{   Cac{A(actor3) I(aim3) Bdir(something)  }   [OR]   Cac{  A(actor4) I(aim4) Bdir(something else)  }}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -6518,7 +6662,7 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementOutputSingleE
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -6547,13 +6691,13 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementOutputSingleE
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_FIRST_ENTRY, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_FIRST_ENTRY, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -6586,23 +6730,25 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementOutputAllEntr
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A(actor1) I(aim1) Bdir{A(actor2) I(aim2) Cac{   Cac{A(actor3) I(aim3) Bdir(something)  }   [OR]   Cac{  A(actor4) I(aim4) Bdir(something else)  }}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -6619,7 +6765,7 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementOutputAllEntr
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -6648,13 +6794,13 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementOutputAllEntr
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_NONE)
+	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_NONE)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -6687,23 +6833,25 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementAndIgScriptOu
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A(actor1) I(aim1) Bdir{A(actor2) I(aim2) Cac{   Cac{A(actor3) I(aim3) Bdir(something)  }   [OR]   Cac{  A(actor4) I(aim4) Bdir(something else)  }}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -6720,7 +6868,7 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementAndIgScriptOu
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -6749,13 +6897,13 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementAndIgScriptOu
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_FIRST_ENTRY, IG_SCRIPT_OUTPUT_FIRST_ENTRY)
+	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_FIRST_ENTRY, IG_SCRIPT_OUTPUT_FIRST_ENTRY)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -6788,23 +6936,25 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementAndIgScriptOu
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A(actor1) I(aim1) Bdir{A(actor2) I(aim2) Cac{   Cac{A(actor3) I(aim3) Bdir(something)  }   [OR]   Cac{  A(actor4) I(aim4) Bdir(something else)  }}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -6821,7 +6971,7 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementAndIgScriptOu
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -6850,13 +7000,13 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementAndIgScriptOu
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateCSVOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_FIRST_ENTRY, IG_SCRIPT_OUTPUT_FIRST_ENTRY)
+	output, err := generateCSVOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_FIRST_ENTRY, IG_SCRIPT_OUTPUT_FIRST_ENTRY)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -6889,23 +7039,25 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementAndIgScriptOu
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A(actor1) I(aim1) Bdir{A(actor2) I(aim2) Cac{   Cac{A(actor3) I(aim3) Bdir(something)  }   [OR]   Cac{  A(actor4) I(aim4) Bdir(something else)  }}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -6922,7 +7074,7 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementAndIgScriptOu
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -6951,13 +7103,13 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementAndIgScriptOu
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -6990,23 +7142,25 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementAndIgScriptOu
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A(actor1) I(aim1) Bdir{A(actor2) I(aim2) Cac{   Cac{A(actor3) I(aim3) Bdir(something)  }   [OR]   Cac{  A(actor4) I(aim4) Bdir(something else)  }}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -7023,7 +7177,7 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementAndIgScriptOu
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -7052,13 +7206,13 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementAndIgScriptOu
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateCSVOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	output, err := generateCSVOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -7091,23 +7245,25 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementSingleAndIgSc
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A(actor1) I(aim1) Bdir{A(actor2) I(aim2) Cac{   Cac{A(actor3) I(aim3) Bdir(something)  }   [OR]   Cac{  A(actor4) I(aim4) Bdir(something else)  }}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -7124,7 +7280,7 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementSingleAndIgSc
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -7153,13 +7309,13 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementSingleAndIgSc
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_FIRST_ENTRY, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_FIRST_ENTRY, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -7192,23 +7348,25 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementAllAndIgScrip
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A(actor1) I(aim1) Bdir{A(actor2) I(aim2) Cac{   Cac{A(actor3) I(aim3) Bdir(something)  }   [OR]   Cac{  A(actor4) I(aim4) Bdir(something else)  }}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -7225,7 +7383,7 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementAllAndIgScrip
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -7254,13 +7412,13 @@ func TestTabularOutputComplexMultilevelNestingWithOriginalStatementAllAndIgScrip
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_FIRST_ENTRY)
+	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_FIRST_ENTRY)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -7290,23 +7448,25 @@ func TestTabularOutputCombinationOfComponentLevelNestingAndCombinationsLogicalOp
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "Cac{ Cac{ A(actor1) I(aim1) } [OR] Cac{ Cac{ A(actor2) I(aim2) Cac{ Cac{ A(actor3) I(aim3) } [OR] Cac{ A(actor4) I(aim4) } } Bdir(object2) } [OR] Cac{ A(actor5) I(aim5) Cac{ A(actor6) I(aim6) } } }}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -7323,7 +7483,7 @@ func TestTabularOutputCombinationOfComponentLevelNestingAndCombinationsLogicalOp
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -7352,13 +7512,13 @@ func TestTabularOutputCombinationOfComponentLevelNestingAndCombinationsLogicalOp
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -7389,23 +7549,25 @@ func TestTabularOutputCombinationOfComponentLevelNestingAndCombinationsLogicalOp
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "Cac{ Cac{ A(actor6) I(actor6) } [XOR] Cac{ A(actor7) I(actor7) }} Cac{ Cac{ A(actor1) I(aim1) } [OR] Cac{ A(actor0) I(aim0) Cac{ A(actor2) I(aim2) Cac{ Cac{ A(actor3) I(aim3) } [OR] Cac{ A(actor4) I(aim4) } } Bdir(object2) } [OR] Cac{ A(actor5) I(aim5)} }}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -7422,7 +7584,7 @@ func TestTabularOutputCombinationOfComponentLevelNestingAndCombinationsLogicalOp
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -7451,13 +7613,13 @@ func TestTabularOutputCombinationOfComponentLevelNestingAndCombinationsLogicalOp
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -7490,23 +7652,25 @@ func TestTabularOutputSubstitutionSpecialSymbolsDuringOutputIGCore(t *testing.T)
 	// Statement with multi-level nesting with embedded nested statement combinations as well as annotations, with quotation marks sprinkled across (to test robust substitution)
 	text := "A[quot=\"annotation\"](actor0) I(\"aim0\") Cac[\"directAnnotation\"]{ Cac{ A(actor6) I(aim\"6) } [XOR] Cac{ A(actor7) I(actor7) }} Cac{ Cac{ A(\"actor1\") I(aim1) } [OR] Cac{ A(actor0) I(aim0) Cac{ A(actor2) I(aim2) Cac{ Cac{ A(actor3) I(aim3) } [OR] Cac{ A(actor4) I[\"anotherAnnotation\"](aim4) } } Bdir(object2) } [OR] Cac{ A(actor5) I(aim5)} }}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(false)
+	opts.IGExtendedOutput = false
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -7523,7 +7687,7 @@ func TestTabularOutputSubstitutionSpecialSymbolsDuringOutputIGCore(t *testing.T)
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -7552,13 +7716,13 @@ func TestTabularOutputSubstitutionSpecialSymbolsDuringOutputIGCore(t *testing.T)
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -7591,23 +7755,25 @@ func TestTabularOutputSubstitutionSpecialSymbolsDuringOutputIGExtendedGoogleShee
 	// Statement with multi-level nesting with embedded nested statement combinations as well as annotations, with quotation marks sprinkled across (to test robust substitution)
 	text := "A[quot=\"annotation\"](actor0) I(\"aim0\") Cac[\"directAnnotation\"]{ Cac{ A(actor6) I(aim\"6) } [XOR] Cac{ A(actor7) I(actor7) }} Cac{ Cac{ A(\"actor1\") I(aim1) } [OR] Cac{ A(actor0) I(aim0) Cac{ A(actor2) I(aim2) Cac{ Cac{ A(actor3) I(aim3) } [OR] Cac{ A(actor4) I[\"anotherAnnotation\"](aim4) } } Bdir(object2) } [OR] Cac{ A(actor5) I(aim5)} }}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -7624,7 +7790,7 @@ func TestTabularOutputSubstitutionSpecialSymbolsDuringOutputIGExtendedGoogleShee
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -7653,13 +7819,13 @@ func TestTabularOutputSubstitutionSpecialSymbolsDuringOutputIGExtendedGoogleShee
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -7692,23 +7858,25 @@ func TestTabularOutputSubstitutionSpecialSymbolsDuringOutputIGExtendedCSV(t *tes
 	// Statement with multi-level nesting with embedded nested statement combinations as well as annotations, with quotation marks sprinkled across (to test robust substitution)
 	text := "A[quot=\"annotation\"](actor0) I(\"aim0\") Cac[\"directAnnotation\"]{ Cac{ A(actor6) I(aim\"6) } [XOR] Cac{ A(actor7) I(actor7) }} Cac{ Cac{ A(\"actor1\") I(aim1) } [OR] Cac{ A(actor0) I(aim0) Cac{ A(actor2) I(aim2) Cac{ Cac{ A(actor3) I(aim3) } [OR] Cac{ A(actor4) I[\"anotherAnnotation\"](aim4) } } Bdir(object2) } [OR] Cac{ A(actor5) I(aim5)} }}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -7725,7 +7893,7 @@ func TestTabularOutputSubstitutionSpecialSymbolsDuringOutputIGExtendedCSV(t *tes
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -7754,13 +7922,13 @@ func TestTabularOutputSubstitutionSpecialSymbolsDuringOutputIGExtendedCSV(t *tes
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateCSVOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	output, err := generateCSVOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -7793,23 +7961,25 @@ func TestTabularOutputDiacriticsHandling(t *testing.T) {
 	// Statement with multi-level nesting with embedded nested statement combinations as well as annotations, with quotation marks sprinkled across (to test robust substitution)
 	text := "A,p(Regional) A[role=enforcer,type=animate](Manaægers), Cex(on behalf of the Secretary), D[stringency=permissive](may) I[act=perfà, è, ì, ò, ù, À, È, Ì, Òormance](review [AND] (reward [XOR] sanction)) Bdir,p(approved) Bdir1,p(certified) Bdir1[role=monitored,type=aniömate](producütion [opeärations]) and Bdir[role=monžöjřitored,type=animate](handling operations) and Bdir2,p(accrediçted) Bdir2[role=monitor,type=animate](certifying agents) ïtext Cex[ctx=purpose](for compliance with the (Act [XOR] regulations in this part)) under the condition that Cac{Cac[state]{A[role=monitored,type=animate](Operations) I[act=violate](wereÅ non-compliant [OR] violated) Bdir[type=inò, ù, À, È, Ì, Ònimate](organic farming provisions)} [AND] Cac[state]{A[role=enforcer,type=animate](Manaò, ù, À, È, Ì, Òer) I[act=terminate](has øconcluded) Bdir[type=activity](investigation)}}."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -7826,7 +7996,7 @@ func TestTabularOutputDiacriticsHandling(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -7855,13 +8025,13 @@ func TestTabularOutputDiacriticsHandling(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateCSVOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	output, err := generateCSVOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -7894,23 +8064,25 @@ func TestTabularOutputStatementLevelAnnotations(t *testing.T) {
 	// Statement with statement-level annotations, annotated conditions, as well as component-level embedded brackets
 	text := "Cac[conditionLevelAnnotation]{Once E(policy) [internalAnnotation] F(comes into force)} A,p(relevant) [annotation1] A(regulators) D([must]) I(monitor [AND] enforce) Bdir(compliance). [annotation2]"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -7927,7 +8099,7 @@ func TestTabularOutputStatementLevelAnnotations(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -7956,13 +8128,13 @@ func TestTabularOutputStatementLevelAnnotations(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -7998,23 +8170,25 @@ func TestTabularOutputNestedStatementLevelAnnotations(t *testing.T) {
 		"Bdir[object2Annotation](object2) [XOR] A(actor3) I(act3)[inner statement3] Bdir[object3Annotation](object3)} " +
 		"[AND] A(actor4) I(act4) Bdir[object4Annotation](object4) [inner statement4] } } [statement-level annotation2]"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -8031,7 +8205,7 @@ func TestTabularOutputNestedStatementLevelAnnotations(t *testing.T) {
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -8060,13 +8234,13 @@ func TestTabularOutputNestedStatementLevelAnnotations(t *testing.T) {
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -8107,23 +8281,25 @@ func TestTabularOutputNestedStatementLevelAnnotationsImplicitAndNestedComponent(
 		// implicitly AND-linked component (note: [inner statement5] is not extracted)
 		"Cac[condition1]{ A(actor5) I(act5) [inner statement5] Bdir(object5) }"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -8140,7 +8316,7 @@ func TestTabularOutputNestedStatementLevelAnnotationsImplicitAndNestedComponent(
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -8169,13 +8345,13 @@ func TestTabularOutputNestedStatementLevelAnnotationsImplicitAndNestedComponent(
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -8218,23 +8394,25 @@ func TestTabularOutputNestedStatementLevelAnnotationsImplicitAndNestedComponentO
 		// separate Or else with nested combination
 		"O[orElse1]{{ A(actor6) [orElse2] I(act6) Bdir(object6) [XOR] A(actor7) I(act7) Bdir(object7) [orElse3]}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Static output
-	SetDynamicOutput(false)
+	opts.SetDynamicOutput(false)
 	// IG Extended output
-	SetProduceIGExtendedOutput(true)
+	opts.IGExtendedOutput = true
 	// Indicates whether annotations are included in output.
-	SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate DoV
-	SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Include shared elements
-	SetIncludeSharedElementsInTabularOutput(true)
+	opts.IncludeSharedElementsInTabularOutput = true
 
 	// Take separator for Google Sheets output
 	separator := ";"
 
 	// Test for correct configuration for static output
-	if tree.AGGREGATE_IMPLICIT_LINKAGES != true {
-		t.Fatal("SetDynamicOutput() did not properly configure implicit link aggregation")
+	if opts.AggregateImplicitLinkages != true {
+		t.Fatal("opts.SetDynamicOutput() did not properly configure implicit link aggregation")
 	}
 
 	stmts, err := parser.ParseStatement(text)
@@ -8251,7 +8429,7 @@ func TestTabularOutputNestedStatementLevelAnnotationsImplicitAndNestedComponentO
 	fmt.Println(s.String())
 
 	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
-	leafArrays, componentRefs := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
 
 	fmt.Println("Component refs:", componentRefs)
 
@@ -8280,13 +8458,13 @@ func TestTabularOutputNestedStatementLevelAnnotationsImplicitAndNestedComponentO
 	// Extract expected output
 	expectedOutput := string(content)
 
-	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, IncludeHeader())
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
 	}
 
 	// Here is the relevant parameterization of the output
-	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, IncludeHeader(), ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
+	output, err := generateGoogleSheetsOutput(statementMap, originalStatement, text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_ALL_ENTRIES, IG_SCRIPT_OUTPUT_ALL_ENTRIES)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
 	}
@@ -8307,3 +8485,219 @@ func TestTabularOutputNestedStatementLevelAnnotationsImplicitAndNestedComponentO
 	}
 
 }
+
+/*
+Tests static tabular output for binary and unary negations on component level, for private properties, and nested statements.
+*/
+func TestStaticTabularOutputNegations(t *testing.T) {
+
+	text := "A(farmer) A1,p([NOT] certified) A1(operator) D(must) I((inspect [NOT] certify)) Bdir([NOT] organic produce) " +
+		"Cac{Cac{A(actor2) I(aim2)} [XOR] Cac{[NOT] A(actor3) I(aim3)}}"
+
+	// Output options
+	opts := tree.DefaultOptions()
+	// Static output
+	opts.SetDynamicOutput(false)
+	// IG Extended output
+	opts.IGExtendedOutput = true
+	// Indicates whether annotations are included in output.
+	opts.IncludeAnnotations = false
+	// Indicates whether header row is included in output.
+	opts.IncludeHeaders = true
+	// With shared elements
+	opts.IncludeSharedElementsInTabularOutput = true
+
+	// Take separator for Google Sheets output
+	separator := ";"
+
+	stmts, err := parser.ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during parsing of statement", err.Error())
+	}
+
+	if len(stmts) > 1 {
+		t.Fatal("Too many statements identified: ", stmts)
+	}
+
+	s := stmts[0].Entry.(*tree.Statement)
+
+	fmt.Println(s.String())
+
+	// This is tested in IGStatementParser_test.go as well as in TestHeaderRowGeneration() (above)
+	leafArrays, componentRefs := s.GenerateLeafArrays(opts.AggregateImplicitLinkages)
+
+	fmt.Println("Component refs:", componentRefs)
+
+	res, err := tree.GenerateNodeArrayPermutations(leafArrays...)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Unexpected error during array generation.")
+	}
+
+	fmt.Println("Input arrays: ", res)
+
+	links := tree.GenerateLogicalOperatorLinkagePerCombination(res, true, true)
+
+	fmt.Println("Links: ", links)
+
+	// Content of statement links is tested in ArrayCombinationGenerator_test.go
+	if len(links) != 5 {
+		t.Fatal("Number of statement reference links is incorrect. Value:", len(links), "Links:", links)
+	}
+
+	// Read reference file
+	content, err2 := os.ReadFile("TestOutputStaticSchemaNegations.test")
+	if err2 != nil {
+		t.Fatal("Error attempting to read test text input. Error: ", err2.Error())
+	}
+
+	// Extract expected output
+	expectedOutput := string(content)
+
+	statementMap, statementHeaders, statementHeadersNames, err := generateStatementMatrix(res, nil, "", componentRefs, links, "650", separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, opts)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Generating tabular output should not fail. Error: " + fmt.Sprint(err.Error()))
+	}
+
+	output, err := generateGoogleSheetsOutput(statementMap, "", text, statementHeaders, statementHeadersNames, separator, "", true, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during Google Sheets generation. Error: " + fmt.Sprint(err.Error()))
+	}
+
+	fmt.Println("Output:", output)
+
+	// Compare to actual output
+	if output != expectedOutput {
+		fmt.Println("Statement headers:\n", statementHeaders)
+		fmt.Println("Statement map:\n", statementMap)
+		fmt.Println("Produced output:\n", output)
+		fmt.Println("Expected output:\n", expectedOutput)
+		err3 := WriteToFile("errorOutput.error", output, true)
+		if err3 != nil {
+			t.Fatal("Error attempting to read test text input. Error: ", err3.Error())
+		}
+		t.Fatal("Output generation is wrong for given input statement. Wrote output to 'errorOutput.error'")
+	}
+}
+
+/*
+Tests the inclusion of statement types in tabular output, including nested statements and component pair combinations.
+*/
+func TestTabularOutputStatementType(t *testing.T) {
+
+	// Input statement
+	text := "A(farmer) D(must) {I(sell) Bdir(goods) [XOR] I(donate) Bdir(produce)} Bdir1{E(certificate) F(is) P(valid)} " +
+		"Cac{A(inspector) D(may) M(can) I(approve)}"
+
+	// Output options
+	opts := tree.DefaultOptions()
+	// Static output
+	opts.SetDynamicOutput(false)
+	// IG Extended output
+	opts.IGExtendedOutput = true
+	// Include statement type
+	opts.IncludeStatementType = true
+
+	// Take separator for Google Sheets output
+	separator := ";"
+
+	stmts, err := parser.ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during parsing of statement", err.Error())
+	}
+
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_GOOGLE_SHEETS, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	for _, v := range results {
+		if v.Error.ErrorCode != tree.PARSING_NO_ERROR {
+			t.Fatal("Error when generating output:", v.Error)
+		}
+	}
+
+	// Read reference file
+	content, err2 := os.ReadFile("TestOutputStatementType.test")
+	if err2 != nil {
+		t.Fatal("Error attempting to read test text input. Error: ", err2.Error())
+	}
+
+	// Extract expected output
+	expectedOutput := string(content)
+
+	// Aggregate output if multiple results
+	output := ""
+	for _, v := range results {
+		output += v.Output
+	}
+
+	// Compare to actual output
+	if output != expectedOutput {
+		fmt.Println("Produced output:\n", output)
+		fmt.Println("Expected output:\n", expectedOutput)
+		err3 := WriteToFile("errorOutput.error", output, true)
+		if err3 != nil {
+			t.Fatal("Error attempting to read test text input. Error: ", err3.Error())
+		}
+		t.Fatal("Output generation is wrong for given input statement. Wrote output to 'errorOutput.error'")
+	}
+
+}
+
+/*
+Tests inclusion of complexity measures (Degree of Variability, nesting depth, number of nested statements, options and
+complexity per component) for atomic statements of statement and nested statements.
+*/
+func TestTabularOutputComplexity(t *testing.T) {
+
+	// Input statement
+	text := "A(farmer) D(must) I((sell [XOR] donate)) Bdir(goods) " +
+		"Cac{A(inspector) I((inspects [OR] certifies)) Bdir{A(council) I(approves)}}"
+
+	// Output options
+	opts := tree.DefaultOptions()
+	// Dynamic output
+	opts.SetDynamicOutput(true)
+	// IG Extended output
+	opts.IGExtendedOutput = true
+	// Include complexity measures
+	opts.IncludeComplexity = true
+
+	// Take separator for CSV output
+	separator := "|"
+
+	stmts, err := parser.ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during parsing of statement", err.Error())
+	}
+
+	results := GenerateTabularOutputFromParsedStatements(stmts, "", "", text, "123", "", true, opts, separator, OUTPUT_TYPE_CSV, opts.IncludeHeaders, ORIGINAL_STATEMENT_OUTPUT_NONE, IG_SCRIPT_OUTPUT_NONE)
+	for _, v := range results {
+		if v.Error.ErrorCode != tree.PARSING_NO_ERROR {
+			t.Fatal("Error when generating output:", v.Error)
+		}
+	}
+
+	// Read reference file
+	content, err2 := os.ReadFile("TestOutputComplexity.test")
+	if err2 != nil {
+		t.Fatal("Error attempting to read test text input. Error: ", err2.Error())
+	}
+
+	// Extract expected output
+	expectedOutput := string(content)
+
+	// Aggregate output if multiple results
+	output := ""
+	for _, v := range results {
+		output += v.Output
+	}
+
+	// Compare to actual output
+	if output != expectedOutput {
+		fmt.Println("Produced output:\n", output)
+		fmt.Println("Expected output:\n", expectedOutput)
+		err3 := WriteToFile("errorOutput.error", output, true)
+		if err3 != nil {
+			t.Fatal("Error attempting to read test text input. Error: ", err3.Error())
+		}
+		t.Fatal("Output generation is wrong for given input statement. Wrote output to 'errorOutput.error'")
+	}
+
+}
@@ -1,6 +1,7 @@
 package visual
 
 import (
+	"IG-Parser/core/diff"
 	"IG-Parser/core/exporter/tabular"
 	"IG-Parser/core/parser"
 	"IG-Parser/core/tree"
@@ -30,18 +31,20 @@ func TestVisualOutputBasicWithoutSharedElements(t *testing.T) {
 		// Activation condition 2
 		"Cac{A(NOP Official) I(recognizes) Bdir(Program Manager)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Activate flat printing
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 	// Activate binary tree printing
-	tree.SetBinaryPrinting(true)
+	opts.BinaryPrinting = true
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Deactivate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(false)
+	opts.IncludeSharedElementsInVisualOutput = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -56,7 +59,7 @@ func TestVisualOutputBasicWithoutSharedElements(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -105,18 +108,20 @@ func TestVisualOutputBasicWithSharedElements(t *testing.T) {
 		// Activation condition 2
 		"Cac{A(NOP Official) I(recognizes) Bdir(Program Manager)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Activate flat printing
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 	// Activate binary tree printing
-	tree.SetBinaryPrinting(true)
+	opts.BinaryPrinting = true
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Activate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(true)
+	opts.IncludeSharedElementsInVisualOutput = true
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -131,7 +136,7 @@ func TestVisualOutputBasicWithSharedElements(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -180,18 +185,20 @@ func TestVisualOutputNestedProperties(t *testing.T) {
 		// Activation condition 2
 		"Cac{A(NOP Official) I(recognizes) Bdir(Program Manager)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Activate flat printing
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 	// Activate binary tree printing
-	tree.SetBinaryPrinting(true)
+	opts.BinaryPrinting = true
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Deactivate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(false)
+	opts.IncludeSharedElementsInVisualOutput = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -206,7 +213,7 @@ func TestVisualOutputNestedProperties(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -254,18 +261,20 @@ func TestVisualOutputAnnotations(t *testing.T) {
 		// Activation condition 2
 		"Cac{A[gov=monitor,anim=animate](NOP Official) I(recognizes) Bdir(Program Manager)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Activate annotations
-	tabular.SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Activate flat printing
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 	// Activate binary tree printing
-	tree.SetBinaryPrinting(true)
+	opts.BinaryPrinting = true
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Deactivate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(false)
+	opts.IncludeSharedElementsInVisualOutput = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -280,7 +289,7 @@ func TestVisualOutputAnnotations(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -320,16 +329,18 @@ Tests flat output for properties (labels, as opposed to tree structure).
 func TestVisualOutputPropertyNodesFlatPrinting(t *testing.T) {
 	text := "A(General Manager) A,p(shared quality) A1(Region Manager) A1,p(left quality) A1,p(right quality) A1,p(third quality)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Activate annotations
-	tabular.SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Activate flat printing
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 	// Activate binary tree printing
-	tree.SetBinaryPrinting(true)
+	opts.BinaryPrinting = true
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -344,7 +355,7 @@ func TestVisualOutputPropertyNodesFlatPrinting(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -383,16 +394,18 @@ Tests flat output for properties (labels, as opposed to tree structure).
 func TestVisualOutputSharedPropertyNodesFlatPrinting(t *testing.T) {
 	text := "The A(Program Manager) D(may) I(initiate) Bdir,p((suspension [XOR] revocation)) Bdir(proceedings)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Activate annotations
-	tabular.SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Activate flat printing
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 	// Activate binary tree printing
-	tree.SetBinaryPrinting(true)
+	opts.BinaryPrinting = true
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -407,7 +420,7 @@ func TestVisualOutputSharedPropertyNodesFlatPrinting(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -446,16 +459,18 @@ Tests tree structure output for properties.
 func TestVisualOutputPropertyNodesTreePrinting(t *testing.T) {
 	text := "A(General Manager) A,p(shared quality) A1(Region Manager) A1,p(left quality) A1,p(right quality) A1,p(third quality)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Activate annotations
-	tabular.SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Activate binary tree printing
-	tree.SetBinaryPrinting(true)
+	opts.BinaryPrinting = true
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -470,7 +485,7 @@ func TestVisualOutputPropertyNodesTreePrinting(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -517,18 +532,20 @@ func TestVisualOutputBasicNonBinaryTree(t *testing.T) {
 		// Activation condition 2
 		"Cac{A(NOP Official) I(recognizes) Bdir(Program Manager)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Activate flat printing
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Deactivate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(false)
+	opts.IncludeSharedElementsInVisualOutput = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -543,7 +560,7 @@ func TestVisualOutputBasicNonBinaryTree(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -585,18 +602,20 @@ func TestVisualOutputComplexNonBinaryTree(t *testing.T) {
 	// Complex entry
 	text := "The Congress finds and declares that it is the E(national policy) F([is] to (encourage [AND] assist)) the P(states) Cex{ A(states) I(to exercise) Cex(effectively) their Bdir(responsibilities) Bdir,p(in the coastal zone) Cex(through the (development [AND] implementation) of management programs to achieve wise use of the (land [AND] water) resources of the coastal zone, giving full consideration to (ecological [AND] cultural [AND] historic [AND] esthetic) values as well as the needs for compatible economic development), Cex{which E(programs) M(should) Cex(at least) F(provide for)— (A) the P1(protection) P1,p1(of natural resources, including (wetlands [AND] floodplains [AND] estuaries [AND] beaches [AND] dunes [AND] barrier islands [AND] coral reefs [AND] fish and wildlife and their habitat) within the coastal zone), the P2(management) P2,p2((of coastal development to minimize the loss of (life [AND] property) caused by improper development in (flood-prone [AND] storm surge [AND] geological hazard [AND] erosion-prone) areas [AND] in areas likely to be (affected by [OR] vulnerable to) (sea level rise [AND] land subsidence [AND] saltwater intrusion) [AND] by the destruction of natural protective features such as (beaches [AND] dunes [AND] wetlands [AND] barrier islands))), (C) the P3(management) P3,p(of coastal development to (improve [AND] safeguard [AND] restore) the quality of coastal waters, [AND] to protect (natural resources [AND] existing uses of those waters)), (D) P4,p1(priority) P4(consideration) P4,p2(being given to (coastal-dependent (uses [AND] orderly processes) for siting major facilities related to (national defense [AND] energy [AND] fisheries development [AND] recreation [AND] ports [AND] transportation), [AND] the location to the maximum extent practicable of new (commercial [AND] industrial) developments (in [XOR] adjacent) to areas where such development already exists)), (E) P5,p1(public) P5(access) P5,p2(to the coasts for recreation purposes), (F) P6(assistance) P6,p(in the redevelopment of (deteriorating urban (waterfronts [AND] ports) [AND] sensitive (preservation [AND] restoration) of (historic [AND] cultural [AND] esthetic) coastal features)), (G) P7(the (coordination [AND] simplification) of procedures) P7,p1(in order to ensure expedited governmental decision making for the management of coastal resources), (H) P8((continued (consultation [AND] coordination) with, [AND] the giving of adequate consideration to the views of affected Federal agencies)), (I) P9(the giving of ((timely [AND] effective) notification of , [AND] opportunities for (public [AND] local) government participation in coastal management decision making)), (J) P10(assistance) P10,p1(to support comprehensive (planning [AND] conservation [AND] management) for living marine resources) P10,p1,p1(including planning for (the siting of (pollution control [AND] aquaculture facilities) within the coastal zone [AND] improved coordination between ((State [AND] Federal) coastal zone management agencies [AND] (State [AND] wildlife) agencies))) }}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Activate flat printing
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Deactivate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(false)
+	opts.IncludeSharedElementsInVisualOutput = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -611,7 +630,7 @@ func TestVisualOutputComplexNonBinaryTree(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -653,16 +672,18 @@ func TestVisualOutputMultiLevelEmbeddedCombinationsWithSharedElements(t *testing
 	// Entry containing multiple combinations on right side of first-order combination
 	text := "Cex(( left1 [XOR] shared (left [AND] right) via (left2 [XOR] right2)))"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Activate flat printing
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 	// Activate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Activate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(true)
+	opts.IncludeSharedElementsInVisualOutput = true
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -677,7 +698,7 @@ func TestVisualOutputMultiLevelEmbeddedCombinationsWithSharedElements(t *testing
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -719,16 +740,18 @@ func TestVisualOutputMultiLevelEmbeddedCombinationsWithoutSharedElements(t *test
 	// Entry containing multiple combinations on right side of first-order combination
 	text := "Cex(( left1 [XOR] shared (left [AND] right) via (left2 [XOR] right2)))"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Activate flat printing
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 	// Activate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Deactivate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(false)
+	opts.IncludeSharedElementsInVisualOutput = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -743,7 +766,7 @@ func TestVisualOutputMultiLevelEmbeddedCombinationsWithoutSharedElements(t *test
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -784,18 +807,20 @@ func TestVisualOutputDefaultExample(t *testing.T) {
 	// Default example
 	text := "A,p(Regional) A[role=enforcer,type=animate](Managers), Cex(on behalf of the Secretary), D[stringency=permissive](may) I[act=performance]((review [AND] (reward [XOR] sanction))) Bdir,p(approved) Bdir1,p(certified) Bdir1[role=monitored,type=animate](production [operations]) and Bdir[role=monitored,type=animate](handling operations) and Bdir2,p(accredited) Bdir2[role=monitor,type=animate](certifying agents) Cex[ctx=purpose](for compliance with the (Act or [XOR] regulations in this part)) under the condition that Cac{Cac[state]{A[role=monitored,type=animate](Operations) I[act=violate](were (non-compliant [OR] violated)) Bdir[type=inanimate](organic farming provisions)} [AND] Cac[state]{A[role=enforcer,type=animate](Manager) I[act=terminate](has concluded) Bdir[type=activity](investigation)}}."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Activate annotations
-	tabular.SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Activate flat printing
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Deactivate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(false)
+	opts.IncludeSharedElementsInVisualOutput = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -810,7 +835,7 @@ func TestVisualOutputDefaultExample(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -851,18 +876,20 @@ func TestVisualOutputDefaultExampleActivationConditionsFirst(t *testing.T) {
 	// Default example
 	text := "A,p(Regional) A[role=enforcer,type=animate](Managers), Cex(on behalf of the Secretary), D[stringency=permissive](may) I[act=performance]((review [AND] (reward [XOR] sanction))) Bdir,p(approved) Bdir1,p(certified) Bdir1[role=monitored,type=animate](production [operations]) and Bdir[role=monitored,type=animate](handling operations) and Bdir2,p(accredited) Bdir2[role=monitor,type=animate](certifying agents) Cex[ctx=purpose](for compliance with the (Act or [XOR] regulations in this part)) under the condition that Cac{Cac[state]{A[role=monitored,type=animate](Operations) I[act=violate](were (non-compliant [OR] violated)) Bdir[type=inanimate](organic farming provisions)} [AND] Cac[state]{A[role=enforcer,type=animate](Manager) I[act=terminate](has concluded) Bdir[type=activity](investigation)}}."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Activate annotations
-	tabular.SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Deactivate flat printing
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Move activation conditions to the top
-	tree.SetMoveActivationConditionsToFront(true)
+	opts.MoveActivationConditionsToFront = true
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Deactivate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(false)
+	opts.IncludeSharedElementsInVisualOutput = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -877,7 +904,7 @@ func TestVisualOutputDefaultExampleActivationConditionsFirst(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -918,16 +945,18 @@ func TestVisualOutputEscapingSymbols(t *testing.T) {
 	// Statement with quotation marks and internal parentheses
 	text := "The E(corporation) M(shall) F(be) P(a \"Type B\" corporation) Cex(pursuant to Section 201(b) of the New York State Not-for-Profit Corporation Law.)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Activate annotations
-	tabular.SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Activate flat printing
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -942,7 +971,7 @@ func TestVisualOutputEscapingSymbols(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -983,16 +1012,18 @@ func TestVisualOutputSpecialSymbols(t *testing.T) {
 	// Statement with quotation marks and internal parentheses
 	text := "The E(cor#po$ration) M(sh<all) F(b>e) P[1%25](a \"Type B\" cor=poration) Cex[#<=>27.14](pur.suant to Se:ct!ion 201(b) of the N;ew York St,ate £Not-for-Profit €Corporatio$n Law.)"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Activate annotations
-	tabular.SetIncludeAnnotations(true)
+	opts.IncludeAnnotations = true
 	// Activate flat printing
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -1007,7 +1038,7 @@ func TestVisualOutputSpecialSymbols(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -1048,16 +1079,18 @@ func TestVisualOutputLinearMultiLevelNesting(t *testing.T) {
 	// Statement with multiple levels of linear nesting (i.e., no combinations)
 	text := "A,p(First) A(Actor) I(action1) I(action2) Bdir{A(actor2) I(actionLevel2) Cac{A(actor3) I(actionLevel3) Bdir(some object)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -1072,7 +1105,7 @@ func TestVisualOutputLinearMultiLevelNesting(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -1113,16 +1146,18 @@ func TestVisualOutputComplexMultiLevelNesting(t *testing.T) {
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A(actor1) I(aim1) Bdir{A(actor2) I(aim2) Cac{   Cac{A(actor3) I(aim3) Bdir(something)  }   [OR]   Cac{  A(actor4) I(aim4) Bdir(something else)  }}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -1137,7 +1172,7 @@ func TestVisualOutputComplexMultiLevelNesting(t *testing.T) {
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -1178,16 +1213,18 @@ func TestVisualOutputComponentNestedStatementCombinationsWithProperties(t *testi
 	// Statement with multi-level nesting with embedded nested statement combinations that contain properties
 	text := "Cac{Cac{A,p(Resident) A(Program Manager) I((suspects [OR] establishes)) Bdir(violations)} [AND] Cac{E(Program Manager) F(is authorized) for the P,p(relevant) P(region)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -1202,7 +1239,7 @@ func TestVisualOutputComponentNestedStatementCombinationsWithProperties(t *testi
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -1243,18 +1280,20 @@ func TestVisualOutputModeratelyComplexStatementWithNestedCombinationsPropertiesA
 	// Statement with multi-level nesting with embedded nested statement combinations on activation condition, or else, and includes properties
 	text := "A,p(National Organic Program's) A(Program Manager), Cex(on behalf of the Secretary), D(must) I(inspect), I((review [AND] (revise [AND] resubmit))) Bdir(approved (certified production and [AND] handling operations and [AND] accredited certifying agents)) Cex(for compliance with the (Act or [XOR] regulations in this part)) if Cac{Cac{A(Program Manager) I((suspects [OR] establishes)) Bdir(violations)} [AND] Cac{E(Program Manager) F(is authorized) for the P,p(relevant) P(region)}}, or else O{O{A,p(Manager's) A(supervisor) D(may) I((suspend [XOR] revoke)) Bdir,p(Program Manager's) Bdir(authority)} [XOR] O{A(regional board) D(may) I((warn [OR] fine)) Bdir,p(violating) Bdir(Program Manager)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Deactivate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(false)
+	opts.IncludeSharedElementsInVisualOutput = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -1269,7 +1308,7 @@ func TestVisualOutputModeratelyComplexStatementWithNestedCombinationsPropertiesA
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -1310,16 +1349,18 @@ func TestVisualOutput2ndOrderNestedStatementCombinationsWithProperties(t *testin
 	// Statement with 2nd-order nesting with statement combinations on activation conditions, and that includes properties in all nested statements
 	text := "Cac{Cac{A(actor1) I(act1) Bdir,p(prop1) Bdir(bdir1) Cex(cex1)}  [OR] Cac{A(actor2) I(act2) Bdir,p(prop2) Bdir(bdir2) Cex(cex2)}} [AND] Cac{A(actor2) I(act2) Bdir,p(prop2) Bdir(bdir2) Cex(cex2)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -1334,7 +1375,7 @@ func TestVisualOutput2ndOrderNestedStatementCombinationsWithProperties(t *testin
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR && (err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT && strings.Join(err.ErrorIgnoredElements, "") != " [AND] ") {
 		t.Fatal("Error when generating visual tree output:", err1)
 	}
@@ -1377,18 +1418,20 @@ func TestVisualOutputHigherOrderStatementNestedComponentCombinationsDefaultExamp
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A,p(Regional) A[role=enforcer,type=animate](Managers), Cex(on behalf of the Secretary), D[stringency=permissive](may) I[act=performance]((review [AND] (reward [XOR] sanction))) Bdir,p(approved) Bdir1,p(certified) Bdir1[role=monitored,type=animate](production [operations]) and Bdir[role=monitored,type=animate](handling operations) and Bdir2,p(accredited) Bdir2[role=monitor,type=animate](certifying agents) Cex[ctx=purpose](for compliance with the (Act or [XOR] regulations in this part)) under the condition that Cac{{Cac[state]{A[role=monitored,type=animate](Operations) I[act=violate](were (non-compliant [OR] violated)) Bdir[type=inanimate](organic farming provisions)} [AND] {Cac[state]{A[role=enforcer,type=animate](Manager) I[act=terminate](has concluded) Bdir[type=activity](investigation)} [OR] Cac{A(actor5) I(act5)}}} [XOR] Cac{A(actor5) I(act5)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Deactivate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(false)
+	opts.IncludeSharedElementsInVisualOutput = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -1403,7 +1446,7 @@ func TestVisualOutputHigherOrderStatementNestedComponentCombinationsDefaultExamp
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -1447,18 +1490,20 @@ func TestVisualOutputHigherOrderStatementNestedComponentCombinationsDefaultExamp
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "A,p(Regional) A[role=enforcer,type=animate](Managers), Cex(on behalf of the Secretary), D[stringency=permissive](may) I[act=performance]((review [AND] (reward [XOR] sanction))) Bdir,p(approved) Bdir1,p(certified) Bdir1[role=monitored,type=animate](production [operations]) and Bdir[role=monitored,type=animate](handling operations) and Bdir2,p(accredited) Bdir2[role=monitor,type=animate](certifying agents) Cex[ctx=purpose](for compliance with the (Act or [XOR] regulations in this part)) under the condition that Cac{{Cac[state]{A[role=monitored,type=animate](Operations) I[act=violate](were (non-compliant [OR] violated)) Bdir[type=inanimate](organic farming provisions)} [AND] {Cac[state]{A[role=enforcer,type=animate](Manager) I[act=terminate](has concluded) Bdir[type=activity](investigation)} [OR] Cac{A(actor5) I(act5)}}} [XOR] Cac{A(actor6) I(act6)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(true)
+	opts.IncludeDegreeOfVariability = true
 	// Deactivate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(false)
+	opts.IncludeSharedElementsInVisualOutput = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -1473,7 +1518,7 @@ func TestVisualOutputHigherOrderStatementNestedComponentCombinationsDefaultExamp
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -1515,16 +1560,18 @@ func TestVisualOutputHigherOrderStatementNestedComponentCombinations(t *testing.
 	// Statement with multi-level nesting with embedded nested statement combinations (erratic spacing is intentional)
 	text := "Cac{Cac{Cac{A(actor1) I(aim1) Bdir(object1)} [AND] Cac{A(actor2) I(aim2) Bdir(object2)} [AND] Cac{A(actor4) I(aim4)}} [OR] Cac{Cac{A(actor3) I(aim3) Bdir(object3)} [XOR] Cac{Cac{A(actor6) I(aim6) Bdir(object6)} [AND] Cac{Cac{A(actor7) I(aim7) Bdir(object7)} [XOR] Cac{A(actor8) I(aim8)}}}}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -1539,7 +1586,7 @@ func TestVisualOutputHigherOrderStatementNestedComponentCombinations(t *testing.
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -1585,18 +1632,20 @@ func TestVisualOutputExcessiveSymbolsOrMissingWhitespaceInNestedComponentCombina
 		"Bdir(with (the Act [OR] regulations in this part))}}, [OR]Cac{when a A((certifying agent [OR] State organic program’s governing State official)) " +
 		"I(fails to enforce) Bdir((the Act [OR] regulations in this part)).} , unnecessary text}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Deactivate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(false)
+	opts.IncludeSharedElementsInVisualOutput = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -1611,7 +1660,7 @@ func TestVisualOutputExcessiveSymbolsOrMissingWhitespaceInNestedComponentCombina
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -1658,16 +1707,18 @@ func TestVisualOutputComponentLevelNestingInNestedComponentCombinations(t *testi
 		"I((violates [OR] does not comply)) with Bdir(regulations)}} [OR] Cac{A(Program Manager) I(has witnessed) " +
 		"Bdir,p(farmer's) Bdir(non-compliance) Cex(in the past)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -1682,7 +1733,7 @@ func TestVisualOutputComponentLevelNestingInNestedComponentCombinations(t *testi
 	s := stmts[0].Entry.(*tree.Statement)
 
 	// Generate tree output
-	output, err1 := s.PrintTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(), tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err1 := s.PrintTree(nil, opts, 0)
 	if err1.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating visual tree output. Error: ", err1.Error())
 	}
@@ -1729,16 +1780,18 @@ func TestVisualOutputComponentLevelNestingInNestedComponentCombinationsInCompone
 		"I((violates [OR] does not comply)) with Bdir(regulations)}} [OR] Cac{A(Program Manager) I(has witnessed) " +
 		"Bdir,p(farmer's) Bdir(non-compliance) Cex(in the past)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -1750,8 +1803,7 @@ func TestVisualOutputComponentLevelNestingInNestedComponentCombinationsInCompone
 		t.Fatal("Too many statements identified: ", stmts)
 	}
 
-	output, err2 := stmts[0].PrintNodeTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(),
-		tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
 	if err2.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating node tree:", err2)
 	}
@@ -1792,16 +1844,18 @@ func TestVisualOutputNestedCombinationsComponentLevelNestingAndComponentPairs(t
 	// Statement with multi-level nesting, combinations as well as component pairs
 	text := "A(actor1) I(aim1) Cac{Cac{A(actor2) I(aim2)} [XOR] Cac{A(actor3) I(aim3)}} {Bdir(directobject1) Bind(indirectobject1) [OR] Bdir{ A(actor4) I(aim4) Bdir(directobject2) Cac{A(actor5) I(aim5)}} Bind(indirectobject2)}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -1813,8 +1867,7 @@ func TestVisualOutputNestedCombinationsComponentLevelNestingAndComponentPairs(t
 		t.Fatal("Too many statements identified: ", stmts)
 	}
 
-	output, err2 := stmts[0].PrintNodeTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(),
-		tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
 	if err2.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating node tree:", err2)
 	}
@@ -1855,16 +1908,18 @@ func TestVisualOutputMultiLevelComponentPair(t *testing.T) {
 	// Statement with multi-level nesting, combinations as well as component pairs
 	text := "{ A(actor1) I(aim1) [XOR] {A(actor2) I(aim2) [AND] A(actor3) I(aim3)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -1876,8 +1931,7 @@ func TestVisualOutputMultiLevelComponentPair(t *testing.T) {
 		t.Fatal("Too many statements identified: ", stmts)
 	}
 
-	output, err2 := stmts[0].PrintNodeTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(),
-		tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
 	if err2.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating node tree:", err2)
 	}
@@ -1918,16 +1972,18 @@ func TestVisualOutputComplexNestedCombinationsWithComponentPairs(t *testing.T) {
 	// Statement with multi-level nesting, combinations as well as component pairs
 	text := "{Cac{Cac{A(actor1) I(aim1) Bdir(object1)} [AND] Cac{A(actor2) I(aim2) Bdir(object2)} [AND] Cac{A(actor4) I(aim4)}} [OR] Cac{Cac{A(actor3) I(aim3) Bdir(object3)} [XOR] Cac{Cac{A(actor6) I(aim6) Bdir(object6)} [AND] Cac{Cac{A(actor7) I(aim7) Bdir(object7)} [XOR] Cac{A(actor8) I(aim8)}}}}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -1939,8 +1995,7 @@ func TestVisualOutputComplexNestedCombinationsWithComponentPairs(t *testing.T) {
 		t.Fatal("Too many statements identified: ", stmts)
 	}
 
-	output, err2 := stmts[0].PrintNodeTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(),
-		tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
 	if err2.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating node tree:", err2)
 	}
@@ -1981,18 +2036,20 @@ func TestVisualOutputComponentPairsInNestedComponents(t *testing.T) {
 	// Statement with multi-level nesting, combinations as well as component pairs
 	text := "A(Individuals) D(must) { I(monitor) Bdir(compliance) [AND] I(report) Bdir(violation) } Cac(in the case of (repeated offense [OR] other reasons)) O{ A(actor2) D(must) {I(enforce) Bdir(compliance) [OR] I(delegate) Bdir(enforcement)}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Deactivate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(false)
+	opts.IncludeSharedElementsInVisualOutput = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -2004,8 +2061,7 @@ func TestVisualOutputComponentPairsInNestedComponents(t *testing.T) {
 		t.Fatal("Too many statements identified: ", stmts)
 	}
 
-	output, err2 := stmts[0].PrintNodeTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(),
-		tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
 	if err2.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating node tree:", err2)
 	}
@@ -2053,18 +2109,20 @@ func TestVisualOutputDegreeOfVariabilityComponentAndStatementCombinationsWithout
 		"Cac{Cac{A(actor2) I(aim2)} [XOR] Cac{A(actor3) I(aim3)}} " +
 		"Cex(for compliance with the (Act [XOR] regulations in this part))."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(true)
+	opts.IncludeDegreeOfVariability = true
 	// Deactivate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(false)
+	opts.IncludeSharedElementsInVisualOutput = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -2076,8 +2134,7 @@ func TestVisualOutputDegreeOfVariabilityComponentAndStatementCombinationsWithout
 		t.Fatal("Too many statements identified: ", stmts)
 	}
 
-	output, err2 := stmts[0].PrintNodeTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(),
-		tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
 	if err2.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating node tree:", err2)
 	}
@@ -2125,18 +2182,20 @@ func TestVisualOutputDegreeOfVariabilityComponentAndStatementCombinationsWithSha
 		"Cac{Cac{A(actor2) I(aim2)} [XOR] Cac{A(actor3) I(aim3)}} " +
 		"Cex(for compliance with the (Act [XOR] regulations in this part))."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(true)
+	opts.IncludeDegreeOfVariability = true
 	// Activate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(true)
+	opts.IncludeSharedElementsInVisualOutput = true
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -2148,8 +2207,7 @@ func TestVisualOutputDegreeOfVariabilityComponentAndStatementCombinationsWithSha
 		t.Fatal("Too many statements identified: ", stmts)
 	}
 
-	output, err2 := stmts[0].PrintNodeTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(),
-		tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
 	if err2.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating node tree:", err2)
 	}
@@ -2196,18 +2254,20 @@ func TestVisualOutputDegreeOfVariabilityComponentPairCombinations(t *testing.T)
 		"{Cac{A(actor2) I(aim2)} [XOR] Cac{A(actor3) I(aim3)}} " +
 		"Cex(for compliance with the (Act [XOR] regulations in this part))."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(true)
+	opts.IncludeDegreeOfVariability = true
 	// Deactivate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(false)
+	opts.IncludeSharedElementsInVisualOutput = false
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -2219,8 +2279,7 @@ func TestVisualOutputDegreeOfVariabilityComponentPairCombinations(t *testing.T)
 		t.Fatal("Too many statements identified: ", stmts)
 	}
 
-	output, err2 := stmts[0].PrintNodeTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(),
-		tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
 	if err2.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating node tree:", err2)
 	}
@@ -2273,18 +2332,20 @@ func TestVisualOutputNestedPropertiesIncludingComponentPairsAndNestedPropertyAnd
 		// nested property
 		"P1,p{where E(date) F(is defined) in the P(Gregorian calendar)}."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Activate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(true)
+	opts.IncludeSharedElementsInVisualOutput = true
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -2296,8 +2357,7 @@ func TestVisualOutputNestedPropertiesIncludingComponentPairsAndNestedPropertyAnd
 		t.Fatal("Too many statements identified: ", stmts)
 	}
 
-	output, err2 := stmts[0].PrintNodeTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(),
-		tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
 	if err2.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating node tree:", err2)
 	}
@@ -2350,18 +2410,20 @@ func TestVisualOutputNestedPropertiesIncludingComponentPairsAndNestedPropertyAnd
 		// nested property
 		"P1,p{where E(date) F(is defined) in the P(Gregorian calendar)}."
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing -- the key difference to the preceding test
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Activate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(true)
+	opts.IncludeSharedElementsInVisualOutput = true
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -2373,8 +2435,7 @@ func TestVisualOutputNestedPropertiesIncludingComponentPairsAndNestedPropertyAnd
 		t.Fatal("Too many statements identified: ", stmts)
 	}
 
-	output, err2 := stmts[0].PrintNodeTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(),
-		tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
 	if err2.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating node tree:", err2)
 	}
@@ -2418,18 +2479,20 @@ func TestVisualOutputNestedStatementLevelAnnotations(t *testing.T) {
 		"Bdir[object2Annotation](object2) [XOR] A(actor3) I(act3)[inner statement3] Bdir[object3Annotation](object3)} " +
 		"[AND] A(actor4) I(act4) Bdir[object4Annotation](object4) [inner statement4] } } [statement-level annotation2]"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Activate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(true)
+	opts.IncludeSharedElementsInVisualOutput = true
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -2441,8 +2504,7 @@ func TestVisualOutputNestedStatementLevelAnnotations(t *testing.T) {
 		t.Fatal("Too many statements identified: ", stmts)
 	}
 
-	output, err2 := stmts[0].PrintNodeTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(),
-		tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
 	if err2.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating node tree:", err2)
 	}
@@ -2491,18 +2553,20 @@ func TestVisualOutputNestedStatementLevelAnnotationsImplicitAndNestedComponent(t
 		// implicitly AND-linked component (note: [inner statement5] is not extracted)
 		"Cac[condition1]{ A(actor5) I(act5) [inner statement5] Bdir(object5) }"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Activate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(true)
+	opts.IncludeSharedElementsInVisualOutput = true
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -2514,8 +2578,7 @@ func TestVisualOutputNestedStatementLevelAnnotationsImplicitAndNestedComponent(t
 		t.Fatal("Too many statements identified: ", stmts)
 	}
 
-	output, err2 := stmts[0].PrintNodeTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(),
-		tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
 	if err2.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating node tree:", err2)
 	}
@@ -2566,18 +2629,20 @@ func TestVisualOutputNestedStatementLevelAnnotationsImplicitAndNestedComponentAn
 		// separate Or else with nested combination
 		"O[orElse1]{{ A(actor6) [orElse2] I(act6) Bdir(object6) [XOR] A(actor7) I(act7) Bdir(object7) [orElse3]}}"
 
+	// Output options
+	opts := tree.DefaultOptions()
 	// Deactivate annotations
-	tabular.SetIncludeAnnotations(false)
+	opts.IncludeAnnotations = false
 	// Deactivate flat printing
-	tree.SetFlatPrinting(false)
+	opts.FlatPrinting = false
 	// Deactivate binary tree printing
-	tree.SetBinaryPrinting(false)
+	opts.BinaryPrinting = false
 	// Deactivate moving of activation conditions
-	tree.SetMoveActivationConditionsToFront(false)
+	opts.MoveActivationConditionsToFront = false
 	// Deactivate DoV
-	tabular.SetIncludeDegreeOfVariability(false)
+	opts.IncludeDegreeOfVariability = false
 	// Activate shared elements
-	tree.SetIncludeSharedElementsInVisualOutput(true)
+	opts.IncludeSharedElementsInVisualOutput = true
 
 	// Parse statement
 	stmts, err := parser.ParseStatement(text)
@@ -2589,8 +2654,7 @@ func TestVisualOutputNestedStatementLevelAnnotationsImplicitAndNestedComponentAn
 		t.Fatal("Too many statements identified: ", stmts)
 	}
 
-	output, err2 := stmts[0].PrintNodeTree(nil, tree.FlatPrinting(), tree.BinaryPrinting(), tabular.IncludeAnnotations(),
-		tabular.IncludeDegreeOfVariability(), tree.MoveActivationConditionsToFront(), 0)
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
 	if err2.ErrorCode != tree.TREE_NO_ERROR {
 		t.Fatal("Error when generating node tree:", err2)
 	}
@@ -2622,3 +2686,198 @@ func TestVisualOutputNestedStatementLevelAnnotationsImplicitAndNestedComponentAn
 	}
 
 }
+
+/*
+Tests visual output for degree of variability with binary and unary negations on component and nested statement level.
+*/
+func TestVisualOutputDegreeOfVariabilityNegations(t *testing.T) {
+
+	// Statement with binary and unary negations
+	text := "A(certifying agent) D(must) I((inspect [NOT] certify)) " +
+		"Bdir(([NOT] certified) operations) " +
+		"Cac{Cac{A(actor2) I(aim2)} [XOR] Cac{[NOT] A(actor3) I(aim3)}}"
+
+	// Output options
+	opts := tree.DefaultOptions()
+	// Deactivate annotations
+	opts.IncludeAnnotations = false
+	// Deactivate flat printing
+	opts.FlatPrinting = false
+	// Deactivate binary tree printing
+	opts.BinaryPrinting = false
+	// Deactivate moving of activation conditions
+	opts.MoveActivationConditionsToFront = false
+	// Activate DoV
+	opts.IncludeDegreeOfVariability = true
+	// Deactivate shared elements
+	opts.IncludeSharedElementsInVisualOutput = false
+
+	// Parse statement
+	stmts, err := parser.ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during parsing of statement", err.Error())
+	}
+
+	if len(stmts) > 1 {
+		t.Fatal("Too many statements identified: ", stmts)
+	}
+
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
+	if err2.ErrorCode != tree.TREE_NO_ERROR {
+		t.Fatal("Error when generating node tree:", err2)
+	}
+
+	outputString := output
+
+	fmt.Println("Generated output: " + outputString)
+
+	// Read reference file
+	content, err3 := os.ReadFile("TestOutputVisualDegreeOfVariabilityNegations.test")
+	if err3 != nil {
+		t.Fatal("Error attempting to read test text input. Error: ", err3.Error())
+	}
+
+	// Extract expected output
+	expectedOutput := string(content)
+
+	fmt.Println("Output:", output)
+
+	// Compare to actual output
+	if outputString != expectedOutput {
+		fmt.Println("Produced output:\n", outputString)
+		fmt.Println("Expected output:\n", expectedOutput)
+		err4 := tabular.WriteToFile("errorOutput.error", outputString, true)
+		if err4 != nil {
+			t.Fatal("Error attempting to read test text input. Error: ", err4.Error())
+		}
+		t.Fatal("Output generation is wrong for given input statement. Wrote output to 'errorOutput.error'")
+	}
+
+}
+
+/*
+Tests visual output including statement types for statement and nested statements.
+*/
+func TestVisualOutputStatementType(t *testing.T) {
+
+	// Regulative statement with nested constitutive statement (i.e., hybrid) and regulative activation condition
+	text := "A(farmer) D(must) I(comply) Bdir{E(rule) F(is) P(valid)} Cac{A(inspector) I(approves)}"
+
+	// Output options
+	opts := tree.DefaultOptions()
+	// Deactivate annotations
+	opts.IncludeAnnotations = false
+	// Deactivate flat printing
+	opts.FlatPrinting = false
+	// Deactivate binary tree printing
+	opts.BinaryPrinting = false
+	// Deactivate moving of activation conditions
+	opts.MoveActivationConditionsToFront = false
+	// Deactivate DoV
+	opts.IncludeDegreeOfVariability = false
+	// Deactivate shared elements
+	opts.IncludeSharedElementsInVisualOutput = false
+	// Activate statement type
+	opts.IncludeStatementType = true
+
+	// Parse statement
+	stmts, err := parser.ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during parsing of statement", err.Error())
+	}
+
+	if len(stmts) > 1 {
+		t.Fatal("Too many statements identified: ", stmts)
+	}
+
+	output, err2 := stmts[0].PrintNodeTree(nil, opts, 0)
+	if err2.ErrorCode != tree.TREE_NO_ERROR {
+		t.Fatal("Error when generating node tree:", err2)
+	}
+
+	outputString := output
+
+	// Read reference file
+	content, err3 := os.ReadFile("TestOutputVisualStatementType.test")
+	if err3 != nil {
+		t.Fatal("Error attempting to read test text input. Error: ", err3.Error())
+	}
+
+	// Extract expected output
+	expectedOutput := string(content)
+
+	// Compare to actual output
+	if outputString != expectedOutput {
+		fmt.Println("Produced output:\n", outputString)
+		fmt.Println("Expected output:\n", expectedOutput)
+		err4 := tabular.WriteToFile("errorOutput.error", outputString, true)
+		if err4 != nil {
+			t.Fatal("Error attempting to read test text input. Error: ", err4.Error())
+		}
+		t.Fatal("Output generation is wrong for given input statement. Wrote output to 'errorOutput.error'")
+	}
+
+}
+
+/*
+Tests the visual diff output of two encodings of a statement, with added, removed and changed nodes being marked.
+*/
+func TestVisualOutputDiff(t *testing.T) {
+
+	base := "A(farmer) D(must) I(sell) Bdir(goods) Cac(at night)"
+	revised := "A(farmer) D(may) I((sell [XOR] buy)) Bdir(goods) Cac{A(inspector) I(approves)}"
+
+	// Output options
+	opts := tree.DefaultOptions()
+	// Deactivate annotations
+	opts.IncludeAnnotations = false
+	// Deactivate flat printing
+	opts.FlatPrinting = false
+	// Deactivate binary tree printing
+	opts.BinaryPrinting = false
+	// Deactivate moving of activation conditions
+	opts.MoveActivationConditionsToFront = false
+	// Deactivate DoV
+	opts.IncludeDegreeOfVariability = false
+
+	// Parse statements
+	baseStmts, err := parser.ParseStatement(base)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during parsing of statement", err.Error())
+	}
+	revisedStmts, err := parser.ParseStatement(revised)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during parsing of statement", err.Error())
+	}
+
+	// Compare encodings and mark nodes
+	diff.MarkNodes(diff.Compare(baseStmts[0], revisedStmts[0]))
+
+	output, err2 := tree.PrintDiffTree(baseStmts[0], revisedStmts[0], opts)
+	if err2.ErrorCode != tree.TREE_NO_ERROR {
+		t.Fatal("Error when generating node tree:", err2)
+	}
+
+	outputString := output
+
+	// Read reference file
+	content, err3 := os.ReadFile("TestOutputVisualDiff.test")
+	if err3 != nil {
+		t.Fatal("Error attempting to read test text input. Error: ", err3.Error())
+	}
+
+	// Extract expected output
+	expectedOutput := string(content)
+
+	// Compare to actual output
+	if outputString != expectedOutput {
+		fmt.Println("Produced output:\n", outputString)
+		fmt.Println("Expected output:\n", expectedOutput)
+		err4 := tabular.WriteToFile("errorOutput.error", outputString, true)
+		if err4 != nil {
+			t.Fatal("Error attempting to read test text input. Error: ", err4.Error())
+		}
+		t.Fatal("Output generation is wrong for given input statement. Wrote output to 'errorOutput.error'")
+	}
+
+}
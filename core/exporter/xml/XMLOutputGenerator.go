@@ -0,0 +1,142 @@
+package xml
+
+import (
+	"IG-Parser/core/exporter/json"
+	"IG-Parser/core/tree"
+	"bytes"
+	"encoding/xml"
+	"reflect"
+)
+
+/*
+This file contains the generation of XML output from parsed statements. The output is derived from the JSON
+representation of the statements (see json.GenerateJSONDocument()), and thus maps the components of tree.Statement
+to elements, represents combinations alongside their logical operators, retains annotations and suffix-based
+private property links, recursively embeds nested statements, and references nodes occurring multiple times
+in the tree by ID. The output conforms to the XML Schema provided in IGStatementSchema.xsd (see #Schema()).
+*/
+
+/*
+Generates XML output for statements returned by parser.ParseStatement(). The statement ID and IG Script input
+are optional and only included in the output if not empty.
+Returns indented XML output (including XML declaration), and error (defaults to tree.PARSING_NO_ERROR).
+*/
+func GenerateXMLOutputFromParsedStatements(stmts []*tree.Node, stmtId string, igScriptInput string) (string, tree.ParsingError) {
+
+	jsonDoc, err := json.GenerateJSONDocument(stmts, stmtId, igScriptInput)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return "", err
+	}
+
+	doc := &XMLDocument{
+		Namespace:     SCHEMA_NAMESPACE,
+		SchemaVersion: SCHEMA_VERSION,
+		StatementId:   jsonDoc.StatementId,
+		IgScript:      jsonDoc.IgScript,
+	}
+	for _, stmt := range jsonDoc.Statements {
+		doc.Statements = append(doc.Statements, generateXMLNode(stmt))
+	}
+
+	buffer := bytes.Buffer{}
+	buffer.WriteString(xml.Header)
+	encoder := xml.NewEncoder(&buffer)
+	encoder.Indent("", "  ")
+	if err2 := encoder.Encode(doc); err2 != nil {
+		return "", tree.ParsingError{ErrorCode: tree.PARSING_ERROR_XML_ENCODING,
+			ErrorMessage: "Error when encoding XML output: " + err2.Error()}
+	}
+	buffer.WriteString("\n")
+
+	return buffer.String(), tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Generates XML representation of a given JSON node, including all child nodes, nested statements and private nodes.
+Returns nil for nil nodes.
+*/
+func generateXMLNode(jsonNode *json.JSONNode) *XMLNode {
+
+	if jsonNode == nil {
+		return nil
+	}
+
+	xmlNode := &XMLNode{
+		Id:            jsonNode.Id,
+		Ref:           jsonNode.Ref,
+		ComponentType: jsonNode.ComponentType,
+		Suffix:        jsonNode.Suffix,
+		Annotations:   jsonNode.Annotations,
+		Entry:         jsonNode.Entry,
+		Statement:     generateXMLStatement(jsonNode.Statement),
+		Statements:    generateXMLNodeCollection(jsonNode.Statements),
+		PrivateNodes:  generateXMLNodeCollection(jsonNode.PrivateNodes),
+	}
+
+	// Convert combination
+	if jsonNode.LogicalOperator != "" && jsonNode.Right != nil {
+		xmlNode.Combination = &XMLCombination{
+			LogicalOperator: jsonNode.LogicalOperator,
+			SharedLeft:      generateXMLSharedElements(jsonNode.SharedLeft),
+			Left:            generateXMLNode(jsonNode.Left),
+			Right:           generateXMLNode(jsonNode.Right),
+			SharedRight:     generateXMLSharedElements(jsonNode.SharedRight),
+		}
+	}
+
+	return xmlNode
+}
+
+/*
+Generates XML representation of a given JSON statement (including all components). Components are assigned
+to the equally named fields of XMLStatement. Returns nil for nil statements.
+*/
+func generateXMLStatement(jsonStmt *json.JSONStatement) *XMLStatement {
+
+	if jsonStmt == nil {
+		return nil
+	}
+
+	xmlStmt := &XMLStatement{}
+
+	jsonValue := reflect.ValueOf(jsonStmt).Elem()
+	xmlValue := reflect.ValueOf(xmlStmt).Elem()
+	for i := 0; i < jsonValue.NumField(); i++ {
+		xmlNode := generateXMLNode(jsonValue.Field(i).Interface().(*json.JSONNode))
+		xmlValue.FieldByName(jsonValue.Type().Field(i).Name).Set(reflect.ValueOf(xmlNode))
+	}
+
+	return xmlStmt
+}
+
+/*
+Generates XML representation of a collection of JSON nodes (e.g., extrapolated statements or private nodes).
+Returns nil for empty collections (in order to omit the element in the output).
+*/
+func generateXMLNodeCollection(jsonNodes []*json.JSONNode) *XMLNodeCollection {
+	if len(jsonNodes) == 0 {
+		return nil
+	}
+	collection := &XMLNodeCollection{}
+	for _, v := range jsonNodes {
+		collection.Nodes = append(collection.Nodes, generateXMLNode(v))
+	}
+	return collection
+}
+
+/*
+Generates XML representation of shared elements, ignoring empty elements (e.g., resulting from combinations
+without shared elements). Returns nil if no non-empty elements exist (in order to omit the element in the output).
+*/
+func generateXMLSharedElements(elements []string) *XMLSharedElements {
+	var shared *XMLSharedElements
+	for _, v := range elements {
+		if v != "" {
+			if shared == nil {
+				shared = &XMLSharedElements{}
+			}
+			shared.Elements = append(shared.Elements, v)
+		}
+	}
+	return shared
+}
@@ -0,0 +1,144 @@
+package xml
+
+import (
+	"IG-Parser/core/exporter/json"
+	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+/*
+Tests XML output for statement with component combinations, shared elements, suffices, annotations,
+private properties and nested statements.
+*/
+func TestXMLOutputBasicStatement(t *testing.T) {
+
+	text := "A[role=enforcer](certifier) A1,p(accredited) A1(inspector) D(must) " +
+		"I(inspect (operations [AND] ([NOT] facilities)) regularly) Bdir(produce) " +
+		"Cac1[condition]{A(operator) I(applies)} [statement-level annotation]"
+
+	testXMLOutput(t, text, "TestOutputXMLBasicStatement.test")
+}
+
+/*
+Tests XML output for component pairs (i.e., combinations of extrapolated statements) and nested statement combinations.
+*/
+func TestXMLOutputComponentPairsAndNestedCombinations(t *testing.T) {
+
+	text := "A(actor) D(may) {I(sell) Bdir(goods) [XOR] I(buy) Bdir(services)} " +
+		"Cac{Cac{A(seller) I(is registered)} [OR] Cac{A(buyer) I(is licensed)}}"
+
+	testXMLOutput(t, text, "TestOutputXMLComponentPairsAndNestedCombinations.test")
+}
+
+/*
+Tests XML output for constitutive statement with shared elements, escaping of special characters and or else.
+*/
+func TestXMLOutputConstitutiveStatement(t *testing.T) {
+
+	text := "E(Program Manager) F(is) P((approved [AND] certified) <by> \"the Secretary\") " +
+		"Cac{E(Program Manager) F(is) P(qualified)} O{A(enforcer) D(must) I(sanction)}"
+
+	testXMLOutput(t, text, "TestOutputXMLConstitutiveStatement.test")
+}
+
+/*
+Parses given IG Script input, compares generated XML output with the content of the given file,
+and validates the output against the XML Schema.
+*/
+func testXMLOutput(t *testing.T, text string, filename string) {
+
+	stmts, err := parser.ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during parsing of statement", err.Error())
+	}
+
+	output, err := GenerateXMLOutputFromParsedStatements(stmts, "123", text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Error during XML output generation:", err.Error())
+	}
+
+	// Read reference file
+	content, err2 := os.ReadFile(filename)
+	if err2 != nil {
+		t.Fatal("Error attempting to read test text input. Error: ", err2.Error())
+	}
+
+	// Extract expected output
+	expectedOutput := string(content)
+
+	// Compare to actual output
+	if output != expectedOutput {
+		fmt.Println("Produced output:\n", output)
+		fmt.Println("Expected output:\n", expectedOutput)
+		err3 := tabular.WriteToFile("errorOutput.error", output, true)
+		if err3 != nil {
+			t.Fatal("Error attempting to read test text input. Error: ", err3.Error())
+		}
+		t.Fatal("Output generation is wrong for given input statement. Wrote output to 'errorOutput.error'")
+	}
+
+	validateAgainstSchema(t, output)
+}
+
+/*
+Validates given XML output against the XML Schema using xmllint (part of libxml2). Skips validation if xmllint
+is not installed (in which case the output is only checked against the expected output in #testXMLOutput()).
+*/
+func validateAgainstSchema(t *testing.T, output string) {
+
+	xmllint, err := exec.LookPath("xmllint")
+	if err != nil {
+		t.Skip("xmllint not available - skipping validation against XML Schema")
+	}
+
+	outputFile := filepath.Join(t.TempDir(), "output.xml")
+	if err2 := os.WriteFile(outputFile, []byte(output), 0644); err2 != nil {
+		t.Fatal("Error when writing XML output for validation:", err2)
+	}
+
+	result, err3 := exec.Command(xmllint, "--noout", "--schema", "IGStatementSchema.xsd", outputFile).CombinedOutput()
+	if err3 != nil {
+		t.Fatal("XML output does not validate against XML Schema:", string(result))
+	}
+}
+
+/*
+Tests whether the XML Schema reflects the schema version and all components of tree.Statement.
+*/
+func TestXMLSchemaConsistency(t *testing.T) {
+
+	if !strings.Contains(Schema(), "version=\""+SCHEMA_VERSION+"\"") {
+		t.Fatal("Schema does not reflect schema version " + SCHEMA_VERSION)
+	}
+
+	if !strings.Contains(Schema(), "targetNamespace=\""+SCHEMA_NAMESPACE+"\"") {
+		t.Fatal("Schema does not reflect namespace " + SCHEMA_NAMESPACE)
+	}
+
+	// Ensure that all statement components are covered by XML structure and schema
+	stmtType := reflect.TypeOf(XMLStatement{})
+	if reflect.TypeOf(tree.Statement{}).NumField() != stmtType.NumField() {
+		t.Fatal("XML statement does not reflect all components of tree.Statement")
+	}
+	// Ensure that XML statement can be generated from JSON statement (see #generateXMLStatement())
+	jsonStmtType := reflect.TypeOf(json.JSONStatement{})
+	for i := 0; i < jsonStmtType.NumField(); i++ {
+		if _, ok := stmtType.FieldByName(jsonStmtType.Field(i).Name); !ok {
+			t.Fatal("XML statement does not contain component", jsonStmtType.Field(i).Name, "of JSON statement")
+		}
+	}
+	for i := 0; i < stmtType.NumField(); i++ {
+		name := strings.Split(stmtType.Field(i).Tag.Get("xml"), ",")[0]
+		if !strings.Contains(Schema(), "<xs:element name=\""+name+"\" type=\"ig:node\" minOccurs=\"0\"/>") {
+			t.Fatal("Component", name, "is not defined in schema")
+		}
+	}
+}
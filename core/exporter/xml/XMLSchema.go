@@ -0,0 +1,17 @@
+package xml
+
+import (
+	_ "embed"
+)
+
+// XML Schema specifying the structure of the generated XML output
+//
+//go:embed IGStatementSchema.xsd
+var schema string
+
+/*
+Returns the XML Schema (see IGStatementSchema.xsd) the generated XML output conforms to.
+*/
+func Schema() string {
+	return schema
+}
@@ -0,0 +1,133 @@
+package xml
+
+import (
+	"encoding/xml"
+)
+
+/*
+This file contains the data structures for the XML representation of parsed statements.
+The structure mirrors tree.Statement and tree.Node and is specified in the accompanying XML Schema (IGStatementSchema.xsd).
+*/
+
+// Version of the XML Schema the generated output conforms to (to be incremented upon structural changes)
+const SCHEMA_VERSION = "1.0.0"
+
+// Target namespace of the XML Schema the generated output conforms to
+const SCHEMA_NAMESPACE = "https://github.com/chrfrantz/IG-Parser/core/exporter/xml"
+
+/*
+Root element holding the parsed statement(s) alongside metadata.
+*/
+type XMLDocument struct {
+	XMLName xml.Name `xml:"igStatements"`
+	// Namespace of XML Schema
+	Namespace string `xml:"xmlns,attr"`
+	// Version of XML Schema
+	SchemaVersion string `xml:"schemaVersion,attr"`
+	// Statement ID as provided by user (optional)
+	StatementId string `xml:"statementId,attr,omitempty"`
+	// IG Script input the statements have been parsed from (optional)
+	IgScript string `xml:"igScript,omitempty"`
+	// Parsed statements (as returned by parser.ParseStatement())
+	Statements []*XMLNode `xml:"institutionalStatement"`
+}
+
+/*
+XML representation of tree.Node. Nodes carry either entry (primitive value), statement (nested statement),
+statements (statements extrapolated from component pairs), or combination (logical combination of nodes).
+Nodes referenced multiple times in the tree (e.g., private nodes linked to multiple nodes) are only represented
+once (carrying id), and otherwise referenced by that ID (carrying ref only; see json.JSONNode).
+*/
+type XMLNode struct {
+	// ID of node referenced multiple times
+	Id string `xml:"id,attr,omitempty"`
+	// Reference to node represented elsewhere (by ID)
+	Ref string `xml:"ref,attr,omitempty"`
+	// Component type (as assigned during parsing; may be inherited from parent nodes if empty)
+	ComponentType string `xml:"componentType,attr,omitempty"`
+	// Suffix
+	Suffix string `xml:"suffix,attr,omitempty"`
+	// Annotations
+	Annotations string `xml:"annotations,attr,omitempty"`
+	// Primitive entry
+	Entry *string `xml:"entry,omitempty"`
+	// Nested statement entry
+	Statement *XMLStatement `xml:"statement,omitempty"`
+	// Statement collection entry (extrapolated from component pairs)
+	Statements *XMLNodeCollection `xml:"statements,omitempty"`
+	// Logical combination of child nodes
+	Combination *XMLCombination `xml:"combination,omitempty"`
+	// Private nodes linked to this node (e.g., private properties)
+	PrivateNodes *XMLNodeCollection `xml:"privateNodes,omitempty"`
+}
+
+/*
+Collection of nodes (e.g., extrapolated statements or private nodes).
+*/
+type XMLNodeCollection struct {
+	Nodes []*XMLNode `xml:"node"`
+}
+
+/*
+XML representation of a logical combination (left omitted for unary negations). Shared elements are
+reflected in reading order (i.e., left shared elements precede, right shared elements succeed the operands).
+*/
+type XMLCombination struct {
+	// Logical operator linking left and right child nodes
+	LogicalOperator string `xml:"logicalOperator,attr"`
+	// Elements shared across left and right children (left side)
+	SharedLeft *XMLSharedElements `xml:"sharedLeft,omitempty"`
+	// Left child node
+	Left *XMLNode `xml:"left,omitempty"`
+	// Right child node
+	Right *XMLNode `xml:"right"`
+	// Elements shared across left and right children (right side)
+	SharedRight *XMLSharedElements `xml:"sharedRight,omitempty"`
+}
+
+/*
+Elements shared across left and right children of a combination.
+*/
+type XMLSharedElements struct {
+	Elements []string `xml:"element"`
+}
+
+/*
+XML representation of tree.Statement. Each field corresponds to the equally named field in tree.Statement
+(and json.JSONStatement, which XMLStatement is generated from).
+Note: The element order is fixed by the XML Schema.
+*/
+type XMLStatement struct {
+	// Regulative Statement
+	Attributes                    *XMLNode `xml:"attributes,omitempty"`
+	AttributesPropertySimple      *XMLNode `xml:"attributesPropertySimple,omitempty"`
+	AttributesPropertyComplex     *XMLNode `xml:"attributesPropertyComplex,omitempty"`
+	Deontic                       *XMLNode `xml:"deontic,omitempty"`
+	Aim                           *XMLNode `xml:"aim,omitempty"`
+	DirectObject                  *XMLNode `xml:"directObject,omitempty"`
+	DirectObjectComplex           *XMLNode `xml:"directObjectComplex,omitempty"`
+	DirectObjectPropertySimple    *XMLNode `xml:"directObjectPropertySimple,omitempty"`
+	DirectObjectPropertyComplex   *XMLNode `xml:"directObjectPropertyComplex,omitempty"`
+	IndirectObject                *XMLNode `xml:"indirectObject,omitempty"`
+	IndirectObjectComplex         *XMLNode `xml:"indirectObjectComplex,omitempty"`
+	IndirectObjectPropertySimple  *XMLNode `xml:"indirectObjectPropertySimple,omitempty"`
+	IndirectObjectPropertyComplex *XMLNode `xml:"indirectObjectPropertyComplex,omitempty"`
+
+	// Constitutive Statement
+	ConstitutedEntity                     *XMLNode `xml:"constitutedEntity,omitempty"`
+	ConstitutedEntityPropertySimple       *XMLNode `xml:"constitutedEntityPropertySimple,omitempty"`
+	ConstitutedEntityPropertyComplex      *XMLNode `xml:"constitutedEntityPropertyComplex,omitempty"`
+	Modal                                 *XMLNode `xml:"modal,omitempty"`
+	ConstitutiveFunction                  *XMLNode `xml:"constitutiveFunction,omitempty"`
+	ConstitutingProperties                *XMLNode `xml:"constitutingProperties,omitempty"`
+	ConstitutingPropertiesComplex         *XMLNode `xml:"constitutingPropertiesComplex,omitempty"`
+	ConstitutingPropertiesPropertySimple  *XMLNode `xml:"constitutingPropertiesPropertySimple,omitempty"`
+	ConstitutingPropertiesPropertyComplex *XMLNode `xml:"constitutingPropertiesPropertyComplex,omitempty"`
+
+	// Shared Components
+	ActivationConditionSimple  *XMLNode `xml:"activationConditionSimple,omitempty"`
+	ActivationConditionComplex *XMLNode `xml:"activationConditionComplex,omitempty"`
+	ExecutionConstraintSimple  *XMLNode `xml:"executionConstraintSimple,omitempty"`
+	ExecutionConstraintComplex *XMLNode `xml:"executionConstraintComplex,omitempty"`
+	OrElse                     *XMLNode `xml:"orElse,omitempty"`
+}
@@ -0,0 +1,114 @@
+package formatter
+
+import (
+	"IG-Parser/core/exporter/json"
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"strings"
+)
+
+/*
+This file contains the formatting of IG Script-encoded statements into canonical IG Script. Statements are
+parsed and reprinted based on the parsed statement tree (see tree.Node.StringifyStatement()), which normalizes
+spacing around components, operators and braces, as well as the order of components. Optionally, nested statement
+combinations and component pairs are spread across multiple indented lines (see Layout.go).
+Formatting never changes the semantics of a statement: the formatted statement is reparsed and only returned
+if it produces a tree identical to the one of the input statement. Since formatted statements may span multiple
+lines, line breaks and tabs in the input are treated as whitespace (see #normalizeWhitespace()).
+*/
+
+// Default indentation per nesting level in multi-line output
+const DEFAULT_INDENT = "    "
+
+/*
+Formatting options.
+*/
+type Options struct {
+	// Indicates whether nested statement combinations and component pairs are spread across multiple lines
+	MultiLine bool
+	// Indentation per nesting level in multi-line output
+	Indent string
+}
+
+/*
+Returns default formatting options (single-line output).
+*/
+func DefaultOptions() Options {
+	return Options{MultiLine: false, Indent: DEFAULT_INDENT}
+}
+
+/*
+Formats a given IG Script-encoded statement into canonical IG Script based on the given options.
+Statements that cannot be parsed without errors or warnings are not formatted (since non-parsed content
+would be lost), in which case the parsing error (or warning) is returned, with positions of offending content
+referring to the lines of the given statement. Returns tree.PARSING_ERROR_FORMATTING
+if the formatted statement does not reproduce the parsed statement tree.
+Returns formatted statement, and error (defaults to tree.PARSING_NO_ERROR).
+*/
+func Format(coded string, opts Options) (string, tree.ParsingError) {
+
+	stmts, err := parser.ParseStatement(normalizeWhitespace(coded))
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		// Offending content is located in normalized input, but lines and columns refer to the given statement
+		for i, span := range err.ErrorSpans {
+			err.ErrorSpans[i] = tree.NewSourceSpan(coded, span.Offset, span.EndOffset)
+		}
+		return "", err
+	}
+
+	elements := []string{}
+	for _, stmt := range stmts {
+		elements = append(elements, stmt.StringifyStatement())
+	}
+	formatted := strings.Join(elements, " ")
+	Println("Canonical statement:", formatted)
+
+	if opts.MultiLine {
+		formatted = layout(formatted, opts.Indent)
+		Println("Multi-line statement:\n" + formatted)
+	}
+
+	// Ensure that formatting retains the parsed statement tree
+	if err := verify(stmts, formatted); err.ErrorCode != tree.PARSING_NO_ERROR {
+		return "", err
+	}
+
+	return formatted, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Verifies that parsing a formatted statement produces the same statement tree as the given one, using the
+JSON representation of both trees for comparison (see json.GenerateJSONOutputFromParsedStatements()).
+Returns tree.PARSING_ERROR_FORMATTING if trees differ or the formatted statement cannot be parsed.
+*/
+func verify(stmts []*tree.Node, formatted string) tree.ParsingError {
+
+	reparsed, err := parser.ParseStatement(normalizeWhitespace(formatted))
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return tree.ParsingError{ErrorCode: tree.PARSING_ERROR_FORMATTING,
+			ErrorMessage: "Formatted statement cannot be parsed (Error: " + err.ErrorCode + "). Formatted statement: '" + formatted + "'"}
+	}
+
+	original, err := json.GenerateJSONOutputFromParsedStatements(stmts, "", "")
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return err
+	}
+	result, err := json.GenerateJSONOutputFromParsedStatements(reparsed, "", "")
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		return err
+	}
+	if original != result {
+		return tree.ParsingError{ErrorCode: tree.PARSING_ERROR_FORMATTING,
+			ErrorMessage: "Formatted statement does not reproduce input statement. Formatted statement: '" + formatted + "'"}
+	}
+
+	return tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Replaces line breaks and tabs in statement with spaces (retaining the byte offsets of all content),
+so that statements spread across multiple lines are parsed like single-line statements.
+*/
+func normalizeWhitespace(coded string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ", "\t", " ").Replace(coded)
+}
@@ -0,0 +1,163 @@
+package formatter
+
+import (
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"testing"
+)
+
+/*
+Tests normalization of spacing around components, operators and braces.
+*/
+func TestFormatNormalizesSpacing(t *testing.T) {
+
+	input := "A(farmer)   D(must)  I((comply  [AND]   report)) Cac{  Cac{A(x) I(y)}[XOR]Cac{A(z)   I(w)}}"
+
+	output, err := Format(input, DefaultOptions())
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Formatting should not fail. Error:", err)
+	}
+	expected := "A(farmer) D(must) I((comply [AND] report)) Cac{Cac{A(x) I(y)} [XOR] Cac{A(z) I(w)}}"
+	if output != expected {
+		t.Fatal("Formatted statement is incorrect:", output)
+	}
+}
+
+/*
+Tests formatting of component pairs with shared components (including component pairs embedded in nested
+statements), as well as of private properties that are combinations.
+*/
+func TestFormatComponentPairsAndPrivatePropertyCombinations(t *testing.T) {
+
+	inputs := map[string]string{
+		"A(actor) D(may) {I(leftAim) Bdir(obj1) [OR] I(rightAim) Bdir(obj2)} Cac{ {A(actor2) I(aim2 [XOR] aim4) [XOR] A(actor3) I(aim3)} }": "" +
+			"A(actor) D(may) Cac{{A(actor2) I((aim2 [XOR] aim4)) [XOR] A(actor3) I(aim3)}} {I(leftAim) Bdir(obj1) [OR] I(rightAim) Bdir(obj2)}",
+		"Bdir1((left [OR] right)) Bdir1,p((private [AND] public)) I(x)": "" +
+			"I(x) Bdir1((left [OR] right)) Bdir1,p(private) Bdir1,p(public)",
+	}
+
+	for input, expected := range inputs {
+		output, err := Format(input, DefaultOptions())
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			t.Fatal("Formatting of '"+input+"' should not fail. Error:", err)
+		}
+		if output != expected {
+			t.Fatal("Formatted statement is incorrect:", output)
+		}
+	}
+}
+
+/*
+Tests multi-line output for nested statement combinations (including suffix and annotation) and component pairs.
+*/
+func TestFormatMultiLine(t *testing.T) {
+
+	inputs := map[string]string{
+		"A(actor) I(act) Cac1[ctx=time]{Cac{A(a) I(b)} [XOR] {Cac{A(c) I(d)} [AND] Cac{A(e) I(f)}}} Cex{A(x) I(y)}": "" +
+			"A(actor) I(act) Cac1[ctx=time]{\n" +
+			"  Cac{A(a) I(b)}\n" +
+			"  [XOR]\n" +
+			"  {\n" +
+			"    Cac{A(c) I(d)}\n" +
+			"    [AND]\n" +
+			"    Cac{A(e) I(f)}\n" +
+			"  }\n" +
+			"} Cex{A(x) I(y)}",
+		"A(actor) I(act) {Bdir(goods) [OR] Bdir(services)}": "" +
+			"A(actor) I(act) {\n" +
+			"  Bdir(goods)\n" +
+			"  [OR]\n" +
+			"  Bdir(services)\n" +
+			"}",
+	}
+
+	for input, expected := range inputs {
+		output, err := Format(input, Options{MultiLine: true, Indent: "  "})
+		if err.ErrorCode != tree.PARSING_NO_ERROR {
+			t.Fatal("Formatting should not fail. Error:", err)
+		}
+		if output != expected {
+			t.Fatal("Formatted statement is incorrect:\n" + output)
+		}
+	}
+}
+
+/*
+Tests formatting of statements spread across multiple lines (including tabs and Windows line breaks), and that
+positions of parsing errors refer to the lines of the input statement.
+*/
+func TestFormatMultiLineInput(t *testing.T) {
+
+	input := "A(farmer) D(must)\n\tI(comply) Cac{\r\n    Cac{A(x) I(y)}\n    [XOR]\n    Cac{A(z) I(w)}\n}"
+	output, err := Format(input, DefaultOptions())
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Formatting should not fail. Error:", err)
+	}
+	expected := "A(farmer) D(must) I(comply) Cac{Cac{A(x) I(y)} [XOR] Cac{A(z) I(w)}}"
+	if output != expected {
+		t.Fatal("Formatted statement is incorrect:", output)
+	}
+
+	_, err = Format("A(farmer) D(must)\nI(comply) Cac{A(x)\nI(y)", DefaultOptions())
+	if err.ErrorCode != tree.PARSING_ERROR_IMBALANCED_PARENTHESES {
+		t.Fatal("Formatting should fail on imbalanced parentheses. Error:", err)
+	}
+	if len(err.ErrorSpans) == 0 || err.ErrorSpans[0].Line != 2 || err.ErrorSpans[0].Column != 14 {
+		t.Fatal("Position of parsing error should refer to line of input statement:", err.ErrorSpans)
+	}
+}
+
+/*
+Tests that formatting is idempotent, and that single-line and multi-line output can be converted into each other.
+*/
+func TestFormatIdempotence(t *testing.T) {
+
+	inputs := []string{
+		"A(farmer) D(must) I(comply)",
+		"A,p(certified) A(farmer) Bdir([NOT] goods) Cac{[NOT] A(x) I(y)} [stmt=annotation]",
+		"A(x) I(y) Cac{A(a) I(b) Cac{Cac{A(c) I(d)} [OR] Cac{A(e) I(f)}}}",
+		"A(x) I((sell [XOR] buy) goods (from [AND] to) customers)",
+		"A(actor) D(may) {I(leftAim) Bdir(obj1) [OR] I(rightAim) Bdir(obj2)} Cac{ {A(actor2) I(aim2 [XOR] aim4) [XOR] A(actor3) I(aim3)} }",
+		"Bdir1((left [OR] right)) Bdir1,p((private [AND] public)) I(x)",
+	}
+
+	for _, input := range inputs {
+		for _, opts := range []Options{DefaultOptions(), {MultiLine: true, Indent: DEFAULT_INDENT}} {
+			first, err := Format(input, opts)
+			if err.ErrorCode != tree.PARSING_NO_ERROR {
+				t.Fatal("Formatting of '"+input+"' should not fail. Error:", err)
+			}
+			second, err := Format(first, opts)
+			if err.ErrorCode != tree.PARSING_NO_ERROR || first != second {
+				t.Fatal("Formatting is not idempotent for '" + input + "': '" + first + "' vs. '" + second + "'")
+			}
+			singleLine, err := Format(first, DefaultOptions())
+			expected, _ := Format(input, DefaultOptions())
+			if err.ErrorCode != tree.PARSING_NO_ERROR || singleLine != expected {
+				t.Fatal("Reformatting into single line is incorrect for '" + input + "': " + singleLine)
+			}
+		}
+	}
+}
+
+/*
+Tests that statements with errors or non-parsed content are not formatted.
+*/
+func TestFormatInvalidStatements(t *testing.T) {
+
+	_, err := Format("A(farmer) D(must) I(comply", DefaultOptions())
+	if err.ErrorCode != tree.PARSING_ERROR_IMBALANCED_PARENTHESES {
+		t.Fatal("Formatting should fail with parsing error, but returned", err.ErrorCode)
+	}
+
+	_, err = Format("A(farmer) D(must) I(comply) (remark)", DefaultOptions())
+	if err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		t.Fatal("Formatting should fail for non-parsed content, but returned", err.ErrorCode)
+	}
+
+	// Formatted statements that deviate from the input statement are rejected
+	stmts, _ := parser.ParseStatement("A(farmer) D(must) I(comply)")
+	if err := verify(stmts, "A(farmer) D(may) I(comply)"); err.ErrorCode != tree.PARSING_ERROR_FORMATTING {
+		t.Fatal("Deviating statement should be rejected, but returned", err.ErrorCode)
+	}
+}
@@ -0,0 +1,228 @@
+package formatter
+
+import (
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"strings"
+)
+
+/*
+This file contains the multi-line layout of canonical IG Script (as generated by tree.Node.StringifyStatement()).
+Braced expressions that combine elements by logical operators (i.e., nested statement combinations and component
+pairs), as well as braced expressions that contain such combinations, are spread across multiple lines, with each
+combined element and operator on a separate line, indented by nesting level, e.g.,
+
+	A(actor) I(act) Cac{
+	    Cac{A(actor1) I(act1)}
+	    [XOR]
+	    Cac{A(actor2) I(act2)}
+	}
+
+All other content (e.g., components and individual nested statements) is retained on a single line.
+*/
+
+/*
+Element of canonical IG Script with respect to layout.
+*/
+type layoutItem struct {
+	// Content of text element, operator (e.g., '[XOR]') or header of braced expression (e.g., 'Cac1[annotation]')
+	text string
+	// Indicates whether item is logical operator linking braced elements
+	operator bool
+	// Indicates whether item is braced expression
+	braced bool
+	// Braced expression as contained in input (for single-line output)
+	raw string
+	// Elements of braced expression
+	children []layoutItem
+}
+
+/*
+Spreads canonical IG Script across multiple lines using the given indentation per nesting level.
+*/
+func layout(canonical string, indent string) string {
+	w := &layoutWriter{indent: indent}
+	w.write(parseLayoutItems(canonical), 0)
+	w.newline()
+	return strings.Join(w.lines, "\n")
+}
+
+/*
+Decomposes canonical IG Script into text elements, operators and braced expressions (including their elements).
+Braces and brackets within component content (i.e., parentheses) and annotations are not considered.
+*/
+func parseLayoutItems(text string) []layoutItem {
+	items := []layoutItem{}
+	// Start of pending text element
+	start := 0
+	flush := func(end int) {
+		if content := strings.TrimSpace(text[start:end]); content != "" {
+			items = append(items, layoutItem{text: content})
+		}
+	}
+	parentheses := 0
+	for i := 0; i < len(text); i++ {
+		switch string(text[i]) {
+		case parser.LEFT_PARENTHESIS:
+			parentheses++
+		case parser.RIGHT_PARENTHESIS:
+			parentheses--
+		case tree.LEFT_BRACKET:
+			if parentheses > 0 {
+				continue
+			}
+			end := matchingClosingSymbol(text, i, tree.LEFT_BRACKET, tree.RIGHT_BRACKET)
+			// Operators are separated from preceding content (as opposed to annotations following component symbols)
+			if isOperator(text[i:end]) && (i == 0 || text[i-1] == ' ') {
+				flush(i)
+				items = append(items, layoutItem{text: text[i:end], operator: true})
+				start = end
+			}
+			i = end - 1
+		case parser.LEFT_BRACE:
+			if parentheses > 0 {
+				continue
+			}
+			header := headerStart(text[:i], start)
+			flush(header)
+			end := matchingClosingSymbol(text, i, parser.LEFT_BRACE, parser.RIGHT_BRACE)
+			inner := text[i+1 : end-1]
+			items = append(items, layoutItem{text: text[header:i], braced: true, raw: text[header:end],
+				children: parseLayoutItems(inner)})
+			start = end
+			i = end - 1
+		}
+	}
+	flush(len(text))
+	return items
+}
+
+/*
+Returns the offset following the symbol that closes the opening symbol at a given offset
+(or the end of the text if it is not closed).
+*/
+func matchingClosingSymbol(text string, start int, open string, close string) int {
+	level := 0
+	for i := start; i < len(text); i++ {
+		switch string(text[i]) {
+		case open:
+			level++
+		case close:
+			level--
+			if level == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(text)
+}
+
+/*
+Returns the start of the header (component symbol, suffix and annotation) of a braced expression
+that starts at the end of the given text, considering content from the given minimum offset only.
+*/
+func headerStart(text string, min int) int {
+	i := len(text)
+	// Annotation (potentially containing whitespace and nested brackets), ...
+	if i > min && string(text[i-1]) == tree.RIGHT_BRACKET {
+		level := 0
+		for i > min {
+			i--
+			if string(text[i]) == tree.RIGHT_BRACKET {
+				level++
+			} else if string(text[i]) == tree.LEFT_BRACKET {
+				level--
+				if level == 0 {
+					break
+				}
+			}
+		}
+	}
+	// ... preceded by component symbol and suffix
+	for i > min && text[i-1] != ' ' {
+		i--
+	}
+	return i
+}
+
+/*
+Indicates whether given bracketed content is a logical operator (e.g., '[AND]').
+*/
+func isOperator(content string) bool {
+	for _, operator := range []string{tree.AND_BRACKETS, tree.OR_BRACKETS, tree.XOR_BRACKETS, tree.NOT_BRACKETS} {
+		if content == operator {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Indicates whether braced expression is spread across multiple lines, i.e., if it links elements
+by logical operators (as opposed to unary negation), or contains braced expressions that do so.
+*/
+func (item layoutItem) multiLine() bool {
+	if !item.braced {
+		return false
+	}
+	for i, child := range item.children {
+		if (child.operator && i > 0 && !item.children[i-1].operator) || child.multiLine() {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Accumulates lines of multi-line output.
+*/
+type layoutWriter struct {
+	lines   []string
+	current string
+	indent  string
+}
+
+/*
+Writes given items at the given nesting level.
+*/
+func (w *layoutWriter) write(items []layoutItem, level int) {
+	for _, item := range items {
+		switch {
+		case item.operator:
+			w.newline()
+			w.append(item.text, level)
+			w.newline()
+		case item.braced && item.multiLine():
+			w.append(item.text+parser.LEFT_BRACE, level)
+			w.newline()
+			w.write(item.children, level+1)
+			w.newline()
+			w.append(parser.RIGHT_BRACE, level)
+		case item.braced:
+			w.append(item.raw, level)
+		default:
+			w.append(item.text, level)
+		}
+	}
+}
+
+/*
+Appends content to the current line (separated by whitespace), indenting new lines by nesting level.
+*/
+func (w *layoutWriter) append(content string, level int) {
+	if w.current == "" {
+		w.current = strings.Repeat(w.indent, level) + content
+		return
+	}
+	w.current += " " + content
+}
+
+/*
+Terminates the current line (if not empty).
+*/
+func (w *layoutWriter) newline() {
+	if w.current != "" {
+		w.lines = append(w.lines, w.current)
+		w.current = ""
+	}
+}
@@ -0,0 +1,151 @@
+package linter
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+)
+
+/*
+This file contains the configuration of the linter, which allows for the enabling or disabling of individual
+rules, as well as the adjustment of their severity. Configurations are provided as JSON files, e.g.,
+
+	{
+	  "rules": {
+	    "MALFORMED_ANNOTATION": { "enabled": false },
+	    "MISSING_AIM": { "severity": "INFO" }
+	  }
+	}
+
+Rules not contained in the configuration are enabled with their default severity (see Rules.go).
+Custom rules are added to the configuration programmatically (see #AddRule()), and can be referenced
+in JSON configurations if passed to #ParseConfig() or #LoadConfig().
+*/
+
+/*
+Configuration of linter, holding settings for individual rules (by rule identifier), as well as
+custom rules applied in addition to the default rules.
+*/
+type Config struct {
+	Rules map[string]RuleConfig `json:"rules"`
+	// Custom rules applied after the default rules (in order of addition; see #AddRule())
+	CustomRules []Rule `json:"-"`
+}
+
+/*
+Configuration of individual rule. Unspecified fields retain the defaults of the rule.
+*/
+type RuleConfig struct {
+	// Indicates whether rule is applied (default: true)
+	Enabled *bool `json:"enabled,omitempty"`
+	// Severity of violations (tree.SEVERITY_WARNING or tree.SEVERITY_INFO)
+	Severity string `json:"severity,omitempty"`
+}
+
+/*
+Returns the default configuration, which applies all default rules with their default severity.
+*/
+func DefaultConfig() Config {
+	return Config{Rules: map[string]RuleConfig{}}
+}
+
+/*
+Loads configuration from JSON file at given path (see #ParseConfig()).
+*/
+func LoadConfig(path string, customRules ...Rule) (Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return DefaultConfig(), err
+	}
+	defer f.Close()
+	return ParseConfig(f, customRules...)
+}
+
+/*
+Parses JSON configuration from given reader, and adds the given custom rules to it (see #AddRule()).
+Returns error if the configuration is malformed, refers to rules that are neither default nor custom rules,
+specifies invalid severities, or if custom rules are invalid.
+*/
+func ParseConfig(reader io.Reader, customRules ...Rule) (Config, error) {
+	config := DefaultConfig()
+	for _, rule := range customRules {
+		if err := config.AddRule(rule); err != nil {
+			return DefaultConfig(), errors.New("Invalid linter configuration: " + err.Error())
+		}
+	}
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return DefaultConfig(), errors.New("Invalid linter configuration: " + err.Error())
+	}
+	if config.Rules == nil {
+		config.Rules = map[string]RuleConfig{}
+	}
+	for id, ruleConfig := range config.Rules {
+		if _, ok := config.lookupRule(id); !ok {
+			return DefaultConfig(), errors.New("Invalid linter configuration: Unknown rule " + id)
+		}
+		if ruleConfig.Severity != "" && !validSeverity(ruleConfig.Severity) {
+			return DefaultConfig(), errors.New("Invalid linter configuration: Invalid severity '" +
+				ruleConfig.Severity + "' for rule " + id)
+		}
+	}
+	return config, nil
+}
+
+/*
+Indicates whether rule with given identifier is enabled.
+*/
+func (c Config) IsEnabled(id string) bool {
+	if ruleConfig, ok := c.Rules[id]; ok && ruleConfig.Enabled != nil {
+		return *ruleConfig.Enabled
+	}
+	return true
+}
+
+/*
+Returns the severity configured for a given rule, or the rule's default severity if not configured.
+*/
+func (c Config) SeverityOf(rule Rule) string {
+	if ruleConfig, ok := c.Rules[rule.Id]; ok && ruleConfig.Severity != "" {
+		return ruleConfig.Severity
+	}
+	return rule.Severity
+}
+
+/*
+Adds custom rule to be applied by the linter (enabled by default). Returns error if the rule is incomplete,
+holds an invalid severity, or if a rule with the same identifier is already part of the configuration.
+*/
+func (c *Config) AddRule(rule Rule) error {
+	if err := validateRule(rule); err != nil {
+		return err
+	}
+	if _, ok := c.lookupRule(rule.Id); ok {
+		return errors.New("Rule " + rule.Id + " is already part of the configuration")
+	}
+	// Copy custom rules to prevent modification of rules shared with copies of this configuration
+	c.CustomRules = append(append([]Rule{}, c.CustomRules...), rule)
+	return nil
+}
+
+/*
+Returns all rules applied based on this configuration, i.e., the default rules followed by the custom rules
+(irrespective of whether they are enabled).
+*/
+func (c Config) AllRules() []Rule {
+	return append(defaultRules(), c.CustomRules...)
+}
+
+/*
+Returns rule with given identifier (default or custom rule), and indicates whether it has been found.
+*/
+func (c Config) lookupRule(id string) (Rule, bool) {
+	for _, rule := range c.AllRules() {
+		if rule.Id == id {
+			return rule, true
+		}
+	}
+	return Rule{}, false
+}
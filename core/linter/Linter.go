@@ -0,0 +1,142 @@
+package linter
+
+import (
+	"IG-Parser/core/tree"
+	"errors"
+	"strings"
+)
+
+/*
+This file contains the linter for parsed IG Script-coded statements, which checks statements for violations of
+coding guidelines of IG 2.0 that do not prevent parsing (e.g., regulative statements without Aim). Checks are
+implemented as rules (see Rules.go for the default rules), which can be extended by adding custom rules
+to the configuration (see Config.#AddRule()), and which can be enabled, disabled or adjusted in severity by
+configuration (see Config.go). Rules are applied to the parsed statement, as well as to all nested statements.
+*/
+
+// Separator between component symbols in location of nested statements (e.g., 'Cac > O')
+const LOCATION_SEPARATOR = " > "
+
+/*
+Lint rule checking a statement for violations of a given coding guideline.
+*/
+type Rule struct {
+	// Unique identifier of rule (used in configuration and as code of reported diagnostics)
+	Id string
+	// Default severity of violations (tree.SEVERITY_WARNING or tree.SEVERITY_INFO)
+	Severity string
+	// Description of coding guideline checked by rule
+	Description string
+	// Checks statement and returns messages describing violations (if any). The node embeds the statement
+	// and holds statement-level annotations (or the annotations of the component embedding a nested statement).
+	Check func(node *tree.Node, stmt *tree.Statement) []string
+}
+
+/*
+Validates rule to be applied by the linter. Returns error if the rule is incomplete or holds an invalid severity.
+*/
+func validateRule(rule Rule) error {
+	if rule.Id == "" || rule.Check == nil {
+		return errors.New("Rule requires identifier and check function")
+	}
+	if !validSeverity(rule.Severity) {
+		return errors.New("Invalid severity '" + rule.Severity + "' for rule " + rule.Id)
+	}
+	return nil
+}
+
+/*
+Indicates whether given severity can be assigned to rules. Violations of coding guidelines are never errors,
+since they do not prevent the processing of statements.
+*/
+func validSeverity(severity string) bool {
+	return severity == tree.SEVERITY_WARNING || severity == tree.SEVERITY_INFO
+}
+
+/*
+Applies all rules enabled in the given configuration to parsed statements (as returned by parser.ParseStatement()),
+including nested statements. Returns violations as diagnostics, with the rule identifier as error code and
+the severity as configured for the respective rule. Identical violations (e.g., in statements extrapolated from
+component pair combinations) are reported once.
+*/
+func Lint(stmts []*tree.Node, config Config) []tree.Diagnostic {
+	diagnostics := []tree.Diagnostic{}
+	reported := map[string]bool{}
+	rules := config.AllRules()
+	for _, stmt := range stmts {
+		visitStatements(stmt, "", func(node *tree.Node, s *tree.Statement, location string) {
+			for _, rule := range rules {
+				if !config.IsEnabled(rule.Id) {
+					continue
+				}
+				for _, msg := range rule.Check(node, s) {
+					if location != "" {
+						msg += " (in nested statement: " + location + ")"
+					}
+					if reported[rule.Id+msg] {
+						continue
+					}
+					reported[rule.Id+msg] = true
+					Println("Lint rule", rule.Id, "reported:", msg)
+					diagnostics = append(diagnostics, tree.Diagnostic{Severity: config.SeverityOf(rule),
+						ParsingError: tree.ParsingError{ErrorCode: rule.Id, ErrorMessage: msg}})
+				}
+			}
+		})
+	}
+	return diagnostics
+}
+
+/*
+Invokes given function for all statements embedded in the given node and its children, as well as all statements
+nested in their components. The location indicates the symbols of the components embedding nested statements
+(see #LOCATION_SEPARATOR), and is empty for top-level statements.
+*/
+func visitStatements(node *tree.Node, location string, fn func(node *tree.Node, stmt *tree.Statement, location string)) {
+	if node == nil {
+		return
+	}
+	switch entry := node.Entry.(type) {
+	case *tree.Statement:
+		if entry == nil {
+			return
+		}
+		fn(node, entry, location)
+		for _, comp := range entry.Components() {
+			// Simple components are visited for nested statements in private properties
+			visitStatements(comp.Node, nestedLocation(location, comp.Symbol), fn)
+		}
+		return
+	case []*tree.Node:
+		for _, child := range entry {
+			visitStatements(child, location, fn)
+		}
+	}
+	for _, private := range node.PrivateNodeLinks {
+		if private != nil {
+			visitStatements(private, nestedLocation(parentLocation(location), private.GetComponentName()), fn)
+		}
+	}
+	visitStatements(node.Left, location, fn)
+	visitStatements(node.Right, location, fn)
+}
+
+/*
+Appends component symbol to location of nested statement.
+*/
+func nestedLocation(location string, symbol string) string {
+	if location == "" {
+		return symbol
+	}
+	return location + LOCATION_SEPARATOR + symbol
+}
+
+/*
+Removes last component symbol from location of nested statement.
+*/
+func parentLocation(location string) string {
+	if idx := strings.LastIndex(location, LOCATION_SEPARATOR); idx != -1 {
+		return location[:idx]
+	}
+	return ""
+}
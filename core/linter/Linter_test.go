@@ -0,0 +1,172 @@
+package linter
+
+import (
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"strings"
+	"testing"
+)
+
+/*
+Parses given statement and lints it with given configuration.
+*/
+func lintStatement(t *testing.T, input string, config Config) []tree.Diagnostic {
+	stmts, err := parser.ParseStatement(input)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Parsing of '"+input+"' should not fail. Error:", err)
+	}
+	return Lint(stmts, config)
+}
+
+/*
+Returns error codes of given diagnostics.
+*/
+func codes(diagnostics []tree.Diagnostic) []string {
+	result := []string{}
+	for _, d := range diagnostics {
+		result = append(result, d.ErrorCode)
+	}
+	return result
+}
+
+/*
+Tests that each default rule reports violations of the corresponding coding guideline (and only those).
+*/
+func TestLintDefaultRules(t *testing.T) {
+
+	inputs := map[string]string{
+		"A(farmer) D(must) Bdir(goods)":                                     RULE_MISSING_AIM,
+		"A(farmer) D(must) M(may) I(sell)":                                  RULE_DEONTIC_WITH_MODAL,
+		"E(council) P(of the region)":                                       RULE_CONSTITUTED_ENTITY_WITHOUT_FUNCTION,
+		"A(farmer) D(must) I(sell) Bdir1,p(fresh) Bdir(goods)":              RULE_UNMATCHED_PRIVATE_PROPERTY,
+		"A(farmer) D(must) I(sell) O{Cac(otherwise)}":                       RULE_EMPTY_OR_ELSE,
+		"A[role](farmer) D(must) I(sell)":                                   RULE_MALFORMED_ANNOTATION,
+		"A(farmer) D(must) I(sell) Cac{A(farmer) D(must) Bdir(inspection)}": RULE_MISSING_AIM,
+	}
+
+	for input, rule := range inputs {
+		diagnostics := lintStatement(t, input, DefaultConfig())
+		if len(diagnostics) != 1 || diagnostics[0].ErrorCode != rule {
+			t.Fatal("Linting of '"+input+"' should report "+rule+", but reported", codes(diagnostics))
+		}
+		if diagnostics[0].Severity != tree.SEVERITY_WARNING {
+			t.Fatal("Violation should be reported as warning, but was", diagnostics[0].Severity)
+		}
+	}
+}
+
+/*
+Tests that statements following coding guidelines do not produce violations.
+*/
+func TestLintValidStatements(t *testing.T) {
+
+	inputs := []string{
+		"A(farmer) D(must) I(sell) Bdir1,p(fresh) Bdir1(goods) Cac[ctx=tim]{A(council) I(approves)} [stmt=example]",
+		"E(council) F(is) P(of the region) M(may)",
+		"A(farmer) D(must) I(sell) O{A(inspector) D(may) I(fine) Bdir(farmer)}",
+	}
+
+	for _, input := range inputs {
+		if diagnostics := lintStatement(t, input, DefaultConfig()); len(diagnostics) != 0 {
+			t.Fatal("Linting of '"+input+"' should not report violations, but reported", codes(diagnostics))
+		}
+	}
+}
+
+/*
+Tests the location of violations in nested statements, and the deduplication of violations
+across statements extrapolated from component pair combinations.
+*/
+func TestLintNestedStatements(t *testing.T) {
+
+	diagnostics := lintStatement(t, "A(farmer) {I(sell) [XOR] I(buy)} Cac{A(council) D(must) Bdir(approval)}", DefaultConfig())
+	if len(diagnostics) != 1 {
+		t.Fatal("Linting should report single violation, but reported", codes(diagnostics))
+	}
+	if !strings.HasSuffix(diagnostics[0].ErrorMessage, "(in nested statement: Cac)") {
+		t.Fatal("Violation should indicate nested statement, but was:", diagnostics[0].ErrorMessage)
+	}
+}
+
+/*
+Tests the disabling of rules and adjustment of severity by configuration.
+*/
+func TestLintConfiguration(t *testing.T) {
+
+	config, err := ParseConfig(strings.NewReader(`{"rules": {"MISSING_AIM": {"enabled": false}, "MALFORMED_ANNOTATION": {"severity": "INFO"}}}`))
+	if err != nil {
+		t.Fatal("Parsing of configuration should not fail. Error:", err)
+	}
+
+	diagnostics := lintStatement(t, "A[role](farmer) D(must) Bdir(goods)", config)
+	if len(diagnostics) != 1 || diagnostics[0].ErrorCode != RULE_MALFORMED_ANNOTATION {
+		t.Fatal("Linting should only report malformed annotation, but reported", codes(diagnostics))
+	}
+	if diagnostics[0].Severity != tree.SEVERITY_INFO {
+		t.Fatal("Severity should be adjusted by configuration, but was", diagnostics[0].Severity)
+	}
+
+	invalid := []string{
+		`{"rules": {"UNKNOWN_RULE": {"enabled": false}}}`,
+		`{"rules": {"MISSING_AIM": {"severity": "ERROR"}}}`,
+		`{"rule": {}}`,
+	}
+	for _, input := range invalid {
+		if _, err := ParseConfig(strings.NewReader(input)); err == nil {
+			t.Fatal("Parsing of invalid configuration should fail:", input)
+		}
+	}
+}
+
+/*
+Tests the addition of custom rules to the configuration, and their referencing in JSON configurations.
+*/
+func TestCustomRules(t *testing.T) {
+
+	rule := Rule{Id: "MISSING_EXECUTION_CONSTRAINT", Severity: tree.SEVERITY_INFO,
+		Description: "Statements specify execution constraints.",
+		Check: func(node *tree.Node, stmt *tree.Statement) []string {
+			if stmt.ExecutionConstraintSimple == nil && stmt.ExecutionConstraintComplex == nil {
+				return []string{"Statement does not contain execution constraint"}
+			}
+			return nil
+		}}
+	config := DefaultConfig()
+	if err := config.AddRule(rule); err != nil {
+		t.Fatal("Addition of rule should not fail. Error:", err)
+	}
+	if err := config.AddRule(rule); err == nil {
+		t.Fatal("Addition of duplicate rule should fail")
+	}
+	if err := config.AddRule(Rule{Id: RULE_MISSING_AIM, Severity: tree.SEVERITY_INFO, Check: rule.Check}); err == nil {
+		t.Fatal("Addition of rule with identifier of default rule should fail")
+	}
+	if err := config.AddRule(Rule{Id: "INVALID", Severity: tree.SEVERITY_ERROR, Check: rule.Check}); err == nil {
+		t.Fatal("Addition of rule with invalid severity should fail")
+	}
+
+	diagnostics := lintStatement(t, "A(farmer) D(must) I(sell)", config)
+	if len(diagnostics) != 1 || diagnostics[0].ErrorCode != rule.Id || diagnostics[0].Severity != tree.SEVERITY_INFO {
+		t.Fatal("Linting should report violation of custom rule, but reported", codes(diagnostics))
+	}
+
+	// Custom rules are confined to the configuration they have been added to
+	diagnostics = lintStatement(t, "A(farmer) D(must) I(sell)", DefaultConfig())
+	if len(diagnostics) != 0 {
+		t.Fatal("Linting with default configuration should not apply custom rule, but reported", codes(diagnostics))
+	}
+
+	// Custom rules can be configured in JSON configuration if passed during parsing
+	input := `{"rules": {"` + rule.Id + `": {"severity": "WARNING"}}}`
+	if _, err := ParseConfig(strings.NewReader(input)); err == nil {
+		t.Fatal("Parsing of configuration referring to unknown rule should fail")
+	}
+	config, err := ParseConfig(strings.NewReader(input), rule)
+	if err != nil {
+		t.Fatal("Parsing of configuration referring to custom rule should not fail. Error:", err)
+	}
+	diagnostics = lintStatement(t, "A(farmer) D(must) I(sell)", config)
+	if len(diagnostics) != 1 || diagnostics[0].ErrorCode != rule.Id || diagnostics[0].Severity != tree.SEVERITY_WARNING {
+		t.Fatal("Linting should report violation of custom rule with configured severity, but reported", codes(diagnostics))
+	}
+}
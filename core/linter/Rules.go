@@ -0,0 +1,237 @@
+package linter
+
+import (
+	"IG-Parser/core/tree"
+	"strings"
+)
+
+/*
+This file contains the default rules of the linter, reflecting coding guidelines of IG 2.0.
+*/
+
+// Identifiers of default rules
+const RULE_MISSING_AIM = "MISSING_AIM"
+const RULE_DEONTIC_WITH_MODAL = "DEONTIC_WITH_MODAL"
+const RULE_CONSTITUTED_ENTITY_WITHOUT_FUNCTION = "CONSTITUTED_ENTITY_WITHOUT_FUNCTION"
+const RULE_UNMATCHED_PRIVATE_PROPERTY = "UNMATCHED_PRIVATE_PROPERTY"
+const RULE_EMPTY_OR_ELSE = "EMPTY_OR_ELSE"
+const RULE_MALFORMED_ANNOTATION = "MALFORMED_ANNOTATION"
+
+/*
+Returns the default rules.
+*/
+func defaultRules() []Rule {
+	return []Rule{
+		{Id: RULE_MISSING_AIM, Severity: tree.SEVERITY_WARNING,
+			Description: "Regulative statements contain an Aim (I).",
+			Check:       checkMissingAim},
+		{Id: RULE_DEONTIC_WITH_MODAL, Severity: tree.SEVERITY_WARNING,
+			Description: "Statements do not combine Deontic (D) and Modal (M), which are reserved for regulative and constitutive statements respectively.",
+			Check:       checkDeonticWithModal},
+		{Id: RULE_CONSTITUTED_ENTITY_WITHOUT_FUNCTION, Severity: tree.SEVERITY_WARNING,
+			Description: "Constituted Entities (E) are accompanied by a Constitutive Function (F).",
+			Check:       checkConstitutedEntityWithoutFunction},
+		{Id: RULE_UNMATCHED_PRIVATE_PROPERTY, Severity: tree.SEVERITY_WARNING,
+			Description: "Private properties (e.g., Bdir1,p) refer to a component with matching suffix (e.g., Bdir1).",
+			Check:       checkUnmatchedPrivateProperty},
+		{Id: RULE_EMPTY_OR_ELSE, Severity: tree.SEVERITY_WARNING,
+			Description: "Or else (O) specifies a consequence beyond contextual information (i.e., components other than Cac and Cex).",
+			Check:       checkEmptyOrElse},
+		{Id: RULE_MALFORMED_ANNOTATION, Severity: tree.SEVERITY_WARNING,
+			Description: "Annotations follow the syntax 'key=value' (e.g., [ctx=tim]).",
+			Check:       checkMalformedAnnotation},
+	}
+}
+
+/*
+Checks whether regulative statement (i.e., statement containing Attributes, Deontic or Objects, but no
+constitutive components) lacks Aim.
+*/
+func checkMissingAim(node *tree.Node, stmt *tree.Statement) []string {
+	regulative := present(stmt.Attributes) || present(stmt.Deontic) ||
+		present(stmt.DirectObject) || present(stmt.DirectObjectComplex) ||
+		present(stmt.IndirectObject) || present(stmt.IndirectObjectComplex)
+	constitutive := present(stmt.ConstitutedEntity) || present(stmt.ConstitutiveFunction) ||
+		present(stmt.ConstitutingProperties) || present(stmt.ConstitutingPropertiesComplex)
+	if regulative && !constitutive && !present(stmt.Aim) {
+		return []string{"Regulative statement does not contain " + describe(tree.AIM)}
+	}
+	return nil
+}
+
+/*
+Checks whether statement combines Deontic and Modal.
+*/
+func checkDeonticWithModal(node *tree.Node, stmt *tree.Statement) []string {
+	if present(stmt.Deontic) && present(stmt.Modal) {
+		return []string{"Statement combines " + describe(tree.DEONTIC) + " and " + describe(tree.MODAL) +
+			" (use Deontic for regulative and Modal for constitutive statements)"}
+	}
+	return nil
+}
+
+/*
+Checks whether statement contains Constituted Entity without Constitutive Function.
+*/
+func checkConstitutedEntityWithoutFunction(node *tree.Node, stmt *tree.Statement) []string {
+	if present(stmt.ConstitutedEntity) && !present(stmt.ConstitutiveFunction) {
+		return []string{"Statement contains " + describe(tree.CONSTITUTED_ENTITY) + " without " +
+			describe(tree.CONSTITUTIVE_FUNCTION)}
+	}
+	return nil
+}
+
+/*
+Checks whether properties with suffix remain in the property components of the statement. Properties are
+moved to the component with matching suffix during parsing (see parser.ProcessPrivateComponentLinkages()),
+such that remaining ones lack a corresponding component.
+*/
+func checkUnmatchedPrivateProperty(node *tree.Node, stmt *tree.Statement) []string {
+	messages := []string{}
+	for _, comp := range stmt.Components() {
+		if !strings.HasSuffix(comp.Symbol, tree.PROPERTY_SYNTAX_SUFFIX) {
+			continue
+		}
+		visitComponentNodes(comp.Node, func(n *tree.Node) bool {
+			suffix, ok := n.Suffix.(string)
+			if !ok || suffix == "" {
+				return true
+			}
+			root := strings.TrimSuffix(comp.Symbol, tree.PROPERTY_SYNTAX_SUFFIX)
+			msg := "Private property " + root + suffix + tree.PROPERTY_SYNTAX_SUFFIX +
+				" does not refer to component " + root + suffix
+			if !containsString(messages, msg) {
+				messages = append(messages, msg)
+			}
+			// Suffix applies to all children
+			return false
+		})
+	}
+	return messages
+}
+
+/*
+Checks whether Or else only contains contextual information (or no components at all).
+*/
+func checkEmptyOrElse(node *tree.Node, stmt *tree.Statement) []string {
+	if stmt.OrElse == nil {
+		return nil
+	}
+	messages := []string{}
+	visitComponentNodes(stmt.OrElse, func(n *tree.Node) bool {
+		if !n.IsLeafNode() {
+			return true
+		}
+		consequence, ok := n.Entry.(*tree.Statement)
+		if ok && consequence != nil && hasConsequence(consequence) {
+			return true
+		}
+		msg := describe(tree.OR_ELSE) + " does not specify consequence beyond contextual information"
+		if !containsString(messages, msg) {
+			messages = append(messages, msg)
+		}
+		return true
+	})
+	return messages
+}
+
+/*
+Indicates whether statement contains components other than Activation Conditions and Execution Constraints.
+*/
+func hasConsequence(stmt *tree.Statement) bool {
+	for _, comp := range stmt.Components() {
+		if comp.Symbol != tree.ACTIVATION_CONDITION && comp.Symbol != tree.EXECUTION_CONSTRAINT && present(comp.Node) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Checks whether statement-level annotations and the annotations of all components follow the syntax 'key=value'.
+Annotations of components embedding nested statements are checked as part of the nested statement.
+*/
+func checkMalformedAnnotation(node *tree.Node, stmt *tree.Statement) []string {
+	messages := []string{}
+	check := func(n *tree.Node) {
+		annotations, ok := n.Annotations.(string)
+		if !ok {
+			return
+		}
+		for _, annotation := range tree.SplitAnnotations(annotations) {
+			msg := "Annotation " + tree.LEFT_BRACKET + annotation + tree.RIGHT_BRACKET + " does not follow syntax '[key" +
+				tree.ANNOTATION_KEY_VALUE_SEPARATOR + "value]'"
+			if !wellFormedAnnotation(annotation) && !containsString(messages, msg) {
+				messages = append(messages, msg)
+			}
+		}
+	}
+	check(node)
+	for _, comp := range stmt.Components() {
+		visitComponentNodes(comp.Node, func(n *tree.Node) bool {
+			if _, nested := n.Entry.(*tree.Statement); !nested {
+				check(n)
+			}
+			return true
+		})
+	}
+	return messages
+}
+
+/*
+Indicates whether the content of an annotation (see tree.SplitAnnotations()) follows the syntax 'key=value',
+with a key without whitespace and a non-empty value.
+*/
+func wellFormedAnnotation(annotation string) bool {
+	key, value, ok := tree.SplitAnnotationKeyValue(annotation)
+	if !ok {
+		return false
+	}
+	key = strings.TrimSpace(key)
+	return key != "" && !strings.ContainsAny(key, " \t") && strings.TrimSpace(value) != ""
+}
+
+/*
+Invokes given function for all nodes of a component tree (including linked private nodes), descending into
+children as long as the function returns true. Nodes embedding nested statements are passed to the function,
+but the nested statements are not traversed (since the linter checks nested statements separately).
+*/
+func visitComponentNodes(n *tree.Node, fn func(n *tree.Node) bool) {
+	if n == nil {
+		return
+	}
+	if !fn(n) {
+		return
+	}
+	for _, private := range n.PrivateNodeLinks {
+		visitComponentNodes(private, fn)
+	}
+	visitComponentNodes(n.Left, fn)
+	visitComponentNodes(n.Right, fn)
+}
+
+/*
+Indicates whether component node is populated.
+*/
+func present(n *tree.Node) bool {
+	return n != nil && !n.IsEmptyOrNilNode()
+}
+
+/*
+Returns name and symbol of component for messages (e.g., 'Aim (I)').
+*/
+func describe(symbol string) string {
+	return tree.IGComponentSymbolNameMap[symbol] + " (" + symbol + ")"
+}
+
+/*
+Indicates whether a given value is contained in a string slice.
+*/
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
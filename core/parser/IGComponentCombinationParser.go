@@ -17,8 +17,10 @@ logical operators.
 /*
 Parses combinations in string. The syntactic form of input is:
 "( leftSide [OPERATOR] rightSide )", where [OPERATOR] is one
-of the logical operators [AND], [OR], [XOR] (including brackets),
+of the logical operators [AND], [OR], [XOR], [NOT] (including brackets),
 and left and right side are either text or combinations themselves.
+The operator [NOT] can further be used as unary negation without left side
+(e.g., "( [NOT] rightSide )"), in which case the returned node only holds a right child.
 For all logical operators, an arbitrary number of expressions can be combined;
 in this case the function will decompose those into nested structures
 (e.g., expanding "( expr1 [AND] expr2 [AND] expr3 )" into
@@ -70,6 +72,7 @@ func ParseIntoNodeTree(input string, nestedNode bool, leftPar string, rightPar s
 		!strings.Contains(input, tree.AND_BRACKETS) &&
 		!strings.Contains(input, tree.XOR_BRACKETS) &&
 		!strings.Contains(input, tree.OR_BRACKETS) &&
+		!strings.Contains(input, tree.NOT_BRACKETS) &&
 		!strings.Contains(input, tree.SAND_BETWEEN_COMPONENTS_BRACKETS) {
 
 		node := &tree.Node{Entry: strings.Trim(input, " ")}
@@ -194,6 +197,9 @@ func ParseIntoNodeTree(input string, nestedNode bool, leftPar string, rightPar s
 						if !res {
 							return nil, input, tree.ParsingError{ErrorCode: err.ErrorCode, ErrorMessage: err.ErrorMessage}
 						}
+					} else if node.LogicalOperator == tree.NOT {
+						// Unary negation (e.g., ([NOT] right)) does not have left side
+						Println("Found unary negation (no left leaf)")
 					} else {
 						msg := "Empty leaf value on left side: " + left +
 							" (Corresponding right value and operator: " + right + "; " + node.LogicalOperator +
@@ -568,6 +574,9 @@ func detectCombinations(expression string, leftPar string, rightPar string) (map
 				case tree.XOR_BRACKETS:
 					Println("Detected " + tree.XOR_BRACKETS)
 					foundOperator = tree.XOR
+				case tree.NOT_BRACKETS:
+					Println("Detected " + tree.NOT_BRACKETS)
+					foundOperator = tree.NOT
 				}
 			}
 			// Separately test for OR due to differing length (but remember to test for length of expression first)
@@ -603,12 +612,13 @@ func detectCombinations(expression string, leftPar string, rightPar string) (map
 
 				if modeMap[level] == tree.PARSING_MODE_OUTSIDE_EXPRESSION {
 					return nil, nil, expression, tree.ParsingError{ErrorCode: tree.PARSING_ERROR_LOGICAL_OPERATOR_OUTSIDE_COMBINATION,
-						ErrorMessage: "Logical operator (e.g., [AND], [OR], [XOR]) found outside of combination. Please check for missing parentheses in input."}
+						ErrorMessage: "Logical operator (e.g., [AND], [OR], [XOR], [NOT]) found outside of combination. Please check for missing parentheses in input."}
 				}
 
 				levelIdx := len(levelMap[level]) - 1
 				// Check whether the logical operator is immediately adjacent to left parenthesis (e.g., ... ([AND] ... - invalid combination
-				if levelMap[level][levelIdx].Left == i {
+				// (except for unary negation, e.g., ... ([NOT] ...)
+				if levelMap[level][levelIdx].Left == i && foundOperator != tree.NOT {
 					msg := "Input contains invalid combination expression in the range '" + expression[levelMap[level][levelIdx].Left:] + "'."
 					log.Println(msg)
 					return levelMap, nonSharedElements, expression, tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_COMBINATION,
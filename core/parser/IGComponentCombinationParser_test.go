@@ -333,7 +333,7 @@ func TestSharedElements(t *testing.T) {
 
 	input := "( shared left (Left side information [XOR] middle information) shared right)"
 
-	tree.SHARED_ELEMENT_INHERITANCE_MODE = tree.SHARED_ELEMENT_INHERIT_NOTHING
+	mode := tree.SHARED_ELEMENT_INHERIT_NOTHING
 
 	// Parse provided expression
 	node, modified, err := ParseIntoNodeTree(input, false, "(", ")")
@@ -347,17 +347,17 @@ func TestSharedElements(t *testing.T) {
 		t.Fatal("Modified output does not correspond to input (Output: '" + modified + "')")
 	}
 
-	if node.GetSharedLeft()[0] != "shared left" {
-		t.Fatal("Parsed left shared value is not correct. Output: '" + fmt.Sprint(node.GetSharedLeft()) + "'")
+	if node.GetSharedLeftForMode(mode)[0] != "shared left" {
+		t.Fatal("Parsed left shared value is not correct. Output: '" + fmt.Sprint(node.GetSharedLeftForMode(mode)) + "'")
 	}
 
-	if node.GetSharedRight()[0] != "shared right" {
-		t.Fatal("Parsed right shared value is not correct. Output: '" + fmt.Sprint(node.GetSharedRight()) + "'")
+	if node.GetSharedRightForMode(mode)[0] != "shared right" {
+		t.Fatal("Parsed right shared value is not correct. Output: '" + fmt.Sprint(node.GetSharedRightForMode(mode)) + "'")
 	}
 
 	// Test reconstruction from tree
-	if node.Stringify() != "(shared left (Left side information [XOR] middle information) shared right)" {
-		t.Fatal("Stringified output does not correspond to input (Output: " + node.Stringify() + "')")
+	if node.StringifyForMode(mode) != "(shared left (Left side information [XOR] middle information) shared right)" {
+		t.Fatal("Stringified output does not correspond to input (Output: " + node.StringifyForMode(mode) + "')")
 	}
 }
 
@@ -368,7 +368,7 @@ func TestSharedElementsLeft(t *testing.T) {
 
 	input := "( shared left (Left side information [XOR] middle information))"
 
-	tree.SHARED_ELEMENT_INHERITANCE_MODE = tree.SHARED_ELEMENT_INHERIT_NOTHING
+	mode := tree.SHARED_ELEMENT_INHERIT_NOTHING
 
 	// Parse provided expression
 	node, modified, err := ParseIntoNodeTree(input, false, "(", ")")
@@ -382,17 +382,17 @@ func TestSharedElementsLeft(t *testing.T) {
 		t.Fatal("Modified output does not correspond to input (Output: '" + modified + "')")
 	}
 
-	if node.GetSharedLeft()[0] != "shared left" {
-		t.Fatal("Parsed left shared value is not correct. Output: '" + fmt.Sprint(node.GetSharedLeft()) + "'")
+	if node.GetSharedLeftForMode(mode)[0] != "shared left" {
+		t.Fatal("Parsed left shared value is not correct. Output: '" + fmt.Sprint(node.GetSharedLeftForMode(mode)) + "'")
 	}
 
-	if node.GetSharedRight() != nil {
-		t.Fatal("Parsed right shared value is not correct. Output: '" + fmt.Sprint(node.GetSharedRight()) + "'")
+	if node.GetSharedRightForMode(mode) != nil {
+		t.Fatal("Parsed right shared value is not correct. Output: '" + fmt.Sprint(node.GetSharedRightForMode(mode)) + "'")
 	}
 
 	// Test reconstruction from tree
-	if node.Stringify() != "(shared left (Left side information [XOR] middle information))" {
-		t.Fatal("Stringified output does not correspond to input (Output: " + node.Stringify() + "')")
+	if node.StringifyForMode(mode) != "(shared left (Left side information [XOR] middle information))" {
+		t.Fatal("Stringified output does not correspond to input (Output: " + node.StringifyForMode(mode) + "')")
 	}
 }
 
@@ -403,7 +403,7 @@ func TestSharedElementsRight(t *testing.T) {
 
 	input := "((Left side information [XOR] middle information) shared right )"
 
-	tree.SHARED_ELEMENT_INHERITANCE_MODE = tree.SHARED_ELEMENT_INHERIT_NOTHING
+	mode := tree.SHARED_ELEMENT_INHERIT_NOTHING
 
 	// Parse provided expression
 	node, modified, err := ParseIntoNodeTree(input, false, "(", ")")
@@ -417,17 +417,17 @@ func TestSharedElementsRight(t *testing.T) {
 		t.Fatal("Modified output does not correspond to input (Output: '" + modified + "')")
 	}
 
-	if node.GetSharedLeft() != nil {
-		t.Fatal("Parsed left shared value is not correct. Output: '" + fmt.Sprint(node.GetSharedLeft()) + "'")
+	if node.GetSharedLeftForMode(mode) != nil {
+		t.Fatal("Parsed left shared value is not correct. Output: '" + fmt.Sprint(node.GetSharedLeftForMode(mode)) + "'")
 	}
 
-	if node.GetSharedRight()[0] != "shared right" {
-		t.Fatal("Parsed right shared value is not correct. Output: '" + fmt.Sprint(node.GetSharedRight()) + "'")
+	if node.GetSharedRightForMode(mode)[0] != "shared right" {
+		t.Fatal("Parsed right shared value is not correct. Output: '" + fmt.Sprint(node.GetSharedRightForMode(mode)) + "'")
 	}
 
 	// Test reconstruction from tree
-	if node.Stringify() != "((Left side information [XOR] middle information) shared right)" {
-		t.Fatal("Stringified output does not correspond to input (Output: " + node.Stringify() + "')")
+	if node.StringifyForMode(mode) != "((Left side information [XOR] middle information) shared right)" {
+		t.Fatal("Stringified output does not correspond to input (Output: " + node.StringifyForMode(mode) + "')")
 	}
 }
 
@@ -452,7 +452,7 @@ func TestSharedElementsAndAndCombinationWithInheritance(t *testing.T) {
 
 	input := "( shared left (Left side information [AND] middle information [AND] right information) shared right)"
 
-	tree.SHARED_ELEMENT_INHERITANCE_MODE = tree.SHARED_ELEMENT_INHERIT_OVERRIDE
+	mode := tree.SHARED_ELEMENT_INHERIT_OVERRIDE
 
 	// Parse provided expression
 	node, modified, err := ParseIntoNodeTree(input, false, "(", ")")
@@ -466,25 +466,25 @@ func TestSharedElementsAndAndCombinationWithInheritance(t *testing.T) {
 		t.Fatal("Modified output does not correspond to input (Output: '" + modified + "')")
 	}
 
-	if len(node.GetSharedLeft()) == 0 || node.GetSharedLeft()[0] != "shared left" {
-		t.Fatal("Parsed left shared value is not correct. Output: " + fmt.Sprint(node.GetSharedLeft()))
+	if len(node.GetSharedLeftForMode(mode)) == 0 || node.GetSharedLeftForMode(mode)[0] != "shared left" {
+		t.Fatal("Parsed left shared value is not correct. Output: " + fmt.Sprint(node.GetSharedLeftForMode(mode)))
 	}
 
-	if len(node.GetSharedRight()) == 0 || node.GetSharedRight()[0] != "shared right" {
-		t.Fatal("Parsed right shared value is not correct. Output: " + fmt.Sprint(node.GetSharedRight()))
+	if len(node.GetSharedRightForMode(mode)) == 0 || node.GetSharedRightForMode(mode)[0] != "shared right" {
+		t.Fatal("Parsed right shared value is not correct. Output: " + fmt.Sprint(node.GetSharedRightForMode(mode)))
 	}
 
-	if len(node.Left.GetSharedLeft()) == 0 || node.Left.GetSharedLeft()[0] != "shared left" {
-		t.Fatal("Left-nested left node did not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedLeft()) + ". Expected output: " + fmt.Sprint(node.GetSharedLeft()))
+	if len(node.Left.GetSharedLeftForMode(mode)) == 0 || node.Left.GetSharedLeftForMode(mode)[0] != "shared left" {
+		t.Fatal("Left-nested left node did not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedLeftForMode(mode)) + ". Expected output: " + fmt.Sprint(node.GetSharedLeftForMode(mode)))
 	}
 
-	if len(node.Left.GetSharedRight()) == 0 || node.Left.GetSharedRight()[0] != "shared right" {
-		t.Fatal("Left-nested right node did not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedRight()) + ". Expected output: " + fmt.Sprint(node.GetSharedRight()))
+	if len(node.Left.GetSharedRightForMode(mode)) == 0 || node.Left.GetSharedRightForMode(mode)[0] != "shared right" {
+		t.Fatal("Left-nested right node did not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedRightForMode(mode)) + ". Expected output: " + fmt.Sprint(node.GetSharedRightForMode(mode)))
 	}
 
 	// Test reconstruction from tree
-	if node.Stringify() != "(shared left ((shared left (Left side information [AND] middle information) shared right) [AND] right information) shared right)" {
-		t.Fatal("Stringified output does not correspond to input (Output: '" + node.Stringify() + "')")
+	if node.StringifyForMode(mode) != "(shared left ((shared left (Left side information [AND] middle information) shared right) [AND] right information) shared right)" {
+		t.Fatal("Stringified output does not correspond to input (Output: '" + node.StringifyForMode(mode) + "')")
 	}
 }
 
@@ -495,7 +495,7 @@ func TestSharedElementsAndAndCombinationWithInheritanceAppendMode(t *testing.T)
 
 	input := "( shared left ( inner left (innermost left ((left-most information [AND] Left side information [AND] middle information) [AND] right information) shared right)))"
 
-	tree.SHARED_ELEMENT_INHERITANCE_MODE = tree.SHARED_ELEMENT_INHERIT_APPEND
+	mode := tree.SHARED_ELEMENT_INHERIT_APPEND
 
 	// Parse provided expression
 	node, modified, err := ParseIntoNodeTree(input, false, "(", ")")
@@ -511,27 +511,27 @@ func TestSharedElementsAndAndCombinationWithInheritanceAppendMode(t *testing.T)
 		t.Fatal("Modified output does not correspond to input (Output: '" + modified + "')")
 	}
 
-	if fmt.Sprint(node.GetSharedLeft()) != "[innermost left]" {
-		t.Fatal("Parsed left shared value is not correct. Node value: " + fmt.Sprint(node.GetSharedLeft()) + ". Expected output: [innermost left]")
+	if fmt.Sprint(node.GetSharedLeftForMode(mode)) != "[innermost left]" {
+		t.Fatal("Parsed left shared value is not correct. Node value: " + fmt.Sprint(node.GetSharedLeftForMode(mode)) + ". Expected output: [innermost left]")
 	}
 
-	if fmt.Sprint(node.GetSharedRight()) != "[shared right]" {
-		t.Fatal("Parsed right shared value is not correct. Node value: " + fmt.Sprint(node.GetSharedRight()) + ". Expected output: [shared right]")
+	if fmt.Sprint(node.GetSharedRightForMode(mode)) != "[shared right]" {
+		t.Fatal("Parsed right shared value is not correct. Node value: " + fmt.Sprint(node.GetSharedRightForMode(mode)) + ". Expected output: [shared right]")
 	}
 
-	if fmt.Sprint(node.Left.GetSharedLeft()) != "[innermost left]" {
-		t.Fatal("Left-nested left node did not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedLeft()) + ". Expected output: [innermost left]")
+	if fmt.Sprint(node.Left.GetSharedLeftForMode(mode)) != "[innermost left]" {
+		t.Fatal("Left-nested left node did not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedLeftForMode(mode)) + ". Expected output: [innermost left]")
 	}
 
-	if fmt.Sprint(node.Left.GetSharedRight()) != "[shared right]" {
-		t.Fatal("Left-nested right node did not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedRight()) + ". Expected output: shared right")
+	if fmt.Sprint(node.Left.GetSharedRightForMode(mode)) != "[shared right]" {
+		t.Fatal("Left-nested right node did not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedRightForMode(mode)) + ". Expected output: shared right")
 	}
 
 	fmt.Println(node)
 
 	// Test reconstruction from tree
-	if node.Stringify() != "(innermost left ((innermost left ((innermost left (left-most information [AND] Left side information) shared right) [AND] middle information) shared right) [AND] right information) shared right)" {
-		t.Fatal("Stringified output does not correspond to input (Output: '" + node.Stringify() + "')")
+	if node.StringifyForMode(mode) != "(innermost left ((innermost left ((innermost left (left-most information [AND] Left side information) shared right) [AND] middle information) shared right) [AND] right information) shared right)" {
+		t.Fatal("Stringified output does not correspond to input (Output: '" + node.StringifyForMode(mode) + "')")
 	}
 }
 
@@ -542,7 +542,7 @@ func TestSharedElementsAndAndCombinationWithInheritanceOverrideMode(t *testing.T
 
 	input := "( shared left ( ( innermost left (Far left side [AND] Left side information [AND] inner right)) [AND] right information) shared right)"
 
-	tree.SHARED_ELEMENT_INHERITANCE_MODE = tree.SHARED_ELEMENT_INHERIT_OVERRIDE
+	mode := tree.SHARED_ELEMENT_INHERIT_OVERRIDE
 
 	// Parse provided expression
 	node, modified, err := ParseIntoNodeTree(input, false, "(", ")")
@@ -558,25 +558,25 @@ func TestSharedElementsAndAndCombinationWithInheritanceOverrideMode(t *testing.T
 		t.Fatal("Modified output does not correspond to input (Output: '" + modified + "')")
 	}
 
-	if len(node.GetSharedLeft()) == 0 || node.GetSharedLeft()[0] != "shared left" {
-		t.Fatal("Parsed left shared value is not correct. Node value: " + fmt.Sprint(node.GetSharedLeft()) + ". Expected output: shared left,inner left")
+	if len(node.GetSharedLeftForMode(mode)) == 0 || node.GetSharedLeftForMode(mode)[0] != "shared left" {
+		t.Fatal("Parsed left shared value is not correct. Node value: " + fmt.Sprint(node.GetSharedLeftForMode(mode)) + ". Expected output: shared left,inner left")
 	}
 
-	if len(node.GetSharedRight()) == 0 || node.GetSharedRight()[0] != "shared right" {
-		t.Fatal("Parsed right shared value is not correct. Node value: " + fmt.Sprint(node.GetSharedRight()) + ". Expected output: shared right")
+	if len(node.GetSharedRightForMode(mode)) == 0 || node.GetSharedRightForMode(mode)[0] != "shared right" {
+		t.Fatal("Parsed right shared value is not correct. Node value: " + fmt.Sprint(node.GetSharedRightForMode(mode)) + ". Expected output: shared right")
 	}
 
-	if fmt.Sprint(node.Left.GetSharedLeft()) != "[shared left]" {
-		t.Fatal("Left-nested left node did not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedLeft()) + ". Expected output: [shared left]")
+	if fmt.Sprint(node.Left.GetSharedLeftForMode(mode)) != "[shared left]" {
+		t.Fatal("Left-nested left node did not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedLeftForMode(mode)) + ". Expected output: [shared left]")
 	}
 
-	if len(node.Left.GetSharedRight()) == 0 || node.Left.GetSharedRight()[0] != "shared right" {
-		t.Fatal("Right-nested right node did not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedRight()) + ". Expected output: shared right")
+	if len(node.Left.GetSharedRightForMode(mode)) == 0 || node.Left.GetSharedRightForMode(mode)[0] != "shared right" {
+		t.Fatal("Right-nested right node did not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedRightForMode(mode)) + ". Expected output: shared right")
 	}
 
 	// Test reconstruction from tree
-	if node.Stringify() != "(shared left ((shared left ((shared left innermost left (Far left side [AND] Left side information) shared right) [AND] inner right) shared right) [AND] right information) shared right)" {
-		t.Fatal("Stringified output does not correspond to input (Output: '" + node.Stringify() + "')")
+	if node.StringifyForMode(mode) != "(shared left ((shared left ((shared left innermost left (Far left side [AND] Left side information) shared right) [AND] inner right) shared right) [AND] right information) shared right)" {
+		t.Fatal("Stringified output does not correspond to input (Output: '" + node.StringifyForMode(mode) + "')")
 	}
 }
 
@@ -587,7 +587,7 @@ func TestSharedElementsAndAndCombinationWithMissingCombination(t *testing.T) {
 
 	input := "( shared left ( inner left (Far left side [AND] Left side information)) [AND] inner right [AND] right information)"
 
-	tree.SHARED_ELEMENT_INHERITANCE_MODE = tree.SHARED_ELEMENT_INHERIT_APPEND
+	mode := tree.SHARED_ELEMENT_INHERIT_APPEND
 
 	// Parse provided expression
 	node, modified, err := ParseIntoNodeTree(input, false, "(", ")")
@@ -603,21 +603,21 @@ func TestSharedElementsAndAndCombinationWithMissingCombination(t *testing.T) {
 		t.Fatal("Modified output does not correspond to input (Output: '" + modified + "')")
 	}
 
-	if len(node.GetSharedLeft()) != 0 || fmt.Sprint(node.GetSharedLeft()) != "[]" {
-		t.Fatal("Parsed left shared value is not correct. Node value: " + fmt.Sprint(node.GetSharedLeft()) + ". Expected output: ")
+	if len(node.GetSharedLeftForMode(mode)) != 0 || fmt.Sprint(node.GetSharedLeftForMode(mode)) != "[]" {
+		t.Fatal("Parsed left shared value is not correct. Node value: " + fmt.Sprint(node.GetSharedLeftForMode(mode)) + ". Expected output: ")
 	}
 
-	if len(node.GetSharedRight()) != 0 {
-		t.Fatal("Parsed right shared value is not correct. Node value: " + fmt.Sprint(node.GetSharedRight()) + ". Expected empty output")
+	if len(node.GetSharedRightForMode(mode)) != 0 {
+		t.Fatal("Parsed right shared value is not correct. Node value: " + fmt.Sprint(node.GetSharedRightForMode(mode)) + ". Expected empty output")
 	}
 
-	if fmt.Sprint(node.Left.Left.GetSharedLeft()) != "[inner left]" {
-		t.Fatal("Left-nested left node did not inherit shared value. Node value: " + fmt.Sprint(node.Left.Left.GetSharedLeft()) + ". Expected output: [inner left]")
+	if fmt.Sprint(node.Left.Left.GetSharedLeftForMode(mode)) != "[inner left]" {
+		t.Fatal("Left-nested left node did not inherit shared value. Node value: " + fmt.Sprint(node.Left.Left.GetSharedLeftForMode(mode)) + ". Expected output: [inner left]")
 	}
 
 	// Test reconstruction from tree
-	if node.Stringify() != "(((inner left (Far left side [AND] Left side information)) [AND] inner right) [AND] right information)" {
-		t.Fatal("Stringified output does not correspond to input (Output: '" + node.Stringify() + "')")
+	if node.StringifyForMode(mode) != "(((inner left (Far left side [AND] Left side information)) [AND] inner right) [AND] right information)" {
+		t.Fatal("Stringified output does not correspond to input (Output: '" + node.StringifyForMode(mode) + "')")
 	}
 }
 
@@ -626,7 +626,7 @@ Tests for correct parsing of shared elements as well as decomposition of multipl
 */
 func TestSharedElementsAndAndCombinationWithoutInheritance(t *testing.T) {
 
-	tree.SHARED_ELEMENT_INHERITANCE_MODE = tree.SHARED_ELEMENT_INHERIT_NOTHING
+	mode := tree.SHARED_ELEMENT_INHERIT_NOTHING
 
 	input := "( shared left (Left side information [AND] middle information [AND] right information) shared right)"
 
@@ -642,25 +642,25 @@ func TestSharedElementsAndAndCombinationWithoutInheritance(t *testing.T) {
 		t.Fatal("Modified output does not correspond to input (Output: '" + modified + "')")
 	}
 
-	if node.GetSharedLeft()[0] != "shared left" {
-		t.Fatal("Parsed left shared value is not correct. Output: " + fmt.Sprint(node.GetSharedLeft()))
+	if node.GetSharedLeftForMode(mode)[0] != "shared left" {
+		t.Fatal("Parsed left shared value is not correct. Output: " + fmt.Sprint(node.GetSharedLeftForMode(mode)))
 	}
 
-	if node.GetSharedRight()[0] != "shared right" {
-		t.Fatal("Parsed right shared value is not correct. Output: " + fmt.Sprint(node.GetSharedRight()))
+	if node.GetSharedRightForMode(mode)[0] != "shared right" {
+		t.Fatal("Parsed right shared value is not correct. Output: " + fmt.Sprint(node.GetSharedRightForMode(mode)))
 	}
 
-	if node.Left.GetSharedLeft() != nil {
-		t.Fatal("Left-nested left node should not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedLeft()) + ". Expected output: ")
+	if node.Left.GetSharedLeftForMode(mode) != nil {
+		t.Fatal("Left-nested left node should not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedLeftForMode(mode)) + ". Expected output: ")
 	}
 
-	if node.Left.GetSharedRight() != nil {
-		t.Fatal("Left-nested right node should not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedRight()) + ". Expected output: ")
+	if node.Left.GetSharedRightForMode(mode) != nil {
+		t.Fatal("Left-nested right node should not inherit shared value. Node value: " + fmt.Sprint(node.Left.GetSharedRightForMode(mode)) + ". Expected output: ")
 	}
 
 	// Test reconstruction from tree
-	if node.Stringify() != "(shared left ((Left side information [AND] middle information) [AND] right information) shared right)" {
-		t.Fatal("Stringified output does not correspond to input (Output: '" + node.Stringify() + "')")
+	if node.StringifyForMode(mode) != "(shared left ((Left side information [AND] middle information) [AND] right information) shared right)" {
+		t.Fatal("Stringified output does not correspond to input (Output: '" + node.StringifyForMode(mode) + "')")
 	}
 }
 
@@ -889,3 +889,128 @@ func TestSimpleStatementWithEmbeddedParentheses(t *testing.T) {
 	}
 
 }
+
+/*
+Tests binary negation (i.e., left side applies, but not right side).
+*/
+func TestBinaryNegation(t *testing.T) {
+
+	input := "(inspect [NOT] certify)"
+
+	// Parse provided expression
+	node, _, err := ParseIntoNodeTree(input, false, "(", ")")
+
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Parsing throws error where there should be none. Error: ", err.Error())
+	}
+
+	if node.LogicalOperator != tree.NOT {
+		t.Fatal("Logical operator should be NOT, but is " + node.LogicalOperator)
+	}
+
+	if node.IsUnaryNegation() {
+		t.Fatal("Node should not be unary negation")
+	}
+
+	if node.Left.Entry != "inspect" {
+		t.Error("Left leaf node has wrong value.")
+	}
+
+	if node.Right.Entry != "certify" {
+		t.Error("Right leaf node has wrong value.")
+	}
+
+	if node.CountLeaves() != 2 {
+		t.Error("Tree leaf count is wrong: " + strconv.Itoa(node.CountLeaves()))
+	}
+
+	if node.Stringify() != input {
+		t.Fatal("Stringified output does not correspond to input (Output: '" + node.Stringify() + "')")
+	}
+
+}
+
+/*
+Tests unary negation (i.e., negation without left side) on top level and embedded in combination.
+*/
+func TestUnaryNegation(t *testing.T) {
+
+	input := "([NOT] certified)"
+
+	// Parse provided expression
+	node, _, err := ParseIntoNodeTree(input, false, "(", ")")
+
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Parsing throws error where there should be none. Error: ", err.Error())
+	}
+
+	if !node.IsUnaryNegation() {
+		t.Fatal("Node should be unary negation")
+	}
+
+	if node.Left != nil {
+		t.Fatal("Unary negation should not have left node")
+	}
+
+	if node.Right.Entry != "certified" {
+		t.Error("Negated node has wrong value.")
+	}
+
+	if !node.Right.IsNegated() {
+		t.Error("Negated node is not identified as negated.")
+	}
+
+	if node.CountLeaves() != 1 {
+		t.Error("Tree leaf count is wrong: " + strconv.Itoa(node.CountLeaves()))
+	}
+
+	if node.Stringify() != input {
+		t.Fatal("Stringified output does not correspond to input (Output: '" + node.Stringify() + "')")
+	}
+
+	input = "(inspected [AND] ([NOT] certified))"
+
+	// Parse provided expression
+	node, _, err = ParseIntoNodeTree(input, false, "(", ")")
+
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Parsing throws error where there should be none. Error: ", err.Error())
+	}
+
+	if node.LogicalOperator != tree.AND {
+		t.Fatal("Logical operator should be AND, but is " + node.LogicalOperator)
+	}
+
+	if !node.Right.IsUnaryNegation() {
+		t.Fatal("Right node should be unary negation")
+	}
+
+	if node.Right.Right.Entry != "certified" {
+		t.Error("Negated node has wrong value.")
+	}
+
+	if node.Stringify() != input {
+		t.Fatal("Stringified output does not correspond to input (Output: '" + node.Stringify() + "')")
+	}
+
+}
+
+/*
+Tests unary negation mixed with other logical operators on the same level (which requires parentheses).
+*/
+func TestUnaryNegationMixedWithOtherOperators(t *testing.T) {
+
+	input := "(inspected [AND] [NOT] certified)"
+
+	// Parse provided expression
+	_, text, err := ParseIntoNodeTree(input, false, "(", ")")
+
+	if err.ErrorCode != tree.PARSING_ERROR_INVALID_OPERATOR_COMBINATIONS {
+		t.Fatal("Did not pick up on invalid logical operator combinations on given level")
+	}
+
+	if text != input {
+		t.Fatal("Returned output does not correspond to input (Output: '" + text + "')")
+	}
+
+}
@@ -18,11 +18,13 @@ parser.IGStatementParser_test.go.
 
 /*
 Parse basic statements identified as part of #separateComponentsNestedStatementsCombinationsAndComponentPairs.
-Takes plain string and statement reference as input. Generates statement if no statement reference (i.e., nil) is passed.
+Takes plain string, statement reference and parsing context as input. Generates statement if no statement reference (i.e., nil) is passed.
 Returns statement embedded in node, as well as remaining part of original input string that has not been parsed.
 Note: If returning an error, the identified part of the text associated with the last parsed component is returned (to simplify diagnostics).
+If the parsing context permits recovery, errors for individual components are reported to the context instead,
+and parsing continues with the remaining components (with the content of the failing component considered as processed).
 */
-func parseBasicStatement(text string, s *tree.Statement) ([]tree.Node, string, tree.ParsingError) {
+func parseBasicStatement(text string, s *tree.Statement, ctx *parsingContext) ([]tree.Node, string, tree.ParsingError) {
 
 	// Check whether statement is passed, else create new one
 	if s == nil {
@@ -31,212 +33,53 @@ func parseBasicStatement(text string, s *tree.Statement) ([]tree.Node, string, t
 	// Initial full string content: keeps track of remaining string content
 	remainingString := text
 
-	result, componentText, err := parseAttributes(text)
-	outErr := handleParsingError(tree.ATTRIBUTES, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.Attributes = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
-	}
-
-	result, componentText, err = parseAttributesProperty(text)
-	outErr = handleParsingError(tree.ATTRIBUTES_PROPERTY, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.AttributesPropertySimple = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
-	}
-
-	result, componentText, err = parseDeontic(text)
-	outErr = handleParsingError(tree.DEONTIC, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.Deontic = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
-	}
-
-	result, componentText, err = parseAim(text)
-	outErr = handleParsingError(tree.AIM, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.Aim = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
-	}
-
-	result, componentText, err = parseDirectObject(text)
-	outErr = handleParsingError(tree.DIRECT_OBJECT, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.DirectObject = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
-	}
-
-	result, componentText, err = parseDirectObjectProperty(text)
-	outErr = handleParsingError(tree.DIRECT_OBJECT_PROPERTY, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.DirectObjectPropertySimple = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
-	}
-
-	result, componentText, err = parseIndirectObject(text)
-	outErr = handleParsingError(tree.INDIRECT_OBJECT, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.IndirectObject = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
-	}
-
-	result, componentText, err = parseIndirectObjectProperty(text)
-	outErr = handleParsingError(tree.INDIRECT_OBJECT_PROPERTY, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.IndirectObjectPropertySimple = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
-	}
-
-	result, componentText, err = parseActivationCondition(text)
-	outErr = handleParsingError(tree.ACTIVATION_CONDITION, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.ActivationConditionSimple = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
-	}
-
-	result, componentText, err = parseExecutionConstraint(text)
-	outErr = handleParsingError(tree.EXECUTION_CONSTRAINT, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.ExecutionConstraintSimple = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
-	}
-
-	result, componentText, err = parseConstitutedEntity(text)
-	outErr = handleParsingError(tree.CONSTITUTED_ENTITY, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.ConstitutedEntity = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
-	}
-
-	result, componentText, err = parseConstitutedEntityProperty(text)
-	outErr = handleParsingError(tree.CONSTITUTED_ENTITY_PROPERTY, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.ConstitutedEntityPropertySimple = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
-	}
-
-	result, componentText, err = parseModal(text)
-	outErr = handleParsingError(tree.MODAL, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.Modal = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
-	}
-
-	result, componentText, err = parseConstitutingFunction(text)
-	outErr = handleParsingError(tree.CONSTITUTIVE_FUNCTION, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.ConstitutiveFunction = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
-	}
-
-	result, componentText, err = parseConstitutingProperties(text)
-	outErr = handleParsingError(tree.CONSTITUTING_PROPERTIES, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.ConstitutingProperties = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
-	}
-
-	result, componentText, err = parseConstitutingPropertiesProperty(text)
-	outErr = handleParsingError(tree.CONSTITUTING_PROPERTIES_PROPERTY, err)
-	if outErr.ErrorCode != tree.PARSING_NO_ERROR {
-		// Populate return structure
-		ret := []tree.Node{tree.Node{Entry: &s}}
-		return ret, strings.Join(componentText, " "), outErr
-	}
-	s.ConstitutingPropertiesPropertySimple = result
-	// Remove elements parsed as part of the component parsing
-	for _, v := range componentText {
-		remainingString = strings.ReplaceAll(remainingString, v, "")
+	// Components to be parsed, alongside parsing function and statement field the result is assigned to
+	components := []struct {
+		component string
+		parse     func(string) (*tree.Node, []string, tree.ParsingError)
+		target    **tree.Node
+	}{
+		{tree.ATTRIBUTES, parseAttributes, &s.Attributes},
+		{tree.ATTRIBUTES_PROPERTY, parseAttributesProperty, &s.AttributesPropertySimple},
+		{tree.DEONTIC, parseDeontic, &s.Deontic},
+		{tree.AIM, parseAim, &s.Aim},
+		{tree.DIRECT_OBJECT, parseDirectObject, &s.DirectObject},
+		{tree.DIRECT_OBJECT_PROPERTY, parseDirectObjectProperty, &s.DirectObjectPropertySimple},
+		{tree.INDIRECT_OBJECT, parseIndirectObject, &s.IndirectObject},
+		{tree.INDIRECT_OBJECT_PROPERTY, parseIndirectObjectProperty, &s.IndirectObjectPropertySimple},
+		{tree.ACTIVATION_CONDITION, parseActivationCondition, &s.ActivationConditionSimple},
+		{tree.EXECUTION_CONSTRAINT, parseExecutionConstraint, &s.ExecutionConstraintSimple},
+		{tree.CONSTITUTED_ENTITY, parseConstitutedEntity, &s.ConstitutedEntity},
+		{tree.CONSTITUTED_ENTITY_PROPERTY, parseConstitutedEntityProperty, &s.ConstitutedEntityPropertySimple},
+		{tree.MODAL, parseModal, &s.Modal},
+		{tree.CONSTITUTIVE_FUNCTION, parseConstitutingFunction, &s.ConstitutiveFunction},
+		{tree.CONSTITUTING_PROPERTIES, parseConstitutingProperties, &s.ConstitutingProperties},
+		{tree.CONSTITUTING_PROPERTIES_PROPERTY, parseConstitutingPropertiesProperty, &s.ConstitutingPropertiesPropertySimple},
+	}
+
+	for _, c := range components {
+		result, componentText, err := c.parse(text)
+		outErr := handleParsingError(c.component, err)
+		if outErr.ErrorCode != tree.PARSING_NO_ERROR {
+			if !ctx.recover {
+				// Populate return structure
+				ret := []tree.Node{tree.Node{Entry: &s}}
+				return ret, strings.Join(componentText, " "), outErr
+			}
+			// Report error (pointing to content of failing component if no specific position is known) and continue with next component
+			if len(outErr.ErrorSpans) == 0 {
+				for _, v := range componentText {
+					outErr.ErrorSpans = append(outErr.ErrorSpans, tree.TextSpan(strings.TrimSpace(v)))
+				}
+			}
+			ctx.report(outErr)
+		} else {
+			*c.target = result
+		}
+		// Remove elements parsed as part of the component parsing
+		for _, v := range componentText {
+			remainingString = strings.ReplaceAll(remainingString, v, "")
+		}
 	}
 
 	Println("Basic statement: " + s.String())
@@ -443,7 +286,8 @@ func extractComponentContent(component string, propertyComponent bool, input str
 			// Example: Bdir,p(left [AND] right)) Bdir((left [AND] right)
 			return nil, tree.ParsingError{ErrorCode: tree.PARSING_ERROR_UNABLE_TO_EXTRACT_COMPONENT_CONTENT,
 				ErrorMessage: "Could not determine component content of component '" + resultContent + "'. " +
-					"Please review parentheses/braces in input '" + processedString + "'."}
+					"Please review parentheses/braces in input '" + processedString + "'.",
+				ErrorSpans: []tree.SourceSpan{tree.TextSpan(strings.TrimSpace(processedString[result[0][0]:]))}}
 		}
 	}
 }
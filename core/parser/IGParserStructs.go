@@ -19,7 +19,12 @@ const LEFT_BRACKET = "["
 const RIGHT_BRACKET = "]"
 
 // Logical operators prepared for regular expression
-const LOGICAL_OPERATORS = "(" + tree.AND + "|" + tree.OR + "|" + tree.XOR + ")"
+const LOGICAL_OPERATORS = "(" + tree.AND + "|" + tree.OR + "|" + tree.XOR + "|" + tree.NOT + ")"
+
+// Internal substitute for leading negation of nested statements in nested combinations (e.g., Cac{[NOT] ...}),
+// which prevents its interpretation as unary combination during combination parsing (must not contain characters
+// of component symbols, since it precedes component symbols if negating elements of combinations (e.g., Cac{[NOT] Cac{...} [AND] ...}))
+const NEGATED_NESTED_STATEMENT_MARKER = "¬"
 
 // General alpha-numeric characters including umlaut and diacritics support
 const ALPHA_NUMERIC_CHARACTERS = "a-zA-ZÀ-ž,0-9"
@@ -0,0 +1,72 @@
+package parser
+
+import (
+	"IG-Parser/core/tree"
+	"strings"
+)
+
+/*
+This file contains the parsing context threaded through the parsing of a statement,
+which collects diagnostics (errors, warnings and information) across all nesting levels
+(see #ParseStatementWithDiagnostics()).
+*/
+
+/*
+Context of an individual parsing run.
+*/
+type parsingContext struct {
+	// Indicates whether parsing continues past recoverable errors (reporting those as diagnostics)
+	recover bool
+	// Diagnostics collected during parsing
+	diagnostics []tree.Diagnostic
+}
+
+/*
+Reports a diagnostic for the given error to the parsing context (ignored if error code is tree.PARSING_NO_ERROR).
+Errors with the same code, message and offending content as previously reported ones are not reported again.
+*/
+func (ctx *parsingContext) report(err tree.ParsingError) {
+	if err.ErrorCode == tree.PARSING_NO_ERROR || ctx.contains(err) {
+		return
+	}
+	ctx.diagnostics = append(ctx.diagnostics, tree.NewDiagnostic(err))
+}
+
+/*
+Indicates whether a diagnostic with the same code, message and offending content as the given error has been reported.
+*/
+func (ctx *parsingContext) contains(err tree.ParsingError) bool {
+	for _, d := range ctx.diagnostics {
+		if d.ErrorCode == err.ErrorCode && d.ErrorMessage == err.ErrorMessage && spanTexts(d.ErrorSpans) == spanTexts(err.ErrorSpans) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Returns the offending content of given spans, or an empty string if no spans are given.
+*/
+func spanTexts(spans []tree.SourceSpan) string {
+	texts := []string{}
+	for _, span := range spans {
+		texts = append(texts, span.Text)
+	}
+	return strings.Join(texts, " ")
+}
+
+/*
+Parses nested content (see #parseStatement()) in a separate parsing context and relates the positions of offending
+content in diagnostics reported during nested parsing (including the returned error) to the nested content
+(see #relateErrorToNestedContent()), before adding them to the given (outer) parsing context.
+Returns parsed statements and error (related to nested content).
+*/
+func parseNestedContent(nestedContent string, ctx *parsingContext) ([]*tree.Node, tree.ParsingError) {
+	nestedCtx := &parsingContext{recover: ctx.recover}
+	stmts, err := parseStatement(nestedContent, nestedCtx)
+	nestedCtx.report(err)
+	for _, d := range nestedCtx.diagnostics {
+		ctx.report(relateErrorToNestedContent(d.ParsingError, nestedContent))
+	}
+	return stmts, relateErrorToNestedContent(err, nestedContent)
+}
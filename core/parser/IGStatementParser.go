@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
 /*
@@ -22,10 +23,66 @@ IGComponentParser.go.
 /*
 Parses statement tree from input string. Returns statement tree, and error.
 If parsing is successful, error code tree.PARSING_NO_ERROR is returned, else
-other context-specific codes are returned.
+other context-specific codes are returned. Errors and warnings carry the position
+of the offending content in the input (see tree.ParsingError.ErrorSpans), where determinable.
 */
 func ParseStatement(text string) ([]*tree.Node, tree.ParsingError) {
 
+	stmts, err := parseStatement(text, &parsingContext{})
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		err = locateError(err, text)
+	}
+	return stmts, err
+}
+
+/*
+Parses statement tree from input string (see #ParseStatement()), but continues parsing past recoverable
+problems (e.g., an invalid component in an otherwise valid statement) instead of returning on the first one.
+Returns the statement tree (comprising the successfully parsed parts of the statement), alongside all diagnostics
+(errors, warnings and information, see tree.Diagnostic) collected across all nesting levels, with the position
+of the offending content in the input, where determinable. Diagnostics are empty if parsing is successful.
+Statement tree may be empty or nil if problems prevent the parsing of the statement as a whole (e.g., imbalanced parentheses),
+and should generally only be processed further if no diagnostic of severity tree.SEVERITY_ERROR has been reported (see tree.HasErrors()).
+*/
+func ParseStatementWithDiagnostics(text string) ([]*tree.Node, []tree.Diagnostic) {
+
+	ctx := &parsingContext{recover: true}
+	stmts, err := parseStatement(text, ctx)
+	ctx.report(err)
+
+	diagnostics := []tree.Diagnostic{}
+	for _, d := range ctx.diagnostics {
+		d.ParsingError = locateError(d.ParsingError, text)
+		diagnostics = append(diagnostics, d)
+	}
+	return stmts, diagnostics
+}
+
+/*
+Determines the positions of the offending content of a given error in the input (see tree.ParsingError.LocateSpans()),
+falling back to ignored elements as offending content if no specific position is known.
+*/
+func locateError(err tree.ParsingError, text string) tree.ParsingError {
+	if len(err.ErrorSpans) == 0 {
+		for _, elem := range err.ErrorIgnoredElements {
+			if strings.TrimSpace(elem) != "" {
+				err.ErrorSpans = append(err.ErrorSpans, tree.TextSpan(strings.TrimSpace(elem)))
+			}
+		}
+	}
+	err.LocateSpans(text)
+	return err
+}
+
+/*
+Parses statement tree from input string (see #ParseStatement()). Positions of offending content
+in errors are not yet resolved with respect to the input (see tree.ParsingError.LocateSpans()),
+so that nested statements can be parsed recursively.
+The parsing context collects diagnostics and determines whether parsing continues past recoverable problems
+(see #ParseStatementWithDiagnostics()). In this case, the returned error is also reported to the context.
+*/
+func parseStatement(text string, ctx *parsingContext) ([]*tree.Node, tree.ParsingError) {
+
 	s := tree.Statement{}
 
 	Println("INITIATING STATEMENT PARSING ...\nProcessing input statement: ", text)
@@ -33,20 +90,24 @@ func ParseStatement(text string) ([]*tree.Node, tree.ParsingError) {
 	// Empty warning - can be overwritten during execution and returned as a result ...
 	warn := tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
 
-	// Validate input string first with respect to parentheses, ...
-	err := validateInput(text, LEFT_PARENTHESIS, RIGHT_PARENTHESIS)
-	if err.ErrorCode != tree.PARSING_NO_ERROR {
-		return []*tree.Node{}, err
+	// Validate input string first with respect to parentheses, braces and brackets
+	// (reporting all imbalances if parsing context permits recovery, but returning the first one)
+	validationErr := tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+	for _, pars := range [][]string{{LEFT_PARENTHESIS, RIGHT_PARENTHESIS}, {LEFT_BRACE, RIGHT_BRACE}, {LEFT_BRACKET, RIGHT_BRACKET}} {
+		err := validateInput(text, pars[0], pars[1])
+		if err.ErrorCode == tree.PARSING_NO_ERROR {
+			continue
+		}
+		if !ctx.recover {
+			return []*tree.Node{}, err
+		}
+		ctx.report(err)
+		if validationErr.ErrorCode == tree.PARSING_NO_ERROR {
+			validationErr = err
+		}
 	}
-	// ... braces
-	err = validateInput(text, LEFT_BRACE, RIGHT_BRACE)
-	if err.ErrorCode != tree.PARSING_NO_ERROR {
-		return []*tree.Node{}, err
-	}
-	// ... and brackets
-	err = validateInput(text, LEFT_BRACKET, RIGHT_BRACKET)
-	if err.ErrorCode != tree.PARSING_NO_ERROR {
-		return []*tree.Node{}, err
+	if validationErr.ErrorCode != tree.PARSING_NO_ERROR {
+		return []*tree.Node{}, validationErr
 	}
 
 	// Now extract component-only expressions, nested statements, statement combinations, as well as component pair combinations (Note: only processed at the end of function)
@@ -97,7 +158,7 @@ func ParseStatement(text string) ([]*tree.Node, tree.ParsingError) {
 		Println("Text to be parsed: " + text)
 
 		// Now parsing on component level
-		_, remainingText, outErr := parseBasicStatement(text, &s)
+		_, remainingText, outErr := parseBasicStatement(text, &s, ctx)
 		if outErr.ErrorCode != tree.PARSING_NO_ERROR {
 			// Populate return structure
 			ret := []*tree.Node{&tree.Node{Entry: &s}}
@@ -130,6 +191,9 @@ func ParseStatement(text string) ([]*tree.Node, tree.ParsingError) {
 				"Please consider reviewing your coding in case it should have been parsed as part of the output."
 			// Pass fragments of concern along
 			warn.ErrorIgnoredElements = []string{remainingText}
+			for _, fragment := range extractNonParsedFragments(remainingText) {
+				warn.ErrorSpans = append(warn.ErrorSpans, tree.TextSpan(fragment))
+			}
 			// Let the final return command handle the actual return ...
 		}
 	}
@@ -142,11 +206,17 @@ func ParseStatement(text string) ([]*tree.Node, tree.ParsingError) {
 		// Iterate through all combinations for fine-granular error handling
 		for _, nestedCombo := range nestedCombos {
 			Println("Attempting to process nested combination " + nestedCombo)
-			err = parseNestedStatementCombination(&s, nestedCombo)
+			err := parseNestedStatementCombination(&s, nestedCombo, ctx)
 			if err.ErrorCode == tree.PARSING_ERROR_NIL_ELEMENT {
 				// Shift to regular nested statement if parsing as combo failed (Regex is too coarse-grained and favors combinations before fine-grained parsing)
 				nestedStmts = append(nestedStmts, err.ErrorIgnoredElements...)
 				Println("Reclassifying statement as nested statement (as opposed to nested combination) ...")
+			} else if err.ErrorCode != tree.PARSING_NO_ERROR && ctx.recover {
+				// Report error (pointing to the nested combination if no specific position is known) and continue with remaining elements
+				if len(err.ErrorSpans) == 0 {
+					err.ErrorSpans = []tree.SourceSpan{tree.TextSpan(strings.TrimSpace(nestedCombo))}
+				}
+				ctx.report(err)
 			} else if err.ErrorCode != tree.PARSING_NO_ERROR {
 				// Populate return structure
 				ret := []*tree.Node{&tree.Node{Entry: &s, Annotations: stmtLevelAnnotations}}
@@ -187,17 +257,27 @@ func ParseStatement(text string) ([]*tree.Node, tree.ParsingError) {
 				}
 				detectedLogicalOperator = tree.OR
 			}
+			if strings.Contains(text, tree.NOT_BRACKETS) {
+				if detectedLogicalOperator != "" {
+					return nil, tree.ParsingError{ErrorCode: tree.PARSING_ERROR_INVALID_OPERATOR_COMBINATIONS,
+						ErrorMessage: "Detected multiple logical operators (" + detectedLogicalOperator + " and " + tree.NOT +
+							") on given parsing level. Please revise your coding with respect to indication of precedence."}
+				}
+				detectedLogicalOperator = tree.NOT
+			}
 		}
 
-		err = parseNestedStatements(&s, nestedStmts, detectedLogicalOperator)
-		// Check whether nested statements have been ignored entirely
-		if err.ErrorCode == tree.PARSING_ERROR_IGNORED_NESTED_ELEMENTS {
+		err := parseNestedStatements(&s, nestedStmts, detectedLogicalOperator, ctx)
+		// Report problems (including ignored nested statements) and continue if parsing context permits recovery
+		if err.ErrorCode != tree.PARSING_NO_ERROR && ctx.recover {
+			ctx.report(err)
+		} else if err.ErrorCode == tree.PARSING_ERROR_IGNORED_NESTED_ELEMENTS {
+			// Check whether nested statements have been ignored entirely
 			// Populate return structure
 			ret := []*tree.Node{&tree.Node{Entry: &s, Annotations: stmtLevelAnnotations}}
 			Println("Returning error "+tree.PARSING_ERROR_IGNORED_NESTED_ELEMENTS+" with ignored elements: ", err)
 			return ret, err
-		}
-		if err.ErrorCode != tree.PARSING_NO_ERROR {
+		} else if err.ErrorCode != tree.PARSING_NO_ERROR {
 			// Populate return structure
 			ret := []*tree.Node{&tree.Node{Entry: &s, Annotations: stmtLevelAnnotations}}
 			return ret, err
@@ -222,10 +302,14 @@ func ParseStatement(text string) ([]*tree.Node, tree.ParsingError) {
 				ErrorIgnoredElements: compAndNestedStmts[3]}
 		} else {
 			// If one component pair combination on a given nesting level, extrapolate (may contain nested pair combination (e.g., { left [AND] { right [XOR] alsoRight }})
-			extrapolatedStmts, err2 := extrapolateStatementWithPairedComponents(&s, compAndNestedStmts[3])
+			extrapolatedStmts, err2 := extrapolateStatementWithPairedComponents(&s, compAndNestedStmts[3], ctx)
 			if err2.ErrorCode != tree.PARSING_NO_ERROR {
 				return extrapolatedStmts, err2
 			}
+			ctx.report(tree.ParsingError{ErrorCode: tree.PARSING_INFO_COMPONENT_PAIR_EXPANSION,
+				ErrorMessage: "Component pair combination has been expanded into multiple atomic statements. " +
+					"Expression: '" + compAndNestedStmts[3][0] + "'",
+				ErrorSpans: []tree.SourceSpan{tree.TextSpan(strings.TrimSpace(compAndNestedStmts[3][0]))}})
 			Println("Final statements (with extrapolation): " + tree.PrintNodes(extrapolatedStmts))
 			// Append statement-level annotations to each output
 			for _, stmt := range extrapolatedStmts {
@@ -487,14 +571,15 @@ func handleParsingError(component string, err tree.ParsingError) tree.ParsingErr
 
 /*
 Parses nested statements (but not combinations) and attaches those to the top-level statement.
-Uses given logical operator to link to existing statements (takes only tree.OR, tree.AND, and tree.XOR - no brackets).
+Uses given logical operator to link to existing statements (takes only tree.OR, tree.AND, tree.XOR, and tree.NOT - no brackets).
+Nested statements with leading negation (e.g., Cac{[NOT] A(actor) I(action)}) are wrapped in a unary negation node.
 Returns an error other than tree.PARSING_NO_ERROR if issues during parsing.
 Specific errors:
 Returns err tree.PARSING_ERROR_IGNORED_NESTED_ELEMENTS if parsing did not pose problems per se,
 but elements have been ignored during parsing (warranting syntax review). In this case, the statements of concern
 are returned in a string array contained in the error object.
 */
-func parseNestedStatements(stmtToAttachTo *tree.Statement, nestedStmts []string, logicalOperator string) tree.ParsingError {
+func parseNestedStatements(stmtToAttachTo *tree.Statement, nestedStmts []string, logicalOperator string, ctx *parsingContext) tree.ParsingError {
 
 	// Copy reference statement for comparison (to check whether modification took place based on parsed element)
 	cachedStmtPriorToNestedParsing := stmtToAttachTo.String()
@@ -573,6 +658,12 @@ func parseNestedStatements(stmtToAttachTo *tree.Statement, nestedStmts []string,
 		suffix, annotation, _, err := extractSuffixAndAnnotations(component, isProperty, v, LEFT_BRACE, RIGHT_BRACE)
 		if err.ErrorCode != tree.PARSING_NO_ERROR {
 			fmt.Println("Error during extraction of suffices and annotations on component '" + component + "': " + err.ErrorCode)
+			if ctx.recover {
+				// Report error (pointing to nested statement) and continue with remaining nested statements
+				err.ErrorSpans = []tree.SourceSpan{tree.TextSpan(strings.TrimSpace(v))}
+				ctx.report(err)
+				continue
+			}
 			return err
 		}
 
@@ -580,9 +671,25 @@ func parseNestedStatements(stmtToAttachTo *tree.Statement, nestedStmts []string,
 		Println("Nested Stmt Suffix:", suffix)
 		Println("Nested Stmt Annotation:", annotation)
 
-		// Parse actual content wrapped in nested component (e.g., content inside Cac{ ... })
-		stmt, errStmt := ParseStatement(v[strings.Index(v, LEFT_BRACE)+1 : strings.LastIndex(v, RIGHT_BRACE)])
-		if errStmt.ErrorCode != tree.PARSING_NO_ERROR && errStmt.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		// Extract actual content wrapped in nested component (e.g., content inside Cac{ ... })
+		nestedContent := v[strings.Index(v, LEFT_BRACE)+1 : strings.LastIndex(v, RIGHT_BRACE)]
+
+		// Check for leading negation of nested statement (e.g., Cac{[NOT] A(actor) I(action)})
+		negated := false
+		if strings.HasPrefix(strings.TrimSpace(nestedContent), tree.NOT_BRACKETS) {
+			Println("Identified negated nested statement:", v)
+			negated = true
+			nestedContent = strings.TrimPrefix(strings.TrimSpace(nestedContent), tree.NOT_BRACKETS)
+		}
+
+		// Parse nested content
+		stmt, errStmt := parseNestedContent(nestedContent, ctx)
+		if errStmt.ErrorCode != tree.PARSING_NO_ERROR && errStmt.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT && ctx.recover &&
+			errStmt.ErrorCode != tree.PARSING_ERROR_EMPTY_STATEMENT {
+			// Error has been reported during nested parsing; continue with remaining nested statements
+			fmt.Println("Error when parsing nested statements: ", errStmt)
+			continue
+		} else if errStmt.ErrorCode != tree.PARSING_NO_ERROR && errStmt.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
 			fmt.Println("Error when parsing nested statements: ", errStmt)
 			if errStmt.ErrorCode == tree.PARSING_ERROR_EMPTY_STATEMENT {
 				// Override error code for empty nested statements, since braces were evidently present
@@ -593,7 +700,10 @@ func parseNestedStatements(stmtToAttachTo *tree.Statement, nestedStmts []string,
 			return errStmt
 		} else if errStmt.ErrorCode == tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
 			fmt.Println("Missing text fragments when parsing nested statements: ", errStmt)
-			defaultError = errStmt
+			// Warning has been reported during nested parsing if parsing context permits recovery
+			if !ctx.recover {
+				defaultError = errStmt
+			}
 		}
 		if len(stmt) > 1 {
 			fmt.Println("Unhandled case: Multiple decomposed statements in nested component ...", stmt)
@@ -621,6 +731,13 @@ func parseNestedStatements(stmtToAttachTo *tree.Statement, nestedStmts []string,
 			}
 		}
 
+		// Wrap negated nested statement in unary negation node (which is attached in place of nested statement)
+		if negated {
+			negation := &tree.Node{LogicalOperator: tree.NOT, ComponentType: component}
+			negation.InsertRightNode(stmtNode)
+			stmtNode = negation
+		}
+
 		// Default error for node combination - can generally only be overridden by detected invalid component combinations
 		nodeCombinationError := tree.NodeError{ErrorCode: tree.TREE_NO_ERROR}
 
@@ -673,8 +790,15 @@ func parseNestedStatements(stmtToAttachTo *tree.Statement, nestedStmts []string,
 			stmtToAttachTo.OrElse, nodeCombinationError = attachComplexComponent(stmtToAttachTo.OrElse, stmtNode, logicalOperator)
 		}
 		if nodeCombinationError.ErrorCode != tree.TREE_NO_ERROR {
-			return tree.ParsingError{ErrorCode: nodeCombinationError.ErrorCode, ErrorMessage: "Error when merging substatements into statement. Error: " +
+			mergeErr := tree.ParsingError{ErrorCode: nodeCombinationError.ErrorCode, ErrorMessage: "Error when merging substatements into statement. Error: " +
 				nodeCombinationError.ErrorMessage}
+			if ctx.recover {
+				// Report error (pointing to nested statement) and continue with remaining nested statements
+				mergeErr.ErrorSpans = []tree.SourceSpan{tree.TextSpan(strings.TrimSpace(v))}
+				ctx.report(mergeErr)
+				continue
+			}
+			return mergeErr
 		}
 
 		// Check if the iterated nested statement has been ignored entirely --> indicates failed detection as nested (as opposed to mere parsing problem)
@@ -707,22 +831,27 @@ Error tree.PARSING_ERROR_INVALID_COMPONENT_TYPE_COMBINATION and tree.PARSING_ERR
 point to an invalid combination of different component types (e.g., Cac and Bdir)
 Error tree.PARSING_ERROR_INVALID_COMBINATION points to syntactic issues during combination parsing.
 */
-func parseNestedStatementCombination(stmtToAttachTo *tree.Statement, nestedCombo string) tree.ParsingError {
+func parseNestedStatementCombination(stmtToAttachTo *tree.Statement, nestedCombo string, ctx *parsingContext) tree.ParsingError {
 
 	// Default error for node combination - can generally only be overridden by detected invalid component combinations
 	nodeCombinationError := tree.NodeError{ErrorCode: tree.TREE_NO_ERROR}
 
 	Println("Found nested statement combination candidate", nestedCombo)
 
-	combo, _, errStmt := ParseIntoNodeTree(nestedCombo, false, LEFT_BRACE, RIGHT_BRACE)
+	// Substitute leading negations of nested statements (e.g., Cac{[NOT] ...}) to prevent their parsing as combinations
+	negationPattern := regexp.MustCompile("\\" + LEFT_BRACE + "\\s*" + regexp.QuoteMeta(tree.NOT_BRACKETS))
+	combo, _, errStmt := ParseIntoNodeTree(negationPattern.ReplaceAllString(nestedCombo, LEFT_BRACE+NEGATED_NESTED_STATEMENT_MARKER),
+		false, LEFT_BRACE, RIGHT_BRACE)
 	if errStmt.ErrorCode != tree.PARSING_NO_ERROR && errStmt.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
 		fmt.Print("Error when parsing nested statement combinations into node:", errStmt)
 		return errStmt
 	}
 
-	// Check whether all leaves have the same prefix
-	flatCombo := tree.Flatten(combo.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES))
+	// Check whether all leaves have the same prefix (aggregation of implicit linkages is irrelevant, since all leaves are flattened)
+	flatCombo := tree.Flatten(combo.GetLeafNodes(true))
 	sharedPrefix := ""
+	// Keeps track of negated nested statements (to be wrapped in negation nodes after parsing)
+	negatedNodes := []*tree.Node{}
 	for _, node := range flatCombo {
 		if node.Entry == nil {
 			// Parsing did not work (incomplete combination (e.g., embedded logical operator, but on wrong level)); simply return error and violating entry
@@ -731,6 +860,14 @@ func parseNestedStatementCombination(stmtToAttachTo *tree.Statement, nestedCombo
 				ErrorIgnoredElements: []string{nestedCombo}}
 		}
 		entry := node.Entry.(string)
+		// Remove negation marker (either negating the content of the nested statement (e.g., Cac{[NOT] A(...) ...}),
+		// or preceding the nested statement as first element of the combination (e.g., Cac{[NOT] Cac{...} [AND] ...}))
+		// prior to the extraction of the component type, and retain node for later wrapping
+		if strings.Contains(entry, NEGATED_NESTED_STATEMENT_MARKER) {
+			entry = strings.TrimSpace(strings.Replace(entry, NEGATED_NESTED_STATEMENT_MARKER, "", 1))
+			node.Entry = entry
+			negatedNodes = append(negatedNodes, node)
+		}
 		Println("Entry to parse for component type: " + entry)
 		// Extract prefix (i.e., component type) for node, but check whether it contains nested statement
 		if strings.Index(entry, LEFT_BRACE) == -1 {
@@ -792,7 +929,8 @@ func parseNestedStatementCombination(stmtToAttachTo *tree.Statement, nestedCombo
 		Println("Nested Combo Stmt Annotation:", annotation)
 		Println("Nested Combo Stmt Content:", content)
 
-		stmt, errStmt := ParseStatement(oldValue[strings.Index(oldValue, LEFT_BRACE)+1 : strings.LastIndex(oldValue, RIGHT_BRACE)])
+		nestedContent := oldValue[strings.Index(oldValue, LEFT_BRACE)+1 : strings.LastIndex(oldValue, RIGHT_BRACE)]
+		stmt, errStmt := parseNestedContent(nestedContent, ctx)
 		if errStmt.ErrorCode != tree.PARSING_NO_ERROR {
 			return stmt[0].Entry.(*tree.Statement), errStmt
 		}
@@ -802,6 +940,18 @@ func parseNestedStatementCombination(stmtToAttachTo *tree.Statement, nestedCombo
 		return err
 	}
 
+	// Wrap negated nested statements in unary negation nodes (placed at the position of the original node)
+	for _, node := range negatedNodes {
+		negation := &tree.Node{LogicalOperator: tree.NOT, Parent: node.Parent}
+		if node.Parent.Left == node {
+			node.Parent.Left = negation
+		} else {
+			node.Parent.Right = negation
+		}
+		node.Parent = nil
+		negation.InsertRightNode(node)
+	}
+
 	//TODO: Check whether combinations are actually filled, or just empty nodes (e.g., { Cac{ A(), I(), Cex() } [AND] Cac{ A(), I(), Cex() } })
 
 	Println("Assigning nested tree structure", combo.String())
@@ -954,7 +1104,7 @@ Attach complex component to tree structure under consideration of existing nodes
 Input:
 - Node of the parent tree to attach to
 - Node to attach
-- Logical operator with which node should be added if a node already exists. Only takes tree.AND, tree.XOR and tree.OR (no brackets).
+- Logical operator with which node should be added if a node already exists. Only takes tree.AND, tree.XOR, tree.OR and tree.NOT (no brackets).
 
 Used by #parseNestedStatementCombination.
 */
@@ -973,9 +1123,11 @@ func attachComplexComponent(nodeToAttachTo *tree.Node, nodeToAttach *tree.Node,
 			logicalOperator = tree.XOR
 		case tree.OR:
 			logicalOperator = tree.OR
+		case tree.NOT:
+			logicalOperator = tree.NOT
 		default:
 			return nil, tree.NodeError{ErrorCode: tree.PARSING_ERROR_UNKNOWN_LOGICAL_OPERATOR, ErrorMessage: "Detected unknown logical operator during processing: " + logicalOperator +
-				" - please review your coding accordingly. Note that the use of the bracket versions (" + tree.AND_BRACKETS + ", " + tree.XOR_BRACKETS + ", " + tree.OR_BRACKETS + " is not supported)."}
+				" - please review your coding accordingly. Note that the use of the bracket versions (" + tree.AND_BRACKETS + ", " + tree.XOR_BRACKETS + ", " + tree.OR_BRACKETS + ", " + tree.NOT_BRACKETS + " is not supported)."}
 		}
 	}
 
@@ -1020,7 +1172,7 @@ func identifyComponentPairCombinations(statement string) ([]string, tree.Parsing
 /*
 Process pair combinations and extrapolate individual statements and populate with content from atomic input statement.
 */
-func extrapolateStatementWithPairedComponents(s *tree.Statement, pairs []string) ([]*tree.Node, tree.ParsingError) {
+func extrapolateStatementWithPairedComponents(s *tree.Statement, pairs []string, ctx *parsingContext) ([]*tree.Node, tree.ParsingError) {
 
 	// Parse all elements of tree structure
 	extrapolatedPairStmts := []*tree.Node{}
@@ -1052,8 +1204,9 @@ func extrapolateStatementWithPairedComponents(s *tree.Statement, pairs []string)
 		for _, v2 := range leaves[0] {
 
 			// Parse content of tree
-			tpNode, err := ParseStatement(v2.Entry.(string))
-			if err.ErrorCode != tree.PARSING_NO_ERROR {
+			tpNode, err := parseNestedContent(v2.Entry.(string), ctx)
+			// Warnings have been reported during nested parsing if parsing context permits recovery
+			if err.ErrorCode != tree.PARSING_NO_ERROR && !(ctx.recover && err.ErrorCode == tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT) {
 				Println("Error when parsing statement: ", err, "; expression for which parsing failed:", v2.Entry)
 				return nil, err
 			}
@@ -1112,15 +1265,24 @@ func validateInput(text string, leftPar string, rightPar string) tree.ParsingErr
 	}
 	// Validate parentheses in input
 	parCount := 0
+	// Offsets of opening parentheses not (yet) matched by closing ones
+	openOffsets := []int{}
+	// Offsets of closing parentheses without preceding opening ones
+	closeOffsets := []int{}
 	for i, letter := range text {
 
 		switch string(letter) {
 		case leftPar:
 			parCount++
+			openOffsets = append(openOffsets, i)
 		case rightPar:
 			parCount--
+			if len(openOffsets) > 0 {
+				openOffsets = openOffsets[:len(openOffsets)-1]
+			} else {
+				closeOffsets = append(closeOffsets, i)
+			}
 		}
-		i++
 	}
 	if parCount != 0 {
 		msg := "Please review the " + parTypePlural + " in the input statement. "
@@ -1141,8 +1303,72 @@ func validateInput(text string, leftPar string, rightPar string) tree.ParsingErr
 			msg = fmt.Sprint(msg, parCountAbs, " additional closing ", par, " ('"+rightPar+"').")
 		}
 		Println(msg)
-		return tree.ParsingError{ErrorCode: tree.PARSING_ERROR_IMBALANCED_PARENTHESES, ErrorMessage: msg}
+		// Mark unmatched parentheses in excess
+		offsets := closeOffsets
+		if parCount > 0 {
+			offsets = openOffsets
+		}
+		spans := []tree.SourceSpan{}
+		for _, offset := range offsets {
+			spans = append(spans, tree.NewSourceSpan(text, offset, offset+len(leftPar)))
+		}
+		return tree.ParsingError{ErrorCode: tree.PARSING_ERROR_IMBALANCED_PARENTHESES, ErrorMessage: msg, ErrorSpans: spans}
 	}
 
 	return tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
 }
+
+/*
+Relates the positions of offending content in errors produced during parsing of nested content
+(which refer to the nested content, as opposed to the overall input) to the nested content as a whole,
+so that the position can be determined with respect to the overall input (see tree.ParsingError.LocateSpans()).
+Errors whose offending content has not been located yet are returned unchanged.
+*/
+func relateErrorToNestedContent(err tree.ParsingError, nestedContent string) tree.ParsingError {
+	for _, span := range err.ErrorSpans {
+		if span.Offset >= 0 {
+			err.ErrorSpans = []tree.SourceSpan{tree.TextSpan(strings.TrimSpace(nestedContent))}
+			break
+		}
+	}
+	return err
+}
+
+/*
+Extracts fragments of non-parsed content containing parentheses, braces or brackets (e.g., '(unparsed content)')
+from the text remaining after parsing, in order to locate those in the input. Fragments are separated by whitespace
+outside of parentheses, braces and brackets.
+*/
+func extractNonParsedFragments(text string) []string {
+	fragments := []string{}
+	depth := 0
+	start := -1
+	for i, letter := range text {
+		switch {
+		case strings.ContainsRune("({[", letter):
+			depth++
+		case strings.ContainsRune(")}]", letter):
+			depth--
+		case unicode.IsSpace(letter) && depth <= 0:
+			if start != -1 {
+				fragments = append(fragments, text[start:i])
+				start = -1
+			}
+			continue
+		}
+		if start == -1 {
+			start = i
+		}
+	}
+	if start != -1 {
+		fragments = append(fragments, text[start:])
+	}
+	// Retain fragments with potential IG Script content only
+	result := []string{}
+	for _, fragment := range fragments {
+		if strings.ContainsAny(fragment, "(){}[]") {
+			result = append(result, fragment)
+		}
+	}
+	return result
+}
@@ -22,9 +22,6 @@ func TestStatementParsingIncludingSyntheticANDs(t *testing.T) {
 
 	s := stmt[0].Entry.(*tree.Statement)
 
-	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
-
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Unexpected error during parsing: ", err.Error())
 	}
@@ -99,13 +96,13 @@ func TestLeafArrayGenerationWithoutAggregationOfImplicitlyLinkedComponents(t *te
 	s, err := ParseStatement(text)
 
 	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
+	aggregateImplicitLinkages := false
 
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Unexpected error during parsing: ", err.Error())
 	}
 
-	nodeArray, componentIdx := s[0].Entry.(*tree.Statement).GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	nodeArray, componentIdx := s[0].Entry.(*tree.Statement).GenerateLeafArrays(aggregateImplicitLinkages)
 
 	if nodeArray == nil {
 		t.Fatal("Generated array should not be empty.")
@@ -208,13 +205,13 @@ func TestLeafArrayGenerationWithAggregationOfImplicitlyLinkedComponents(t *testi
 	s, err := ParseStatement(text)
 
 	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = true
+	aggregateImplicitLinkages := true
 
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Unexpected error during parsing: ", err.Error())
 	}
 
-	nodeArray, componentIdx := s[0].Entry.(*tree.Statement).GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	nodeArray, componentIdx := s[0].Entry.(*tree.Statement).GenerateLeafArrays(aggregateImplicitLinkages)
 
 	if nodeArray == nil {
 		t.Fatal("Generated array should not be empty.")
@@ -294,9 +291,9 @@ func TestSyntheticRootRetrieval(t *testing.T) {
 	}
 
 	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
+	aggregateImplicitLinkages := false
 
-	nodeArray, componentIdx := s[0].Entry.(*tree.Statement).GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	nodeArray, componentIdx := s[0].Entry.(*tree.Statement).GenerateLeafArrays(aggregateImplicitLinkages)
 
 	fmt.Println(nodeArray)
 	fmt.Println(componentIdx)
@@ -359,9 +356,6 @@ func TestExcessiveParentheses(t *testing.T) {
 		t.Fatal("Test did not pick up on unbalanced parentheses")
 	}
 
-	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
-
 	// Test excessive left parentheses
 	text = "A(National Organic Program's Program Manager), Cex(on behalf of the Secretary), " +
 		"D(may) " +
@@ -377,6 +371,47 @@ func TestExcessiveParentheses(t *testing.T) {
 
 }
 
+/*
+Tests position information for excessive closing and opening parentheses, including multi-line input
+and non-ASCII characters (byte vs. rune offsets).
+*/
+func TestExcessiveParenthesesPosition(t *testing.T) {
+
+	// Excessive closing parenthesis
+	text := "A(farmer) D(must)) I(comply)"
+
+	_, err := ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_ERROR_IMBALANCED_PARENTHESES {
+		t.Fatal("Test did not pick up on unbalanced parentheses")
+	}
+	if len(err.ErrorSpans) != 1 {
+		t.Fatal("Error should contain single span, but contains:", err.ErrorSpans)
+	}
+	span := err.ErrorSpans[0]
+	if span.Offset != 17 || span.EndOffset != 18 || span.Line != 1 || span.Column != 18 || span.Text != ")" {
+		t.Fatal("Position of excessive closing parenthesis is incorrect:", span)
+	}
+	if !strings.Contains(err.Error(), "(Line 1, Column 18)") {
+		t.Fatal("Error message should contain position:", err.Error())
+	}
+
+	// Excessive opening parenthesis in second line, preceded by non-ASCII characters
+	text = "A(Bäuerin) D(müssen)\nI((comply [AND] sell)"
+
+	_, err = ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_ERROR_IMBALANCED_PARENTHESES {
+		t.Fatal("Test did not pick up on unbalanced parentheses")
+	}
+	if len(err.ErrorSpans) != 1 {
+		t.Fatal("Error should contain single span, but contains:", err.ErrorSpans)
+	}
+	span = err.ErrorSpans[0]
+	if span.Offset != 24 || span.RuneOffset != 22 || span.Line != 2 || span.Column != 2 ||
+		span.EndLine != 2 || span.EndColumn != 3 || span.Text != "(" {
+		t.Fatal("Position of excessive opening parenthesis is incorrect:", span)
+	}
+}
+
 func TestComponentTwoLevelNestedStatement(t *testing.T) {
 	text := "A(National Organic Program's Program Manager), Cex(on behalf of the Secretary), " +
 		"D(may) " +
@@ -396,9 +431,9 @@ func TestComponentTwoLevelNestedStatement(t *testing.T) {
 	}
 
 	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
+	aggregateImplicitLinkages := false
 
-	nodeArray, componentIdx := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	nodeArray, componentIdx := s.GenerateLeafArrays(aggregateImplicitLinkages)
 
 	if nodeArray == nil {
 		t.Fatal("Generated array should not be empty.")
@@ -478,7 +513,7 @@ func TestComponentTwoLevelNestedStatement(t *testing.T) {
 
 	// Test for nested elements
 	nestedStmt := element[0].Entry.(*tree.Statement)
-	leaves, _ := nestedStmt.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leaves, _ := nestedStmt.GenerateLeafArrays(aggregateImplicitLinkages)
 	if len(leaves) != 4 {
 		t.Fatal("Did not leaf elements of first-order nested statement correctly")
 	}
@@ -505,7 +540,7 @@ func TestComponentTwoLevelNestedStatement(t *testing.T) {
 	}
 
 	nestedStmt = element[0].Entry.(*tree.Statement).ActivationConditionComplex.Entry.(*tree.Statement)
-	leaves, _ = nestedStmt.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leaves, _ = nestedStmt.GenerateLeafArrays(aggregateImplicitLinkages)
 	if len(leaves) != 3 {
 		t.Fatal("Did not leaf elements of second-order nested statement correctly")
 	}
@@ -563,9 +598,9 @@ func TestComponentTwoLevelNestedStatementAndSimpleCombination(t *testing.T) {
 	}
 
 	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
+	aggregateImplicitLinkages := false
 
-	nodeArray, componentIdx := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	nodeArray, componentIdx := s.GenerateLeafArrays(aggregateImplicitLinkages)
 
 	if nodeArray == nil {
 		t.Fatal("Generated array should not be empty.")
@@ -675,7 +710,7 @@ func TestComponentTwoLevelNestedStatementAndSimpleCombination(t *testing.T) {
 
 	// Test for nested elements
 	nestedStmt := element[0].Entry.(*tree.Statement)
-	leaves, _ := nestedStmt.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leaves, _ := nestedStmt.GenerateLeafArrays(aggregateImplicitLinkages)
 	if len(leaves) != 4 {
 		t.Fatal("Did not leaf elements of first-order nested statement correctly")
 	}
@@ -702,7 +737,7 @@ func TestComponentTwoLevelNestedStatementAndSimpleCombination(t *testing.T) {
 	}
 
 	nestedStmt = element[0].Entry.(*tree.Statement).ActivationConditionComplex.Entry.(*tree.Statement)
-	leaves, _ = nestedStmt.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leaves, _ = nestedStmt.GenerateLeafArrays(aggregateImplicitLinkages)
 	if len(leaves) != 3 {
 		t.Fatal("Did not leaf elements of second-order nested statement correctly")
 	}
@@ -763,9 +798,9 @@ func TestComponentMultipleHorizontallyNestedStatement(t *testing.T) {
 	}
 
 	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
+	aggregateImplicitLinkages := false
 
-	nodeArray, componentIdx := s.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	nodeArray, componentIdx := s.GenerateLeafArrays(aggregateImplicitLinkages)
 
 	if nodeArray == nil {
 		t.Fatal("Generated array should not be empty.")
@@ -847,7 +882,7 @@ func TestComponentMultipleHorizontallyNestedStatement(t *testing.T) {
 
 	// Test for nested elements
 	nestedStmt := element[0].Left.Entry.(*tree.Statement)
-	leaves, _ := nestedStmt.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leaves, _ := nestedStmt.GenerateLeafArrays(aggregateImplicitLinkages)
 	if len(leaves) != 4 {
 		t.Fatal("Did not leaf elements of first-order nested statement correctly")
 	}
@@ -874,7 +909,7 @@ func TestComponentMultipleHorizontallyNestedStatement(t *testing.T) {
 	}
 
 	nestedStmt = element[0].Left.Entry.(*tree.Statement).ActivationConditionComplex.Entry.(*tree.Statement)
-	leaves, _ = nestedStmt.GenerateLeafArrays(tree.AGGREGATE_IMPLICIT_LINKAGES)
+	leaves, _ = nestedStmt.GenerateLeafArrays(aggregateImplicitLinkages)
 	if len(leaves) != 3 {
 		t.Fatal("Did not leaf elements of second-order nested statement correctly")
 	}
@@ -921,7 +956,7 @@ func TestFlatteningAndParsingOfStatementCombinations(t *testing.T) {
 		"Cac{E(Program Participant) F(is) P(employed)}}}"
 
 	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
+	aggregateImplicitLinkages := false
 
 	combo, _, errStmt := ParseIntoNodeTree(input, false, LEFT_BRACE, RIGHT_BRACE)
 	if errStmt.ErrorCode != tree.PARSING_NO_ERROR {
@@ -929,7 +964,7 @@ func TestFlatteningAndParsingOfStatementCombinations(t *testing.T) {
 	}
 
 	// Check whether all leaves have the same prefix
-	flatCombo := tree.Flatten(combo.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES))
+	flatCombo := tree.Flatten(combo.GetLeafNodes(aggregateImplicitLinkages))
 	sharedPrefix := ""
 	for _, node := range flatCombo {
 		entry := node.Entry.(string)
@@ -947,7 +982,7 @@ func TestFlatteningAndParsingOfStatementCombinations(t *testing.T) {
 		}
 	}
 
-	if len(tree.Flatten(combo.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES))) != 3 {
+	if len(tree.Flatten(combo.GetLeafNodes(aggregateImplicitLinkages))) != 3 {
 		t.Fatal("Wrong number of parsed string nodes.")
 	}
 
@@ -963,7 +998,7 @@ func TestFlatteningAndParsingOfStatementCombinations(t *testing.T) {
 		t.Fatal("Conversion of string entries to parsed statements failed:", err.Error())
 	}
 
-	if len(tree.Flatten(combo.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES))) != 3 {
+	if len(tree.Flatten(combo.GetLeafNodes(aggregateImplicitLinkages))) != 3 {
 		t.Fatal("Wrong number of parsed string nodes.")
 	}
 
@@ -1003,9 +1038,6 @@ func TestSpecialCharacters(t *testing.T) {
 		t.Fatal("Unexpected error during parsing: ", err.Error())
 	}
 
-	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
-
 	if s.Attributes.Entry != "A&dsisgj=" {
 		t.Fatal("Failed to detect Attributes")
 	}
@@ -1061,9 +1093,6 @@ func TestUnambiguousExtractionOfComponentAndRelatedProperties(t *testing.T) {
 		t.Fatal("Unexpected error during parsing: ", err.Error())
 	}
 
-	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
-
 	if s.Attributes.CountLeaves() != 1 {
 		t.Fatal("Attributes count should be 1, but is:", s.Attributes.CountLeaves())
 	}
@@ -1094,9 +1123,6 @@ func TestExtractSuffixAndAnnotationsSingleComponentValue(t *testing.T) {
 	// Single component entry
 	text := "A1[annotation=(left,right)](content)"
 
-	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
-
 	suffix, annotation, content, err := extractSuffixAndAnnotations("A", false, text, "(", ")")
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Extraction should not have failed.")
@@ -1126,9 +1152,6 @@ func TestExtractSuffixOnlySingleComponentValue(t *testing.T) {
 	// Single component entry
 	text := "A1(content)"
 
-	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
-
 	suffix, annotation, content, err := extractSuffixAndAnnotations("A", false, text, "(", ")")
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Extraction should not have failed.")
@@ -1158,9 +1181,6 @@ func TestExtractAnnotationOnlySingleComponentValue(t *testing.T) {
 	// Single component entry
 	text := "A[abc=(left;right)](content)"
 
-	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
-
 	suffix, annotation, content, err := extractSuffixAndAnnotations("A", false, text, "(", ")")
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Extraction should not have failed.")
@@ -1190,9 +1210,6 @@ func TestExtractAnnotationOnlyWithSpecialCharacters(t *testing.T) {
 	// Single component entry
 	text := "A[abc=(left|right)](content)"
 
-	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
-
 	suffix, annotation, content, err := extractSuffixAndAnnotations("A", false, text, "(", ")")
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Extraction should not have failed.")
@@ -1222,9 +1239,6 @@ func TestExtractSuffixOnlyWithSpecialCharacters(t *testing.T) {
 	// Single component entry
 	text := "A2#|(cont$ent)"
 
-	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
-
 	suffix, annotation, content, err := extractSuffixAndAnnotations("A", false, text, "(", ")")
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Extraction should not have failed.")
@@ -1254,9 +1268,6 @@ func TestExtractSuffixAndAnnotationWithSpecialCharacters(t *testing.T) {
 	// Single component entry
 	text := "A2#|[abc=(le#ft|righ$t)](cont$ent)"
 
-	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
-
 	suffix, annotation, content, err := extractSuffixAndAnnotations("A", false, text, "(", ")")
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Extraction should not have failed.")
@@ -1293,42 +1304,42 @@ func TestNodeParsingOfSuffixAndAnnotationsAtomicStatement(t *testing.T) {
 	stmt := s[0].Entry.(*tree.Statement)
 
 	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
+	aggregateImplicitLinkages := false
 
 	// Check Attributes
 
-	if stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Suffix != "1" {
-		t.Fatal("Suffix should be 1, but is:", stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Suffix)
+	if stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[0][0].Suffix != "1" {
+		t.Fatal("Suffix should be 1, but is:", stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[0][0].Suffix)
 	}
 
-	if stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[1][0].Suffix != "2" {
-		t.Fatal("Suffix should be 2, but is:", stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[1][0].Suffix)
+	if stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[1][0].Suffix != "2" {
+		t.Fatal("Suffix should be 2, but is:", stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[1][0].Suffix)
 	}
 
-	if stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[2][0].Suffix != "3" {
-		t.Fatal("Suffix should be 3, but is:", stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[2][0].Suffix)
+	if stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[2][0].Suffix != "3" {
+		t.Fatal("Suffix should be 3, but is:", stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[2][0].Suffix)
 	}
 
-	if stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Annotations != "[annotation1]" {
-		t.Fatal("Suffix should be [annotation1], but is:", stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Annotations)
+	if stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[0][0].Annotations != "[annotation1]" {
+		t.Fatal("Suffix should be [annotation1], but is:", stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[0][0].Annotations)
 	}
 
-	if stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[1][0].Annotations != "[annotation2]" {
-		t.Fatal("Suffix should be [annotation2], but is:", stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[1][0].Annotations)
+	if stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[1][0].Annotations != "[annotation2]" {
+		t.Fatal("Suffix should be [annotation2], but is:", stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[1][0].Annotations)
 	}
 
-	if stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[2][0].Annotations != nil {
-		t.Fatal("Suffix should be nil, but is:", stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[2][0].Annotations)
+	if stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[2][0].Annotations != nil {
+		t.Fatal("Suffix should be nil, but is:", stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[2][0].Annotations)
 	}
 
 	// Check Aim
 
-	if stmt.Aim.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Suffix != "4" {
-		t.Fatal("Suffix should be 4, but is:", stmt.Aim.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Suffix)
+	if stmt.Aim.GetLeafNodes(aggregateImplicitLinkages)[0][0].Suffix != "4" {
+		t.Fatal("Suffix should be 4, but is:", stmt.Aim.GetLeafNodes(aggregateImplicitLinkages)[0][0].Suffix)
 	}
 
-	if stmt.Aim.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Annotations != "[annotation=(left,right)]" {
-		t.Fatal("Suffix should be [annotation=(left,right)], but is:", stmt.Aim.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Annotations)
+	if stmt.Aim.GetLeafNodes(aggregateImplicitLinkages)[0][0].Annotations != "[annotation=(left,right)]" {
+		t.Fatal("Suffix should be [annotation=(left,right)], but is:", stmt.Aim.GetLeafNodes(aggregateImplicitLinkages)[0][0].Annotations)
 	}
 
 }
@@ -1348,36 +1359,36 @@ func TestNodeParsingOfIndexedSuffixAndAnnotationsAtomicStatement(t *testing.T) {
 	stmt := s[0].Entry.(*tree.Statement)
 
 	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
+	aggregateImplicitLinkages := false
 
 	fmt.Println(stmt.String())
 
 	// Check Attributes
 
-	if stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Suffix != "1" {
-		t.Fatal("Suffix should be 1, but is:", stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Suffix)
+	if stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[0][0].Suffix != "1" {
+		t.Fatal("Suffix should be 1, but is:", stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[0][0].Suffix)
 	}
 
-	if stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Annotations != "[annotation1]" {
-		t.Fatal("Suffix should be [annotation1], but is:", stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Annotations)
+	if stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[0][0].Annotations != "[annotation1]" {
+		t.Fatal("Suffix should be [annotation1], but is:", stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[0][0].Annotations)
 	}
 
-	if stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].PrivateNodeLinks[0].Annotations != "[annotation2]" {
-		t.Fatal("Suffix of private node should be [annotation2], but is:", stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[1][0].Annotations)
+	if stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[0][0].PrivateNodeLinks[0].Annotations != "[annotation2]" {
+		t.Fatal("Suffix of private node should be [annotation2], but is:", stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[1][0].Annotations)
 	}
 
-	if stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].PrivateNodeLinks[1].Annotations != nil {
-		t.Fatal("Suffix should be nil, but is:", stmt.Attributes.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[2][0].Annotations)
+	if stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[0][0].PrivateNodeLinks[1].Annotations != nil {
+		t.Fatal("Suffix should be nil, but is:", stmt.Attributes.GetLeafNodes(aggregateImplicitLinkages)[2][0].Annotations)
 	}
 
 	// Check Aim
 
-	if stmt.Aim.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Suffix != "4" {
-		t.Fatal("Suffix should be 4, but is:", stmt.Aim.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Suffix)
+	if stmt.Aim.GetLeafNodes(aggregateImplicitLinkages)[0][0].Suffix != "4" {
+		t.Fatal("Suffix should be 4, but is:", stmt.Aim.GetLeafNodes(aggregateImplicitLinkages)[0][0].Suffix)
 	}
 
-	if stmt.Aim.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Annotations != "[annotation=(left,right)]" {
-		t.Fatal("Suffix should be [annotation=(left,right)], but is:", stmt.Aim.GetLeafNodes(tree.AGGREGATE_IMPLICIT_LINKAGES)[0][0].Annotations)
+	if stmt.Aim.GetLeafNodes(aggregateImplicitLinkages)[0][0].Annotations != "[annotation=(left,right)]" {
+		t.Fatal("Suffix should be [annotation=(left,right)], but is:", stmt.Aim.GetLeafNodes(aggregateImplicitLinkages)[0][0].Annotations)
 	}
 
 }
@@ -1397,9 +1408,6 @@ func TestNodeParsingOfSuffixAndAnnotationsNestedStatement(t *testing.T) {
 
 	stmt := s[0].Entry.(*tree.Statement)
 
-	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
-
 	// Left activation condition
 	if stmt.ActivationConditionComplex.Left.Suffix.(string) != "1" {
 		t.Fatal("Suffix should be 1, but is:", stmt.ActivationConditionComplex.Left.Suffix)
@@ -1463,9 +1471,6 @@ func TestComponentNameIdentification(t *testing.T) {
 
 	text := "A(Single Element) D( must) I((combLeft [AND] combRight)) Cac{A(Nested Element) I(perform) Bdir(something)}"
 
-	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
-
 	s, err := ParseStatement(text)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		t.Fatal("Parsing error for statement", text)
@@ -2972,6 +2977,164 @@ func TestWrongParenthesisOrderButCorrectCount(t *testing.T) {
 
 }
 
+/*
+Tests position information for component content that cannot be extracted due to wrong parentheses order.
+*/
+func TestWrongParenthesisOrderPosition(t *testing.T) {
+
+	text := "A(actor) Bdir,p(left [AND] right)) Bdir((left [AND] right) Cac(condition)"
+
+	_, err := ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_ERROR_UNABLE_TO_EXTRACT_COMPONENT_CONTENT {
+		t.Fatal("Parsing should have returned error "+
+			tree.PARSING_ERROR_UNABLE_TO_EXTRACT_COMPONENT_CONTENT+", but returned error ", err)
+	}
+	if len(err.ErrorSpans) != 1 || err.ErrorSpans[0].Offset != 35 || err.ErrorSpans[0].Column != 36 ||
+		!strings.HasPrefix(err.ErrorSpans[0].Text, "Bdir((left [AND] right)") {
+		t.Fatal("Position of unextractable component is incorrect:", err.ErrorSpans)
+	}
+}
+
+/*
+Tests position information for potentially non-parsed content and ignored nested elements.
+*/
+func TestNonParsedContentPosition(t *testing.T) {
+
+	// Non-parsed content that also occurs as part of a component
+	text := "A(farmer) D(must) I(comply) (farmer) Bdir(rules) (remark)"
+
+	_, err := ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		t.Fatal("Parsing should have returned warning, but returned", err)
+	}
+	if len(err.ErrorSpans) != 2 {
+		t.Fatal("Warning should contain two spans, but contains:", err.ErrorSpans)
+	}
+	if err.ErrorSpans[0].Offset != 28 || err.ErrorSpans[0].Text != "(farmer)" {
+		t.Fatal("Position of first non-parsed fragment is incorrect:", err.ErrorSpans[0])
+	}
+	if err.ErrorSpans[1].Offset != 49 || err.ErrorSpans[1].Text != "(remark)" {
+		t.Fatal("Position of second non-parsed fragment is incorrect:", err.ErrorSpans[1])
+	}
+
+	// Ignored nested element
+	text = "A(farmer) D(must) I(comply) z{w}"
+
+	_, err = ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_ERROR_IGNORED_NESTED_ELEMENTS {
+		t.Fatal("Parsing should have returned error "+tree.PARSING_ERROR_IGNORED_NESTED_ELEMENTS+", but returned", err)
+	}
+	if len(err.ErrorSpans) != 1 || err.ErrorSpans[0].Offset != 28 || err.ErrorSpans[0].Text != "z{w}" {
+		t.Fatal("Position of ignored nested element is incorrect:", err.ErrorSpans)
+	}
+}
+
+/*
+Tests collection of multiple diagnostics, with parsing continuing past invalid components.
+*/
+func TestParseStatementWithDiagnosticsMultipleErrors(t *testing.T) {
+
+	text := "A((x [AND] y [OR] z)) D(must) I((a [AND] b [XOR] c)) Bdir(goods)"
+
+	// Legacy parsing returns first error only
+	_, err := ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_ERROR_INVALID_OPERATOR_COMBINATIONS {
+		t.Fatal("Parsing should have returned error "+tree.PARSING_ERROR_INVALID_OPERATOR_COMBINATIONS+", but returned", err)
+	}
+
+	stmts, diagnostics := ParseStatementWithDiagnostics(text)
+	if len(diagnostics) != 2 {
+		t.Fatal("Parsing should have returned two diagnostics, but returned:", diagnostics)
+	}
+	if !tree.HasErrors(diagnostics) {
+		t.Fatal("Diagnostics should contain errors:", diagnostics)
+	}
+	for _, d := range diagnostics {
+		if d.Severity != tree.SEVERITY_ERROR || d.ErrorCode != tree.PARSING_ERROR_INVALID_OPERATOR_COMBINATIONS {
+			t.Fatal("Diagnostic is incorrect:", d)
+		}
+	}
+	if len(diagnostics[0].ErrorSpans) != 1 || diagnostics[0].ErrorSpans[0].Offset != 0 || diagnostics[0].ErrorSpans[0].Text != "A((x [AND] y [OR] z))" {
+		t.Fatal("Position of first error is incorrect:", diagnostics[0].ErrorSpans)
+	}
+	if len(diagnostics[1].ErrorSpans) != 1 || diagnostics[1].ErrorSpans[0].Offset != 30 || diagnostics[1].ErrorSpans[0].Text != "I((a [AND] b [XOR] c))" {
+		t.Fatal("Position of second error is incorrect:", diagnostics[1].ErrorSpans)
+	}
+
+	// Valid components should still be parsed
+	s := stmts[0].Entry.(*tree.Statement)
+	if s.Attributes != nil || s.Aim != nil {
+		t.Fatal("Invalid components should not be parsed:", s.String())
+	}
+	if s.Deontic == nil || s.Deontic.Entry != "must" || s.DirectObject == nil || s.DirectObject.Entry != "goods" {
+		t.Fatal("Valid components have not been parsed correctly:", s.String())
+	}
+}
+
+/*
+Tests collection of diagnostics of different severities across nesting levels.
+*/
+func TestParseStatementWithDiagnosticsSeverities(t *testing.T) {
+
+	// Error in nested statement and warning on top level
+	text := "A(farmer) D(must) I(comply) (remark) Cac{A(x) I((a [AND] b [OR] c))}"
+
+	stmts, diagnostics := ParseStatementWithDiagnostics(text)
+	if len(diagnostics) != 2 {
+		t.Fatal("Parsing should have returned two diagnostics, but returned:", diagnostics)
+	}
+	if diagnostics[0].Severity != tree.SEVERITY_ERROR || diagnostics[0].ErrorCode != tree.PARSING_ERROR_INVALID_OPERATOR_COMBINATIONS ||
+		len(diagnostics[0].ErrorSpans) != 1 || diagnostics[0].ErrorSpans[0].Offset != 46 {
+		t.Fatal("Error in nested statement is incorrect:", diagnostics[0])
+	}
+	if diagnostics[1].Severity != tree.SEVERITY_WARNING || diagnostics[1].ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT ||
+		len(diagnostics[1].ErrorSpans) != 1 || diagnostics[1].ErrorSpans[0].Text != "(remark)" {
+		t.Fatal("Warning for non-parsed content is incorrect:", diagnostics[1])
+	}
+	s := stmts[0].Entry.(*tree.Statement)
+	if s.Attributes == nil || s.Deontic == nil || s.Aim == nil {
+		t.Fatal("Top-level components have not been parsed:", s.String())
+	}
+
+	// Information on expansion of component pairs
+	text = "A(farmer) D(must) {I(sell) Bdir(goods) [XOR] I(buy) Bdir(food)}"
+
+	_, diagnostics = ParseStatementWithDiagnostics(text)
+	if len(diagnostics) != 1 || diagnostics[0].Severity != tree.SEVERITY_INFO ||
+		diagnostics[0].ErrorCode != tree.PARSING_INFO_COMPONENT_PAIR_EXPANSION || diagnostics[0].ErrorSpans[0].Offset != 18 {
+		t.Fatal("Parsing should have returned information on component pair expansion, but returned:", diagnostics)
+	}
+	if tree.HasErrors(diagnostics) {
+		t.Fatal("Diagnostics should not contain errors:", diagnostics)
+	}
+
+	// Valid statement
+	_, diagnostics = ParseStatementWithDiagnostics("A(farmer) D(must) I(comply)")
+	if len(diagnostics) != 0 {
+		t.Fatal("Parsing should not have returned diagnostics, but returned:", diagnostics)
+	}
+}
+
+/*
+Tests reporting of all imbalanced parentheses, braces and brackets.
+*/
+func TestParseStatementWithDiagnosticsImbalancedParentheses(t *testing.T) {
+
+	text := "A(farmer D(must) I(comply) Cac{A(x) I(y]}"
+
+	_, diagnostics := ParseStatementWithDiagnostics(text)
+	if len(diagnostics) != 2 {
+		t.Fatal("Parsing should have returned two diagnostics, but returned:", diagnostics)
+	}
+	if diagnostics[0].ErrorCode != tree.PARSING_ERROR_IMBALANCED_PARENTHESES || len(diagnostics[0].ErrorSpans) != 2 {
+		t.Fatal("Imbalanced parentheses have not been reported correctly:", diagnostics[0])
+	}
+	if diagnostics[1].ErrorCode != tree.PARSING_ERROR_IMBALANCED_PARENTHESES || len(diagnostics[1].ErrorSpans) != 1 ||
+		diagnostics[1].ErrorSpans[0].Offset != 39 {
+		t.Fatal("Imbalanced bracket has not been reported correctly:", diagnostics[1])
+	}
+}
+
 /*
 Tests duplicate components in input (detection of duplicate statements).
 */
@@ -3663,3 +3826,136 @@ func TestStatementLevelAnnotationParsingComponentPairs(t *testing.T) {
 	}
 
 }
+
+/*
+Tests parsing of negated component values (unary negation) and negated private properties.
+*/
+func TestComponentLevelNegation(t *testing.T) {
+
+	text := "A(farmer) A1,p([NOT] certified) A1(operator) D(must) I(inspect) Bdir([NOT] organic produce)"
+
+	stmt, err := ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Parsing should have returned error "+
+			tree.PARSING_NO_ERROR+", but returned error ", err)
+	}
+
+	s := stmt[0].Entry.(*tree.Statement)
+
+	// Negated direct object
+	if !s.DirectObject.IsUnaryNegation() {
+		t.Fatal("Direct object should be unary negation, but is", s.DirectObject)
+	}
+	if s.DirectObject.Right.Entry != "organic produce" {
+		t.Fatal("Negated direct object has wrong value:", s.DirectObject.Right.Entry)
+	}
+
+	// Negated private property (negation should be retained as private node)
+	if s.AttributesPropertySimple != nil {
+		t.Fatal("Private property should have been removed from statement tree, but is", s.AttributesPropertySimple)
+	}
+	privateNodes := s.Attributes.Right.PrivateNodeLinks
+	if len(privateNodes) != 1 {
+		t.Fatal("Wrong number of private nodes:", privateNodes)
+	}
+	if !privateNodes[0].IsUnaryNegation() || privateNodes[0].Right.Entry != "certified" {
+		t.Fatal("Private node should be negated property 'certified', but is", privateNodes[0])
+	}
+
+	if s.Stringify() != "A(farmer) A1(operator) A1,p(([NOT] certified)) D(must) I(inspect) Bdir(([NOT] organic produce))" {
+		t.Fatal("Stringified output is wrong:", s.Stringify())
+	}
+
+}
+
+/*
+Tests parsing of negated nested statements, both individually and as part of nested statement combinations.
+*/
+func TestNestedStatementNegation(t *testing.T) {
+
+	text := "A(farmer) D(must) I(comply) Cac[negated]{[NOT] A(operator) I(is exempted)}"
+
+	stmt, err := ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Parsing should have returned error "+
+			tree.PARSING_NO_ERROR+", but returned error ", err)
+	}
+
+	cac := stmt[0].Entry.(*tree.Statement).ActivationConditionComplex
+	if !cac.IsUnaryNegation() {
+		t.Fatal("Activation condition should be unary negation, but is", cac)
+	}
+	if cac.Right.Entry.(*tree.Statement).Aim.Entry != "is exempted" {
+		t.Fatal("Negated nested statement has wrong content:", cac.Right)
+	}
+	if cac.Right.Annotations != "[negated]" {
+		t.Fatal("Annotations of negated nested statement are wrong:", cac.Right.Annotations)
+	}
+
+	// Negation within nested statement combination
+	text = "A(farmer) D(must) I(comply) Cac{Cac{A(operator) I(registers)} [XOR] Cac{[NOT] A(operator) I(is exempted)}}"
+
+	stmt, err = ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Parsing should have returned error "+
+			tree.PARSING_NO_ERROR+", but returned error ", err)
+	}
+
+	cac = stmt[0].Entry.(*tree.Statement).ActivationConditionComplex
+	if cac.LogicalOperator != tree.XOR {
+		t.Fatal("Nested combination should be linked by XOR, but is", cac.LogicalOperator)
+	}
+	if !cac.Right.IsUnaryNegation() || cac.Right.Right.Entry.(*tree.Statement).Aim.Entry != "is exempted" {
+		t.Fatal("Right nested statement should be negated, but is", cac.Right)
+	}
+	if cac.Left.IsNegated() {
+		t.Fatal("Left nested statement should not be negated")
+	}
+
+	// Binary negation between nested statements
+	text = "A(farmer) D(must) I(comply) Cac{Cac{A(operator) I(registers)} [NOT] Cac{A(operator) I(is exempted)}}"
+
+	stmt, err = ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Parsing should have returned error "+
+			tree.PARSING_NO_ERROR+", but returned error ", err)
+	}
+
+	cac = stmt[0].Entry.(*tree.Statement).ActivationConditionComplex
+	if cac.LogicalOperator != tree.NOT || cac.IsUnaryNegation() {
+		t.Fatal("Nested combination should be linked by binary NOT, but is", cac)
+	}
+
+	// Negated operand leading nested statement combination (negation marker must not be mistaken for component symbol)
+	text = "A(farmer) D(must) I(comply) Cac{[NOT] Cac{A(operator) I(registers)} [AND] Cac{A(operator) I(is exempted)}}"
+
+	stmt, err = ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Parsing should have returned error "+
+			tree.PARSING_NO_ERROR+", but returned error ", err)
+	}
+
+	cac = stmt[0].Entry.(*tree.Statement).ActivationConditionComplex
+	if cac.LogicalOperator != tree.AND {
+		t.Fatal("Nested combination should be linked by AND, but is", cac.LogicalOperator)
+	}
+	if !cac.Left.IsUnaryNegation() || cac.Left.Right.Entry.(*tree.Statement).Aim.Entry != "registers" {
+		t.Fatal("Left nested statement should be negated, but is", cac.Left)
+	}
+	if cac.Right.IsNegated() {
+		t.Fatal("Right nested statement should not be negated")
+	}
+
+	// Invalid component type in negated operand is reported without internal negation marker
+	text = "A(farmer) D(must) I(comply) Cac{[NOT] Bdir{A(operator) I(registers)} [AND] Cac{A(operator) I(is exempted)}}"
+
+	_, err = ParseStatement(text)
+	if err.ErrorCode != tree.PARSING_ERROR_INVALID_TYPES_IN_NESTED_STATEMENT_COMBINATION {
+		t.Fatal("Parsing should have returned error "+
+			tree.PARSING_ERROR_INVALID_TYPES_IN_NESTED_STATEMENT_COMBINATION+", but returned error ", err)
+	}
+	if strings.Contains(err.ErrorMessage, NEGATED_NESTED_STATEMENT_MARKER) {
+		t.Fatal("Error message should not contain internal negation marker:", err.ErrorMessage)
+	}
+
+}
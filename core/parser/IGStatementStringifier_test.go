@@ -0,0 +1,358 @@
+package parser
+
+import (
+	"IG-Parser/core/tree"
+	"fmt"
+	"testing"
+)
+
+/*
+This file contains round-trip tests for the IG Script serialization of parsed statements (see tree.IGStatementStringifier.go).
+Each test parses a statement, stringifies it, parses the result again, and checks that both trees are equivalent.
+*/
+
+/*
+Parses input, stringifies the parsed statement, and reparses the generated IG Script. Fails if the reparsed tree
+is not equivalent to the original one, or if the stringified output is not stable across parsing iterations.
+Returns the stringified output for further checks.
+*/
+func testStringifyRoundTrip(t *testing.T, input string) string {
+
+	original, err := ParseStatement(input)
+	if err.ErrorCode != tree.PARSING_NO_ERROR && err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		t.Fatal("Unexpected error when parsing input '"+input+"':", err.ErrorMessage)
+	}
+
+	output := original[0].StringifyStatement()
+
+	reparsed, err := ParseStatement(output)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Unexpected error when parsing stringified output '"+output+"':", err.ErrorMessage)
+	}
+
+	if len(original) != len(reparsed) {
+		t.Fatal("Number of statements differs after reparsing (Original: ", len(original), ", Reparsed: ", len(reparsed), ")")
+	}
+	for i := range original {
+		if msg := compareStatementNodes(original[i], reparsed[i]); msg != "" {
+			t.Fatal("Reparsed statement is not equivalent to original statement for input '" + input +
+				"' (Stringified: '" + output + "'): " + msg)
+		}
+	}
+
+	if reparsedOutput := reparsed[0].StringifyStatement(); reparsedOutput != output {
+		t.Fatal("Stringified output is not stable across parsing iterations (First: '" + output +
+			"', Second: '" + reparsedOutput + "')")
+	}
+
+	return output
+}
+
+/*
+Compares nodes with respect to structure and content (including embedded statements and private nodes).
+Returns empty string if nodes are equivalent, else a description of the first deviation.
+*/
+func compareStatementNodes(left *tree.Node, right *tree.Node) string {
+	if left.IsEmptyOrNilNode() || right.IsEmptyOrNilNode() {
+		if left.IsEmptyOrNilNode() != right.IsEmptyOrNilNode() {
+			return "Only one node is empty (Left: " + fmt.Sprint(left) + ", Right: " + fmt.Sprint(right) + ")"
+		}
+		return ""
+	}
+	if left.GetComponentName() != right.GetComponentName() {
+		return "Component names differ: " + left.GetComponentName() + " vs. " + right.GetComponentName()
+	}
+	if left.LogicalOperator != right.LogicalOperator {
+		return "Logical operators differ: " + left.LogicalOperator + " vs. " + right.LogicalOperator
+	}
+	if fmt.Sprint(left.SharedLeft) != fmt.Sprint(right.SharedLeft) || fmt.Sprint(left.SharedRight) != fmt.Sprint(right.SharedRight) {
+		return "Shared elements differ: " + fmt.Sprint(left.SharedLeft, left.SharedRight) + " vs. " + fmt.Sprint(right.SharedLeft, right.SharedRight)
+	}
+	if fmt.Sprint(left.Suffix) != fmt.Sprint(right.Suffix) {
+		return "Suffices differ: " + fmt.Sprint(left.Suffix) + " vs. " + fmt.Sprint(right.Suffix)
+	}
+	if fmt.Sprint(left.Annotations) != fmt.Sprint(right.Annotations) {
+		return "Annotations differ: " + fmt.Sprint(left.Annotations) + " vs. " + fmt.Sprint(right.Annotations)
+	}
+	if len(left.PrivateNodeLinks) != len(right.PrivateNodeLinks) {
+		return "Number of private nodes differs for node " + left.String()
+	}
+	for i := range left.PrivateNodeLinks {
+		if msg := compareStatementNodes(left.PrivateNodeLinks[i], right.PrivateNodeLinks[i]); msg != "" {
+			return msg
+		}
+	}
+	switch entry := left.Entry.(type) {
+	case string:
+		if entry != right.Entry {
+			return "Entries differ: " + entry + " vs. " + fmt.Sprint(right.Entry)
+		}
+	case *tree.Statement:
+		rightEntry, ok := right.Entry.(*tree.Statement)
+		if !ok {
+			return "Entry types differ for entry " + entry.Stringify()
+		}
+		if msg := compareStatements(entry, rightEntry); msg != "" {
+			return msg
+		}
+	case []*tree.Node:
+		rightEntry, ok := right.Entry.([]*tree.Node)
+		if !ok || len(entry) != len(rightEntry) {
+			return "Entry types differ for extrapolated statement " + left.StringifyStatement()
+		}
+		for i := range entry {
+			if msg := compareStatementNodes(entry[i], rightEntry[i]); msg != "" {
+				return msg
+			}
+		}
+	}
+	if msg := compareStatementNodes(left.Left, right.Left); msg != "" {
+		return msg
+	}
+	return compareStatementNodes(left.Right, right.Right)
+}
+
+/*
+Compares all components of both statements. Returns empty string if statements are equivalent.
+*/
+func compareStatements(left *tree.Statement, right *tree.Statement) string {
+	leftComponents := []*tree.Node{left.Attributes, left.AttributesPropertySimple, left.AttributesPropertyComplex,
+		left.Deontic, left.Aim, left.DirectObject, left.DirectObjectComplex, left.DirectObjectPropertySimple,
+		left.DirectObjectPropertyComplex, left.IndirectObject, left.IndirectObjectComplex, left.IndirectObjectPropertySimple,
+		left.IndirectObjectPropertyComplex, left.ConstitutedEntity, left.ConstitutedEntityPropertySimple,
+		left.ConstitutedEntityPropertyComplex, left.Modal, left.ConstitutiveFunction, left.ConstitutingProperties,
+		left.ConstitutingPropertiesComplex, left.ConstitutingPropertiesPropertySimple, left.ConstitutingPropertiesPropertyComplex,
+		left.ActivationConditionSimple, left.ActivationConditionComplex, left.ExecutionConstraintSimple,
+		left.ExecutionConstraintComplex, left.OrElse}
+	rightComponents := []*tree.Node{right.Attributes, right.AttributesPropertySimple, right.AttributesPropertyComplex,
+		right.Deontic, right.Aim, right.DirectObject, right.DirectObjectComplex, right.DirectObjectPropertySimple,
+		right.DirectObjectPropertyComplex, right.IndirectObject, right.IndirectObjectComplex, right.IndirectObjectPropertySimple,
+		right.IndirectObjectPropertyComplex, right.ConstitutedEntity, right.ConstitutedEntityPropertySimple,
+		right.ConstitutedEntityPropertyComplex, right.Modal, right.ConstitutiveFunction, right.ConstitutingProperties,
+		right.ConstitutingPropertiesComplex, right.ConstitutingPropertiesPropertySimple, right.ConstitutingPropertiesPropertyComplex,
+		right.ActivationConditionSimple, right.ActivationConditionComplex, right.ExecutionConstraintSimple,
+		right.ExecutionConstraintComplex, right.OrElse}
+	for i := range leftComponents {
+		if msg := compareStatementNodes(leftComponents[i], rightComponents[i]); msg != "" {
+			return msg
+		}
+	}
+	return ""
+}
+
+/*
+Tests stringification of basic statement with atomic components.
+*/
+func TestStringifyBasicStatement(t *testing.T) {
+
+	output := testStringifyRoundTrip(t, "A(actor) D(must) I(comply) Bdir(with regulations) Cac(in any case)")
+
+	if output != "A(actor) D(must) I(comply) Bdir(with regulations) Cac(in any case)" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+}
+
+/*
+Tests stringification of component combinations, including shared elements and implicitly linked combinations
+within a component, as well as implicitly linked components.
+*/
+func TestStringifyComponentCombinations(t *testing.T) {
+
+	output := testStringifyRoundTrip(t, "A((Sellers [AND] Buyers) from (Northern [OR] Southern) states) "+
+		"I(inspect), I(as well as (review [AND] (audit [AND] challenge))) "+
+		"Bdir(shared left (left object [XOR] (inner left [AND] inner right) inner shared) shared right)")
+
+	if output != "A((Sellers [AND] Buyers) from (Northern [OR] Southern) states) "+
+		"I(inspect) I(as well as (review [AND] (audit [AND] challenge))) "+
+		"Bdir(shared left (left object [XOR] ((inner left [AND] inner right) inner shared)) shared right)" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+}
+
+/*
+Tests stringification of suffices, component-level annotations and private property linkages.
+*/
+func TestStringifySuffixAnnotationsAndPrivateProperties(t *testing.T) {
+
+	output := testStringifyRoundTrip(t, "A1[role=enforcer](Program Manager) A1,p(National) A2(Inspector) "+
+		"I[act=review](review) Bdir1[type=target]((operations [AND] agents)) Bdir1,p[quality](certified) Bdir,p(relevant)")
+
+	if output != "A1[role=enforcer](Program Manager) A1,p(National) A2(Inspector) I[act=review](review) "+
+		"Bdir1[type=target]((operations [AND] agents)) Bdir1,p[quality](certified) Bdir,p(relevant)" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+}
+
+/*
+Tests stringification of nested statements, including annotations on nested component and statement level.
+*/
+func TestStringifyNestedStatements(t *testing.T) {
+
+	output := testStringifyRoundTrip(t, "A(actor) I(act) Cac1[condition]{A(other actor) I(violates) [inner annotation]} "+
+		"Bdir,p{A(owner) I(owns)} O{A(enforcer) D(may) I(sanction)}")
+
+	if output != "A(actor) I(act) Bdir,p{A(owner) I(owns)} Cac1[condition]{A(other actor) I(violates) [inner annotation]} "+
+		"O{A(enforcer) D(may) I(sanction)}" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+}
+
+/*
+Tests stringification of nested statement combinations, including precedence within combinations.
+*/
+func TestStringifyNestedStatementCombinations(t *testing.T) {
+
+	output := testStringifyRoundTrip(t, "A(actor) I(act) Cac{ Cac{ A(a1) I(i1) } [AND] { Cac[cond]{ A(a2) I(i2) } [XOR] Cac{ A(a3) I(i3) } } } "+
+		"O{O{A(supervisor) D(may) I(suspend [XOR] revoke)} [XOR] O{A(board) D(may) I(warn)}}")
+
+	if output != "A(actor) I(act) Cac{Cac{A(a1) I(i1)} [AND] {Cac[cond]{A(a2) I(i2)} [XOR] Cac{A(a3) I(i3)}}} "+
+		"O{O{A(supervisor) D(may) I((suspend [XOR] revoke))} [XOR] O{A(board) D(may) I(warn)}}" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+}
+
+/*
+Tests stringification of nested statement combinations with suffix and annotation on the combination itself.
+*/
+func TestStringifyNestedStatementCombinationsWithSuffixAndAnnotation(t *testing.T) {
+
+	output := testStringifyRoundTrip(t, "A(actor) I(act) Cac1[ctx=time]{ Cac{ A(a1) I(i1) } [XOR] Cac{ A(a2) I(i2) } }")
+
+	if output != "A(actor) I(act) Cac1[ctx=time]{Cac{A(a1) I(i1)} [XOR] Cac{A(a2) I(i2)}}" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+}
+
+/*
+Tests stringification of binary and unary negations on component level and for nested statements.
+*/
+func TestStringifyNegations(t *testing.T) {
+
+	output := testStringifyRoundTrip(t, "A(actor) A1,p([NOT] certified) A1(operator) I((inspect [NOT] certify)) Bdir([NOT] produce) "+
+		"Cac{Cac{A(a1) I(i1)} [XOR] Cac1[cond]{[NOT] A(a2) I(i2)}} O{[NOT] A(supervisor) D(may) I(suspend)}")
+
+	if output != "A(actor) A1(operator) A1,p(([NOT] certified)) I((inspect [NOT] certify)) Bdir(([NOT] produce)) "+
+		"Cac{Cac{A(a1) I(i1)} [XOR] Cac1[cond]{[NOT] A(a2) I(i2)}} O{[NOT] A(supervisor) D(may) I(suspend)}" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+}
+
+/*
+Tests stringification of component pairs, which are emitted in factored form (i.e., with shared components
+preceding the combination of pairs), as well as component pairs embedded in nested statements.
+*/
+func TestStringifyComponentPairs(t *testing.T) {
+
+	output := testStringifyRoundTrip(t, "A(actor1) {I(action1) Bdir(object1) [XOR] {I(action2) Bdir(object2) [AND] I(action3) Cex(constraint3)}} "+
+		"Cac(condition1) [statement annotation]")
+
+	if output != "A(actor1) Cac(condition1) {I(action1) Bdir(object1) [XOR] {I(action2) Bdir(object2) [AND] "+
+		"I(action3) Cex(constraint3)}} [statement annotation]" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+
+	output = testStringifyRoundTrip(t, "A(actor) I(act) Cac[lead]{ {A(a) I(b) [XOR] A(c) I(d)} [inner] }")
+
+	if output != "A(actor) I(act) Cac[lead]{{A(a) I(b) [XOR] A(c) I(d)} [inner]}" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+}
+
+/*
+Tests stringification of component pairs with components shared across pairs, in combination with component pairs
+embedded in nested statements and nested component combinations on the same level.
+*/
+func TestStringifyComponentPairsWithSharedComponents(t *testing.T) {
+
+	output := testStringifyRoundTrip(t, "A(actor) D(may) {I(leftAim) Bdir(obj1) [OR] I(rightAim) Bdir(obj2)} "+
+		"Cac{ {A(actor2) I(aim2 [XOR] aim4) [XOR] A(actor3) I(aim3)} }")
+
+	if output != "A(actor) D(may) Cac{{A(actor2) I((aim2 [XOR] aim4)) [XOR] A(actor3) I(aim3)}} "+
+		"{I(leftAim) Bdir(obj1) [OR] I(rightAim) Bdir(obj2)}" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+
+	output = testStringifyRoundTrip(t, "A(Individuals) D(must) { I(monitor) Bdir(compliance) [AND] I(report) Bdir(violation) } "+
+		"Cac(in the case of (repeated offense [OR] other reasons)) O{ A(actor2) D(must) {I(enforce) Bdir(compliance) [OR] I(delegate) Bdir(enforcement)}}")
+
+	if output != "A(Individuals) D(must) Cac(in the case of (repeated offense [OR] other reasons)) "+
+		"O{A(actor2) D(must) {I(enforce) Bdir(compliance) [OR] I(delegate) Bdir(enforcement)}} "+
+		"{I(monitor) Bdir(compliance) [AND] I(report) Bdir(violation)}" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+
+	output = testStringifyRoundTrip(t, "A(actor1) I(aim1) Cac{Cac{A(actor2) I(aim2)} [XOR] Cac{A(actor3) I(aim3)}} "+
+		"{Bdir(directobject1) Bind(indirectobject1) [OR] Bdir{ A(actor4) I(aim4) Bdir(directobject2) Cac{A(actor5) I(aim5)}} Bind(indirectobject2)}")
+
+	if output != "A(actor1) I(aim1) Cac{Cac{A(actor2) I(aim2)} [XOR] Cac{A(actor3) I(aim3)}} "+
+		"{Bdir(directobject1) Bind(indirectobject1) [OR] Bdir{A(actor4) I(aim4) Bdir(directobject2) Cac{A(actor5) I(aim5)}} Bind(indirectobject2)}" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+}
+
+/*
+Tests stringification of private properties that are combinations, which the parser links to the component
+as individual private nodes.
+*/
+func TestStringifyPrivatePropertyCombinations(t *testing.T) {
+
+	output := testStringifyRoundTrip(t, "Bdir1((left [OR] right)) Bdir1,p((private [AND] public)) I(x)")
+
+	if output != "I(x) Bdir1((left [OR] right)) Bdir1,p(private) Bdir1,p(public)" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+}
+
+/*
+Tests stringification of nested component combinations that are themselves combined (retaining the component
+symbol of inner combinations), as well as of separately specified nested components of the same type.
+*/
+func TestStringifyNestedCombinationsOfNestedCombinations(t *testing.T) {
+
+	output := testStringifyRoundTrip(t, "A(actor) I(act) Cac{Cac{Cac{A(actor1) I(aim1)} [AND] Cac{A(actor2) I(aim2)} [AND] Cac{A(actor4) I(aim4)}} "+
+		"[OR] Cac{Cac{A(actor3) I(aim3)} [XOR] Cac{A(actor5) I(aim5)}}}")
+
+	if output != "A(actor) I(act) Cac{Cac{{Cac{A(actor1) I(aim1)} [AND] Cac{A(actor2) I(aim2)}} [AND] Cac{A(actor4) I(aim4)}} "+
+		"[OR] Cac{Cac{A(actor3) I(aim3)} [XOR] Cac{A(actor5) I(aim5)}}}" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+
+	output = testStringifyRoundTrip(t, "A(actor) I(act) Cac[ctx=condition]{A(council) I(approves)} Cac{A(state) I(permits)}")
+
+	if output != "A(actor) I(act) Cac[ctx=condition]{A(council) I(approves)} Cac{A(state) I(permits)}" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+}
+
+/*
+Tests stringification of statement with statement-level annotations.
+*/
+func TestStringifyStatementLevelAnnotations(t *testing.T) {
+
+	output := testStringifyRoundTrip(t, "[first annotation] A(actor) I(act) [second annotation]")
+
+	if output != "A(actor) I(act) [first annotation][second annotation]" {
+		t.Fatal("Stringified output is incorrect: " + output)
+	}
+}
+
+/*
+Tests stringification of a statement tree modified after parsing.
+*/
+func TestStringifyModifiedStatement(t *testing.T) {
+
+	stmts, err := ParseStatement("A(actor) D(must) I((comply [XOR] report))")
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Unexpected error during parsing:", err.ErrorMessage)
+	}
+
+	s := stmts[0].Entry.(*tree.Statement)
+	s.Deontic.Entry = "may"
+	s.Aim.LogicalOperator = tree.OR
+	s.Aim.Right.Entry = "notify"
+	s.ActivationConditionSimple = &tree.Node{Entry: "upon request", ComponentType: tree.ACTIVATION_CONDITION}
+
+	if s.Stringify() != "A(actor) D(may) I((comply [OR] notify)) Cac(upon request)" {
+		t.Fatal("Stringified output of modified statement is incorrect: " + s.Stringify())
+	}
+}
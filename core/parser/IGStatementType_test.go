@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"IG-Parser/core/tree"
+	"testing"
+)
+
+/*
+This file contains tests for the classification of parsed statements by type (see tree.IGStatementType.go).
+*/
+
+/*
+Parses input and returns the classification of the parsed statement.
+*/
+func classifyStatement(t *testing.T, input string) tree.StatementClassification {
+	stmts, err := ParseStatement(input)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Parsing of '"+input+"' should not fail. Error:", err)
+	}
+	return stmts[0].Entry.(*tree.Statement).Classify()
+}
+
+/*
+Tests the classification of regulative, constitutive, hybrid and undetermined statements.
+*/
+func TestStatementClassification(t *testing.T) {
+
+	inputs := map[string]string{
+		"A(farmer) D(must) I(sell) Bdir(goods)":                               tree.STATEMENT_TYPE_REGULATIVE,
+		"A,p(certified) A(farmer) I(sell) Cac(upon approval)":                 tree.STATEMENT_TYPE_REGULATIVE,
+		"E(council) F(is established) P(in the region) M(may)":                tree.STATEMENT_TYPE_CONSTITUTIVE,
+		"E(council) F(is) P{A(residents) I(elect) Bdir(members)}":             tree.STATEMENT_TYPE_HYBRID,
+		"A(farmer) D(must) I(comply) Bdir{E(rule) F(is) P(valid)}":            tree.STATEMENT_TYPE_HYBRID,
+		"A(farmer) D(must) I(sell) Cac{E(certificate) F(is) P(valid)}":        tree.STATEMENT_TYPE_REGULATIVE,
+		"A(farmer) D(must) I(sell) O{E(licence) F(is revoked)}":               tree.STATEMENT_TYPE_REGULATIVE,
+		"A(x) I(y) Bdir{ Bdir{A(z) I(w)} [XOR] Bdir{E(entity) F(function)} }": tree.STATEMENT_TYPE_HYBRID,
+		"Cac(upon certification) Cex(in the region)":                          tree.STATEMENT_TYPE_UNDETERMINED,
+	}
+
+	for input, expected := range inputs {
+		classification := classifyStatement(t, input)
+		if classification.Type != expected {
+			t.Fatal("Statement '"+input+"' should be classified as "+expected+", but was", classification.Type)
+		}
+		if classification.Inconsistent {
+			t.Fatal("Statement '" + input + "' should not be flagged as inconsistent")
+		}
+	}
+}
+
+/*
+Tests the flagging of statements mixing regulative and constitutive components on the same level.
+*/
+func TestStatementClassificationInconsistent(t *testing.T) {
+
+	classification := classifyStatement(t, "A(farmer) D(must) M(may) I(sell)")
+	if classification.Type != tree.STATEMENT_TYPE_HYBRID || !classification.Inconsistent {
+		t.Fatal("Statement should be classified as inconsistent hybrid, but was", classification)
+	}
+	if classification.String() != tree.STATEMENT_TYPE_HYBRID+tree.STATEMENT_TYPE_INCONSISTENT_SUFFIX {
+		t.Fatal("String representation should indicate inconsistency, but was", classification.String())
+	}
+	if len(classification.RegulativeComponents) != 3 || len(classification.ConstitutiveComponents) != 1 ||
+		classification.ConstitutiveComponents[0] != tree.MODAL {
+		t.Fatal("Classification should report conflicting components, but reported",
+			classification.RegulativeComponents, classification.ConstitutiveComponents)
+	}
+}
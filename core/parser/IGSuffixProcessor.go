@@ -178,6 +178,10 @@ func ProcessPrivateComponentLinkages(s *tree.Statement, complex bool) {
 				// Draw direct linkage between source and target component
 				for srcComp, tgtCompArr := range linkedLeaves {
 					for _, tgtComp := range tgtCompArr {
+						// Link negation (as opposed to negated element) to retain negation in private node
+						if tgtComp.IsNegated() {
+							tgtComp = tgtComp.Parent
+						}
 						linkComps := []*tree.Node{}
 						// Retrieve potentially existing node links
 						if srcComp.PrivateNodeLinks != nil {
@@ -198,14 +202,22 @@ func ProcessPrivateComponentLinkages(s *tree.Statement, complex bool) {
 	}
 	Println("Private node linkages to remove from main tree:", identifiedLinkages)
 
+	// Copy potentially inherited component name and suffix into target nodes directly (which will be lost after node removal).
+	// Done for all nodes prior to removal, since removing one node detaches its siblings from the component root.
+	for _, pair := range identifiedLinkages {
+		if pair.Tgt.ComponentType == "" {
+			pair.Tgt.ComponentType = pair.Tgt.GetComponentName()
+		}
+		if pair.Tgt.Suffix == nil && pair.Tgt.GetSuffix() != "" {
+			pair.Tgt.Suffix = pair.Tgt.GetSuffix()
+		}
+	}
+
 	// Post process linkages for removal of private nodes from property tree, and potential removal of source node if empty
 	for _, pair := range identifiedLinkages {
 
 		Println("-> Processing removal of identified private node from statement tree structure. Node: " + pair.Tgt.String())
 
-		// Copy potentially inherited component name into target node directly (which will be lost after node removal)
-		pair.Tgt.ComponentType = pair.Tgt.GetComponentName()
-
 		// Remove private node from original tree structure
 		rt, err := tree.RemoveNodeFromTree(pair.Tgt)
 		if err.ErrorCode != tree.TREE_NO_ERROR {
@@ -11,9 +11,6 @@ func TestExtractComponentLinkageNonAggregateLinkages(t *testing.T) {
 
 	text := "A,p(Certified) A1,p(non-suspended) A1,p(previously reviewed) A1(Operator) or A2,p(recognized) A2(Handler) D(must not) I((produce [AND] trade))"
 
-	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = false
-
 	// Parse statement, which should consider private suffices
 	stmt, err := ParseStatement(text)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -42,9 +39,6 @@ func TestExtractComponentLinkageAggregateLinkages(t *testing.T) {
 
 	text := "A,p(Certified) A1,p1(non-suspended) A1,p1(previously reviewed) A1(Operator) or A2,p(recognized) A2(Handler) D(must not) I((produce [AND] trade))"
 
-	// Indicates whether implicitly linked components (e.g., I(one) I(two)) are aggregated into a single component
-	tree.AGGREGATE_IMPLICIT_LINKAGES = true
-
 	// Parse statement, which should consider private suffices
 	stmt, err := ParseStatement(text)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
@@ -0,0 +1,165 @@
+package query
+
+import (
+	"IG-Parser/core/tree"
+	"strings"
+)
+
+/*
+This file contains the element structure queries are evaluated against. A parsed statement is represented as
+tree of elements, with each element corresponding to
+- an individual entry of a component (i.e., a leaf of a component tree, e.g., 'farmer' in 'A(farmer)'),
+- a statement nested in a component (e.g., 'Cac{A(actor) I(aim)}'), or
+- a private property linked to a particular component entry (e.g., 'Bdir1,p(private)' linked to 'Bdir1(object)').
+The components of nested statements are children of the element representing the nested statement, and private
+properties are children of the component entry they are linked to.
+*/
+
+// Separator between the symbols of element paths (e.g., 'Cac/A')
+const PATH_SEPARATOR = "/"
+
+/*
+Element of a parsed statement (component entry, nested statement or private property).
+*/
+type Element struct {
+	// Component symbol (e.g., 'A', 'Cac', 'Bdir,p')
+	Symbol string
+	// Content of component entry, or IG Script of nested statement
+	Content string
+	// Suffix of component (e.g., '1' for 'Bdir1(object)')
+	Suffix string
+	// Annotations of component entry, including those of embedding combinations (e.g., '[ref=1][dir]')
+	Annotations string
+	// Logical operators of the combinations the element is part of within its component (outermost first)
+	Operators []string
+	// Nesting level of the statement the element is part of (0 for components of top-level statements)
+	Level int
+	// Indicates whether element is a nested statement
+	Nested bool
+	// Indicates whether element is a private property
+	Private bool
+	// Path of element, consisting of the symbols of its ancestors and its own symbol (e.g., 'Cac/A')
+	Path string
+	// Parent element (nil for components of top-level statements)
+	Parent *Element
+	// Child elements (components of nested statement, or private properties of component entry)
+	Children []*Element
+}
+
+/*
+Generates the elements of a parsed statement (i.e., the node returned by the parser, embedding either a statement
+or a combination of statements extrapolated from component pair combinations). Returns all elements in order of
+the statement's components, with children following their parents. Components shared across extrapolated
+statements are only included once.
+*/
+func GenerateElements(root *tree.Node) []*Element {
+	elements := []*Element{}
+	visited := map[*tree.Node]bool{}
+	for _, stmtNode := range root.GetTopLevelStatementNodes() {
+		collectStatement(stmtNode.GetEmbeddedStatement(), 0, nil, visited, &elements)
+	}
+	return elements
+}
+
+/*
+Collects the elements of all components of a given statement on a given nesting level, and returns the
+elements of the statement's components (i.e., excluding descendants).
+*/
+func collectStatement(stmt *tree.Statement, level int, parent *Element, visited map[*tree.Node]bool, elements *[]*Element) []*Element {
+	children := []*Element{}
+	for _, comp := range stmt.Components() {
+		if comp.Node == nil || comp.Node.IsEmptyOrNilNode() {
+			continue
+		}
+		children = append(children, collectNode(comp.Node, comp.Symbol, level, false, []string{}, parent, visited, elements)...)
+	}
+	return children
+}
+
+/*
+Collects the elements of a given component node (descending into combinations, nested statements and private
+properties), and returns the elements on the level of the given node (i.e., excluding descendants).
+Operators holds the logical operators of the combinations embedding the node.
+*/
+func collectNode(node *tree.Node, symbol string, level int, private bool, operators []string, parent *Element, visited map[*tree.Node]bool, elements *[]*Element) []*Element {
+	if node == nil || visited[node] {
+		return nil
+	}
+	visited[node] = true
+
+	// Combinations (including unary negations)
+	if node.Left != nil || node.Right != nil {
+		nestedOperators := append(append([]string{}, operators...), node.LogicalOperator)
+		return append(collectNode(node.Left, symbol, level, private, nestedOperators, parent, visited, elements),
+			collectNode(node.Right, symbol, level, private, nestedOperators, parent, visited, elements)...)
+	}
+
+	element := &Element{Symbol: symbol, Suffix: suffixOf(node), Operators: operators, Level: level,
+		Private: private, Parent: parent, Path: symbol}
+	if annotations, ok := node.GetAnnotations().(string); ok {
+		element.Annotations = annotations
+	}
+	if parent != nil {
+		element.Path = parent.Path + PATH_SEPARATOR + symbol
+	}
+
+	switch entry := node.Entry.(type) {
+	case string:
+		if entry == "" {
+			return nil
+		}
+		element.Content = entry
+		*elements = append(*elements, element)
+	case *tree.Statement:
+		element.Content = entry.Stringify()
+		element.Nested = true
+		*elements = append(*elements, element)
+		element.Children = collectStatement(entry, level+1, element, visited, elements)
+	case []*tree.Node:
+		// Component pair combinations within component (retaining the position of the embedding element)
+		result := []*Element{}
+		for _, pair := range entry {
+			result = append(result, collectNode(pair, symbol, level, private, operators, parent, visited, elements)...)
+		}
+		return result
+	default:
+		return nil
+	}
+
+	for _, privateNode := range node.PrivateNodeLinks {
+		element.Children = append(element.Children, collectNode(privateNode, privateNode.GetComponentName(), level,
+			true, []string{}, element, visited, elements)...)
+	}
+	return []*Element{element}
+}
+
+/*
+Returns the suffix of a given node, which is inherited from embedding combinations (e.g., '1' for the
+entries of 'Bdir1((left [XOR] right))').
+*/
+func suffixOf(node *tree.Node) string {
+	for n := node; n != nil; n = n.Parent {
+		if suffix, ok := n.Suffix.(string); ok {
+			return suffix
+		}
+	}
+	return ""
+}
+
+/*
+Returns the values of the annotations with a given key (e.g., '1' for key 'ref' in '[ref=1][dir]'), and
+whether an annotation with the key exists. Annotations without key-value syntax (e.g., '[dir]') are
+considered keys with empty value.
+*/
+func (e *Element) AnnotationValues(key string) ([]string, bool) {
+	values := []string{}
+	found := false
+	for _, annotation := range tree.SplitAnnotations(e.Annotations) {
+		annotationKey, value, _ := tree.SplitAnnotationKeyValue(annotation)
+		if strings.TrimSpace(annotationKey) == key {
+			values = append(values, strings.TrimSpace(value))
+			found = true
+		}
+	}
+	return values, found
+}
@@ -0,0 +1,224 @@
+package query
+
+import (
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+)
+
+/*
+This file contains the evaluation of structural queries over IG Script-encoded statements. Queries select
+elements (component entries, nested statements and private properties, see Element) based on component type,
+content, annotations, suffix, logical operators, nesting level and their relation to other elements
+(see QueryParser.go for the syntax). A statement matches a query if all selectors of any alternative of the
+query select at least one element of the statement.
+*/
+
+/*
+IG Script-encoded statement to be queried.
+*/
+type Record struct {
+	// Statement ID
+	StmtId string
+	// IG Script-encoded statement
+	Statement string
+}
+
+/*
+Element selected by a query.
+*/
+type Match struct {
+	// Path of element (e.g., 'Cac/A')
+	Path string `json:"path"`
+	// Component symbol (e.g., 'A')
+	Component string `json:"component"`
+	// Content of component entry, or IG Script of nested statement
+	Content string `json:"content"`
+	// Suffix of component
+	Suffix string `json:"suffix,omitempty"`
+	// Annotations of component entry
+	Annotations string `json:"annotations,omitempty"`
+	// Nesting level of the statement the element is part of
+	Level int `json:"level"`
+}
+
+/*
+Statement matching a query, alongside the selected elements.
+*/
+type StatementMatch struct {
+	// Statement ID
+	StmtId string `json:"stmtId"`
+	// IG Script-encoded statement
+	Statement string `json:"statement"`
+	// Selected elements (in order of the statement's components)
+	Elements []Match `json:"elements"`
+}
+
+/*
+Statement excluded from the query, since it could not be parsed.
+*/
+type ExcludedStatement struct {
+	// Statement ID
+	StmtId string `json:"stmtId"`
+	// Error code
+	ErrorCode string `json:"errorCode"`
+	// Error message
+	ErrorMessage string `json:"errorMessage"`
+}
+
+/*
+Result of a query across a set of statements.
+*/
+type Result struct {
+	// Query as provided
+	Query string `json:"query"`
+	// Number of queried statements (excluding statements that could not be parsed)
+	Statements int `json:"statements"`
+	// Number of statements matching the query
+	MatchingStatements int `json:"matchingStatements"`
+	// Statements matching the query
+	Matches []StatementMatch `json:"matches"`
+	// Statements excluded from the query
+	Excluded []ExcludedStatement `json:"excluded"`
+}
+
+/*
+Evaluates the given query against the given statements. Statements that cannot be parsed are excluded and
+listed in the result.
+*/
+func Search(records []Record, query Query) Result {
+
+	result := Result{Query: query.Source, Matches: []StatementMatch{}, Excluded: []ExcludedStatement{}}
+
+	for _, record := range records {
+		stmts, err := parser.ParseStatement(record.Statement)
+		if err.ErrorCode != tree.PARSING_NO_ERROR && err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+			Println("Excluded statement", record.StmtId, "-", err)
+			result.Excluded = append(result.Excluded, ExcludedStatement{StmtId: record.StmtId, ErrorCode: err.ErrorCode,
+				ErrorMessage: err.ErrorMessage})
+			continue
+		}
+		result.Statements++
+
+		elements := query.Match(stmts[0])
+		if elements == nil {
+			continue
+		}
+		match := StatementMatch{StmtId: record.StmtId, Statement: record.Statement, Elements: []Match{}}
+		for _, element := range elements {
+			match.Elements = append(match.Elements, Match{Path: element.Path, Component: element.Symbol,
+				Content: element.Content, Suffix: element.Suffix, Annotations: element.Annotations, Level: element.Level})
+		}
+		result.Matches = append(result.Matches, match)
+	}
+	result.MatchingStatements = len(result.Matches)
+
+	return result
+}
+
+/*
+Evaluates the query against a parsed statement (i.e., the node returned by the parser). Returns the elements
+selected by the first matching alternative (in order of the statement's components), or nil if the statement
+does not match the query.
+*/
+func (q Query) Match(root *tree.Node) []*Element {
+	elements := GenerateElements(root)
+	for _, alternative := range q.alternatives {
+		selected := map[*Element]bool{}
+		matches := true
+		for _, sel := range alternative {
+			found := false
+			for _, element := range elements {
+				if sel.matches(element, nil) {
+					selected[element] = true
+					found = true
+				}
+			}
+			if !found {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			result := []*Element{}
+			for _, element := range elements {
+				if selected[element] {
+					result = append(result, element)
+				}
+			}
+			return result
+		}
+	}
+	return nil
+}
+
+/*
+Indicates whether the given element matches the compound's symbol and conditions.
+*/
+func (c compound) matches(e *Element) bool {
+	if c.symbol != SYMBOL_ANY && c.symbol != e.Symbol {
+		return false
+	}
+	for _, condition := range c.conditions {
+		if !condition(e) {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+Indicates whether the selector matches the given element. If an anchor is given (for relative selectors),
+the element matched by the first compound must be a descendant (or child, respectively) of the anchor.
+*/
+func (s selector) matches(e *Element, anchor *Element) bool {
+	return s.matchesCompound(e, len(s.compounds)-1, anchor)
+}
+
+/*
+Indicates whether the compound at the given index matches the given element, and the preceding compounds
+match its ancestors as required by the combinators (evaluated from right to left).
+*/
+func (s selector) matchesCompound(e *Element, index int, anchor *Element) bool {
+	if !s.compounds[index].matches(e) {
+		return false
+	}
+	if index == 0 && anchor == nil {
+		return true
+	}
+	switch s.combinators[index] {
+	case COMBINATOR_CHILD:
+		if index == 0 {
+			return e.Parent == anchor
+		}
+		return e.Parent != nil && s.matchesCompound(e.Parent, index-1, anchor)
+	default:
+		for ancestor := e.Parent; ancestor != nil; ancestor = ancestor.Parent {
+			if index == 0 && ancestor == anchor {
+				return true
+			}
+			if index > 0 && s.matchesCompound(ancestor, index-1, anchor) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+/*
+Indicates whether the (relative) selector matches any descendant of the given element.
+*/
+func (s selector) hasMatchBelow(e *Element) bool {
+	return s.matchesDescendant(e, e)
+}
+
+/*
+Indicates whether the selector matches any descendant of the given element relative to the given anchor.
+*/
+func (s selector) matchesDescendant(e *Element, anchor *Element) bool {
+	for _, child := range e.Children {
+		if s.matches(child, anchor) || s.matchesDescendant(child, anchor) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,548 @@
+package query
+
+import (
+	"IG-Parser/core/tree"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+/*
+This file contains the parser for queries. Queries consist of selectors in the following syntax:
+
+	query       := conjunction ( '|' conjunction )*
+	conjunction := selector ( '&' selector )*
+	selector    := compound ( combinator compound )*
+	combinator  := ' ' (descendant, i.e., nested at any depth) | '>' (child, i.e., directly nested)
+	compound    := ( symbol | '*' ) ( filter | pseudo )*
+	filter      := '[' attribute ( operator value )? ']'
+	attribute   := 'content' | 'suffix' | 'operator' | 'level' | '@' annotationKey
+	operator    := '=' | '!=' | '*=' | '^=' | '$=' | '~=' | '<' | '<=' | '>' | '>='
+	value       := quoted string ('...' or "...") | regular expression (/.../) | unquoted word
+	pseudo      := ':nested' | ':private' | ':has(' [ '>' ] selector ')' | ':not(' compound ')'
+
+The symbol may be omitted if a compound starts with a filter or pseudo-class (e.g., '[@ref]' corresponds to '*[@ref]').
+*/
+
+// Combinators between compounds of selectors
+const COMBINATOR_DESCENDANT = " "
+const COMBINATOR_CHILD = ">"
+
+// Operators combining selectors
+const OPERATOR_AND = "&"
+const OPERATOR_OR = "|"
+
+// Symbol matching elements of any component type
+const SYMBOL_ANY = "*"
+
+// Attributes of elements available in filters
+const ATTRIBUTE_CONTENT = "content"
+const ATTRIBUTE_SUFFIX = "suffix"
+const ATTRIBUTE_OPERATOR = "operator"
+const ATTRIBUTE_LEVEL = "level"
+
+// Prefix of annotation keys in filters (e.g., '[@ref=1]')
+const ATTRIBUTE_ANNOTATION_PREFIX = "@"
+
+// Comparison operators of filters (longer operators first to support longest match)
+const COMPARISON_NOT_EQUALS = "!="
+const COMPARISON_CONTAINS = "*="
+const COMPARISON_PREFIX = "^="
+const COMPARISON_SUFFIX = "$="
+const COMPARISON_REGEX = "~="
+const COMPARISON_LESS_EQUALS = "<="
+const COMPARISON_GREATER_EQUALS = ">="
+const COMPARISON_EQUALS = "="
+const COMPARISON_LESS = "<"
+const COMPARISON_GREATER = ">"
+
+var COMPARISONS = []string{COMPARISON_NOT_EQUALS, COMPARISON_CONTAINS, COMPARISON_PREFIX, COMPARISON_SUFFIX,
+	COMPARISON_REGEX, COMPARISON_LESS_EQUALS, COMPARISON_GREATER_EQUALS, COMPARISON_EQUALS, COMPARISON_LESS,
+	COMPARISON_GREATER}
+
+// Pseudo-classes
+const PSEUDO_NESTED = "nested"
+const PSEUDO_PRIVATE = "private"
+const PSEUDO_HAS = "has"
+const PSEUDO_NOT = "not"
+
+/*
+Parsed query, consisting of alternatives (combined by '|') of conjunctions of selectors (combined by '&').
+*/
+type Query struct {
+	// Query as provided
+	Source string
+	// Alternatives of conjunctions of selectors
+	alternatives [][]selector
+}
+
+/*
+Selector consisting of compounds linked by combinators, with combinators[i] linking compounds[i] to the preceding
+compound (and combinators[0] linking the first compound to the context element of relative selectors in ':has()').
+*/
+type selector struct {
+	compounds   []compound
+	combinators []string
+}
+
+/*
+Compound of symbol and conditions an element needs to satisfy.
+*/
+type compound struct {
+	symbol     string
+	conditions []func(e *Element) bool
+}
+
+/*
+Internal state of the query parser.
+*/
+type queryParser struct {
+	input string
+	pos   int
+}
+
+/*
+Parses the given query. Returns an error indicating the position of syntax errors (e.g., unknown attributes,
+invalid regular expressions).
+*/
+func Parse(query string) (Query, error) {
+	p := &queryParser{input: query}
+	result := Query{Source: query}
+
+	p.skipSpaces()
+	if p.eof() {
+		return result, errors.New("empty query")
+	}
+	for {
+		conjunction := []selector{}
+		for {
+			sel, err := p.parseSelector(false)
+			if err != nil {
+				return result, err
+			}
+			conjunction = append(conjunction, sel)
+			p.skipSpaces()
+			if !p.consume(OPERATOR_AND) {
+				break
+			}
+		}
+		result.alternatives = append(result.alternatives, conjunction)
+		if !p.consume(OPERATOR_OR) {
+			break
+		}
+	}
+	if !p.eof() {
+		return result, p.errorf("unexpected character '%c'", p.peek())
+	}
+	return result, nil
+}
+
+/*
+Parses a selector. Relative selectors (as argument of ':has()') may start with the child combinator.
+*/
+func (p *queryParser) parseSelector(relative bool) (selector, error) {
+	sel := selector{}
+	p.skipSpaces()
+	combinator := COMBINATOR_DESCENDANT
+	if relative && p.consume(COMBINATOR_CHILD) {
+		combinator = COMBINATOR_CHILD
+		p.skipSpaces()
+	}
+	for {
+		comp, err := p.parseCompound()
+		if err != nil {
+			return sel, err
+		}
+		sel.compounds = append(sel.compounds, comp)
+		sel.combinators = append(sel.combinators, combinator)
+
+		// Determine combinator to next compound (if any)
+		start := p.pos
+		p.skipSpaces()
+		if p.consume(COMBINATOR_CHILD) {
+			combinator = COMBINATOR_CHILD
+			p.skipSpaces()
+		} else if p.pos > start && p.startsCompound() {
+			combinator = COMBINATOR_DESCENDANT
+		} else {
+			return sel, nil
+		}
+	}
+}
+
+/*
+Parses a compound of symbol, filters and pseudo-classes.
+*/
+func (p *queryParser) parseCompound() (compound, error) {
+	comp := compound{symbol: SYMBOL_ANY}
+	if !p.startsCompound() {
+		if p.eof() {
+			return comp, p.errorf("missing component symbol")
+		}
+		return comp, p.errorf("unexpected character '%c' (expected component symbol)", p.peek())
+	}
+	if p.consume(SYMBOL_ANY) {
+		// Any component type
+	} else if isSymbolLetter(p.peek()) {
+		start := p.pos
+		for !p.eof() && isSymbolLetter(p.peek()) {
+			p.pos++
+		}
+		if strings.HasPrefix(p.input[p.pos:], tree.PROPERTY_SYNTAX_SUFFIX) {
+			p.pos += len(tree.PROPERTY_SYNTAX_SUFFIX)
+		}
+		comp.symbol = p.input[start:p.pos]
+	}
+	for !p.eof() {
+		switch p.peek() {
+		case '[':
+			condition, err := p.parseFilter()
+			if err != nil {
+				return comp, err
+			}
+			comp.conditions = append(comp.conditions, condition)
+		case ':':
+			condition, err := p.parsePseudo()
+			if err != nil {
+				return comp, err
+			}
+			comp.conditions = append(comp.conditions, condition)
+		default:
+			return comp, nil
+		}
+	}
+	return comp, nil
+}
+
+/*
+Parses a filter (e.g., '[content*=farmer]') and returns the corresponding condition.
+*/
+func (p *queryParser) parseFilter() (func(e *Element) bool, error) {
+	p.consume("[")
+	p.skipSpaces()
+	start := p.pos
+	for !p.eof() && !strings.ContainsRune("=!*^$~<>] ", p.peek()) {
+		p.pos++
+	}
+	attribute := p.input[start:p.pos]
+	if attribute == "" {
+		return nil, p.errorf("missing attribute in filter")
+	}
+	p.skipSpaces()
+
+	// Filter without comparison (i.e., presence of attribute value)
+	if p.consume("]") {
+		return presenceCondition(attribute, p, start)
+	}
+
+	comparison := ""
+	for _, candidate := range COMPARISONS {
+		if p.consume(candidate) {
+			comparison = candidate
+			break
+		}
+	}
+	if comparison == "" {
+		return nil, p.errorf("invalid comparison operator in filter")
+	}
+	p.skipSpaces()
+	valueStart := p.pos
+	value, regex, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if !p.consume("]") {
+		return nil, p.errorf("missing ']' in filter")
+	}
+	if regex && comparison != COMPARISON_REGEX {
+		return nil, (&queryParser{input: p.input, pos: valueStart}).errorf("regular expression requires operator '%s'", COMPARISON_REGEX)
+	}
+	return comparisonCondition(attribute, comparison, value, p, start, valueStart)
+}
+
+/*
+Parses the value of a filter. Returns the value and whether it is given as regular expression (i.e., '/.../').
+*/
+func (p *queryParser) parseValue() (string, bool, error) {
+	if p.eof() {
+		return "", false, p.errorf("missing value in filter")
+	}
+	delimiter := p.peek()
+	if delimiter == '\'' || delimiter == '"' || delimiter == '/' {
+		p.pos++
+		value := strings.Builder{}
+		for !p.eof() {
+			letter := p.peek()
+			p.pos += len(string(letter))
+			if letter == '\\' && !p.eof() && (p.peek() == delimiter || p.peek() == '\\') && delimiter != '/' {
+				value.WriteRune(p.peek())
+				p.pos++
+				continue
+			}
+			if letter == '\\' && !p.eof() && p.peek() == '/' && delimiter == '/' {
+				value.WriteRune('/')
+				p.pos++
+				continue
+			}
+			if letter == delimiter {
+				return value.String(), delimiter == '/', nil
+			}
+			value.WriteRune(letter)
+		}
+		return "", false, p.errorf("unterminated value in filter")
+	}
+	start := p.pos
+	for !p.eof() && p.peek() != ']' && !unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+	if start == p.pos {
+		return "", false, p.errorf("missing value in filter")
+	}
+	return p.input[start:p.pos], false, nil
+}
+
+/*
+Parses a pseudo-class (e.g., ':nested', ':has(O)') and returns the corresponding condition.
+*/
+func (p *queryParser) parsePseudo() (func(e *Element) bool, error) {
+	p.consume(":")
+	start := p.pos
+	for !p.eof() && unicode.IsLetter(p.peek()) {
+		p.pos++
+	}
+	name := p.input[start:p.pos]
+	switch name {
+	case PSEUDO_NESTED:
+		return func(e *Element) bool { return e.Nested }, nil
+	case PSEUDO_PRIVATE:
+		return func(e *Element) bool { return e.Private }, nil
+	case PSEUDO_HAS:
+		if !p.consume("(") {
+			return nil, p.errorf("missing '(' for ':%s'", name)
+		}
+		sel, err := p.parseSelector(true)
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if !p.consume(")") {
+			return nil, p.errorf("missing ')' for ':%s'", name)
+		}
+		return func(e *Element) bool { return sel.hasMatchBelow(e) }, nil
+	case PSEUDO_NOT:
+		if !p.consume("(") {
+			return nil, p.errorf("missing '(' for ':%s'", name)
+		}
+		p.skipSpaces()
+		comp, err := p.parseCompound()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if !p.consume(")") {
+			return nil, p.errorf("missing ')' for ':%s'", name)
+		}
+		return func(e *Element) bool { return !comp.matches(e) }, nil
+	}
+	return nil, (&queryParser{input: p.input, pos: start}).errorf("unknown pseudo-class ':%s'", name)
+}
+
+/*
+Returns condition for filters without comparison, which match elements with non-empty attribute values
+(or annotations with the given key, respectively).
+*/
+func presenceCondition(attribute string, p *queryParser, start int) (func(e *Element) bool, error) {
+	if strings.HasPrefix(attribute, ATTRIBUTE_ANNOTATION_PREFIX) {
+		key := strings.TrimPrefix(attribute, ATTRIBUTE_ANNOTATION_PREFIX)
+		return func(e *Element) bool {
+			_, found := e.AnnotationValues(key)
+			return found
+		}, nil
+	}
+	switch attribute {
+	case ATTRIBUTE_CONTENT:
+		return func(e *Element) bool { return e.Content != "" }, nil
+	case ATTRIBUTE_SUFFIX:
+		return func(e *Element) bool { return e.Suffix != "" }, nil
+	case ATTRIBUTE_OPERATOR:
+		return func(e *Element) bool { return len(e.Operators) > 0 }, nil
+	case ATTRIBUTE_LEVEL:
+		return nil, (&queryParser{input: p.input, pos: start}).errorf("attribute '%s' requires comparison", attribute)
+	}
+	return nil, (&queryParser{input: p.input, pos: start}).errorf("unknown attribute '%s'", attribute)
+}
+
+/*
+Returns condition comparing the values of the given attribute to the given value. Attributes with multiple
+values (i.e., operators, annotations with the same key) match if any value satisfies the comparison
+(or no value is equal for operator '!=').
+*/
+func comparisonCondition(attribute string, comparison string, value string, p *queryParser, start int, valueStart int) (func(e *Element) bool, error) {
+
+	if attribute == ATTRIBUTE_LEVEL {
+		level, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, (&queryParser{input: p.input, pos: valueStart}).errorf("level must be a number, but is '%s'", value)
+		}
+		compare, err := levelComparison(comparison, level)
+		if err != nil {
+			return nil, (&queryParser{input: p.input, pos: valueStart}).errorf("%s", err.Error())
+		}
+		return func(e *Element) bool { return compare(e.Level) }, nil
+	}
+
+	compare, err := textComparison(comparison, value)
+	if err != nil {
+		return nil, (&queryParser{input: p.input, pos: valueStart}).errorf("%s", err.Error())
+	}
+	var values func(e *Element) []string
+	switch {
+	case strings.HasPrefix(attribute, ATTRIBUTE_ANNOTATION_PREFIX):
+		key := strings.TrimPrefix(attribute, ATTRIBUTE_ANNOTATION_PREFIX)
+		values = func(e *Element) []string {
+			annotationValues, _ := e.AnnotationValues(key)
+			return annotationValues
+		}
+	case attribute == ATTRIBUTE_CONTENT:
+		values = func(e *Element) []string { return []string{e.Content} }
+	case attribute == ATTRIBUTE_SUFFIX:
+		values = func(e *Element) []string { return []string{e.Suffix} }
+	case attribute == ATTRIBUTE_OPERATOR:
+		values = func(e *Element) []string { return e.Operators }
+	default:
+		return nil, (&queryParser{input: p.input, pos: start}).errorf("unknown attribute '%s'", attribute)
+	}
+
+	if comparison == COMPARISON_NOT_EQUALS {
+		return func(e *Element) bool {
+			for _, v := range values(e) {
+				if !compare(v) {
+					return false
+				}
+			}
+			return true
+		}, nil
+	}
+	return func(e *Element) bool {
+		for _, v := range values(e) {
+			if compare(v) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+/*
+Returns function comparing text values to the given value. Comparisons are case-insensitive, except for
+regular expressions (which can use the flag '(?i)' instead).
+*/
+func textComparison(comparison string, value string) (func(v string) bool, error) {
+	lowerValue := strings.ToLower(value)
+	switch comparison {
+	case COMPARISON_EQUALS:
+		return func(v string) bool { return strings.EqualFold(v, value) }, nil
+	case COMPARISON_NOT_EQUALS:
+		return func(v string) bool { return !strings.EqualFold(v, value) }, nil
+	case COMPARISON_CONTAINS:
+		return func(v string) bool { return strings.Contains(strings.ToLower(v), lowerValue) }, nil
+	case COMPARISON_PREFIX:
+		return func(v string) bool { return strings.HasPrefix(strings.ToLower(v), lowerValue) }, nil
+	case COMPARISON_SUFFIX:
+		return func(v string) bool { return strings.HasSuffix(strings.ToLower(v), lowerValue) }, nil
+	case COMPARISON_REGEX:
+		regex, err := regexp.Compile(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression '%s': %s", value, err.Error())
+		}
+		return regex.MatchString, nil
+	}
+	return nil, fmt.Errorf("operator '%s' is only supported for attribute '%s'", comparison, ATTRIBUTE_LEVEL)
+}
+
+/*
+Returns function comparing nesting levels to the given level.
+*/
+func levelComparison(comparison string, level int) (func(v int) bool, error) {
+	switch comparison {
+	case COMPARISON_EQUALS:
+		return func(v int) bool { return v == level }, nil
+	case COMPARISON_NOT_EQUALS:
+		return func(v int) bool { return v != level }, nil
+	case COMPARISON_LESS:
+		return func(v int) bool { return v < level }, nil
+	case COMPARISON_LESS_EQUALS:
+		return func(v int) bool { return v <= level }, nil
+	case COMPARISON_GREATER:
+		return func(v int) bool { return v > level }, nil
+	case COMPARISON_GREATER_EQUALS:
+		return func(v int) bool { return v >= level }, nil
+	}
+	return nil, fmt.Errorf("operator '%s' is not supported for attribute '%s'", comparison, ATTRIBUTE_LEVEL)
+}
+
+/*
+Indicates whether the given character can be part of component symbols.
+*/
+func isSymbolLetter(letter rune) bool {
+	return (letter >= 'a' && letter <= 'z') || (letter >= 'A' && letter <= 'Z')
+}
+
+/*
+Indicates whether the input at the current position starts a compound.
+*/
+func (p *queryParser) startsCompound() bool {
+	if p.eof() {
+		return false
+	}
+	letter := p.peek()
+	return isSymbolLetter(letter) || strings.ContainsRune(SYMBOL_ANY+"[:", letter)
+}
+
+/*
+Consumes the given token if the input continues with it. Returns whether the token has been consumed.
+*/
+func (p *queryParser) consume(token string) bool {
+	if strings.HasPrefix(p.input[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+/*
+Skips whitespace at current position.
+*/
+func (p *queryParser) skipSpaces() {
+	for !p.eof() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+/*
+Returns character at current position.
+*/
+func (p *queryParser) peek() rune {
+	for _, letter := range p.input[p.pos:] {
+		return letter
+	}
+	return 0
+}
+
+/*
+Indicates whether the end of the input has been reached.
+*/
+func (p *queryParser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+/*
+Returns error with the given message, including the current position (counted in characters, starting at 1).
+*/
+func (p *queryParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid query at position %d: %s", len([]rune(p.input[:p.pos]))+1, fmt.Sprintf(format, args...))
+}
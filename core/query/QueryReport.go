@@ -0,0 +1,55 @@
+package query
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+)
+
+/*
+This file contains the generation of query results (see Result) in CSV and JSON format.
+*/
+
+// Output formats of query results
+const OUTPUT_FORMAT_CSV = "csv"
+const OUTPUT_FORMAT_JSON = "json"
+
+// Output formats available for query results
+var OUTPUT_FORMATS = []string{OUTPUT_FORMAT_CSV, OUTPUT_FORMAT_JSON}
+
+// Header row of CSV output
+var CSV_HEADER = []string{"Statement ID", "Path", "Component", "Content", "Suffix", "Annotations", "Level"}
+
+/*
+Generates CSV output for the given result, with one row per selected element. Statements excluded from the
+query are only listed in the JSON output.
+*/
+func GenerateCSV(result Result) (string, error) {
+	buffer := bytes.Buffer{}
+	writer := csv.NewWriter(&buffer)
+	rows := [][]string{CSV_HEADER}
+
+	for _, match := range result.Matches {
+		for _, element := range match.Elements {
+			rows = append(rows, []string{match.StmtId, element.Path, element.Component, element.Content,
+				element.Suffix, element.Annotations, strconv.Itoa(element.Level)})
+		}
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+/*
+Generates JSON output for the given result.
+*/
+func GenerateJSON(result Result) (string, error) {
+	output, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
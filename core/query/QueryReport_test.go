@@ -0,0 +1,42 @@
+package query
+
+import (
+	"strings"
+	"testing"
+)
+
+/*
+Tests CSV and JSON output of query results.
+*/
+func TestQueryReportOutput(t *testing.T) {
+
+	q, err := Parse("I[operator=XOR] | Cac A")
+	if err != nil {
+		t.Fatal("Query should be valid. Error:", err)
+	}
+	result := Search([]Record{
+		{StmtId: "1", Statement: "A(farmer) I((sell [XOR] buy)) Cac{A[ref=1](council) I(approves)}"},
+		{StmtId: "2", Statement: "A(farmer) I(sell"},
+		{StmtId: "3", Statement: "A(trader) I(sells) Cac{A(council) I(approves)}"},
+	}, q)
+
+	csvOutput, err := GenerateCSV(result)
+	if err != nil {
+		t.Fatal("CSV output generation should not fail. Error:", err)
+	}
+	lines := strings.Split(strings.TrimSpace(csvOutput), "\n")
+	if len(lines) != 4 || lines[0] != strings.Join(CSV_HEADER, ",") {
+		t.Fatal("CSV output should contain header and 3 rows, but was:\n", csvOutput)
+	}
+	if lines[1] != "1,I,I,sell,,,0" || lines[3] != "3,Cac/A,A,council,,,1" {
+		t.Fatal("CSV output should contain selected elements, but was:\n", csvOutput)
+	}
+
+	jsonOutput, err := GenerateJSON(result)
+	if err != nil {
+		t.Fatal("JSON output generation should not fail. Error:", err)
+	}
+	if !strings.Contains(jsonOutput, "\"matchingStatements\": 2") || !strings.Contains(jsonOutput, "\"stmtId\": \"2\",\n      \"errorCode\"") {
+		t.Fatal("JSON output does not contain expected elements:\n", jsonOutput)
+	}
+}
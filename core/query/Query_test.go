@@ -0,0 +1,167 @@
+package query
+
+import (
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"strings"
+	"testing"
+)
+
+/*
+Returns the paths and contents of the elements selected by a query on a given statement (e.g., 'A:farmer'),
+or nil if the statement does not match.
+*/
+func selectElements(t *testing.T, query string, statement string) []string {
+	q, err := Parse(query)
+	if err != nil {
+		t.Fatal("Query '"+query+"' should be valid. Error:", err)
+	}
+	stmts, err2 := parser.ParseStatement(statement)
+	if err2.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Statement should be parsed. Error:", err2)
+	}
+	elements := q.Match(stmts[0])
+	if elements == nil {
+		return nil
+	}
+	result := []string{}
+	for _, element := range elements {
+		result = append(result, element.Path+":"+element.Content)
+	}
+	return result
+}
+
+/*
+Tests the selection of elements by component type, content, annotations, suffix and logical operator.
+*/
+func TestQueryFilters(t *testing.T) {
+
+	statement := "A[ref=1](farmer) D(must) I((sell [XOR] buy)) Bdir1(goods) Bdir1,p(perishable) " +
+		"Cac{A(inspector) I[dir](approves)}"
+
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"A", "A:farmer Cac/A:inspector"},
+		{"A[content='Farmer']", "A:farmer"},
+		{"I[content^=se]", "I:sell"},
+		{"I[content$=Y]", "I:buy"},
+		{"*[content*=spect]", "Cac:A(inspector) I[dir](approves) Cac/A:inspector"},
+		{"I[content~=/^b.y$/]", "I:buy"},
+		{"I[content!=sell]", "I:buy Cac/I:approves"},
+		{"[@ref]", "A:farmer"},
+		{"[@ref=1]", "A:farmer"},
+		{"I[@dir]", "Cac/I:approves"},
+		{"Bdir[suffix=1]", "Bdir:goods"},
+		{"I[operator=xor]", "I:sell I:buy"},
+		{"I[operator]", "I:sell I:buy"},
+		{"*[level>=1]", "Cac/A:inspector Cac/I:approves"},
+		{"Bdir,p", "Bdir/Bdir,p:perishable"},
+		{"*:private", "Bdir/Bdir,p:perishable"},
+		{"Cac:nested", "Cac:A(inspector) I[dir](approves)"},
+		{"I:not([operator])", "Cac/I:approves"},
+		{"D[content=may]", ""},
+	}
+
+	for _, test := range tests {
+		result := strings.Join(selectElements(t, test.query, statement), " ")
+		if result != test.expected {
+			t.Error("Query '" + test.query + "' should select '" + test.expected + "', but selected '" + result + "'")
+		}
+	}
+}
+
+/*
+Tests the selection of elements based on their relation to other elements, as well as the combination of
+selectors.
+*/
+func TestQueryRelations(t *testing.T) {
+
+	statement := "A(actor) I(aim) Bdir1(object) Bdir1,p(public) Bdir2(other) Bdir,p(shared) " +
+		"Cac{Cac{A(council) I(approves)} [AND] Cac{A(mayor) I(signs) Cac{A(office) I(opens)}}}"
+
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"Cac A", "Cac/A:council Cac/A:mayor Cac/Cac/A:office"},
+		{"Cac > A", "Cac/A:council Cac/A:mayor Cac/Cac/A:office"},
+		{"Cac > Cac > A", "Cac/Cac/A:office"},
+		{"Cac Cac A", "Cac/Cac/A:office"},
+		{"Cac[operator=and] > Cac A", "Cac/Cac/A:office"},
+		{"Cac:has(> A[content=mayor])", "Cac:A(mayor) I(signs) Cac{A(office) I(opens)}"},
+		{"Cac:has(A[content=office])", "Cac:A(mayor) I(signs) Cac{A(office) I(opens)} Cac/Cac:A(office) I(opens)"},
+		{"Cac:has(> A[content=office])", "Cac/Cac:A(office) I(opens)"},
+		{"Bdir:has(> Bdir,p:private)", "Bdir:object"},
+		{"Bdir,p:not(:private)", "Bdir,p:shared"},
+		{"A[level=0] & Cac[level=1]", "A:actor Cac/Cac:A(office) I(opens)"},
+		{"A[content=actor] & I[content=missing]", ""},
+		{"A[content=missing] | I[content=opens]", "Cac/Cac/I:opens"},
+	}
+
+	for _, test := range tests {
+		result := strings.Join(selectElements(t, test.query, statement), " ")
+		if result != test.expected {
+			t.Error("Query '" + test.query + "' should select '" + test.expected + "', but selected '" + result + "'")
+		}
+	}
+}
+
+/*
+Tests the rejection of invalid queries, including the position of the syntax error.
+*/
+func TestQueryInvalid(t *testing.T) {
+
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"", "empty query"},
+		{"A[color=red]", "position 3: unknown attribute 'color'"},
+		{"A[content<3]", "position 11: operator '<' is only supported for attribute 'level'"},
+		{"A[level=high]", "position 9: level must be a number"},
+		{"A[content~=/(/]", "position 12: invalid regular expression"},
+		{"A[content=/x/]", "position 11: regular expression requires operator '~='"},
+		{"A[content='farmer]", "unterminated value"},
+		{"A:has(I", "missing ')'"},
+		{"A:unknown", "position 3: unknown pseudo-class ':unknown'"},
+		{"A &", "missing component symbol"},
+		{"A )", "position 3: unexpected character ')'"},
+	}
+
+	for _, test := range tests {
+		_, err := Parse(test.query)
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Error("Query '"+test.query+"' should be rejected with '"+test.expected+"', but returned:", err)
+		}
+	}
+}
+
+/*
+Tests the evaluation of queries across statements, including component pair combinations and the exclusion
+of statements that cannot be parsed.
+*/
+func TestSearch(t *testing.T) {
+
+	q, err := Parse("I[operator=OR]")
+	if err != nil {
+		t.Fatal("Query should be valid. Error:", err)
+	}
+	result := Search([]Record{
+		{StmtId: "1", Statement: "A(farmer) I((sell [XOR] buy))"},
+		{StmtId: "2", Statement: "{A(trader) I(sells) [XOR] A(farmer) I((sells [OR] buys))}"},
+		{StmtId: "3", Statement: "A(farmer) I((sell [XOR] buy)"},
+	}, q)
+
+	if result.Statements != 2 || result.MatchingStatements != 1 {
+		t.Fatal("Search should query 2 statements with 1 match, but returned:", result)
+	}
+	if result.Matches[0].StmtId != "2" || len(result.Matches[0].Elements) != 2 ||
+		result.Matches[0].Elements[1].Content != "buys" {
+		t.Fatal("Search should select both entries of the OR combination in statement 2, but returned:", result.Matches)
+	}
+	if len(result.Excluded) != 1 || result.Excluded[0].StmtId != "3" {
+		t.Fatal("Statement 3 should be excluded, but returned:", result.Excluded)
+	}
+}
@@ -0,0 +1,206 @@
+package reliability
+
+import (
+	"math"
+	"sort"
+)
+
+/*
+This file contains the agreement measures computed for the coding decisions of multiple coders.
+Coding decisions are provided as units (e.g., the content of a given component in a given statement),
+each of which holds the values assigned by the individual coders (in consistent order across units).
+Coders that have not coded a unit are represented by MISSING_VALUE. All measures treat values as
+nominal categories.
+*/
+
+// Value of coders that have not coded a given unit
+const MISSING_VALUE = ""
+
+/*
+Returns the values of a unit assigned by coders (i.e., excluding missing values).
+*/
+func codedValues(unit []string) []string {
+	values := []string{}
+	for _, value := range unit {
+		if value != MISSING_VALUE {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+/*
+Returns the units that have been coded by all coders (i.e., without missing values).
+*/
+func completeUnits(units [][]string) [][]string {
+	result := [][]string{}
+	for _, unit := range units {
+		if len(codedValues(unit)) == len(unit) {
+			result = append(result, unit)
+		}
+	}
+	return result
+}
+
+/*
+Computes the percent agreement (0-1) as the share of agreeing pairs of coders per unit, averaged across
+units coded by at least two coders (which, for two coders, corresponds to the share of units with
+identical values). Returns NaN if no unit has been coded by at least two coders.
+*/
+func PercentAgreement(units [][]string) float64 {
+	sum := 0.0
+	count := 0
+	for _, unit := range units {
+		values := codedValues(unit)
+		if len(values) < 2 {
+			continue
+		}
+		agreeing := 0
+		for i := range values {
+			for j := i + 1; j < len(values); j++ {
+				if values[i] == values[j] {
+					agreeing++
+				}
+			}
+		}
+		sum += float64(agreeing) / float64(len(values)*(len(values)-1)/2)
+		count++
+	}
+	if count == 0 {
+		return math.NaN()
+	}
+	return sum / float64(count)
+}
+
+/*
+Computes Cohen's kappa for two coders, based on units coded by both coders.
+Returns NaN if there are no such units, or if chance agreement is perfect (e.g., all values are identical).
+*/
+func CohenKappa(units [][]string) float64 {
+	observed := 0.0
+	first := map[string]float64{}
+	second := map[string]float64{}
+	n := 0.0
+	for _, unit := range completeUnits(units) {
+		if len(unit) != 2 {
+			return math.NaN()
+		}
+		if unit[0] == unit[1] {
+			observed++
+		}
+		first[unit[0]]++
+		second[unit[1]]++
+		n++
+	}
+	if n == 0 {
+		return math.NaN()
+	}
+	expected := 0.0
+	for _, value := range sortedKeys(first) {
+		expected += first[value] / n * second[value] / n
+	}
+	return kappa(observed/n, expected)
+}
+
+/*
+Computes Fleiss' kappa for any number of coders, based on units coded by at least two coders. Units may be
+coded by varying numbers of coders, in which case the agreement per unit is based on the coders having coded it.
+Returns NaN if there are no such units, or if chance agreement is perfect (e.g., all values are identical).
+*/
+func FleissKappa(units [][]string) float64 {
+	observed := 0.0
+	counts := map[string]float64{}
+	total := 0.0
+	n := 0.0
+	for _, unit := range units {
+		values := codedValues(unit)
+		if len(values) < 2 {
+			continue
+		}
+		unitCounts := countValues(values)
+		agreeing := 0.0
+		for _, value := range sortedKeys(unitCounts) {
+			agreeing += unitCounts[value] * (unitCounts[value] - 1)
+			counts[value] += unitCounts[value]
+		}
+		observed += agreeing / float64(len(values)*(len(values)-1))
+		total += float64(len(values))
+		n++
+	}
+	if n == 0 {
+		return math.NaN()
+	}
+	expected := 0.0
+	for _, value := range sortedKeys(counts) {
+		expected += (counts[value] / total) * (counts[value] / total)
+	}
+	return kappa(observed/n, expected)
+}
+
+/*
+Computes Krippendorff's alpha for nominal data, based on the coincidences of values within units coded by at least
+two coders (i.e., considering units with missing values). Returns NaN if there are no such units, or if all values
+are identical (i.e., no disagreement is expected).
+*/
+func KrippendorffAlpha(units [][]string) float64 {
+	// Coincidences of values within units (disagreeing and total)
+	disagreements := 0.0
+	totals := map[string]float64{}
+	n := 0.0
+	for _, unit := range units {
+		values := codedValues(unit)
+		if len(values) < 2 {
+			continue
+		}
+		for i := range values {
+			for j := range values {
+				if i != j && values[i] != values[j] {
+					disagreements += 1 / float64(len(values)-1)
+				}
+			}
+			totals[values[i]]++
+		}
+		n += float64(len(values))
+	}
+	expected := 0.0
+	for _, value := range sortedKeys(totals) {
+		expected += totals[value] * (n - totals[value])
+	}
+	if expected == 0 {
+		return math.NaN()
+	}
+	return 1 - (n-1)*disagreements/expected
+}
+
+/*
+Computes kappa coefficient from observed and expected agreement.
+*/
+func kappa(observed float64, expected float64) float64 {
+	if expected == 1 {
+		return math.NaN()
+	}
+	return (observed - expected) / (1 - expected)
+}
+
+/*
+Counts the occurrences of the given values.
+*/
+func countValues(values []string) map[string]float64 {
+	counts := map[string]float64{}
+	for _, value := range values {
+		counts[value]++
+	}
+	return counts
+}
+
+/*
+Returns the keys of a map in sorted order (for deterministic floating point summation).
+*/
+func sortedKeys(values map[string]float64) []string {
+	keys := []string{}
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,82 @@
+package reliability
+
+import (
+	"math"
+	"testing"
+)
+
+/*
+Indicates whether two values are equal up to rounding errors.
+*/
+func approximately(value float64, expected float64) bool {
+	return math.Abs(value-expected) < 0.0001
+}
+
+/*
+Tests agreement measures for two coders against manually computed values.
+*/
+func TestMeasuresTwoCoders(t *testing.T) {
+
+	units := [][]string{{"a", "a"}, {"a", "b"}, {"b", "b"}, {"b", "b"}}
+
+	if value := PercentAgreement(units); !approximately(value, 0.75) {
+		t.Fatal("Percent agreement should be 0.75, but was", value)
+	}
+	if value := CohenKappa(units); !approximately(value, 0.5) {
+		t.Fatal("Cohen's kappa should be 0.5, but was", value)
+	}
+	if value := FleissKappa(units); !approximately(value, 0.46667) {
+		t.Fatal("Fleiss' kappa should be 0.46667, but was", value)
+	}
+	if value := KrippendorffAlpha(units); !approximately(value, 0.53333) {
+		t.Fatal("Krippendorff's alpha should be 0.53333, but was", value)
+	}
+
+	// Units coded by a single coder do not affect measures
+	units = append(units, []string{"a", MISSING_VALUE})
+	if value := KrippendorffAlpha(units); !approximately(value, 0.53333) {
+		t.Fatal("Krippendorff's alpha should ignore units with single value, but was", value)
+	}
+	if value := CohenKappa(units); !approximately(value, 0.5) {
+		t.Fatal("Cohen's kappa should ignore incomplete units, but was", value)
+	}
+}
+
+/*
+Tests agreement measures for three coders with missing values against manually computed values.
+*/
+func TestMeasuresThreeCoders(t *testing.T) {
+
+	units := [][]string{{"a", "a", "a"}, {"a", "a", "b"}, {"b", "b", MISSING_VALUE}}
+
+	// Pairwise agreement: 1, 1/3, 1
+	if value := PercentAgreement(units); !approximately(value, 7.0/9) {
+		t.Fatal("Percent agreement should be 0.7778, but was", value)
+	}
+	// Observed: (1 + 1/3 + 1) / 3, expected: (5/8)^2 + (3/8)^2
+	if value := FleissKappa(units); !approximately(value, (7.0/9-34.0/64)/(1-34.0/64)) {
+		t.Fatal("Fleiss' kappa should be 0.4741, but was", value)
+	}
+	// Disagreements: 4 coincidences in second unit (weighted by 1/2), n = 8 (a: 5, b: 3)
+	if value := KrippendorffAlpha(units); !approximately(value, 1-7*2.0/30) {
+		t.Fatal("Krippendorff's alpha should be 0.5333, but was", value)
+	}
+}
+
+/*
+Tests that chance-corrected measures are undefined if all coders assign the same value to all units.
+*/
+func TestMeasuresUndefined(t *testing.T) {
+
+	units := [][]string{{"a", "a"}, {"a", "a"}}
+
+	if value := PercentAgreement(units); !approximately(value, 1) {
+		t.Fatal("Percent agreement should be 1, but was", value)
+	}
+	if !math.IsNaN(CohenKappa(units)) || !math.IsNaN(FleissKappa(units)) || !math.IsNaN(KrippendorffAlpha(units)) {
+		t.Fatal("Kappa and alpha should be undefined for uniform values")
+	}
+	if !math.IsNaN(PercentAgreement([][]string{})) {
+		t.Fatal("Percent agreement should be undefined without units")
+	}
+}
@@ -0,0 +1,526 @@
+package reliability
+
+import (
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+This file contains the inter-coder reliability analysis for statements encoded by multiple coders (double coding).
+The codings of a statement are aligned component by component, with components identified by their path
+(i.e., their symbol, prefixed by the symbols of the components embedding the nested statements they are contained
+in, e.g., 'Cac/A' for Attributes in a nested Activation Condition). Agreement is assessed for the following
+dimensions (see DIMENSIONS), each of which treats the components of each statement as units:
+- Presence: whether coders have coded a component (for components coded by at least one coder of the statement).
+- Content: the content of a component (i.e., its leaf nodes, excluding annotations, compared case-insensitively),
+  for components with content coded by at least two coders. Additionally, the word overlap (Jaccard index)
+  of the content is reported, which, as opposed to the other measures, reflects partial agreement.
+- Operators: the logical operators used within a component (e.g., '[XOR]', '[NOT]').
+- Nesting: the number of nested statements embedded in a component.
+Logical operators of component pair combinations are assessed as component COMPONENT_PAIRS.
+*/
+
+// Dimensions of agreement
+const DIMENSION_PRESENCE = "presence"
+const DIMENSION_CONTENT = "content"
+const DIMENSION_OPERATORS = "operators"
+const DIMENSION_NESTING = "nesting"
+
+// Dimensions in order of reporting
+var DIMENSIONS = []string{DIMENSION_PRESENCE, DIMENSION_CONTENT, DIMENSION_OPERATORS, DIMENSION_NESTING}
+
+// Kappa measures (Cohen's kappa for two coders, Fleiss' kappa otherwise)
+const KAPPA_COHEN = "Cohen"
+const KAPPA_FLEISS = "Fleiss"
+
+// Separator of component symbols in component paths (e.g., 'Cac/A')
+const PATH_SEPARATOR = "/"
+
+// Component path for logical operators of component pair combinations
+const COMPONENT_PAIRS = "{}"
+
+// Values for presence dimension
+const VALUE_PRESENT = "1"
+const VALUE_ABSENT = "0"
+
+// Value for components without logical operators
+const VALUE_NO_OPERATORS = "none"
+
+// Separator of leaf node contents (content dimension) and logical operators (operators dimension) in values
+const CONTENT_SEPARATOR = " | "
+const OPERATOR_SEPARATOR = ","
+
+// Annotations and inferred content embedded in component content (e.g., 'goods [ref=x]')
+var bracketedContentRegex = regexp.MustCompile(`\[[^\[\]]*\]`)
+
+/*
+IG Script encoding of a statement by a given coder.
+*/
+type Coding struct {
+	// Statement ID
+	StmtId string
+	// Coder
+	Coder string
+	// IG Script-encoded statement
+	Statement string
+}
+
+/*
+Agreement of coders for a given dimension. Measures that are not defined for the given units (e.g., kappa
+if all coders assigned the same value to all units) are nil.
+*/
+type Agreement struct {
+	// Dimension (see DIMENSIONS)
+	Dimension string `json:"dimension"`
+	// Number of units coded by at least two coders
+	Units int `json:"units"`
+	// Percent agreement (0-1)
+	PercentAgreement *float64 `json:"percentAgreement"`
+	// Cohen's kappa (two coders) or Fleiss' kappa (more than two coders) (see Report.KappaMeasure)
+	Kappa *float64 `json:"kappa"`
+	// Krippendorff's alpha (nominal)
+	Alpha *float64 `json:"alpha"`
+	// Mean word overlap (Jaccard index, 0-1) of content (content dimension only)
+	Overlap *float64 `json:"overlap,omitempty"`
+}
+
+/*
+Agreement of coders for an individual statement.
+*/
+type StatementReport struct {
+	// Statement ID
+	StmtId string `json:"stmtId"`
+	// Coders having coded the statement
+	Coders []string `json:"coders"`
+	// Agreement per dimension
+	Agreement []Agreement `json:"agreement"`
+}
+
+/*
+Agreement of coders for an individual component (across statements).
+*/
+type ComponentReport struct {
+	// Component path (e.g., 'Cac/A')
+	Component string `json:"component"`
+	// Agreement per dimension
+	Agreement []Agreement `json:"agreement"`
+}
+
+/*
+Result of reliability analysis.
+*/
+type Report struct {
+	// Coders (in order of first occurrence)
+	Coders []string `json:"coders"`
+	// Kappa measure (see KAPPA_COHEN, KAPPA_FLEISS)
+	KappaMeasure string `json:"kappaMeasure"`
+	// Agreement per dimension across all statements
+	Overall []Agreement `json:"overall"`
+	// Agreement per statement
+	Statements []StatementReport `json:"statements"`
+	// Agreement per component
+	Components []ComponentReport `json:"components"`
+	// IDs of statements excluded from the analysis, since they have been coded by fewer than two coders
+	Excluded []string `json:"excluded"`
+}
+
+/*
+Coded unit, i.e., the values assigned to a component of a given statement by all coders for a given dimension.
+*/
+type unit struct {
+	stmtId    string
+	component string
+	dimension string
+	// Values by coder (in order of Report.Coders)
+	values []string
+}
+
+/*
+Coding of an individual component by a coder.
+*/
+type component struct {
+	// Normalized content of leaf nodes (sorted, without duplicates)
+	content []string
+	// Logical operators used in component
+	operators []string
+	// Number of embedded nested statements
+	nested int
+}
+
+/*
+Parses the given codings and computes the agreement of coders per statement, per component and across all statements.
+Statements coded by fewer than two coders are excluded. Returns the error of the first coding that cannot be parsed
+(with statement ID and coder prepended to the error message), or tree.PARSING_ERROR_DUPLICATE_CODING if a coder has
+coded a statement multiple times. Otherwise returns tree.PARSING_NO_ERROR.
+*/
+func Analyze(codings []Coding) (Report, tree.ParsingError) {
+
+	report := Report{Coders: []string{}, Statements: []StatementReport{}, Components: []ComponentReport{}, Excluded: []string{}}
+
+	// Parse codings, grouped by statement and coder
+	stmtIds := []string{}
+	coded := map[string]map[string]map[string]*component{}
+	for _, coding := range codings {
+		if _, ok := coded[coding.StmtId]; !ok {
+			stmtIds = append(stmtIds, coding.StmtId)
+			coded[coding.StmtId] = map[string]map[string]*component{}
+		}
+		if _, ok := coded[coding.StmtId][coding.Coder]; ok {
+			return report, tree.ParsingError{ErrorCode: tree.PARSING_ERROR_DUPLICATE_CODING,
+				ErrorMessage: "Statement '" + coding.StmtId + "' has been coded multiple times by coder '" + coding.Coder + "'."}
+		}
+		if !containsString(report.Coders, coding.Coder) {
+			report.Coders = append(report.Coders, coding.Coder)
+		}
+		stmts, err := parser.ParseStatement(coding.Statement)
+		if err.ErrorCode != tree.PARSING_NO_ERROR && err.ErrorCode != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+			err.ErrorMessage = "Statement '" + coding.StmtId + "' (coder '" + coding.Coder + "'): " + err.ErrorMessage
+			return report, err
+		}
+		coded[coding.StmtId][coding.Coder] = extractComponents(stmts)
+	}
+
+	report.KappaMeasure = KAPPA_FLEISS
+	if len(report.Coders) == 2 {
+		report.KappaMeasure = KAPPA_COHEN
+	}
+
+	// Generate units for statements coded by at least two coders
+	units := []unit{}
+	for _, stmtId := range stmtIds {
+		if len(coded[stmtId]) < 2 {
+			report.Excluded = append(report.Excluded, stmtId)
+			continue
+		}
+		stmtUnits := generateUnits(stmtId, coded[stmtId], report.Coders)
+		units = append(units, stmtUnits...)
+		stmtCoders := []string{}
+		for _, coder := range report.Coders {
+			if _, ok := coded[stmtId][coder]; ok {
+				stmtCoders = append(stmtCoders, coder)
+			}
+		}
+		report.Statements = append(report.Statements, StatementReport{StmtId: stmtId, Coders: stmtCoders,
+			Agreement: measure(stmtUnits, report.KappaMeasure)})
+	}
+	Println("Generated units:", len(units))
+
+	// Aggregate units per component
+	paths := []string{}
+	byPath := map[string][]unit{}
+	for _, u := range units {
+		if _, ok := byPath[u.component]; !ok {
+			paths = append(paths, u.component)
+		}
+		byPath[u.component] = append(byPath[u.component], u)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		report.Components = append(report.Components, ComponentReport{Component: path,
+			Agreement: measure(byPath[path], report.KappaMeasure)})
+	}
+
+	report.Overall = measure(units, report.KappaMeasure)
+	return report, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Generates the units of all dimensions for a given statement, based on the components coded by the individual coders.
+Coders that have not coded the statement are assigned MISSING_VALUE.
+*/
+func generateUnits(stmtId string, codings map[string]map[string]*component, coders []string) []unit {
+
+	// Collect components coded by any coder
+	paths := []string{}
+	for _, coder := range coders {
+		for path := range codings[coder] {
+			if !containsString(paths, path) {
+				paths = append(paths, path)
+			}
+		}
+	}
+	sort.Strings(paths)
+
+	units := []unit{}
+	for _, path := range paths {
+		presence := unit{stmtId: stmtId, component: path, dimension: DIMENSION_PRESENCE}
+		content := unit{stmtId: stmtId, component: path, dimension: DIMENSION_CONTENT}
+		operators := unit{stmtId: stmtId, component: path, dimension: DIMENSION_OPERATORS}
+		nesting := unit{stmtId: stmtId, component: path, dimension: DIMENSION_NESTING}
+		hasContent := false
+		for _, coder := range coders {
+			components, codedStmt := codings[coder]
+			comp, codedComp := components[path]
+			switch {
+			case !codedStmt:
+				presence.values = append(presence.values, MISSING_VALUE)
+			case !codedComp:
+				presence.values = append(presence.values, VALUE_ABSENT)
+			default:
+				presence.values = append(presence.values, VALUE_PRESENT)
+			}
+			if !codedComp {
+				content.values = append(content.values, MISSING_VALUE)
+				operators.values = append(operators.values, MISSING_VALUE)
+				nesting.values = append(nesting.values, MISSING_VALUE)
+				continue
+			}
+			content.values = append(content.values, strings.Join(comp.content, CONTENT_SEPARATOR))
+			hasContent = hasContent || len(comp.content) > 0
+			ops := VALUE_NO_OPERATORS
+			if len(comp.operators) > 0 {
+				ops = strings.Join(comp.operators, OPERATOR_SEPARATOR)
+			}
+			operators.values = append(operators.values, ops)
+			nesting.values = append(nesting.values, strconv.Itoa(comp.nested))
+		}
+		units = append(units, presence)
+		// Content is only assessed for components holding content (as opposed to nested statements only)
+		if hasContent {
+			units = append(units, content)
+		}
+		if path != COMPONENT_PAIRS {
+			units = append(units, nesting)
+		}
+		units = append(units, operators)
+	}
+	return units
+}
+
+/*
+Computes the agreement for the given units per dimension (in order of DIMENSIONS), using the given kappa measure.
+*/
+func measure(units []unit, kappaMeasure string) []Agreement {
+	result := []Agreement{}
+	for _, dimension := range DIMENSIONS {
+		values := [][]string{}
+		for _, u := range units {
+			if u.dimension == dimension && len(codedValues(u.values)) >= 2 {
+				values = append(values, u.values)
+			}
+		}
+		agreement := Agreement{Dimension: dimension, Units: len(values),
+			PercentAgreement: defined(PercentAgreement(values)), Alpha: defined(KrippendorffAlpha(values))}
+		if kappaMeasure == KAPPA_COHEN {
+			agreement.Kappa = defined(CohenKappa(values))
+		} else {
+			agreement.Kappa = defined(FleissKappa(values))
+		}
+		if dimension == DIMENSION_CONTENT {
+			agreement.Overlap = defined(meanOverlap(values))
+		}
+		result = append(result, agreement)
+	}
+	return result
+}
+
+/*
+Returns reference to given value, or nil if the value is not defined (NaN).
+*/
+func defined(value float64) *float64 {
+	if math.IsNaN(value) {
+		return nil
+	}
+	return &value
+}
+
+/*
+Computes the word overlap (Jaccard index) of the content of pairs of coders per unit, averaged across pairs and units.
+Returns NaN if no unit has been coded by at least two coders.
+*/
+func meanOverlap(units [][]string) float64 {
+	sum := 0.0
+	count := 0
+	for _, u := range units {
+		values := codedValues(u)
+		for i := range values {
+			for j := i + 1; j < len(values); j++ {
+				sum += jaccard(words(values[i]), words(values[j]))
+				count++
+			}
+		}
+	}
+	if count == 0 {
+		return math.NaN()
+	}
+	return sum / float64(count)
+}
+
+/*
+Returns the distinct words of a content value.
+*/
+func words(value string) map[string]bool {
+	result := map[string]bool{}
+	for _, w := range strings.Fields(strings.ReplaceAll(value, CONTENT_SEPARATOR, " ")) {
+		result[w] = true
+	}
+	return result
+}
+
+/*
+Computes the Jaccard index of two sets of words (1 if both are empty).
+*/
+func jaccard(first map[string]bool, second map[string]bool) float64 {
+	if len(first) == 0 && len(second) == 0 {
+		return 1
+	}
+	intersection := 0
+	for w := range first {
+		if second[w] {
+			intersection++
+		}
+	}
+	return float64(intersection) / float64(len(first)+len(second)-intersection)
+}
+
+/*
+Extracts the components of parsed statements (as returned by parser.ParseStatement()) by component path.
+*/
+func extractComponents(stmts []*tree.Node) map[string]*component {
+	components := map[string]*component{}
+	visited := map[*tree.Node]bool{}
+	for _, stmt := range stmts {
+		extractFromStatementNode(stmt, "", components, visited)
+	}
+	for _, comp := range components {
+		sort.Strings(comp.content)
+		sort.Strings(comp.operators)
+	}
+	return components
+}
+
+/*
+Extracts the components of a node embedding statements, including combinations of statements (i.e., component pairs,
+whose logical operators are attributed to COMPONENT_PAIRS), using the given prefix for component paths.
+*/
+func extractFromStatementNode(node *tree.Node, prefix string, components map[string]*component, visited map[*tree.Node]bool) {
+	if node == nil || visited[node] {
+		return
+	}
+	visited[node] = true
+	switch entry := node.Entry.(type) {
+	case *tree.Statement:
+		extractFromStatement(entry, prefix, components, visited)
+	case []*tree.Node:
+		for _, pairNode := range entry {
+			extractFromStatementNode(pairNode, prefix, components, visited)
+		}
+	}
+	if node.LogicalOperator != "" {
+		addOperator(getComponent(components, prefix+COMPONENT_PAIRS), node.LogicalOperator)
+	}
+	extractFromStatementNode(node.Left, prefix, components, visited)
+	extractFromStatementNode(node.Right, prefix, components, visited)
+}
+
+/*
+Extracts the components of a statement, using the given prefix for component paths.
+*/
+func extractFromStatement(stmt *tree.Statement, prefix string, components map[string]*component, visited map[*tree.Node]bool) {
+	if stmt == nil {
+		return
+	}
+	for _, comp := range stmt.Components() {
+		if comp.Node != nil && !comp.Node.IsEmptyOrNilNode() {
+			extractFromComponentNode(comp.Node, prefix+comp.Symbol, components, visited)
+		}
+	}
+}
+
+/*
+Extracts the content (including shared elements), logical operators and nested statements of a component node
+(and its children) for the given component path. Private nodes linked to the component are attributed to their own component.
+*/
+func extractFromComponentNode(node *tree.Node, path string, components map[string]*component, visited map[*tree.Node]bool) {
+	if node == nil || visited[node] {
+		return
+	}
+	visited[node] = true
+	comp := getComponent(components, path)
+	// Elements shared across combinations (e.g., 'goods' in '(sell [AND] deliver) goods') are considered content
+	for _, shared := range [][]string{node.SharedLeft, node.SharedRight} {
+		for _, element := range shared {
+			addContent(comp, element)
+		}
+	}
+	switch entry := node.Entry.(type) {
+	case string:
+		addContent(comp, entry)
+	case *tree.Statement:
+		if entry != nil {
+			comp.nested++
+			extractFromStatement(entry, path+PATH_SEPARATOR, components, visited)
+		}
+	case []*tree.Node:
+		comp.nested++
+		for _, pairNode := range entry {
+			extractFromStatementNode(pairNode, path+PATH_SEPARATOR, components, visited)
+		}
+	}
+	addOperator(comp, node.LogicalOperator)
+	extractFromComponentNode(node.Left, path, components, visited)
+	extractFromComponentNode(node.Right, path, components, visited)
+	for _, privateNode := range node.PrivateNodeLinks {
+		privatePath := ""
+		if idx := strings.LastIndex(path, PATH_SEPARATOR); idx != -1 {
+			privatePath = path[:idx+1] + privateNode.GetComponentName()
+		} else {
+			privatePath = privateNode.GetComponentName()
+		}
+		extractFromComponentNode(privateNode, privatePath, components, visited)
+	}
+}
+
+/*
+Returns the component for the given path, which is created if not existing.
+*/
+func getComponent(components map[string]*component, path string) *component {
+	if _, ok := components[path]; !ok {
+		components[path] = &component{content: []string{}, operators: []string{}}
+	}
+	return components[path]
+}
+
+/*
+Adds normalized content to component (unless empty or already contained).
+*/
+func addContent(comp *component, content string) {
+	content = normalizeContent(content)
+	if content != "" && !containsString(comp.content, content) {
+		comp.content = append(comp.content, content)
+	}
+}
+
+/*
+Adds logical operator to component, ignoring implicit linkages (bAND, wAND).
+*/
+func addOperator(comp *component, operator string) {
+	switch operator {
+	case tree.AND, tree.OR, tree.XOR, tree.NOT:
+		comp.operators = append(comp.operators, operator)
+	}
+}
+
+/*
+Normalizes component content for comparison (removing annotations, lower case, uniform whitespace).
+*/
+func normalizeContent(content string) string {
+	return strings.ToLower(strings.Join(strings.Fields(bracketedContentRegex.ReplaceAllString(content, " ")), " "))
+}
+
+/*
+Indicates whether a given value is contained in a string slice.
+*/
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
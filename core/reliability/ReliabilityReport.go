@@ -0,0 +1,80 @@
+package reliability
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+)
+
+/*
+This file contains the generation of reliability reports (see Report) in CSV and JSON format.
+*/
+
+// Output formats of reliability reports
+const OUTPUT_FORMAT_CSV = "csv"
+const OUTPUT_FORMAT_JSON = "json"
+
+// Output formats available for reliability reports
+var OUTPUT_FORMATS = []string{OUTPUT_FORMAT_CSV, OUTPUT_FORMAT_JSON}
+
+// Scopes of agreement in CSV output
+const SCOPE_OVERALL = "overall"
+const SCOPE_STATEMENT = "statement"
+const SCOPE_COMPONENT = "component"
+
+// Header row of CSV output
+var CSV_HEADER = []string{"Scope", "Statement ID", "Component", "Dimension", "Units", "Percent agreement", "Kappa", "Alpha", "Overlap"}
+
+/*
+Generates CSV output for the given report, with one row per dimension for all statements (scope 'overall'),
+each statement (scope 'statement') and each component (scope 'component'). Undefined measures are left empty.
+*/
+func GenerateCSV(report Report) (string, error) {
+	buffer := bytes.Buffer{}
+	writer := csv.NewWriter(&buffer)
+	rows := [][]string{CSV_HEADER}
+	rows = appendRows(rows, SCOPE_OVERALL, "", "", report.Overall)
+	for _, stmt := range report.Statements {
+		rows = appendRows(rows, SCOPE_STATEMENT, stmt.StmtId, "", stmt.Agreement)
+	}
+	for _, comp := range report.Components {
+		rows = appendRows(rows, SCOPE_COMPONENT, "", comp.Component, comp.Agreement)
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+/*
+Generates JSON output for the given report. Undefined measures are represented as null.
+*/
+func GenerateJSON(report Report) (string, error) {
+	output, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
+
+/*
+Appends rows for the agreement per dimension to the given rows.
+*/
+func appendRows(rows [][]string, scope string, stmtId string, component string, agreement []Agreement) [][]string {
+	for _, a := range agreement {
+		rows = append(rows, []string{scope, stmtId, component, a.Dimension, strconv.Itoa(a.Units),
+			formatMeasure(a.PercentAgreement), formatMeasure(a.Kappa), formatMeasure(a.Alpha), formatMeasure(a.Overlap)})
+	}
+	return rows
+}
+
+/*
+Formats measure with four decimal places, or returns an empty string if the measure is undefined.
+*/
+func formatMeasure(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', 4, 64)
+}
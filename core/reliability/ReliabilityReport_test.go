@@ -0,0 +1,41 @@
+package reliability
+
+import (
+	"strings"
+	"testing"
+)
+
+/*
+Tests CSV and JSON output of reliability reports, including the representation of undefined measures.
+*/
+func TestReliabilityReportOutput(t *testing.T) {
+
+	report, _ := Analyze([]Coding{
+		{StmtId: "1", Coder: "alice", Statement: "A(farmer) I(sell)"},
+		{StmtId: "1", Coder: "bob", Statement: "A(farmer) I(buy)"},
+	})
+
+	csvOutput, err := GenerateCSV(report)
+	if err != nil {
+		t.Fatal("CSV output generation should not fail. Error:", err)
+	}
+	lines := strings.Split(strings.TrimSpace(csvOutput), "\n")
+	// Header, 4 dimensions each for overall, statement and two components
+	if len(lines) != 17 || lines[0] != strings.Join(CSV_HEADER, ",") {
+		t.Fatal("CSV output should contain header and 16 rows, but was:\n", csvOutput)
+	}
+	if lines[2] != "overall,,,content,2,0.5000,0.3333,0.4000,0.5000" {
+		t.Fatal("CSV output should contain overall content agreement, but was:", lines[2])
+	}
+	if lines[1] != "overall,,,presence,2,1.0000,,," {
+		t.Fatal("CSV output should leave undefined measures empty, but was:", lines[1])
+	}
+
+	jsonOutput, err := GenerateJSON(report)
+	if err != nil {
+		t.Fatal("JSON output generation should not fail. Error:", err)
+	}
+	if !strings.Contains(jsonOutput, "\"kappaMeasure\": \"Cohen\"") || !strings.Contains(jsonOutput, "\"kappa\": null") {
+		t.Fatal("JSON output does not contain expected elements:\n", jsonOutput)
+	}
+}
@@ -0,0 +1,125 @@
+package reliability
+
+import (
+	"IG-Parser/core/tree"
+	"strings"
+	"testing"
+)
+
+/*
+Returns agreement for given dimension.
+*/
+func agreementFor(t *testing.T, agreement []Agreement, dimension string) Agreement {
+	for _, a := range agreement {
+		if a.Dimension == dimension {
+			return a
+		}
+	}
+	t.Fatal("Agreement does not contain dimension", dimension)
+	return Agreement{}
+}
+
+/*
+Tests the alignment of two codings component by component, including nested statements,
+and the exclusion of statements coded by a single coder.
+*/
+func TestAnalyzeTwoCoders(t *testing.T) {
+
+	codings := []Coding{
+		{StmtId: "1", Coder: "alice", Statement: "A(farmer) D(must) I(sell) Bdir(goods)"},
+		{StmtId: "1", Coder: "bob", Statement: "A(Farmer) D(may) I(sell) Bdir(fresh goods) Cac{A(council) I(approves)}"},
+		{StmtId: "2", Coder: "alice", Statement: "A(council) I(approves)"},
+	}
+
+	report, err := Analyze(codings)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Analysis should not fail. Error:", err)
+	}
+	if report.KappaMeasure != KAPPA_COHEN || len(report.Coders) != 2 {
+		t.Fatal("Analysis of two coders should use Cohen's kappa, but used", report.KappaMeasure)
+	}
+	if len(report.Excluded) != 1 || report.Excluded[0] != "2" || len(report.Statements) != 1 {
+		t.Fatal("Statement coded by single coder should be excluded, but excluded", report.Excluded)
+	}
+
+	// Presence of A, Bdir, Cac, Cac/A, Cac/I, D and I
+	presence := agreementFor(t, report.Overall, DIMENSION_PRESENCE)
+	if presence.Units != 7 || !approximately(*presence.PercentAgreement, 4.0/7) {
+		t.Fatal("Presence should be assessed for 7 components with agreement 4/7, but was", presence.Units, *presence.PercentAgreement)
+	}
+
+	// Content of A, Bdir, D and I (components of nested statement have only been coded by one coder)
+	content := agreementFor(t, report.Overall, DIMENSION_CONTENT)
+	if content.Units != 4 || !approximately(*content.PercentAgreement, 0.5) || !approximately(*content.Overlap, 0.625) {
+		t.Fatal("Content should be assessed for 4 components with agreement 0.5 and overlap 0.625, but was",
+			content.Units, *content.PercentAgreement, *content.Overlap)
+	}
+
+	// Uniform values for nesting (no chance-corrected measures)
+	nesting := agreementFor(t, report.Overall, DIMENSION_NESTING)
+	if nesting.Units != 4 || !approximately(*nesting.PercentAgreement, 1) || nesting.Kappa != nil || nesting.Alpha != nil {
+		t.Fatal("Nesting should agree for 4 components without kappa and alpha, but was", nesting)
+	}
+
+	if len(report.Components) != 7 || report.Components[2].Component != "Cac" || report.Components[3].Component != "Cac/A" {
+		t.Fatal("Components should be reported by path, but were", report.Components)
+	}
+}
+
+/*
+Tests the assessment of logical operators, nesting and component pairs for three coders.
+*/
+func TestAnalyzeThreeCoders(t *testing.T) {
+
+	codings := []Coding{
+		{StmtId: "1", Coder: "alice", Statement: "A(farmer) I((sell [XOR] deliver)) Cac{Cac{A(council) I(approves)} [XOR] Cac{A(state) I(permits)}}"},
+		{StmtId: "1", Coder: "bob", Statement: "A(farmer) I((sell [AND] deliver)) Cac{A(council) I(approves)}"},
+		{StmtId: "1", Coder: "carol", Statement: "A(farmer) {I(sell) [XOR] I(deliver)} Cac{Cac{A(council) I(approves)} [OR] Cac{A(state) I(permits)}}"},
+	}
+
+	report, err := Analyze(codings)
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		t.Fatal("Analysis should not fail. Error:", err)
+	}
+	if report.KappaMeasure != KAPPA_FLEISS {
+		t.Fatal("Analysis of three coders should use Fleiss' kappa, but used", report.KappaMeasure)
+	}
+
+	for _, comp := range report.Components {
+		switch comp.Component {
+		case "I":
+			// Operators: XOR, AND, none (component pair)
+			if a := agreementFor(t, comp.Agreement, DIMENSION_OPERATORS); !approximately(*a.PercentAgreement, 0) {
+				t.Fatal("Operators of Aim should disagree, but agreement was", *a.PercentAgreement)
+			}
+		case "Cac":
+			// Nesting: 2, 1, 2
+			if a := agreementFor(t, comp.Agreement, DIMENSION_NESTING); !approximately(*a.PercentAgreement, 1.0/3) {
+				t.Fatal("Nesting of Activation Condition should partially agree, but agreement was", *a.PercentAgreement)
+			}
+		case COMPONENT_PAIRS:
+			// Presence: only coded by carol
+			if a := agreementFor(t, comp.Agreement, DIMENSION_PRESENCE); !approximately(*a.PercentAgreement, 1.0/3) {
+				t.Fatal("Presence of component pairs should partially agree, but agreement was", *a.PercentAgreement)
+			}
+		}
+	}
+}
+
+/*
+Tests the rejection of duplicate and unparseable codings.
+*/
+func TestAnalyzeInvalidCodings(t *testing.T) {
+
+	_, err := Analyze([]Coding{{StmtId: "1", Coder: "alice", Statement: "A(farmer) I(sell)"},
+		{StmtId: "1", Coder: "alice", Statement: "A(farmer) I(sell)"}})
+	if err.ErrorCode != tree.PARSING_ERROR_DUPLICATE_CODING {
+		t.Fatal("Duplicate coding should be rejected, but returned", err)
+	}
+
+	_, err = Analyze([]Coding{{StmtId: "1", Coder: "alice", Statement: "A(farmer) I(sell)"},
+		{StmtId: "1", Coder: "bob", Statement: "A(farmer) I(sell"}})
+	if err.ErrorCode != tree.PARSING_ERROR_IMBALANCED_PARENTHESES || !strings.HasPrefix(err.ErrorMessage, "Statement '1' (coder 'bob')") {
+		t.Fatal("Unparseable coding should be reported with statement and coder, but returned", err)
+	}
+}
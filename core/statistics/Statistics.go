@@ -0,0 +1,413 @@
+package statistics
+
+import (
+	"IG-Parser/core/parser"
+	"IG-Parser/core/tree"
+	"sort"
+	"strings"
+)
+
+/*
+This file contains the corpus statistics, which characterize a set of IG Script-encoded statements. For each
+statement (including the statements nested in it), the following measures are determined:
+- Component frequencies per component type, based on the frequency maps of tree.Statement.GenerateLeafArrays()
+  (i.e., counting component trees linked by synthetic AND separately, as for the columns of dynamic tabular
+  output, with complex components (e.g., 'Cac{...}') being reported with reference suffix (e.g., 'Cac-Ref')).
+  Private properties are not counted as separate components.
+- Degree of variability (i.e., tree.StateComplexity.TotalStateComplexity of the statement, or of the
+  combination of statements for component pair combinations).
+- Combination depth (i.e., the highest depth of logical combinations within any component, see tree.Node.CalculateDepth()).
+- Nesting depth (i.e., the number of levels of nested statements, 0 for statements without nested statements).
+- Logical operator usage (i.e., the number of combinations per operator, including the synthetic operators
+  tree.SAND_BETWEEN_COMPONENTS and tree.SAND_WITHIN_COMPONENTS inferred during parsing).
+- Number of atomic statements generated from the statement (as for the tabular output).
+- Annotation key usage (i.e., the key of annotations following the syntax '[key=value]', or the entire
+  annotation otherwise).
+The measures are aggregated across all statements as distributions (for numeric measures) and frequencies (for
+components, operators and annotation keys).
+*/
+
+/*
+IG Script-encoded statement to be analyzed.
+*/
+type Record struct {
+	// Statement ID
+	StmtId string
+	// IG Script-encoded statement
+	Statement string
+}
+
+/*
+Measures of an individual statement.
+*/
+type StatementStatistics struct {
+	// Statement ID
+	StmtId string `json:"stmtId"`
+	// Degree of variability
+	DegreeOfVariability int `json:"degreeOfVariability"`
+	// Highest depth of logical combinations within components
+	CombinationDepth int `json:"combinationDepth"`
+	// Number of levels of nested statements
+	NestingDepth int `json:"nestingDepth"`
+	// Number of atomic statements generated from statement
+	AtomicStatements int `json:"atomicStatements"`
+	// Number of instances per component type (e.g., 'A')
+	Components map[string]int `json:"components"`
+	// Number of combinations per logical operator (e.g., 'XOR')
+	Operators map[string]int `json:"operators"`
+	// Number of annotations per annotation key
+	AnnotationKeys map[string]int `json:"annotationKeys"`
+}
+
+/*
+Frequency of a given key (e.g., component type) across all statements.
+*/
+type Frequency struct {
+	// Key (e.g., component type, logical operator, annotation key)
+	Key string `json:"key"`
+	// Number of occurrences across all statements
+	Occurrences int `json:"occurrences"`
+	// Number of statements the key occurs in
+	Statements int `json:"statements"`
+}
+
+/*
+Number of statements for which a numeric measure has a given value.
+*/
+type ValueCount struct {
+	// Value of measure
+	Value int `json:"value"`
+	// Number of statements
+	Count int `json:"count"`
+}
+
+/*
+Distribution of a numeric measure across all statements.
+*/
+type Distribution struct {
+	// Minimum value
+	Min int `json:"min"`
+	// Maximum value
+	Max int `json:"max"`
+	// Mean value
+	Mean float64 `json:"mean"`
+	// Median value
+	Median float64 `json:"median"`
+	// Sum of values
+	Total int `json:"total"`
+	// Number of statements per value (in ascending order of values)
+	Values []ValueCount `json:"values"`
+}
+
+/*
+Statement excluded from the analysis, since it could not be parsed.
+*/
+type ExcludedStatement struct {
+	// Statement ID
+	StmtId string `json:"stmtId"`
+	// Error code (see tree.ParsingError)
+	ErrorCode string `json:"errorCode"`
+	// Error message
+	ErrorMessage string `json:"errorMessage"`
+}
+
+/*
+Result of the corpus statistics.
+*/
+type Report struct {
+	// Number of analyzed statements
+	Statements int `json:"statements"`
+	// Component frequencies (in descending order of occurrences)
+	Components []Frequency `json:"components"`
+	// Logical operator frequencies (in descending order of occurrences)
+	Operators []Frequency `json:"operators"`
+	// Annotation key frequencies (in descending order of occurrences)
+	AnnotationKeys []Frequency `json:"annotationKeys"`
+	// Distribution of degree of variability
+	DegreeOfVariability Distribution `json:"degreeOfVariability"`
+	// Distribution of combination depth
+	CombinationDepth Distribution `json:"combinationDepth"`
+	// Distribution of nesting depth
+	NestingDepth Distribution `json:"nestingDepth"`
+	// Distribution of number of atomic statements per statement
+	AtomicStatements Distribution `json:"atomicStatements"`
+	// Measures per statement (in order of input)
+	StatementStatistics []StatementStatistics `json:"statementStatistics"`
+	// Statements excluded from the analysis
+	Excluded []ExcludedStatement `json:"excluded"`
+}
+
+/*
+Parses the given statements and determines the measures per statement, as well as their distributions and
+frequencies across all statements. Statements that cannot be parsed are excluded from the analysis (see
+Report.Excluded), whereas statements with parsing warnings (e.g., potentially non-parsed content) are retained.
+*/
+func Analyze(records []Record) Report {
+
+	report := Report{StatementStatistics: []StatementStatistics{}, Excluded: []ExcludedStatement{}}
+
+	for _, record := range records {
+		stmts, err := parser.ParseStatement(record.Statement)
+		if err.ErrorCode == tree.PARSING_NO_ERROR || err.ErrorCode == tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+			var stats StatementStatistics
+			stats, err = analyzeStatement(record.StmtId, stmts[0])
+			if err.ErrorCode == tree.PARSING_NO_ERROR {
+				report.StatementStatistics = append(report.StatementStatistics, stats)
+				continue
+			}
+		}
+		Println("Excluded statement", record.StmtId, "-", err)
+		report.Excluded = append(report.Excluded, ExcludedStatement{StmtId: record.StmtId, ErrorCode: err.ErrorCode,
+			ErrorMessage: err.ErrorMessage})
+	}
+	report.Statements = len(report.StatementStatistics)
+
+	// Aggregate measures across statements
+	dov := []int{}
+	combinationDepth := []int{}
+	nestingDepth := []int{}
+	atomicStmts := []int{}
+	components := []map[string]int{}
+	operators := []map[string]int{}
+	annotations := []map[string]int{}
+	for _, stats := range report.StatementStatistics {
+		dov = append(dov, stats.DegreeOfVariability)
+		combinationDepth = append(combinationDepth, stats.CombinationDepth)
+		nestingDepth = append(nestingDepth, stats.NestingDepth)
+		atomicStmts = append(atomicStmts, stats.AtomicStatements)
+		components = append(components, stats.Components)
+		operators = append(operators, stats.Operators)
+		annotations = append(annotations, stats.AnnotationKeys)
+	}
+	report.DegreeOfVariability = distribution(dov)
+	report.CombinationDepth = distribution(combinationDepth)
+	report.NestingDepth = distribution(nestingDepth)
+	report.AtomicStatements = distribution(atomicStmts)
+	report.Components = frequencies(components)
+	report.Operators = frequencies(operators)
+	report.AnnotationKeys = frequencies(annotations)
+
+	return report
+}
+
+/*
+Determines the measures for a parsed statement (i.e., the node returned by the parser, embedding either a
+statement or a combination of component pairs). Returns an error if the degree of variability or the atomic
+statements cannot be determined. Otherwise returns tree.PARSING_NO_ERROR.
+*/
+func analyzeStatement(stmtId string, root *tree.Node) (StatementStatistics, tree.ParsingError) {
+
+	stats := StatementStatistics{StmtId: stmtId, Components: map[string]int{}, Operators: map[string]int{},
+		AnnotationKeys: map[string]int{}}
+
+	dov, err := root.CalculateStateComplexity()
+	if err.ErrorCode != tree.TREE_NO_ERROR {
+		return stats, tree.ParsingError{ErrorCode: tree.PARSING_ERROR_EMBEDDED_NODE_ERROR,
+			ErrorMessage: "Degree of variability could not be determined: " + err.ErrorMessage}
+	}
+	stats.DegreeOfVariability = dov
+
+	// Atomic statements are generated per statement extrapolated from component pair combinations
+	opts := tree.DefaultOptions()
+	for _, stmtNode := range root.GetTopLevelStatementNodes() {
+		leafArrays, _ := stmtNode.GetEmbeddedStatement().GenerateLeafArrays(opts.AggregateImplicitLinkages)
+		atomicStmts, err2 := tree.GenerateNodeArrayPermutations(leafArrays...)
+		if err2.ErrorCode != tree.PARSING_NO_ERROR {
+			return stats, err2
+		}
+		stats.AtomicStatements += len(atomicStmts)
+	}
+
+	visitNode(root, 0, 0, &stats, map[*tree.Node]bool{}, map[*tree.Node]bool{})
+	return stats, tree.ParsingError{ErrorCode: tree.PARSING_NO_ERROR}
+}
+
+/*
+Collects logical operators and annotation keys of the given node and its children (including linked private
+nodes), and descends into embedded statements, which are considered to be nested on the given nesting level.
+The pair level indicates the depth of the node within a component pair combination (0 for other nodes).
+Visited nodes and counted components are tracked across the traversal (see #visitStatement()).
+*/
+func visitNode(node *tree.Node, nestingLevel int, pairLevel int, stats *StatementStatistics, visited map[*tree.Node]bool,
+	counted map[*tree.Node]bool) {
+	if node == nil || visited[node] {
+		return
+	}
+	visited[node] = true
+
+	if annotations, ok := node.Annotations.(string); ok {
+		for _, key := range annotationKeys(annotations) {
+			stats.AnnotationKeys[key]++
+		}
+	}
+	childPairLevel := pairLevel
+	if node.IsCombination() {
+		stats.Operators[node.LogicalOperator]++
+		// Combinations of component pairs add to the combination depth of the extrapolated statements
+		if isComponentPairCombination(node) {
+			childPairLevel++
+		}
+	}
+
+	switch entry := node.Entry.(type) {
+	case *tree.Statement:
+		visitStatement(entry, nestingLevel, pairLevel, stats, visited, counted)
+	case []*tree.Node:
+		// Component pairs
+		for _, pair := range entry {
+			visitNode(pair, nestingLevel, pairLevel, stats, visited, counted)
+		}
+	}
+
+	for _, private := range node.PrivateNodeLinks {
+		visitNode(private, nestingLevel, 0, stats, visited, counted)
+	}
+	visitNode(node.Left, nestingLevel, childPairLevel, stats, visited, counted)
+	visitNode(node.Right, nestingLevel, childPairLevel, stats, visited, counted)
+}
+
+/*
+Indicates whether the given node combines component pairs, i.e., holds statements extrapolated from
+component pairs (or further component pair combinations) as children.
+*/
+func isComponentPairCombination(node *tree.Node) bool {
+	for _, child := range []*tree.Node{node.Left, node.Right} {
+		if child == nil {
+			continue
+		}
+		if _, ok := child.Entry.([]*tree.Node); ok || (child.IsCombination() && isComponentPairCombination(child)) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Collects component frequencies and combination depth of the given statement on a given nesting level
+(considering the depth of the statement within component pair combinations), and visits its components
+(with embedded statements considered to be nested on the next level). Components shared across statements
+extrapolated from component pairs are only considered for the first statement holding them.
+*/
+func visitStatement(stmt *tree.Statement, nestingLevel int, pairLevel int, stats *StatementStatistics,
+	visited map[*tree.Node]bool, counted map[*tree.Node]bool) {
+	if nestingLevel > stats.NestingDepth {
+		stats.NestingDepth = nestingLevel
+	}
+
+	stmt = withoutCountedComponents(stmt, counted)
+
+	_, componentFrequency := stmt.GenerateLeafArrays(false)
+	for component, count := range componentFrequency {
+		stats.Components[component] += count
+	}
+
+	for _, comp := range stmt.Components() {
+		if comp.Node == nil {
+			continue
+		}
+		counted[comp.Node] = true
+		if comp.Node.LogicalOperator == tree.SAND_BETWEEN_COMPONENTS {
+			// Shared components may be combined with components of individual statements
+			counted[comp.Node.Left] = true
+			counted[comp.Node.Right] = true
+		}
+		if depth := pairLevel + comp.Node.CalculateDepth(); depth > stats.CombinationDepth {
+			stats.CombinationDepth = depth
+		}
+		visitNode(comp.Node, nestingLevel+1, 0, stats, visited, counted)
+	}
+}
+
+/*
+Returns copy of the given statement without components that have already been counted (i.e., components shared
+across statements extrapolated from component pairs). Shared components combined with components of the
+individual statement (by implicit linkage (bAND)) are reduced to the latter.
+*/
+func withoutCountedComponents(stmt *tree.Statement, counted map[*tree.Node]bool) *tree.Statement {
+	result := *stmt
+	for _, comp := range stmt.Components() {
+		if comp.Node == nil {
+			continue
+		}
+		field := result.ComponentField(comp.Symbol, comp.Complex)
+		if counted[comp.Node] {
+			*field = nil
+		} else if comp.Node.LogicalOperator == tree.SAND_BETWEEN_COMPONENTS && counted[comp.Node.Right] {
+			*field = comp.Node.Left
+		}
+	}
+	return &result
+}
+
+/*
+Returns the keys of the given annotations (e.g., 'ref' and 'dir' for '[ref=1][dir]').
+*/
+func annotationKeys(annotations string) []string {
+	keys := []string{}
+	for _, annotation := range tree.SplitAnnotations(annotations) {
+		key, _, _ := tree.SplitAnnotationKeyValue(annotation)
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+/*
+Determines the distribution of the given values.
+*/
+func distribution(values []int) Distribution {
+	result := Distribution{Values: []ValueCount{}}
+	if len(values) == 0 {
+		return result
+	}
+	sorted := append([]int{}, values...)
+	sort.Ints(sorted)
+
+	result.Min = sorted[0]
+	result.Max = sorted[len(sorted)-1]
+	for _, value := range sorted {
+		result.Total += value
+		if len(result.Values) > 0 && result.Values[len(result.Values)-1].Value == value {
+			result.Values[len(result.Values)-1].Count++
+		} else {
+			result.Values = append(result.Values, ValueCount{Value: value, Count: 1})
+		}
+	}
+	result.Mean = float64(result.Total) / float64(len(sorted))
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		result.Median = float64(sorted[middle-1]+sorted[middle]) / 2
+	} else {
+		result.Median = float64(sorted[middle])
+	}
+	return result
+}
+
+/*
+Aggregates the given counts per statement into frequencies, sorted in descending order of occurrences
+(and in alphabetical order of keys for equal occurrences).
+*/
+func frequencies(counts []map[string]int) []Frequency {
+	byKey := map[string]*Frequency{}
+	for _, stmtCounts := range counts {
+		for key, count := range stmtCounts {
+			if _, ok := byKey[key]; !ok {
+				byKey[key] = &Frequency{Key: key}
+			}
+			byKey[key].Occurrences += count
+			byKey[key].Statements++
+		}
+	}
+	result := []Frequency{}
+	for _, frequency := range byKey {
+		result = append(result, *frequency)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Occurrences != result[j].Occurrences {
+			return result[i].Occurrences > result[j].Occurrences
+		}
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
@@ -0,0 +1,143 @@
+package statistics
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"sort"
+	"strconv"
+)
+
+/*
+This file contains the generation of statistics reports (see Report) in CSV and JSON format.
+*/
+
+// Output formats of statistics reports
+const OUTPUT_FORMAT_CSV = "csv"
+const OUTPUT_FORMAT_JSON = "json"
+
+// Output formats available for statistics reports
+var OUTPUT_FORMATS = []string{OUTPUT_FORMAT_CSV, OUTPUT_FORMAT_JSON}
+
+// Scopes of measures in CSV output
+const SCOPE_CORPUS = "corpus"
+const SCOPE_STATEMENT = "statement"
+const SCOPE_EXCLUDED = "excluded"
+
+// Measures in CSV output
+const MEASURE_STATEMENTS = "statements"
+const MEASURE_DEGREE_OF_VARIABILITY = "degree of variability"
+const MEASURE_COMBINATION_DEPTH = "combination depth"
+const MEASURE_NESTING_DEPTH = "nesting depth"
+const MEASURE_ATOMIC_STATEMENTS = "atomic statements"
+const MEASURE_COMPONENT = "component"
+const MEASURE_OPERATOR = "operator"
+const MEASURE_ANNOTATION_KEY = "annotation key"
+const MEASURE_ERROR = "error"
+
+// Keys of distribution summaries in CSV output
+const KEY_MIN = "min"
+const KEY_MAX = "max"
+const KEY_MEAN = "mean"
+const KEY_MEDIAN = "median"
+const KEY_TOTAL = "total"
+
+// Header row of CSV output
+var CSV_HEADER = []string{"Scope", "Statement ID", "Measure", "Key", "Value", "Statements"}
+
+/*
+Generates CSV output for the given report in long format (i.e., one row per value). Rows with scope 'corpus'
+hold the number of analyzed statements, the summaries of distributions (keys 'min', 'max', 'mean', 'median' and
+'total'), and the frequencies of components, logical operators and annotation keys (with the number of statements
+they occur in). Rows with scope 'statement' hold the measures of individual statements. Rows with scope 'excluded'
+hold the error code (as key) and error message (as value) of statements excluded from the analysis.
+*/
+func GenerateCSV(report Report) (string, error) {
+	buffer := bytes.Buffer{}
+	writer := csv.NewWriter(&buffer)
+	rows := [][]string{CSV_HEADER}
+
+	rows = append(rows, []string{SCOPE_CORPUS, "", MEASURE_STATEMENTS, "", strconv.Itoa(report.Statements), ""})
+	rows = appendDistributionRows(rows, MEASURE_DEGREE_OF_VARIABILITY, report.DegreeOfVariability)
+	rows = appendDistributionRows(rows, MEASURE_COMBINATION_DEPTH, report.CombinationDepth)
+	rows = appendDistributionRows(rows, MEASURE_NESTING_DEPTH, report.NestingDepth)
+	rows = appendDistributionRows(rows, MEASURE_ATOMIC_STATEMENTS, report.AtomicStatements)
+	rows = appendFrequencyRows(rows, MEASURE_COMPONENT, report.Components)
+	rows = appendFrequencyRows(rows, MEASURE_OPERATOR, report.Operators)
+	rows = appendFrequencyRows(rows, MEASURE_ANNOTATION_KEY, report.AnnotationKeys)
+
+	for _, stats := range report.StatementStatistics {
+		rows = append(rows,
+			[]string{SCOPE_STATEMENT, stats.StmtId, MEASURE_DEGREE_OF_VARIABILITY, "", strconv.Itoa(stats.DegreeOfVariability), ""},
+			[]string{SCOPE_STATEMENT, stats.StmtId, MEASURE_COMBINATION_DEPTH, "", strconv.Itoa(stats.CombinationDepth), ""},
+			[]string{SCOPE_STATEMENT, stats.StmtId, MEASURE_NESTING_DEPTH, "", strconv.Itoa(stats.NestingDepth), ""},
+			[]string{SCOPE_STATEMENT, stats.StmtId, MEASURE_ATOMIC_STATEMENTS, "", strconv.Itoa(stats.AtomicStatements), ""})
+		rows = appendCountRows(rows, stats.StmtId, MEASURE_COMPONENT, stats.Components)
+		rows = appendCountRows(rows, stats.StmtId, MEASURE_OPERATOR, stats.Operators)
+		rows = appendCountRows(rows, stats.StmtId, MEASURE_ANNOTATION_KEY, stats.AnnotationKeys)
+	}
+	for _, excluded := range report.Excluded {
+		rows = append(rows, []string{SCOPE_EXCLUDED, excluded.StmtId, MEASURE_ERROR, excluded.ErrorCode, excluded.ErrorMessage, ""})
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+/*
+Generates JSON output for the given report.
+*/
+func GenerateJSON(report Report) (string, error) {
+	output, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
+
+/*
+Appends rows summarizing the distribution of a given measure to the given rows.
+*/
+func appendDistributionRows(rows [][]string, measure string, distribution Distribution) [][]string {
+	return append(rows,
+		[]string{SCOPE_CORPUS, "", measure, KEY_MIN, strconv.Itoa(distribution.Min), ""},
+		[]string{SCOPE_CORPUS, "", measure, KEY_MAX, strconv.Itoa(distribution.Max), ""},
+		[]string{SCOPE_CORPUS, "", measure, KEY_MEAN, formatDecimal(distribution.Mean), ""},
+		[]string{SCOPE_CORPUS, "", measure, KEY_MEDIAN, formatDecimal(distribution.Median), ""},
+		[]string{SCOPE_CORPUS, "", measure, KEY_TOTAL, strconv.Itoa(distribution.Total), ""})
+}
+
+/*
+Appends rows for the frequencies of a given measure to the given rows.
+*/
+func appendFrequencyRows(rows [][]string, measure string, frequencies []Frequency) [][]string {
+	for _, frequency := range frequencies {
+		rows = append(rows, []string{SCOPE_CORPUS, "", measure, frequency.Key, strconv.Itoa(frequency.Occurrences),
+			strconv.Itoa(frequency.Statements)})
+	}
+	return rows
+}
+
+/*
+Appends rows for the counts of a given measure for an individual statement (in alphabetical order of keys) to the given rows.
+*/
+func appendCountRows(rows [][]string, stmtId string, measure string, counts map[string]int) [][]string {
+	keys := []string{}
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		rows = append(rows, []string{SCOPE_STATEMENT, stmtId, measure, key, strconv.Itoa(counts[key]), ""})
+	}
+	return rows
+}
+
+/*
+Formats decimal value with four decimal places.
+*/
+func formatDecimal(value float64) string {
+	return strconv.FormatFloat(value, 'f', 4, 64)
+}
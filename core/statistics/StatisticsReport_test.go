@@ -0,0 +1,51 @@
+package statistics
+
+import (
+	"strings"
+	"testing"
+)
+
+/*
+Tests CSV and JSON output of statistics reports.
+*/
+func TestStatisticsReportOutput(t *testing.T) {
+
+	report := Analyze([]Record{
+		{StmtId: "1", Statement: "A(farmer) I((sell [XOR] buy))"},
+		{StmtId: "2", Statement: "A(farmer) I(sell"},
+	})
+
+	csvOutput, err := GenerateCSV(report)
+	if err != nil {
+		t.Fatal("CSV output generation should not fail. Error:", err)
+	}
+	lines := strings.Split(strings.TrimSpace(csvOutput), "\n")
+	// Header, statements, 4 distributions with 5 summaries, 2 components, 1 operator,
+	// 4 measures, 2 components and 1 operator for statement, excluded statement
+	if len(lines) != 33 || lines[0] != strings.Join(CSV_HEADER, ",") {
+		t.Fatal("CSV output should contain header and 32 rows, but was:\n", csvOutput)
+	}
+	if lines[1] != "corpus,,statements,,1," {
+		t.Fatal("CSV output should contain number of statements, but was:", lines[1])
+	}
+	if lines[5] != "corpus,,degree of variability,median,2.0000," {
+		t.Fatal("CSV output should contain median degree of variability, but was:", lines[5])
+	}
+	if lines[24] != "corpus,,operator,XOR,1,1" {
+		t.Fatal("CSV output should contain operator frequency, but was:", lines[24])
+	}
+	if lines[28] != "statement,1,atomic statements,,2," || lines[30] != "statement,1,component,I,1," {
+		t.Fatal("CSV output should contain statement measures, but was:", lines[28], lines[30])
+	}
+	if !strings.HasPrefix(lines[32], "excluded,2,error,IMBALANCED_PARENTHESES,") {
+		t.Fatal("CSV output should contain excluded statement, but was:", lines[32])
+	}
+
+	jsonOutput, err := GenerateJSON(report)
+	if err != nil {
+		t.Fatal("JSON output generation should not fail. Error:", err)
+	}
+	if !strings.Contains(jsonOutput, "\"atomicStatements\": 2") || !strings.Contains(jsonOutput, "\"stmtId\": \"2\",\n      \"errorCode\"") {
+		t.Fatal("JSON output does not contain expected elements:\n", jsonOutput)
+	}
+}
@@ -0,0 +1,167 @@
+package statistics
+
+import (
+	"IG-Parser/core/tree"
+	"reflect"
+	"testing"
+)
+
+/*
+Returns statistics of statement with given ID.
+*/
+func statisticsFor(t *testing.T, report Report, stmtId string) StatementStatistics {
+	for _, stats := range report.StatementStatistics {
+		if stats.StmtId == stmtId {
+			return stats
+		}
+	}
+	t.Fatal("Report does not contain statistics for statement", stmtId)
+	return StatementStatistics{}
+}
+
+/*
+Tests the measures of individual statements, including nested statements, component pair combinations,
+synthetic AND linkages and annotations.
+*/
+func TestAnalyzeStatements(t *testing.T) {
+
+	report := Analyze([]Record{
+		{StmtId: "1", Statement: "A[ref=1](farmer) D(must) I((sell [XOR] buy)) Bdir(goods) Cac{A(inspector) I[dir](approves)}"},
+		{StmtId: "2", Statement: "{A(trader) I(sells) [XOR] A(farmer) I((sells [OR] buys))}"},
+		{StmtId: "3", Statement: "A((Sellers [AND] Buyers) from (Northern [OR] Southern) states) I(trade) " +
+			"Cac{Cac{A(council) I(approves)} [AND] Cac{A(mayor) I(signs) Cac{A(office) I(opens)}}}"},
+	})
+
+	stats := statisticsFor(t, report, "1")
+	if stats.DegreeOfVariability != 2 || stats.CombinationDepth != 1 || stats.NestingDepth != 1 || stats.AtomicStatements != 2 {
+		t.Fatal("Statement 1 has wrong measures:", stats)
+	}
+	if !reflect.DeepEqual(stats.Components, map[string]int{"A": 2, "D": 1, "I": 2, "Bdir": 1, tree.ACTIVATION_CONDITION_REFERENCE: 1}) {
+		t.Fatal("Statement 1 has wrong component frequencies (including nested statement):", stats.Components)
+	}
+	if !reflect.DeepEqual(stats.Operators, map[string]int{tree.XOR: 1}) {
+		t.Fatal("Statement 1 has wrong operator frequencies:", stats.Operators)
+	}
+	if !reflect.DeepEqual(stats.AnnotationKeys, map[string]int{"ref": 1, "dir": 1}) {
+		t.Fatal("Statement 1 has wrong annotation keys:", stats.AnnotationKeys)
+	}
+
+	// Component pair combination (states of combined statements, atomic statements of both)
+	stats = statisticsFor(t, report, "2")
+	if stats.DegreeOfVariability != 4 || stats.NestingDepth != 0 || stats.AtomicStatements != 3 {
+		t.Fatal("Statement 2 has wrong measures:", stats)
+	}
+	if !reflect.DeepEqual(stats.Operators, map[string]int{tree.XOR: 1, tree.OR: 1}) {
+		t.Fatal("Statement 2 has wrong operator frequencies (including component pairs):", stats.Operators)
+	}
+
+	// Synthetic AND within Attributes, and statement nested on two levels
+	stats = statisticsFor(t, report, "3")
+	if stats.CombinationDepth != 2 || stats.NestingDepth != 2 || stats.AtomicStatements != 4 {
+		t.Fatal("Statement 3 has wrong measures:", stats)
+	}
+	if !reflect.DeepEqual(stats.Operators, map[string]int{tree.AND: 2, tree.OR: 1, tree.SAND_WITHIN_COMPONENTS: 1}) {
+		t.Fatal("Statement 3 has wrong operator frequencies (including synthetic AND):", stats.Operators)
+	}
+	if stats.Components[tree.ATTRIBUTES] != 5 || stats.Components[tree.ACTIVATION_CONDITION_REFERENCE] != 2 {
+		t.Fatal("Statement 3 has wrong component frequencies:", stats.Components)
+	}
+}
+
+/*
+Tests the aggregation of measures across statements and the exclusion of statements that cannot be parsed.
+*/
+func TestAnalyzeCorpus(t *testing.T) {
+
+	report := Analyze([]Record{
+		{StmtId: "1", Statement: "A(farmer) D(must) I((sell [XOR] buy))"},
+		{StmtId: "2", Statement: "A(farmer) I((sell [XOR] buy [XOR] trade))"},
+		{StmtId: "3", Statement: "A(farmer I(sell)"},
+		{StmtId: "4", Statement: "A[ref=1](trader) I(trade)"},
+	})
+
+	if report.Statements != 3 || len(report.Excluded) != 1 || report.Excluded[0].StmtId != "3" ||
+		report.Excluded[0].ErrorCode != tree.PARSING_ERROR_IMBALANCED_PARENTHESES {
+		t.Fatal("Statement that cannot be parsed should be excluded, but excluded", report.Excluded)
+	}
+
+	expected := Distribution{Min: 1, Max: 3, Mean: 2, Median: 2, Total: 6,
+		Values: []ValueCount{{Value: 1, Count: 1}, {Value: 2, Count: 1}, {Value: 3, Count: 1}}}
+	if !reflect.DeepEqual(report.AtomicStatements, expected) {
+		t.Fatal("Wrong distribution of atomic statements:", report.AtomicStatements)
+	}
+	if !reflect.DeepEqual(report.DegreeOfVariability, expected) {
+		t.Fatal("Wrong distribution of degree of variability:", report.DegreeOfVariability)
+	}
+	if report.NestingDepth.Max != 0 || report.NestingDepth.Values[0].Count != 3 {
+		t.Fatal("Wrong distribution of nesting depth:", report.NestingDepth)
+	}
+
+	// Ordered by occurrences, followed by keys
+	expectedComponents := []Frequency{{Key: "A", Occurrences: 3, Statements: 3}, {Key: "I", Occurrences: 3, Statements: 3},
+		{Key: "D", Occurrences: 1, Statements: 1}}
+	if !reflect.DeepEqual(report.Components, expectedComponents) {
+		t.Fatal("Wrong component frequencies:", report.Components)
+	}
+	if !reflect.DeepEqual(report.Operators, []Frequency{{Key: tree.XOR, Occurrences: 3, Statements: 2}}) {
+		t.Fatal("Wrong operator frequencies:", report.Operators)
+	}
+	if !reflect.DeepEqual(report.AnnotationKeys, []Frequency{{Key: "ref", Occurrences: 1, Statements: 1}}) {
+		t.Fatal("Wrong annotation key frequencies:", report.AnnotationKeys)
+	}
+}
+
+/*
+Tests whether component pair combinations produce the measures of their equivalent component combinations,
+i.e., components shared across the extrapolated statements are counted once, and the combination of component
+pairs contributes to the combination depth. Components of the individual pairs are counted per instance.
+*/
+func TestAnalyzeComponentPairsAndEquivalentCombinations(t *testing.T) {
+
+	report := Analyze([]Record{
+		{StmtId: "pair", Statement: "A(actor) D(must) {I(a1) [XOR] I(a2)} Bdir(goods)"},
+		{StmtId: "combination", Statement: "A(actor) D(must) I((a1 [XOR] a2)) Bdir(goods)"},
+		{StmtId: "nestedPair", Statement: "A(actor) D(must) {I((a1 [OR] a2)) [XOR] I(a3)} Bdir(goods)"},
+		{StmtId: "nestedCombination", Statement: "A(actor) D(must) I(((a1 [OR] a2) [XOR] a3)) Bdir(goods)"},
+		{StmtId: "sharedAndLocal", Statement: "A(actor) {A(seller) I(sells) [XOR] I(buys)}"},
+	})
+
+	for _, ids := range [][]string{{"pair", "combination"}, {"nestedPair", "nestedCombination"}} {
+		pair := statisticsFor(t, report, ids[0])
+		combination := statisticsFor(t, report, ids[1])
+		if pair.DegreeOfVariability != combination.DegreeOfVariability ||
+			pair.CombinationDepth != combination.CombinationDepth ||
+			pair.NestingDepth != combination.NestingDepth ||
+			pair.AtomicStatements != combination.AtomicStatements {
+			t.Fatal("Measures of component pair combination differ from equivalent combination:", pair, combination)
+		}
+		if !reflect.DeepEqual(pair.Operators, combination.Operators) {
+			t.Fatal("Operator frequencies of component pair combination differ from equivalent combination:",
+				pair.Operators, combination.Operators)
+		}
+		for _, component := range []string{tree.ATTRIBUTES, tree.DEONTIC, tree.DIRECT_OBJECT} {
+			if pair.Components[component] != 1 || combination.Components[component] != 1 {
+				t.Fatal("Shared component", component, "should be counted once:", pair.Components, combination.Components)
+			}
+		}
+		if pair.Components[tree.AIM] != 2 {
+			t.Fatal("Aims of component pairs should be counted per instance:", pair.Components)
+		}
+	}
+
+	// Shared component combined with component of individual pair
+	stats := statisticsFor(t, report, "sharedAndLocal")
+	if !reflect.DeepEqual(stats.Components, map[string]int{tree.ATTRIBUTES: 2, tree.AIM: 2}) {
+		t.Fatal("Shared and individual components should be counted once each:", stats.Components)
+	}
+}
+
+/*
+Tests the extraction of annotation keys, including annotations without value and nested brackets.
+*/
+func TestAnnotationKeys(t *testing.T) {
+	keys := annotationKeys("[ref=1][dir][ctx=[time,place]][ =x]")
+	if !reflect.DeepEqual(keys, []string{"ref", "dir", "ctx"}) {
+		t.Fatal("Wrong annotation keys:", keys)
+	}
+}
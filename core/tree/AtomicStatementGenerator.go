@@ -0,0 +1,254 @@
+package tree
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+This file contains the expansion of parsed statements into atomic statements, i.e., statements holding a single
+entry per component combination (e.g., 'A(farmer) I((sell [XOR] buy))' expands into 'A(farmer) I(sell)' and
+'A(farmer) I(buy)'). Component pair combinations are extrapolated into separate statements before expanding those.
+The expansion corresponds to the rows of tabular output, but retains parsed nodes and structured logical linkages
+(instead of generating strings), so that exporters and analyses can operate on atomic statements directly.
+Nested statements are retained as nested components of the atomic statements (and can be expanded separately).
+*/
+
+// Separator between statement ID and index of extrapolated or atomic statement (e.g., '123.1')
+const atomicStatementIdSeparator = "."
+
+/*
+Atomic statement generated from the expansion of component combinations (and component pair combinations)
+of a parsed statement.
+*/
+type AtomicStatement struct {
+	// Generated ID (e.g., '123.2' for the second atomic statement of statement '123', or '123.1.2' for statements
+	// extrapolated from component pair combinations; corresponds to IDs in tabular output)
+	ID string
+	// Atomic statement holding the entries of the statement (copies of the parent statement's leaf nodes)
+	Statement *Statement
+	// Entries the atomic statement is composed of (leaf nodes of the parent statement, or nested statement
+	// nodes, in order of components)
+	Entries []*Node
+	// Logical linkages to sibling atomic statements
+	Linkages []AtomicStatementLinkage
+	// ID of the statement the atomic statement has been expanded from (i.e., the given statement ID, or the
+	// derived ID of the extrapolated statement for component pair combinations, e.g., '123.1')
+	ParentID string
+	// Node of the statement the atomic statement has been expanded from (i.e., top-level statement or item of
+	// component pair combination)
+	Parent *Node
+}
+
+/*
+Logical linkage between an atomic statement and a sibling atomic statement.
+*/
+type AtomicStatementLinkage struct {
+	// ID of the linked atomic statement
+	TargetID string
+	// Component symbol for linkages between entries of a component (e.g., 'A'), or empty for linkages between
+	// statements extrapolated from component pair combinations
+	Component string
+	// Logical operators on the path between the linked entries or statements (e.g., [XOR], or [AND XOR] for
+	// nested combinations); adjacent conjunctions are collapsed if indicated in the options
+	Operators []string
+}
+
+/*
+Expands a parsed statement (as returned by the parser) into atomic statements, generating their IDs based on the
+given statement ID. The options control the aggregation of implicitly linked component instances
+(AggregateImplicitLinkages) and the collapsing of adjacent conjunctions in linkages (CollapseOperators).
+Returns the atomic statements in the order of the rows of tabular output.
+*/
+func GenerateAtomicStatements(node *Node, stmtId string, opts Options) ([]AtomicStatement, ParsingError) {
+
+	if node == nil || node.IsEmptyOrNilNode() {
+		return nil, ParsingError{ErrorCode: PARSING_ERROR_EMPTY_LEAF, ErrorMessage: "No parseable node found."}
+	}
+
+	// Extrapolate component pair combinations into separate statements
+	parents := node.GetTopLevelStatementNodes()
+	parentIds := map[*Node]string{}
+	for i, parent := range parents {
+		parentIds[parent] = stmtId
+		if len(parents) > 1 {
+			parentIds[parent] = stmtId + atomicStatementIdSeparator + strconv.Itoa(i+1)
+		}
+	}
+
+	atomicStmts := []AtomicStatement{}
+	// Atomic statements per parent (for linkages between extrapolated statements)
+	atomicStmtIndices := map[*Node][]int{}
+
+	for _, parent := range parents {
+		stmt, err := extractStatement(parent)
+		if err.ErrorCode != PARSING_NO_ERROR {
+			return nil, err
+		}
+
+		Println("Expanding statement", parentIds[parent], "into atomic statements")
+		leafArrays, _ := stmt.GenerateLeafArrays(opts.AggregateImplicitLinkages)
+		permutations, err := GenerateNodeArrayPermutations(leafArrays...)
+		if err.ErrorCode != PARSING_NO_ERROR {
+			return nil, err
+		}
+
+		first := len(atomicStmts)
+		for i, entries := range permutations {
+			id := parentIds[parent]
+			if len(permutations) > 1 {
+				id = parentIds[parent] + atomicStatementIdSeparator + strconv.Itoa(i+1)
+			}
+			atomicStmt, err := generateAtomicStatement(entries)
+			if err.ErrorCode != PARSING_NO_ERROR {
+				return nil, err
+			}
+			atomicStmts = append(atomicStmts, AtomicStatement{ID: id, Statement: atomicStmt, Entries: entries,
+				Linkages: []AtomicStatementLinkage{}, ParentID: parentIds[parent], Parent: parent})
+			atomicStmtIndices[parent] = append(atomicStmtIndices[parent], len(atomicStmts)-1)
+		}
+
+		// Link atomic statements based on the linkages between their differing entries
+		for i := first; i < len(atomicStmts); i++ {
+			for j := first; j < len(atomicStmts); j++ {
+				if i == j {
+					continue
+				}
+				for k, entry := range atomicStmts[i].Entries {
+					target := atomicStmts[j].Entries[k]
+					if entry == target {
+						continue
+					}
+					linked, ops, err := findAtomicStatementLinkage(entry, target, opts)
+					if err.ErrorCode != PARSING_NO_ERROR {
+						return nil, err
+					}
+					if linked {
+						atomicStmts[i].Linkages = append(atomicStmts[i].Linkages, AtomicStatementLinkage{
+							TargetID: atomicStmts[j].ID, Component: entry.GetComponentName(), Operators: ops})
+					}
+				}
+			}
+		}
+	}
+
+	// Link atomic statements of different extrapolated statements based on the linkage between those
+	for _, parent := range parents {
+		for _, target := range parents {
+			if parent == target {
+				continue
+			}
+			linked, ops, err := findAtomicStatementLinkage(parent, target, opts)
+			if err.ErrorCode != PARSING_NO_ERROR {
+				return nil, err
+			}
+			if !linked {
+				continue
+			}
+			for _, i := range atomicStmtIndices[parent] {
+				for _, j := range atomicStmtIndices[target] {
+					atomicStmts[i].Linkages = append(atomicStmts[i].Linkages, AtomicStatementLinkage{
+						TargetID: atomicStmts[j].ID, Operators: ops})
+				}
+			}
+		}
+	}
+
+	return atomicStmts, ParsingError{ErrorCode: PARSING_NO_ERROR}
+}
+
+/*
+Extracts the statement from a top-level statement node, or an item of a component pair combination.
+*/
+func extractStatement(node *Node) (*Statement, ParsingError) {
+	switch entry := node.Entry.(type) {
+	case *Statement:
+		return entry, ParsingError{ErrorCode: PARSING_NO_ERROR}
+	case []*Node:
+		if len(entry) > 0 {
+			if stmt, ok := entry[0].Entry.(*Statement); ok {
+				return stmt, ParsingError{ErrorCode: PARSING_NO_ERROR}
+			}
+		}
+	}
+	return nil, ParsingError{ErrorCode: PARSING_ERROR_UNKNOWN_INPUT_TYPE, ErrorMessage: "Expansion into atomic " +
+		"statements failed for unknown input type " + fmt.Sprintf("%T", node.Entry) + "."}
+}
+
+/*
+Generates a statement holding the given entries. Primitive entries are copied (detached from the parent statement's
+tree, but retaining the suffix of their component instance), nested statements are referenced as is. Negated entries
+(i.e., operands of unary negations and right operands of binary negations, e.g., 'certify' in '(inspect [NOT] certify)')
+are wrapped in unary negations (with nested statements copied, so that the parent statement's tree remains unmodified).
+Multiple entries of the same component are combined by implicit linkage (bAND).
+*/
+func generateAtomicStatement(entries []*Node) (*Statement, ParsingError) {
+	stmt := &Statement{}
+	for _, entry := range entries {
+		if entry.IsEmptyOrNilNode() {
+			continue
+		}
+		value := entry
+		nested := !entry.HasPrimitiveEntry()
+		if !nested {
+			entryCopy := *entry
+			entryCopy.Parent = nil
+			entryCopy.ComponentType = entry.GetComponentName()
+			// Retain suffix of component instance (e.g., 'Bdir1((apples [OR] pears))')
+			if suffix := entry.GetSuffix(); suffix != "" {
+				entryCopy.Suffix = suffix
+			}
+			value = &entryCopy
+		}
+		value = negateAtomicStatementEntry(entry, value)
+		field := stmt.ComponentField(entry.GetComponentName(), nested)
+		if field == nil {
+			return nil, ParsingError{ErrorCode: PARSING_ERROR_UNKNOWN_INPUT_TYPE, ErrorMessage: "Entry '" +
+				entry.String() + "' of atomic statement cannot be assigned to component '" + entry.GetComponentName() + "'."}
+		}
+		combined, err := Combine(*field, value, SAND_BETWEEN_COMPONENTS)
+		if err.ErrorCode != TREE_NO_ERROR {
+			return nil, ParsingError{ErrorCode: PARSING_ERROR_LOGICAL_EXPRESSION_GENERATION, ErrorMessage: err.ErrorMessage}
+		}
+		*field = combined
+	}
+	return stmt, ParsingError{ErrorCode: PARSING_NO_ERROR}
+}
+
+/*
+Wraps the value of the given entry in a unary negation for each negation the entry is operand of (see
+generateAtomicStatement()), and returns the resulting node.
+*/
+func negateAtomicStatementEntry(entry *Node, value *Node) *Node {
+	for node := entry; node.Parent != nil && node.Parent.LogicalOperator == NOT && node.Parent.Right == node; node = node.Parent {
+		if value == entry {
+			entryCopy := *entry
+			entryCopy.Parent = nil
+			value = &entryCopy
+		}
+		negation := &Node{LogicalOperator: NOT, ComponentType: entry.GetComponentName()}
+		if entry.HasPrimitiveEntry() {
+			// Suffix of primitive component instance is held by its root (e.g., 'Bdir1(([NOT] organic))')
+			negation.Suffix, value.Suffix = value.Suffix, nil
+		}
+		negation.InsertRightNode(value)
+		value = negation
+	}
+	return value
+}
+
+/*
+Determines the logical linkage between two nodes (entries or statements), collapsing adjacent conjunctions if
+indicated in the options.
+*/
+func findAtomicStatementLinkage(source *Node, target *Node, opts Options) (bool, []string, ParsingError) {
+	linked, ops, err := FindLogicalLinkage(source, target)
+	if err.ErrorCode != TREE_NO_ERROR {
+		return false, nil, ParsingError{ErrorCode: PARSING_ERROR_LOGICAL_EXPRESSION_GENERATION,
+			ErrorMessage: "Error when retrieving logical linkage: " + err.ErrorMessage}
+	}
+	if linked && opts.CollapseOperators {
+		ops = CollapseAdjacentOperators(ops, []string{AND, SAND_BETWEEN_COMPONENTS, SAND_WITHIN_COMPONENTS})
+	}
+	return linked, ops, ParsingError{ErrorCode: PARSING_NO_ERROR}
+}
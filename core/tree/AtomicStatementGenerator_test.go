@@ -0,0 +1,202 @@
+package tree
+
+import (
+	"fmt"
+	"testing"
+)
+
+/*
+Creates component combination of the given nodes linked by the given logical operator (with left node omitted for unary negations).
+*/
+func combineForTest(t *testing.T, left *Node, operator string, right *Node, componentType string) *Node {
+	node := &Node{LogicalOperator: operator, ComponentType: componentType}
+	if left != nil {
+		if _, err := node.InsertLeftNode(left); err.ErrorCode != TREE_NO_ERROR {
+			t.Fatal("Error when populating tree. Error:", err)
+		}
+	}
+	if _, err := node.InsertRightNode(right); err.ErrorCode != TREE_NO_ERROR {
+		t.Fatal("Error when populating tree. Error:", err)
+	}
+	return node
+}
+
+/*
+Tests the expansion of statements with component combinations into atomic statements, with nested statements
+(and combinations of those) retained as nested components.
+*/
+func TestGenerateAtomicStatementsNestedStatements(t *testing.T) {
+
+	sell := &Node{Entry: "sell"}
+	buy := &Node{Entry: "buy"}
+	nested := &Node{ComponentType: ACTIVATION_CONDITION, Entry: &Statement{
+		Attributes: &Node{ComponentType: ATTRIBUTES, Entry: "council"}, Aim: &Node{ComponentType: AIM, Entry: "approves"}}}
+	nestedCombination := combineForTest(t,
+		&Node{Entry: &Statement{Attributes: &Node{ComponentType: ATTRIBUTES, Entry: "state"}, Aim: &Node{ComponentType: AIM, Entry: "permits"}}},
+		XOR,
+		&Node{Entry: &Statement{Attributes: &Node{ComponentType: ATTRIBUTES, Entry: "office"}, Aim: &Node{ComponentType: AIM, Entry: "licenses"}}},
+		EXECUTION_CONSTRAINT)
+	stmt := &Statement{
+		Attributes:                 &Node{ComponentType: ATTRIBUTES, Entry: "farmer"},
+		Aim:                        combineForTest(t, sell, XOR, buy, AIM),
+		ActivationConditionComplex: nested,
+		ExecutionConstraintComplex: nestedCombination,
+	}
+	root := &Node{Entry: stmt}
+
+	atomicStmts, err := GenerateAtomicStatements(root, "650", DefaultOptions())
+	if err.ErrorCode != PARSING_NO_ERROR {
+		t.Fatal("Expansion should not fail. Error:", err)
+	}
+
+	expectedStmts := []string{
+		"A(farmer) I(sell) Cac{A(council) I(approves)} Cex{Cex{A(state) I(permits)} [XOR] Cex{A(office) I(licenses)}}",
+		"A(farmer) I(buy) Cac{A(council) I(approves)} Cex{Cex{A(state) I(permits)} [XOR] Cex{A(office) I(licenses)}}",
+	}
+	if len(atomicStmts) != len(expectedStmts) {
+		t.Fatal("Expansion should return", len(expectedStmts), "atomic statements, but returned", atomicStmts)
+	}
+	for i, atomicStmt := range atomicStmts {
+		if atomicStmt.ID != fmt.Sprint("650.", i+1) || atomicStmt.ParentID != "650" || atomicStmt.Parent != root {
+			t.Fatal("Atomic statement has wrong IDs or parent:", atomicStmt.ID, atomicStmt.ParentID, atomicStmt.Parent)
+		}
+		if atomicStmt.Statement.Stringify() != expectedStmts[i] {
+			t.Fatal("Atomic statement should be '"+expectedStmts[i]+"', but was", atomicStmt.Statement.Stringify())
+		}
+		// Nested statements (and their combinations) are referenced as is
+		if atomicStmt.Statement.ActivationConditionComplex != nested || atomicStmt.Statement.ExecutionConstraintComplex != nestedCombination {
+			t.Fatal("Nested statements should be referenced by atomic statement, but were", atomicStmt.Statement)
+		}
+	}
+	if atomicStmts[0].Entries[1] != sell || atomicStmts[1].Entries[1] != buy {
+		t.Fatal("Entries should reference leaf nodes of parsed statement, but were", atomicStmts[0].Entries, atomicStmts[1].Entries)
+	}
+	if fmt.Sprint(atomicStmts[0].Linkages) != "[{650.2 I [XOR]}]" || fmt.Sprint(atomicStmts[1].Linkages) != "[{650.1 I [XOR]}]" {
+		t.Fatal("Linkages of atomic statements are wrong:", atomicStmts[0].Linkages, atomicStmts[1].Linkages)
+	}
+
+	// Entries are copied, leaving the parsed statement unmodified
+	if atomicStmts[0].Statement.Aim == sell || sell.Parent != stmt.Aim {
+		t.Fatal("Expansion should not modify parsed statement")
+	}
+
+	// Expansion of empty node fails
+	if _, err := GenerateAtomicStatements(&Node{}, "650", DefaultOptions()); err.ErrorCode != PARSING_ERROR_EMPTY_LEAF {
+		t.Fatal("Expansion of empty node should fail, but returned", err)
+	}
+
+}
+
+/*
+Tests the expansion of component pair combinations into extrapolated statements and their atomic statements, including
+components shared across the extrapolated statements and the linkages at statement level.
+*/
+func TestGenerateAtomicStatementsComponentPairs(t *testing.T) {
+
+	// {A(council) I(approves) [XOR] A(state) I((permits [OR] licenses))} Bdir(goods)
+	shared := &Node{ComponentType: DIRECT_OBJECT, Entry: "goods"}
+	left := &Node{Entry: &Statement{
+		Attributes:   &Node{ComponentType: ATTRIBUTES, Entry: "council"},
+		Aim:          &Node{ComponentType: AIM, Entry: "approves"},
+		DirectObject: shared,
+	}}
+	right := &Node{Entry: &Statement{
+		Attributes:   &Node{ComponentType: ATTRIBUTES, Entry: "state"},
+		Aim:          combineForTest(t, &Node{Entry: "permits"}, OR, &Node{Entry: "licenses"}, AIM),
+		DirectObject: shared,
+	}}
+	// Items of component pair combination embed extrapolated statements in node collection, with statement nodes
+	// linked to the combination (as generated by the parser)
+	root := combineForTest(t, &Node{Entry: []*Node{left}}, XOR, &Node{Entry: []*Node{right}}, "")
+	left.Parent = root
+	right.Parent = root
+
+	atomicStmts, err := GenerateAtomicStatements(root, "650", DefaultOptions())
+	if err.ErrorCode != PARSING_NO_ERROR {
+		t.Fatal("Expansion should not fail. Error:", err)
+	}
+
+	expected := []struct {
+		id, parentId, stmt, linkages string
+		parent                       *Node
+	}{
+		{"650.1", "650.1", "A(council) I(approves) Bdir(goods)", "[{650.2.1  [XOR]} {650.2.2  [XOR]}]", left},
+		{"650.2.1", "650.2", "A(state) I(permits) Bdir(goods)", "[{650.2.2 I [OR]} {650.1  [XOR]}]", right},
+		{"650.2.2", "650.2", "A(state) I(licenses) Bdir(goods)", "[{650.2.1 I [OR]} {650.1  [XOR]}]", right},
+	}
+	if len(atomicStmts) != len(expected) {
+		t.Fatal("Expansion should return", len(expected), "atomic statements, but returned", atomicStmts)
+	}
+	for i, exp := range expected {
+		atomicStmt := atomicStmts[i]
+		if atomicStmt.ID != exp.id || atomicStmt.ParentID != exp.parentId || atomicStmt.Parent != exp.parent {
+			t.Fatal("Atomic statement has wrong IDs or parent:", atomicStmt.ID, atomicStmt.ParentID, atomicStmt.Parent)
+		}
+		if atomicStmt.Statement.Stringify() != exp.stmt {
+			t.Fatal("Atomic statement should be '"+exp.stmt+"', but was", atomicStmt.Statement.Stringify())
+		}
+		if fmt.Sprint(atomicStmt.Linkages) != exp.linkages {
+			t.Fatal("Linkages of atomic statement", atomicStmt.ID, "are wrong:", atomicStmt.Linkages)
+		}
+		// Shared component is referenced as entry, but copied into each atomic statement
+		if !NodeInSlice(shared, atomicStmt.Entries) || atomicStmt.Statement.DirectObject == shared {
+			t.Fatal("Shared component should be entry of atomic statement, but be copied into statement:", atomicStmt)
+		}
+	}
+
+}
+
+/*
+Tests the expansion of statements with unary and binary negations, with negated entries (i.e., operands of unary
+negations and right operands of binary negations) wrapped in unary negations in the atomic statements.
+*/
+func TestGenerateAtomicStatementsNegations(t *testing.T) {
+
+	// I((inspect [NOT] certify)) Bdir1(([NOT] organic)) Bdir2((apples [NOT] ([NOT] pears))) (with implicitly linked instances of Bdir)
+	certify := &Node{Entry: "certify"}
+	organic := &Node{Entry: "organic"}
+	pears := &Node{Entry: "pears"}
+	organicInstance := combineForTest(t, nil, NOT, organic, DIRECT_OBJECT)
+	organicInstance.Suffix = "1"
+	fruitInstance := combineForTest(t, &Node{Entry: "apples"}, NOT, combineForTest(t, nil, NOT, pears, ""), DIRECT_OBJECT)
+	fruitInstance.Suffix = "2"
+	stmt := &Statement{
+		Attributes:   &Node{ComponentType: ATTRIBUTES, Entry: "farmer"},
+		Aim:          combineForTest(t, &Node{Entry: "inspect"}, NOT, certify, AIM),
+		DirectObject: combineForTest(t, organicInstance, SAND_BETWEEN_COMPONENTS, fruitInstance, DIRECT_OBJECT),
+	}
+
+	atomicStmts, err := GenerateAtomicStatements(&Node{Entry: stmt}, "650", DefaultOptions())
+	if err.ErrorCode != PARSING_NO_ERROR {
+		t.Fatal("Expansion should not fail. Error:", err)
+	}
+
+	expectedStmts := []string{
+		"A(farmer) I(inspect) Bdir1(([NOT] organic))",
+		"A(farmer) I(inspect) Bdir2(apples)",
+		"A(farmer) I(inspect) Bdir2(([NOT] ([NOT] pears)))",
+		"A(farmer) I(([NOT] certify)) Bdir1(([NOT] organic))",
+		"A(farmer) I(([NOT] certify)) Bdir2(apples)",
+		"A(farmer) I(([NOT] certify)) Bdir2(([NOT] ([NOT] pears)))",
+	}
+	if len(atomicStmts) != len(expectedStmts) {
+		t.Fatal("Expansion should return", len(expectedStmts), "atomic statements, but returned", atomicStmts)
+	}
+	for i, atomicStmt := range atomicStmts {
+		if atomicStmt.Statement.Stringify() != expectedStmts[i] {
+			t.Fatal("Atomic statement should be '"+expectedStmts[i]+"', but was", atomicStmt.Statement.Stringify())
+		}
+	}
+	// Linkages retain binary negations, but not unary ones
+	if fmt.Sprint(atomicStmts[0].Linkages) != "[{650.2 Bdir [bAND NOT]} {650.3 Bdir [bAND NOT]} {650.4 I [NOT]} "+
+		"{650.5 I [NOT]} {650.5 Bdir [bAND NOT]} {650.6 I [NOT]} {650.6 Bdir [bAND NOT]}]" ||
+		fmt.Sprint(atomicStmts[1].Linkages[1]) != "{650.3 Bdir [NOT]}" {
+		t.Fatal("Linkages of atomic statements are wrong:", atomicStmts[0].Linkages, atomicStmts[1].Linkages)
+	}
+
+	// Negated entries remain linked to negations of parsed statement
+	if !organic.IsNegated() || certify.Parent != stmt.Aim || pears.Parent.Parent != fruitInstance {
+		t.Fatal("Expansion should not modify parsed statement")
+	}
+
+}
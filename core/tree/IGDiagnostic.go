@@ -0,0 +1,56 @@
+package tree
+
+/*
+This file contains the representation of diagnostics (errors, warnings and information) collected
+during the parsing of a statement (see parser.ParseStatementWithDiagnostics()).
+*/
+
+// Severity of diagnostics
+const SEVERITY_ERROR = "ERROR"
+const SEVERITY_WARNING = "WARNING"
+const SEVERITY_INFO = "INFO"
+
+// Indicates that a statement has been expanded into multiple atomic statements based on component pair combinations
+const PARSING_INFO_COMPONENT_PAIR_EXPANSION = "COMPONENT_PAIR_EXPANSION"
+
+/*
+Diagnostic reported during parsing, consisting of severity (see SEVERITY_* constants) and the
+corresponding parsing error (including error code, message, ignored elements and position).
+*/
+type Diagnostic struct {
+	Severity string
+	ParsingError
+}
+
+/*
+Creates diagnostic for given parsing error, with severity derived from the error code (see #SeverityOf()).
+*/
+func NewDiagnostic(err ParsingError) Diagnostic {
+	return Diagnostic{Severity: SeverityOf(err.ErrorCode), ParsingError: err}
+}
+
+/*
+Returns the severity associated with a given error code. Codes not explicitly
+associated with warnings or information are considered errors.
+*/
+func SeverityOf(errorCode string) string {
+	switch errorCode {
+	case PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT, PARSING_WARNING_NON_ANCHORED_CONTENT:
+		return SEVERITY_WARNING
+	case PARSING_INFO_COMPONENT_PAIR_EXPANSION:
+		return SEVERITY_INFO
+	}
+	return SEVERITY_ERROR
+}
+
+/*
+Indicates whether given diagnostics contain at least one error (as opposed to warnings or information only).
+*/
+func HasErrors(diagnostics []Diagnostic) bool {
+	for _, d := range diagnostics {
+		if d.Severity == SEVERITY_ERROR {
+			return true
+		}
+	}
+	return false
+}
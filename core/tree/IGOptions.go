@@ -0,0 +1,85 @@
+package tree
+
+/*
+This file contains the options controlling output generation (tabular and visual output) and
+associated tree operations. Options are passed explicitly with each conversion (e.g., via the endpoints),
+allowing for concurrent conversions with different settings.
+*/
+
+/*
+Options for the generation of output from parsed statements.
+Should be instantiated using DefaultOptions() and adjusted as needed.
+*/
+type Options struct {
+	// Indicates whether tabular export produces dynamic output based on present components
+	// (better for individual statements), or produces fixed predefined structure (better for datasets).
+	// Should be modified using SetDynamicOutput() (to adjust AggregateImplicitLinkages accordingly).
+	DynamicOutput bool
+	// Indicates whether the output includes IG Extended features (component-level nesting), or follows IG Core
+	IGExtendedOutput bool
+	// Indicates whether annotations are included in output
+	IncludeAnnotations bool
+	// Indicates whether Degree of Variability is included in output
+	IncludeDegreeOfVariability bool
+	// Indicates whether statement type (regulative, constitutive, hybrid) is included in output (see Statement.Classify())
+	IncludeStatementType bool
+	// Indicates whether complexity measures (Degree of Variability, options and complexity per component, nesting depth,
+	// number of nested statements) are included in tabular output (see Statement.CalculateComplexity())
+	IncludeComplexity bool
+	// Indicates whether header row is included in tabular output
+	IncludeHeaders bool
+	// Indicates whether adjacent operators should be collapsed (right now AND, sAND and bAND)
+	CollapseOperators bool
+	// Indicates whether shared elements are included in tabular output
+	IncludeSharedElementsInTabularOutput bool
+	// Indicates whether output for properties is a flat listing of properties attached as labels to the component
+	// (as opposed to a tree structure nested on the corresponding component)
+	FlatPrinting bool
+	// Indicates whether visual output is a binary tree structure (as opposed to collapsing entries linked via the
+	// same logical operators for given component types)
+	BinaryPrinting bool
+	// Indicates whether activation conditions are moved to the top of the visual tree output
+	MoveActivationConditionsToFront bool
+	// Indicates whether shared elements (i.e., preceding or following core entry in the case of scoped combinations)
+	// are included in visual output. Example: Bdir(sharedLeft (leftOption [XOR] rightOption) rightShared)
+	IncludeSharedElementsInVisualOutput bool
+	// Indicates whether implicitly linked components (e.g., multiple Cex annotations) should be aggregated
+	// into single tree, or be returned as separate trees (based on the implicit AND operator).
+	// Inverse of DynamicOutput by default (see SetDynamicOutput()).
+	AggregateImplicitLinkages bool
+	// Indicates inheritance mode of shared elements from parent to children nodes (see SHARED_ELEMENT_INHERIT_* constants)
+	SharedElementInheritanceMode string
+}
+
+/*
+Returns the default options for output generation (static IG Extended tabular output with header row
+and shared elements, hierarchical and non-binary visual output, no annotations, Degree of Variability, statement type or complexity measures).
+*/
+func DefaultOptions() Options {
+	return Options{
+		DynamicOutput:                        false,
+		IGExtendedOutput:                     true,
+		IncludeAnnotations:                   false,
+		IncludeDegreeOfVariability:           false,
+		IncludeStatementType:                 false,
+		IncludeComplexity:                    false,
+		IncludeHeaders:                       true,
+		CollapseOperators:                    true,
+		IncludeSharedElementsInTabularOutput: true,
+		FlatPrinting:                         false,
+		BinaryPrinting:                       false,
+		MoveActivationConditionsToFront:      false,
+		IncludeSharedElementsInVisualOutput:  true,
+		AggregateImplicitLinkages:            true,
+		SharedElementInheritanceMode:         DEFAULT_SHARED_ELEMENT_INHERITANCE_MODE,
+	}
+}
+
+/*
+Sets whether produced output should be dynamic or static. Implicitly linked components are
+aggregated for static output, but not for dynamic output (see AggregateImplicitLinkages).
+*/
+func (o *Options) SetDynamicOutput(dynamic bool) {
+	o.DynamicOutput = dynamic
+	o.AggregateImplicitLinkages = !dynamic
+}
@@ -0,0 +1,139 @@
+package tree
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+This file contains the representation of positions of offending content in the input statement,
+as used in parsing errors and warnings (see ParsingError.ErrorSpans).
+*/
+
+/*
+Position of a text span in the input statement.
+Offsets are zero-based, lines and columns are one-based (with columns counted in runes).
+End positions are exclusive, i.e., they point to the position immediately following the span.
+*/
+type SourceSpan struct {
+	// Byte offset of span start (-1 if span has not been located in input)
+	Offset int
+	// Byte offset of span end
+	EndOffset int
+	// Rune offset of span start
+	RuneOffset int
+	// Rune offset of span end
+	RuneEndOffset int
+	// Line of span start
+	Line int
+	// Column of span start
+	Column int
+	// Line of span end
+	EndLine int
+	// Column of span end
+	EndColumn int
+	// Offending content
+	Text string
+}
+
+/*
+Creates span for the content between given byte offsets (start inclusive, end exclusive) of the input,
+including rune offsets and line/column information.
+*/
+func NewSourceSpan(input string, offset int, endOffset int) SourceSpan {
+	span := SourceSpan{Offset: offset, EndOffset: endOffset, Text: input[offset:endOffset]}
+	span.RuneOffset, span.Line, span.Column = position(input, offset)
+	span.RuneEndOffset, span.EndLine, span.EndColumn = position(input, endOffset)
+	return span
+}
+
+/*
+Creates span for given offending content that is yet to be located in the input statement
+(see #ParsingError.LocateSpans()). Used where the content is known, but not its position in the input
+(e.g., during parsing of nested statements).
+*/
+func TextSpan(text string) SourceSpan {
+	return SourceSpan{Offset: -1, EndOffset: -1, Text: text}
+}
+
+/*
+Indicates whether the span has been located in the input statement.
+*/
+func (s SourceSpan) IsLocated() bool {
+	return s.Line > 0
+}
+
+/*
+Determines rune offset, line and column for a given byte offset in the input.
+*/
+func position(input string, offset int) (int, int, int) {
+	runeOffset := utf8.RuneCountInString(input[:offset])
+	line := strings.Count(input[:offset], "\n") + 1
+	column := utf8.RuneCountInString(input[strings.LastIndex(input[:offset], "\n")+1:offset]) + 1
+	return runeOffset, line, column
+}
+
+/*
+Determines the position of all error spans in the given input statement. Spans with known offsets are
+complemented with rune offsets and line/column information. Spans holding only offending content
+(see #TextSpan()) are located by searching the content in the input (in order of the spans, and preferring
+occurrences that are not part of other expressions, e.g., '(content)' as opposed to 'A(content)').
+Spans that cannot be located are removed.
+*/
+func (e *ParsingError) LocateSpans(input string) {
+	if len(e.ErrorSpans) == 0 {
+		return
+	}
+	located := []SourceSpan{}
+	// Position from which content is searched (to respect order of multiple spans)
+	searchStart := 0
+	for _, span := range e.ErrorSpans {
+		if span.Offset >= 0 && span.EndOffset >= span.Offset && span.EndOffset <= len(input) {
+			located = append(located, NewSourceSpan(input, span.Offset, span.EndOffset))
+			continue
+		}
+		if span.Text == "" {
+			continue
+		}
+		idx := findContent(input, span.Text, searchStart)
+		if idx == -1 {
+			// Retry from start of input
+			idx = findContent(input, span.Text, 0)
+		}
+		if idx == -1 {
+			continue
+		}
+		located = append(located, NewSourceSpan(input, idx, idx+len(span.Text)))
+		searchStart = idx + len(span.Text)
+	}
+	e.ErrorSpans = located
+}
+
+/*
+Returns the byte offset of the first occurrence of content in input (starting from a given offset)
+that is not directly preceded by other non-whitespace content (e.g., component identifiers), or alternatively
+the first occurrence of content at all. Returns -1 if content is not contained.
+*/
+func findContent(input string, content string, start int) int {
+	first := -1
+	for offset := start; offset <= len(input); {
+		idx := strings.Index(input[offset:], content)
+		if idx == -1 {
+			break
+		}
+		idx += offset
+		if first == -1 {
+			first = idx
+		}
+		if idx == 0 {
+			return idx
+		}
+		previous, _ := utf8.DecodeLastRuneInString(input[:idx])
+		if unicode.IsSpace(previous) || strings.ContainsRune("([{", previous) {
+			return idx
+		}
+		offset = idx + 1
+	}
+	return first
+}
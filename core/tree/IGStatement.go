@@ -3,7 +3,6 @@ package tree
 import (
 	"IG-Parser/core/shared"
 	"fmt"
-	"log"
 	"strings"
 )
 
@@ -43,6 +42,120 @@ type Statement struct {
 	OrElse                     *Node
 }
 
+/*
+Component field of a statement, alongside its symbol and indication whether it holds nested (complex) content.
+*/
+type StatementComponent struct {
+	Node    *Node
+	Symbol  string
+	Complex bool
+}
+
+/*
+Returns all component fields of statement (including unpopulated ones) in canonical order
+(as used for serialization, see #Stringify()).
+*/
+func (s *Statement) Components() []StatementComponent {
+	return []StatementComponent{
+		{s.Attributes, ATTRIBUTES, false},
+		{s.AttributesPropertySimple, ATTRIBUTES_PROPERTY, false},
+		{s.AttributesPropertyComplex, ATTRIBUTES_PROPERTY, true},
+		{s.Deontic, DEONTIC, false},
+		{s.Aim, AIM, false},
+		{s.DirectObject, DIRECT_OBJECT, false},
+		{s.DirectObjectComplex, DIRECT_OBJECT, true},
+		{s.DirectObjectPropertySimple, DIRECT_OBJECT_PROPERTY, false},
+		{s.DirectObjectPropertyComplex, DIRECT_OBJECT_PROPERTY, true},
+		{s.IndirectObject, INDIRECT_OBJECT, false},
+		{s.IndirectObjectComplex, INDIRECT_OBJECT, true},
+		{s.IndirectObjectPropertySimple, INDIRECT_OBJECT_PROPERTY, false},
+		{s.IndirectObjectPropertyComplex, INDIRECT_OBJECT_PROPERTY, true},
+		{s.ConstitutedEntity, CONSTITUTED_ENTITY, false},
+		{s.ConstitutedEntityPropertySimple, CONSTITUTED_ENTITY_PROPERTY, false},
+		{s.ConstitutedEntityPropertyComplex, CONSTITUTED_ENTITY_PROPERTY, true},
+		{s.Modal, MODAL, false},
+		{s.ConstitutiveFunction, CONSTITUTIVE_FUNCTION, false},
+		{s.ConstitutingProperties, CONSTITUTING_PROPERTIES, false},
+		{s.ConstitutingPropertiesComplex, CONSTITUTING_PROPERTIES, true},
+		{s.ConstitutingPropertiesPropertySimple, CONSTITUTING_PROPERTIES_PROPERTY, false},
+		{s.ConstitutingPropertiesPropertyComplex, CONSTITUTING_PROPERTIES_PROPERTY, true},
+		{s.ActivationConditionSimple, ACTIVATION_CONDITION, false},
+		{s.ActivationConditionComplex, ACTIVATION_CONDITION, true},
+		{s.ExecutionConstraintSimple, EXECUTION_CONSTRAINT, false},
+		{s.ExecutionConstraintComplex, EXECUTION_CONSTRAINT, true},
+		{s.OrElse, OR_ELSE, true},
+	}
+}
+
+/*
+Returns the statement field holding (primitive or nested) content of a given component. Returns nil if the
+component does not support the given kind of content (e.g., nested statements for Attributes).
+*/
+func (s *Statement) ComponentField(symbol string, nested bool) **Node {
+	if nested {
+		switch symbol {
+		case ATTRIBUTES_PROPERTY:
+			return &s.AttributesPropertyComplex
+		case DIRECT_OBJECT:
+			return &s.DirectObjectComplex
+		case DIRECT_OBJECT_PROPERTY:
+			return &s.DirectObjectPropertyComplex
+		case INDIRECT_OBJECT:
+			return &s.IndirectObjectComplex
+		case INDIRECT_OBJECT_PROPERTY:
+			return &s.IndirectObjectPropertyComplex
+		case CONSTITUTED_ENTITY_PROPERTY:
+			return &s.ConstitutedEntityPropertyComplex
+		case CONSTITUTING_PROPERTIES:
+			return &s.ConstitutingPropertiesComplex
+		case CONSTITUTING_PROPERTIES_PROPERTY:
+			return &s.ConstitutingPropertiesPropertyComplex
+		case ACTIVATION_CONDITION:
+			return &s.ActivationConditionComplex
+		case EXECUTION_CONSTRAINT:
+			return &s.ExecutionConstraintComplex
+		case OR_ELSE:
+			return &s.OrElse
+		}
+		return nil
+	}
+	switch symbol {
+	case ATTRIBUTES:
+		return &s.Attributes
+	case ATTRIBUTES_PROPERTY:
+		return &s.AttributesPropertySimple
+	case DEONTIC:
+		return &s.Deontic
+	case AIM:
+		return &s.Aim
+	case DIRECT_OBJECT:
+		return &s.DirectObject
+	case DIRECT_OBJECT_PROPERTY:
+		return &s.DirectObjectPropertySimple
+	case INDIRECT_OBJECT:
+		return &s.IndirectObject
+	case INDIRECT_OBJECT_PROPERTY:
+		return &s.IndirectObjectPropertySimple
+	case CONSTITUTED_ENTITY:
+		return &s.ConstitutedEntity
+	case CONSTITUTED_ENTITY_PROPERTY:
+		return &s.ConstitutedEntityPropertySimple
+	case MODAL:
+		return &s.Modal
+	case CONSTITUTIVE_FUNCTION:
+		return &s.ConstitutiveFunction
+	case CONSTITUTING_PROPERTIES:
+		return &s.ConstitutingProperties
+	case CONSTITUTING_PROPERTIES_PROPERTY:
+		return &s.ConstitutingPropertiesPropertySimple
+	case ACTIVATION_CONDITION:
+		return &s.ActivationConditionSimple
+	case EXECUTION_CONSTRAINT:
+		return &s.ExecutionConstraintSimple
+	}
+	return nil
+}
+
 /*
 Indicates whether a statement is empty, i.e., has no initialized components.
 Returns false if at least one component value is provided,
@@ -304,14 +417,6 @@ func (s *Statement) StringFlat(includeComponentSymbol bool) string {
 	return out
 }
 
-/*
-Stringifies institutional statement
-*/
-func (s *Statement) Stringify() string {
-	log.Fatal("Stringify() is not yet implemented.")
-	return ""
-}
-
 /*
 Generates map of arrays containing pointers to leaf nodes in each component.
 Key is an incrementing index, and value is an array of the corresponding nodes.
@@ -0,0 +1,553 @@
+package tree
+
+import (
+	"strings"
+)
+
+/*
+This file contains the functionality to serialize parsed statement trees back into IG Script.
+The generated output is canonical (i.e., components are emitted in a fixed order, and combinations
+are fully parenthesized), such that parsing the output of Stringify() produces a tree equivalent to
+the one it has been generated from. Round-trip tests are provided in the parser package.
+*/
+
+/*
+Stringifies institutional statement into parseable IG Script (e.g., 'A(actor) D(must) I((comply [XOR] report))').
+Statement-level annotations are not held by the statement itself, but by the node embedding it. Use
+Node.StringifyStatement() on nodes returned by the parser to include those (as well as component pairs).
+*/
+func (s *Statement) Stringify() string {
+	elements := []string{}
+	for _, comp := range s.Components() {
+		if comp.Node == nil || comp.Node.IsEmptyOrNilNode() {
+			continue
+		}
+		out := ""
+		if comp.Complex {
+			out = stringifyNestedComponent(comp.Node, comp.Symbol)
+		} else {
+			out = stringifyComponent(comp.Node, comp.Symbol)
+		}
+		if out != "" {
+			elements = append(elements, out)
+		}
+	}
+	return strings.Join(elements, " ")
+}
+
+/*
+Stringifies node embedding a statement (as returned by parser.ParseStatement()) into IG Script,
+including statement-level annotations held by the node. Nodes combining extrapolated statements
+(i.e., component pairs) are returned in brace notation, with shared components emitted once
+(e.g., 'A(actor) {I(act1) [XOR] I(act2)}').
+*/
+func (n *Node) StringifyStatement() string {
+	if n == nil {
+		return ""
+	}
+	out := ""
+	if n.isComponentPair() {
+		out = stringifyComponentPair(n)
+	} else {
+		out = stringifyStatementEntry(n)
+	}
+	// Append statement-level annotations
+	if annotations := stringifyAnnotations(n); annotations != "" {
+		if out != "" {
+			out += " "
+		}
+		out += annotations
+	}
+	return out
+}
+
+/*
+Stringifies primitive component (e.g., A(actor)), including suffix, annotations and combinations. Multiple
+component instances that are implicitly linked (bAND) are emitted as separate components (e.g., 'I(act1) I(act2)').
+Private property nodes linked to the component are emitted following the respective component instance.
+*/
+func stringifyComponent(n *Node, symbol string) string {
+	if n == nil {
+		return ""
+	}
+	if n.LogicalOperator == SAND_BETWEEN_COMPONENTS {
+		return joinNonEmpty(stringifyComponent(n.Left, symbol), stringifyComponent(n.Right, symbol))
+	}
+	return stringifyComponentInstance(n, symbol, n.Suffix)
+}
+
+/*
+Stringifies individual primitive component instance using the given suffix, followed by linked private nodes.
+*/
+func stringifyComponentInstance(n *Node, symbol string, suffix interface{}) string {
+	// Prefer symbol assigned to node (e.g., for private nodes)
+	if n.ComponentType != "" {
+		symbol = n.ComponentType
+	}
+	out := stringifyComponentHeader(symbol, suffix, stringifyAnnotations(n)) +
+		"(" + stringifyComponentContent(n) + ")"
+
+	return joinNonEmpty(out, stringifyPrivateNodes(n))
+}
+
+/*
+Stringifies private nodes linked to given component node or any of its children. Suffices of private nodes
+that have lost their own suffix information during detachment from the property tree are reconstructed from
+the node they are linked to.
+*/
+func stringifyPrivateNodes(n *Node) string {
+	elements := []string{}
+	visited := []*Node{}
+	var collect func(node *Node)
+	collect = func(node *Node) {
+		if node == nil {
+			return
+		}
+		for _, priv := range node.PrivateNodeLinks {
+			if NodeInSlice(priv, visited) {
+				continue
+			}
+			visited = append(visited, priv)
+			// Reconstruct suffix from linked node if private node does not hold it
+			var suffix interface{} = priv.Suffix
+			if priv.Suffix == nil && node.GetSuffix() != "" {
+				linkedSuffix := node.GetSuffix()
+				if idx := strings.Index(linkedSuffix, ","); idx != -1 {
+					linkedSuffix = linkedSuffix[:idx]
+				}
+				suffix = linkedSuffix
+			}
+			if priv.HasPrimitiveEntry() || priv.IsCombination() {
+				elements = append(elements, stringifyComponentInstance(priv, priv.GetComponentName(), suffix))
+			} else {
+				elements = append(elements, stringifyNestedComponentInstance(priv, priv.GetComponentName(), suffix))
+			}
+		}
+		collect(node.Left)
+		collect(node.Right)
+	}
+	collect(n)
+	return strings.Join(elements, " ")
+}
+
+/*
+Stringifies the content of a primitive component (i.e., the part embedded in parentheses), including
+combinations and shared elements (e.g., 'shared left (left [AND] right) shared right').
+*/
+func stringifyComponentContent(n *Node) string {
+	if n == nil {
+		return ""
+	}
+	if n.IsLeafNode() {
+		if n.HasPrimitiveEntry() {
+			return n.Entry.(string)
+		}
+		return ""
+	}
+	if n.LogicalOperator == SAND_WITHIN_COMPONENTS {
+		return stringifyWithinComponentLinkage(n)
+	}
+	out := "(" + stringifyLogicalExpression(stringifyCombinationElement(n.Left), n.LogicalOperator,
+		stringifyCombinationElement(n.Right)) + ")"
+	return joinNonEmpty(joinNonEmpty(stringifySharedElements(n.SharedLeft), out), stringifySharedElements(n.SharedRight))
+}
+
+/*
+Stringifies left or right element of a combination. Nested combinations that carry shared elements (or
+are themselves implicitly linked combinations) are wrapped in parentheses to retain their scope.
+*/
+func stringifyCombinationElement(n *Node) string {
+	if n == nil {
+		return ""
+	}
+	if n.IsLeafNode() {
+		return stringifyComponentContent(n)
+	}
+	if n.LogicalOperator != SAND_WITHIN_COMPONENTS &&
+		stringifySharedElements(n.SharedLeft) == "" && stringifySharedElements(n.SharedRight) == "" {
+		return stringifyComponentContent(n)
+	}
+	return "(" + stringifyComponentContent(n) + ")"
+}
+
+/*
+Generates logical expression linking left and right element by given operator (e.g., 'left [AND] right').
+Unary negations (i.e., empty left element) are emitted without left side (e.g., '[NOT] right').
+*/
+func stringifyLogicalExpression(left string, operator string, right string) string {
+	if left == "" && operator == NOT {
+		return LEFT_BRACKET + operator + RIGHT_BRACKET + " " + right
+	}
+	return left + " " + LEFT_BRACKET + operator + RIGHT_BRACKET + " " + right
+}
+
+/*
+Stringifies combinations implicitly linked within a component (wAND), e.g., '(Sellers [AND] Buyers) from (Northern [OR] Southern) states'.
+Since the parser attaches text between combinations to both adjacent combinations (as shared right of the left, and shared left of the
+right combination), such text is only emitted once.
+*/
+func stringifyWithinComponentLinkage(n *Node) string {
+	// Collect combinations in order of appearance
+	combinations := []*Node{}
+	var collect func(node *Node)
+	collect = func(node *Node) {
+		if node == nil {
+			return
+		}
+		if node.LogicalOperator == SAND_WITHIN_COMPONENTS {
+			collect(node.Left)
+			collect(node.Right)
+			return
+		}
+		combinations = append(combinations, node)
+	}
+	collect(n)
+
+	out := ""
+	for i, comb := range combinations {
+		if comb.IsLeafNode() {
+			out = joinNonEmpty(out, stringifyComponentContent(comb))
+			continue
+		}
+		// Leading shared elements (only for first element, or if diverging from preceding shared elements)
+		left := stringifySharedElements(comb.SharedLeft)
+		if i == 0 || left != stringifySharedElements(combinations[i-1].SharedRight) {
+			out = joinNonEmpty(out, left)
+		}
+		out = joinNonEmpty(out, "("+stringifyLogicalExpression(stringifyCombinationElement(comb.Left), comb.LogicalOperator,
+			stringifyCombinationElement(comb.Right))+")")
+		out = joinNonEmpty(out, stringifySharedElements(comb.SharedRight))
+	}
+	return out
+}
+
+/*
+Stringifies nested (complex) component (e.g., 'Cac{A(actor) I(act)}'), as well as combinations of
+nested components (e.g., 'Cac{Cac{A(actor1) I(act1)} [XOR] Cac{A(actor2) I(act2)}}') and component
+pairs embedded in nested components (e.g., 'Cac{ {A(actor1) I(act1) [XOR] A(actor2) I(act2)} }').
+*/
+func stringifyNestedComponent(n *Node, symbol string) string {
+	if n == nil {
+		return ""
+	}
+	// Separately specified nested components are linked by AND, but (unlike nested component combinations) do not hold component header
+	if n.LogicalOperator == SAND_BETWEEN_COMPONENTS ||
+		(n.LogicalOperator == AND && !n.isComponentPair() && !hasNestedCombinationHeader(n, symbol)) {
+		return joinNonEmpty(stringifyNestedComponent(n.Left, symbol), stringifyNestedComponent(n.Right, symbol))
+	}
+	return stringifyNestedComponentInstance(n, symbol, n.Suffix)
+}
+
+/*
+Stringifies individual nested component instance (or nested component combination) using the given suffix.
+*/
+func stringifyNestedComponentInstance(n *Node, symbol string, suffix interface{}) string {
+	if n.ComponentType != "" {
+		symbol = n.ComponentType
+	}
+	// Component-level annotation and remaining (i.e., statement-level) annotations of nested statement
+	leadingAnnotation, remainingAnnotations := splitLeadingAnnotation(stringifyAnnotations(n))
+	header := stringifyComponentHeader(symbol, suffix, leadingAnnotation)
+
+	inner := ""
+	if n.isComponentPair() {
+		inner = stringifyComponentPair(n)
+	} else if n.IsLeafNode() {
+		inner = stringifyStatementEntry(n)
+	} else if n.IsUnaryNegation() && n.Right.IsLeafNode() && !n.Right.isComponentPair() {
+		// Negated individual nested statement (e.g., 'Cac{[NOT] A(actor) I(act)}'), which carries suffix and annotations on negated node
+		leadingAnnotation, remainingAnnotations = splitLeadingAnnotation(stringifyAnnotations(n.Right))
+		if n.Right.Suffix != nil {
+			suffix = n.Right.Suffix
+		}
+		header = stringifyComponentHeader(symbol, suffix, leadingAnnotation)
+		inner = stringifyLogicalExpression("", n.LogicalOperator, stringifyStatementEntry(n.Right))
+	} else {
+		// Nested component combinations retain their header (including suffix and annotation, e.g., 'Cac1[cond]') as shared left element
+		if hasNestedCombinationHeader(n, symbol) {
+			header = stringifySharedElements(n.SharedLeft)
+		}
+		inner = stringifyLogicalExpression(stringifyNestedCombinationElement(n.Left, symbol), n.LogicalOperator,
+			stringifyNestedCombinationElement(n.Right, symbol))
+	}
+	return header + "{" + joinNonEmpty(inner, remainingAnnotations) + "}"
+}
+
+/*
+Stringifies left or right element of a nested component combination. Nested statements (including negated
+ones) and inner nested component combinations (e.g., 'Cac{Cac{...} [AND] Cac{...}}') are emitted with leading
+component symbol, whereas inner combinations indicating precedence are only wrapped in braces.
+*/
+func stringifyNestedCombinationElement(n *Node, symbol string) string {
+	if n == nil {
+		return ""
+	}
+	if n.IsLeafNode() || n.isComponentPair() || (n.IsUnaryNegation() && n.Right.IsLeafNode()) {
+		return stringifyNestedComponent(n, symbol)
+	}
+	if hasNestedCombinationHeader(n, symbol) {
+		return stringifyNestedComponentInstance(n, symbol, n.Suffix)
+	}
+	return "{" + stringifyLogicalExpression(stringifyNestedCombinationElement(n.Left, symbol), n.LogicalOperator,
+		stringifyNestedCombinationElement(n.Right, symbol)) + "}"
+}
+
+/*
+Indicates whether nested component combination holds component header (e.g., 'Cac1[condition]' in
+'Cac1[condition]{Cac{...} [XOR] Cac{...}}'), which the parser retains as shared left element.
+*/
+func hasNestedCombinationHeader(n *Node, symbol string) bool {
+	return strings.HasPrefix(stringifySharedElements(n.SharedLeft), symbol)
+}
+
+/*
+Stringifies component pair combination in factored form, i.e., components shared across all extrapolated
+statements are emitted once, followed by the combination of the components specific to the individual pairs
+(e.g., 'A(actor) D(may) {I(act1) Bdir(object1) [XOR] {I(act2) [AND] I(act3)}}').
+*/
+func stringifyComponentPair(n *Node) string {
+	if n == nil {
+		return ""
+	}
+	shared := sharedPairComponents(n)
+	return joinNonEmpty(shared.Stringify(), stringifyComponentPairCombination(n, shared, true))
+}
+
+/*
+Stringifies combination of component pairs, omitting the given components shared across all pairs. Shared
+elements of inner combinations (i.e., text surrounding the braces, such as the symbol of combined nested
+components in 'Cac{Cac{...} [AND] Cac{...}}') are retained.
+*/
+func stringifyComponentPairCombination(n *Node, shared *Statement, root bool) string {
+	if n == nil {
+		return ""
+	}
+	if n.IsLeafNode() {
+		return stringifyComponentPairItem(n, shared)
+	}
+	out := "{" + stringifyLogicalExpression(stringifyComponentPairCombination(n.Left, shared, false), n.LogicalOperator,
+		stringifyComponentPairCombination(n.Right, shared, false)) + "}"
+	if root {
+		return out
+	}
+	return joinNonEmpty(stringifySharedElements(n.SharedLeft)+out, stringifySharedElements(n.SharedRight))
+}
+
+/*
+Stringifies individual item of component pair combination (i.e., node embedding extrapolated statement),
+including its annotations, but omitting the given shared components.
+*/
+func stringifyComponentPairItem(n *Node, shared *Statement) string {
+	entry, ok := n.Entry.([]*Node)
+	if !ok {
+		return stringifyStatementEntry(n)
+	}
+	elements := []string{}
+	for _, node := range entry {
+		stmt, ok := node.Entry.(*Statement)
+		if !ok {
+			elements = append(elements, node.StringifyStatement())
+			continue
+		}
+		elements = append(elements, joinNonEmpty(stmt.withoutComponents(shared).Stringify(), stringifyAnnotations(node)))
+	}
+	return strings.Join(elements, " ")
+}
+
+/*
+Returns statements extrapolated from component pair combination in order of appearance.
+*/
+func componentPairStatements(n *Node) []*Statement {
+	stmts := []*Statement{}
+	if n == nil {
+		return stmts
+	}
+	if !n.IsLeafNode() {
+		return append(componentPairStatements(n.Left), componentPairStatements(n.Right)...)
+	}
+	if entry, ok := n.Entry.([]*Node); ok {
+		for _, node := range entry {
+			if stmt, ok := node.Entry.(*Statement); ok {
+				stmts = append(stmts, stmt)
+			}
+		}
+	}
+	return stmts
+}
+
+/*
+Identifies the components shared across all statements extrapolated from a component pair combination.
+During extrapolation, shared components are either assigned to the extrapolated statements as is, or, if
+the extrapolated statement holds the component itself, attached as right child of an implicit linkage (bAND).
+Returns a statement holding the shared components.
+*/
+func sharedPairComponents(n *Node) *Statement {
+	shared := &Statement{}
+	stmts := componentPairStatements(n)
+	if len(stmts) < 2 {
+		return shared
+	}
+	for _, comp := range stmts[0].Components() {
+		if comp.Node == nil {
+			continue
+		}
+		candidates := []*Node{comp.Node}
+		if comp.Node.LogicalOperator == SAND_BETWEEN_COMPONENTS {
+			candidates = append(candidates, comp.Node.Right)
+		}
+		for _, candidate := range candidates {
+			isShared := true
+			for _, stmt := range stmts[1:] {
+				if !holdsComponent(*stmt.ComponentField(comp.Symbol, comp.Complex), candidate) {
+					isShared = false
+					break
+				}
+			}
+			if isShared {
+				*shared.ComponentField(comp.Symbol, comp.Complex) = candidate
+				break
+			}
+		}
+	}
+	return shared
+}
+
+/*
+Indicates whether a component value holds the given component node, either as is or as right child of an
+implicit linkage (bAND).
+*/
+func holdsComponent(value *Node, component *Node) bool {
+	if value == nil || component == nil {
+		return false
+	}
+	return value == component || (value.LogicalOperator == SAND_BETWEEN_COMPONENTS && value.Right == component)
+}
+
+/*
+Returns copy of statement without the components held by the given statement (see #holdsComponent()).
+*/
+func (s *Statement) withoutComponents(components *Statement) *Statement {
+	stmt := *s
+	for _, comp := range components.Components() {
+		if comp.Node == nil {
+			continue
+		}
+		field := stmt.ComponentField(comp.Symbol, comp.Complex)
+		if *field == comp.Node {
+			*field = nil
+		} else if holdsComponent(*field, comp.Node) {
+			*field = (*field).Left
+		}
+	}
+	return &stmt
+}
+
+/*
+Stringifies statement embedded in leaf node, irrespective of whether it is held as statement, statement
+reference, or node embedding the statement (as is the case for extrapolated component pairs).
+*/
+func stringifyStatementEntry(n *Node) string {
+	switch entry := n.Entry.(type) {
+	case *Statement:
+		return entry.Stringify()
+	case Statement:
+		return entry.Stringify()
+	case []*Node:
+		elements := []string{}
+		for _, node := range entry {
+			elements = append(elements, node.StringifyStatement())
+		}
+		return strings.Join(elements, " ")
+	}
+	return ""
+}
+
+/*
+Indicates whether node holds component pairs, i.e., combinations of extrapolated statements.
+*/
+func (n *Node) isComponentPair() bool {
+	if n == nil {
+		return false
+	}
+	if n.IsLeafNode() {
+		_, ok := n.Entry.([]*Node)
+		return ok
+	}
+	// Unary negation only holds right child
+	if n.IsUnaryNegation() {
+		return n.Right.isComponentPair()
+	}
+	// Combinations of component pairs with other nested statements (e.g., separately specified nested components) are no component pairs
+	return n.Left.isComponentPair() && n.Right.isComponentPair()
+}
+
+/*
+Generates component header including symbol, suffix and annotation (e.g., A1[annotation]).
+For property symbols, the suffix is placed before the property indicator (e.g., A1,p).
+*/
+func stringifyComponentHeader(symbol string, suffix interface{}, annotation string) string {
+	suffixString := ""
+	if suffix != nil {
+		suffixString = suffix.(string)
+	}
+	if suffixString != "" && strings.HasSuffix(symbol, PROPERTY_SYNTAX_SUFFIX) {
+		return strings.TrimSuffix(symbol, PROPERTY_SYNTAX_SUFFIX) + suffixString + PROPERTY_SYNTAX_SUFFIX + annotation
+	}
+	return symbol + suffixString + annotation
+}
+
+/*
+Returns annotations held by node itself (i.e., without inherited ones) as string.
+*/
+func stringifyAnnotations(n *Node) string {
+	if n == nil || n.Annotations == nil {
+		return ""
+	}
+	if annotations, ok := n.Annotations.(string); ok {
+		return strings.Trim(annotations, " ")
+	}
+	return ""
+}
+
+/*
+Separates leading annotation (e.g., '[first]' in '[first][second=[a,b]]') from remaining annotations
+under consideration of nested brackets. Returns leading annotation and remainder.
+*/
+func splitLeadingAnnotation(annotations string) (string, string) {
+	if !strings.HasPrefix(annotations, LEFT_BRACKET) {
+		return "", annotations
+	}
+	level := 0
+	for i, letter := range annotations {
+		switch string(letter) {
+		case LEFT_BRACKET:
+			level++
+		case RIGHT_BRACKET:
+			level--
+			if level == 0 {
+				return annotations[:i+1], strings.Trim(annotations[i+1:], " ")
+			}
+		}
+	}
+	return annotations, ""
+}
+
+/*
+Joins shared elements into single string.
+*/
+func stringifySharedElements(elements []string) string {
+	return strings.Trim(strings.Join(elements, " "), " ")
+}
+
+/*
+Joins both strings with separating whitespace if both are non-empty.
+*/
+func joinNonEmpty(left string, right string) string {
+	if left == "" {
+		return right
+	}
+	if right == "" {
+		return left
+	}
+	return left + " " + right
+}
@@ -0,0 +1,168 @@
+package tree
+
+import (
+	"strings"
+)
+
+/*
+This file contains the classification of statements by type (regulative, constitutive or hybrid).
+Statements are classified based on the type-specific components they contain, i.e., Attributes, Deontic,
+Aim, Direct and Indirect Object for regulative statements, and Constituted Entity, Modal, Constitutive
+Function and Constituting Properties for constitutive statements (including the respective properties).
+Activation Conditions, Execution Constraints and Or else are shared amongst both types and do not
+affect the classification.
+*/
+
+// Statement types
+const STATEMENT_TYPE_REGULATIVE = "regulative"
+const STATEMENT_TYPE_CONSTITUTIVE = "constitutive"
+const STATEMENT_TYPE_HYBRID = "hybrid"
+
+// Statements that do not contain type-specific components (e.g., nested activation conditions only holding Cac and Cex)
+const STATEMENT_TYPE_UNDETERMINED = "undetermined"
+
+// Suffix indicating inconsistent mix of type-specific components in string representation of classification
+const STATEMENT_TYPE_INCONSISTENT_SUFFIX = " (inconsistent)"
+
+/*
+Classification of statement by type.
+*/
+type StatementClassification struct {
+	// Statement type (see STATEMENT_TYPE_* constants)
+	Type string
+	// Indicates whether the statement itself combines regulative and constitutive components (as opposed
+	// to a hybrid statement whose type-specific components embed nested statements of the other type)
+	Inconsistent bool
+	// Symbols of regulative components contained in statement
+	RegulativeComponents []string
+	// Symbols of constitutive components contained in statement
+	ConstitutiveComponents []string
+}
+
+/*
+Returns statement type, with suffix indicating inconsistent mix of type-specific components (e.g., 'hybrid (inconsistent)').
+*/
+func (c StatementClassification) String() string {
+	if c.Inconsistent {
+		return c.Type + STATEMENT_TYPE_INCONSISTENT_SUFFIX
+	}
+	return c.Type
+}
+
+/*
+Classifies statement as regulative, constitutive or hybrid.
+Statements exclusively containing components of one type are classified accordingly, unless type-specific
+components embed nested statements of the other type (or hybrid nested statements), in which case the statement
+is classified as hybrid. Nested statements in Activation Conditions, Execution Constraints and Or else do not
+affect the classification of the statement they are nested in. Statements that combine regulative and constitutive
+components on the same level (e.g., Deontic and Modal) are classified as hybrid and flagged as inconsistent.
+Statements without type-specific components are classified as undetermined.
+Nested statements are classified separately (i.e., by invoking this function on the nested statement).
+*/
+func (s *Statement) Classify() StatementClassification {
+
+	classification := StatementClassification{
+		RegulativeComponents:   []string{},
+		ConstitutiveComponents: []string{},
+	}
+
+	// Nested statements embedded in type-specific components
+	nestedStmts := []*Statement{}
+
+	for _, comp := range s.Components() {
+		if comp.Node == nil || comp.Node.IsEmptyOrNilNode() {
+			continue
+		}
+		switch componentStatementType(comp.Symbol) {
+		case STATEMENT_TYPE_REGULATIVE:
+			classification.RegulativeComponents = appendSymbolIfNotExisting(classification.RegulativeComponents, comp.Symbol)
+		case STATEMENT_TYPE_CONSTITUTIVE:
+			classification.ConstitutiveComponents = appendSymbolIfNotExisting(classification.ConstitutiveComponents, comp.Symbol)
+		default:
+			// Shared components do not affect classification
+			continue
+		}
+		nestedStmts = append(nestedStmts, collectNestedStatements(comp.Node)...)
+	}
+
+	regulative := len(classification.RegulativeComponents) > 0
+	constitutive := len(classification.ConstitutiveComponents) > 0
+
+	switch {
+	case regulative && constitutive:
+		classification.Type = STATEMENT_TYPE_HYBRID
+		classification.Inconsistent = true
+		return classification
+	case regulative:
+		classification.Type = STATEMENT_TYPE_REGULATIVE
+	case constitutive:
+		classification.Type = STATEMENT_TYPE_CONSTITUTIVE
+	default:
+		classification.Type = STATEMENT_TYPE_UNDETERMINED
+		return classification
+	}
+
+	// Check whether nested statements deviate from type of statement
+	for _, nestedStmt := range nestedStmts {
+		nestedType := nestedStmt.Classify().Type
+		if nestedType != STATEMENT_TYPE_UNDETERMINED && nestedType != classification.Type {
+			classification.Type = STATEMENT_TYPE_HYBRID
+			break
+		}
+	}
+
+	return classification
+}
+
+/*
+Returns the statement type a component (identified by symbol, including property symbols) is specific to,
+or an empty string for components shared amongst regulative and constitutive statements.
+*/
+func componentStatementType(symbol string) string {
+	switch strings.TrimSuffix(symbol, PROPERTY_SYNTAX_SUFFIX) {
+	case ATTRIBUTES, DEONTIC, AIM, DIRECT_OBJECT, INDIRECT_OBJECT:
+		return STATEMENT_TYPE_REGULATIVE
+	case CONSTITUTED_ENTITY, MODAL, CONSTITUTIVE_FUNCTION, CONSTITUTING_PROPERTIES:
+		return STATEMENT_TYPE_CONSTITUTIVE
+	}
+	return ""
+}
+
+/*
+Collects the statements nested in a given component tree (including combinations of nested statements,
+component pairs and linked private nodes). Does not descend into the collected statements.
+*/
+func collectNestedStatements(node *Node) []*Statement {
+	if node == nil {
+		return nil
+	}
+	stmts := []*Statement{}
+	switch entry := node.Entry.(type) {
+	case *Statement:
+		if entry != nil {
+			stmts = append(stmts, entry)
+		}
+	case []*Node:
+		for _, pairNode := range entry {
+			stmts = append(stmts, collectNestedStatements(pairNode)...)
+		}
+	}
+	for _, privateNode := range node.PrivateNodeLinks {
+		stmts = append(stmts, collectNestedStatements(privateNode)...)
+	}
+	stmts = append(stmts, collectNestedStatements(node.Left)...)
+	stmts = append(stmts, collectNestedStatements(node.Right)...)
+	return stmts
+}
+
+/*
+Appends symbol to given slice if not already contained.
+*/
+func appendSymbolIfNotExisting(symbols []string, symbol string) []string {
+	for _, existing := range symbols {
+		if existing == symbol {
+			return symbols
+		}
+	}
+	return append(symbols, symbol)
+}
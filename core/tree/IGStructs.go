@@ -1,7 +1,6 @@
 package tree
 
 import (
-	"errors"
 	"strconv"
 	"strings"
 )
@@ -24,6 +23,9 @@ const RIGHT_BRACKET = "]"
 // Syntax for properties in component identifiers (e.g., A,p)
 const PROPERTY_SYNTAX_SUFFIX = ",p"
 
+// Separator between key and value of annotations (e.g., [ref=1])
+const ANNOTATION_KEY_VALUE_SEPARATOR = "="
+
 // Suffix for component-specific annotation column header
 const ANNOTATION = " (Annotation)"
 
@@ -177,6 +179,43 @@ func validIGComponentName(name string) bool {
 	return res
 }
 
+/*
+Splits annotations into the contents of individual bracketed annotations (e.g., '[a=b][c=[d,e]]' into 'a=b' and
+'c=[d,e]'), under consideration of nested brackets.
+*/
+func SplitAnnotations(annotations string) []string {
+	result := []string{}
+	level := 0
+	start := 0
+	for i, letter := range annotations {
+		switch string(letter) {
+		case LEFT_BRACKET:
+			if level == 0 {
+				start = i + 1
+			}
+			level++
+		case RIGHT_BRACKET:
+			level--
+			if level == 0 {
+				result = append(result, annotations[start:i])
+			}
+		}
+	}
+	return result
+}
+
+/*
+Splits the content of an individual annotation (see #SplitAnnotations()) into key and value at the first
+key-value separator (e.g., 'ctx' and '[time,place]' for 'ctx=[time,place]'). Returns the content as key,
+an empty value and false for annotations without separator (e.g., 'dir').
+*/
+func SplitAnnotationKeyValue(annotation string) (string, string, bool) {
+	if idx := strings.Index(annotation, ANNOTATION_KEY_VALUE_SEPARATOR); idx != -1 {
+		return annotation[:idx], annotation[idx+len(ANNOTATION_KEY_VALUE_SEPARATOR):], true
+	}
+	return annotation, "", false
+}
+
 /*
 IG 2.0 Component Symbols
 */
@@ -519,6 +558,9 @@ const PARSING_ERROR_UNEXPECTED_ERROR = "UNEXPECTED_ERROR"
 // Indicates potential presence of not-parsed content (which should have been parsed) based on presence of parentheses, braces or brackets -- only used as warning, not as error
 const PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT = "POSSIBLY_MISSING_NON_PARSED_CONTENT"
 
+// Indicates component content that could not be located in the text of stand-off output (e.g., brat export) and has been omitted -- only used as warning, not as error
+const PARSING_WARNING_NON_ANCHORED_CONTENT = "NON_ANCHORED_CONTENT"
+
 // Indicates nesting on invalid component (no component-level nesting)
 const PARSING_ERROR_NESTING_ON_UNSUPPORTED_COMPONENT = "NESTING_ON_NON-NESTED_COMPONENT"
 
@@ -549,18 +591,57 @@ const PARSING_ERROR_MULTIPLE_COMPONENT_PAIRS_ON_SAME_LEVEL = "MULTIPLE_COMPONENT
 // Indicates invalid type when calculating degree of variability (or other complexity metrics)
 const PARSING_ERROR_INVALID_TYPE_COMPLEXITY_CALCULATION = "INVALID_TYPE_FOR_COMPLEXITY_CALCULATION"
 
+// Indicates invalid type of node entry (i.e., no string, statement or node collection) during JSON output generation
+const PARSING_ERROR_INVALID_TYPE_JSON_OUTPUT = "INVALID_TYPE_FOR_JSON_OUTPUT"
+
+// Indicates failed encoding of JSON output
+const PARSING_ERROR_JSON_ENCODING = "JSON_ENCODING_ERROR"
+
+// Indicates failed decoding of JSON input (e.g., invalid syntax or unknown properties)
+const PARSING_ERROR_JSON_DECODING = "JSON_DECODING_ERROR"
+
+// Indicates JSON input that does not correspond to a valid statement tree structure or unsupported schema version
+const PARSING_ERROR_INVALID_JSON_INPUT = "INVALID_JSON_INPUT"
+
+// Indicates failed encoding of XML output
+const PARSING_ERROR_XML_ENCODING = "XML_ENCODING_ERROR"
+
+// Indicates that formatted IG Script does not reproduce the statement it has been generated from (see formatter.Format())
+const PARSING_ERROR_FORMATTING = "FORMATTING_ERROR"
+
+// Indicates brat annotations that do not correspond to a valid statement structure (e.g., unknown entity types or malformed lines)
+const PARSING_ERROR_INVALID_BRAT_INPUT = "INVALID_BRAT_INPUT"
+
+// Indicates multiple codings of the same statement by the same coder (see reliability.Analyze())
+const PARSING_ERROR_DUPLICATE_CODING = "DUPLICATE_CODING"
+
+// Indicates syntax error in structural query (see query.Parse())
+const PARSING_ERROR_INVALID_QUERY = "INVALID_QUERY"
+
+// Indicates tabular input that does not correspond to tabular output of IG Parser or cannot be reconstructed as IG Script (see tabular.ParseTabularInput())
+const PARSING_ERROR_INVALID_TABULAR_INPUT = "INVALID_TABULAR_INPUT"
+
 /*
-Error type signaling errors during statement parsing
+Error type signaling errors during statement parsing.
+ErrorSpans holds the position(s) of the offending content in the input statement (if determinable),
+e.g., an unmatched parenthesis, or content that has not been parsed (see SourceSpan).
 */
 type ParsingError struct {
 	ErrorCode            string
 	ErrorMessage         string
 	ErrorIgnoredElements []string
+	ErrorSpans           []SourceSpan
 }
 
-func (e *ParsingError) Error() error {
-	return errors.New("Parsing Error " + e.ErrorCode + ": " + e.ErrorMessage +
-		" (Ignored elements: " + strconv.Itoa(len(e.ErrorIgnoredElements)) + ")")
+/*
+Returns string representation of parsing error, including the position of the first offending span (if known).
+*/
+func (e ParsingError) Error() string {
+	msg := "Parsing Error " + e.ErrorCode + ": " + e.ErrorMessage
+	if len(e.ErrorSpans) > 0 && e.ErrorSpans[0].IsLocated() {
+		msg += " (Line " + strconv.Itoa(e.ErrorSpans[0].Line) + ", Column " + strconv.Itoa(e.ErrorSpans[0].Column) + ")"
+	}
+	return msg + " (Ignored elements: " + strconv.Itoa(len(e.ErrorIgnoredElements)) + ")"
 }
 
 /*
@@ -572,9 +653,9 @@ type NodeError struct {
 	ErrorIgnoredElements []string
 }
 
-func (e *NodeError) Error() error {
-	return errors.New("Node Error " + e.ErrorCode + ": " + e.ErrorMessage +
-		" (Ignored elements: " + strconv.Itoa(len(e.ErrorIgnoredElements)) + ")")
+func (e NodeError) Error() string {
+	return "Node Error " + e.ErrorCode + ": " + e.ErrorMessage +
+		" (Ignored elements: " + strconv.Itoa(len(e.ErrorIgnoredElements)) + ")"
 }
 
 // Standard error type if no error was found
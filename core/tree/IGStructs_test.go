@@ -2,6 +2,7 @@ package tree
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -23,6 +24,29 @@ func TestValidIGSymbol(t *testing.T) {
 
 }
 
+/*
+Tests splitting of annotations into individual annotations (considering nested brackets), and of individual
+annotations into key and value.
+*/
+func TestSplitAnnotations(t *testing.T) {
+
+	annotations := SplitAnnotations("[ref=1][dir] [ctx=[time,place]]")
+	if !reflect.DeepEqual(annotations, []string{"ref=1", "dir", "ctx=[time,place]"}) {
+		t.Fatal("Annotations are split incorrectly:", annotations)
+	}
+
+	key, value, ok := SplitAnnotationKeyValue(annotations[2])
+	if !ok || key != "ctx" || value != "[time,place]" {
+		t.Fatal("Annotation is split incorrectly into key and value:", key, value, ok)
+	}
+
+	key, value, ok = SplitAnnotationKeyValue(annotations[1])
+	if ok || key != "dir" || value != "" {
+		t.Fatal("Annotation without value should be returned as key:", key, value, ok)
+	}
+
+}
+
 /*
 Tests merging of arrays without consideration of subitems
 */
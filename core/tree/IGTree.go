@@ -37,6 +37,12 @@ type Node struct {
 	Annotations interface{}
 	// Private links to given node (e.g., private properties)
 	PrivateNodeLinks []*Node
+	// Position of leaf content in IG Script input (nil if not anchored, see anchoring.AnchorStatements())
+	IGScriptSpan *SourceSpan
+	// Position of leaf content in Original Statement (nil if not anchored or not located in Original Statement)
+	OriginalStatementSpan *SourceSpan
+	// Status of node in comparison of encodings (e.g., 'added'), included in visual output if populated (see diff.MarkNodes())
+	DiffStatus string
 }
 
 /*
@@ -78,13 +84,21 @@ func (n *Node) getParentsRightSharedElements() []string {
 }
 
 /*
-Returns left shared elements under consideration of SHARED_ELEMENT_INHERITANCE_MODE
+Returns left shared elements under consideration of DEFAULT_SHARED_ELEMENT_INHERITANCE_MODE
 */
 func (n *Node) GetSharedLeft() []string {
+	return n.GetSharedLeftForMode(DEFAULT_SHARED_ELEMENT_INHERITANCE_MODE)
+}
+
+/*
+Returns left shared elements under consideration of a given shared element inheritance mode
+(see SHARED_ELEMENT_INHERIT_* constants).
+*/
+func (n *Node) GetSharedLeftForMode(mode string) []string {
 	if n == nil {
 		return nil
 	}
-	switch SHARED_ELEMENT_INHERITANCE_MODE {
+	switch mode {
 	case SHARED_ELEMENT_INHERIT_OVERRIDE:
 		// Overwrite child with parent shared element values
 		shared := n.getParentsLeftSharedElements()
@@ -111,10 +125,10 @@ func (n *Node) GetSharedLeft() []string {
 		if !n.IsCombination() && n.Parent != nil {
 			if n.SharedLeft != nil {
 				// Return parent and own shared left information
-				return append(n.Parent.GetSharedLeft(), n.SharedLeft...)
+				return append(n.Parent.GetSharedLeftForMode(mode), n.SharedLeft...)
 			}
 			// Return parent left shared
-			return n.Parent.GetSharedLeft()
+			return n.Parent.GetSharedLeftForMode(mode)
 		} else {
 			// Return shared left (may be of combination of leaf node)
 			return n.SharedLeft
@@ -127,13 +141,21 @@ func (n *Node) GetSharedLeft() []string {
 }
 
 /*
-Returns right shared elements under consideration of SHARED_ELEMENT_INHERITANCE_MODE
+Returns right shared elements under consideration of DEFAULT_SHARED_ELEMENT_INHERITANCE_MODE
 */
 func (n *Node) GetSharedRight() []string {
+	return n.GetSharedRightForMode(DEFAULT_SHARED_ELEMENT_INHERITANCE_MODE)
+}
+
+/*
+Returns right shared elements under consideration of a given shared element inheritance mode
+(see SHARED_ELEMENT_INHERIT_* constants).
+*/
+func (n *Node) GetSharedRightForMode(mode string) []string {
 	if n == nil {
 		return nil
 	}
-	switch SHARED_ELEMENT_INHERITANCE_MODE {
+	switch mode {
 	case SHARED_ELEMENT_INHERIT_OVERRIDE:
 		// Overwrite child with parent shared element values
 		shared := n.getParentsRightSharedElements()
@@ -160,10 +182,10 @@ func (n *Node) GetSharedRight() []string {
 		if !n.IsCombination() && n.Parent != nil {
 			if n.SharedRight != nil {
 				// Return parent and own shared right information
-				return append(n.Parent.GetSharedRight(), n.SharedRight...)
+				return append(n.Parent.GetSharedRightForMode(mode), n.SharedRight...)
 			}
 			// Return parent right shared
-			return n.Parent.GetSharedRight()
+			return n.Parent.GetSharedRightForMode(mode)
 		} else {
 			// Return shared right (may be of combination of leaf node)
 			return n.SharedRight
@@ -236,10 +258,19 @@ func (n *Node) CountParents() int {
 }
 
 /*
-Returns string representation of node tree structure. The output is
-compatible to the input parser to reconstruct the tree.
+Returns string representation of node tree structure under consideration of
+DEFAULT_SHARED_ELEMENT_INHERITANCE_MODE. The output is compatible to the input parser
+to reconstruct the tree.
 */
 func (n *Node) Stringify() string {
+	return n.StringifyForMode(DEFAULT_SHARED_ELEMENT_INHERITANCE_MODE)
+}
+
+/*
+Returns string representation of node tree structure under consideration of a given
+shared element inheritance mode (see SHARED_ELEMENT_INHERIT_* constants).
+*/
+func (n *Node) StringifyForMode(mode string) string {
 	// Empty node
 	if n.Left == nil && n.Right == nil && n.Entry == "" {
 		return ""
@@ -249,10 +280,8 @@ func (n *Node) Stringify() string {
 		if n.HasPrimitiveEntry() {
 			return n.Entry.(string)
 		} else {
-			// Return string of statement
-			// TODO: REVIEW TO ENSURE IT PRINTS CORRECTLY OR ADJUST TO STRINGIFY()
-			val := n.Entry.(Statement)
-			return val.String()
+			// Return IG Script representation of embedded statement
+			return stringifyStatementEntry(n)
 		}
 	}
 	// Walk the tree
@@ -260,7 +289,7 @@ func (n *Node) Stringify() string {
 
 	printSharedParentheses := false
 	// Check whether left or right shared elements exist (to ensure correct syntax (parentheses))
-	if n.GetSharedLeft() != nil || n.GetSharedRight() != nil {
+	if n.GetSharedLeftForMode(mode) != nil || n.GetSharedRightForMode(mode) != nil {
 		printSharedParentheses = true
 	}
 
@@ -270,25 +299,28 @@ func (n *Node) Stringify() string {
 		out += "("
 	}
 	// Potential left shared elements; note: explicitly checks for empty elements (e.g., "")
-	if n.GetSharedLeft() != nil && len(n.GetSharedLeft()) != 0 && n.GetSharedLeft()[0] != "" {
-		out += strings.Trim(fmt.Sprint(n.GetSharedLeft()), "[]") + " "
+	if n.GetSharedLeftForMode(mode) != nil && len(n.GetSharedLeftForMode(mode)) != 0 && n.GetSharedLeftForMode(mode)[0] != "" {
+		out += strings.Trim(fmt.Sprint(n.GetSharedLeftForMode(mode)), "[]") + " "
 	}
 	// Inner combination
 	out += "("
 	if n.Left != nil {
-		out += n.Left.Stringify()
+		out += n.Left.StringifyForMode(mode)
 	}
-	if n.LogicalOperator != "" {
+	if n.IsUnaryNegation() {
+		// Unary negation has no left side, hence no leading spacing
+		out += "[" + n.LogicalOperator + "] "
+	} else if n.LogicalOperator != "" {
 		out += " [" + n.LogicalOperator + "] " // no extra spacing on left side; due to parsing
 	}
 	if n.Right != nil {
-		out += n.Right.Stringify()
+		out += n.Right.StringifyForMode(mode)
 	}
 	// Close inner combinations
 	out += ")"
 	// Potential right shared elements; note: explicitly checks for empty elements (e.g., "")
-	if n.GetSharedRight() != nil && len(n.GetSharedRight()) != 0 && n.GetSharedRight()[0] != "" {
-		out += " " + strings.Trim(fmt.Sprint(n.GetSharedRight()), "[]")
+	if n.GetSharedRightForMode(mode) != nil && len(n.GetSharedRightForMode(mode)) != 0 && n.GetSharedRightForMode(mode)[0] != "" {
+		out += " " + strings.Trim(fmt.Sprint(n.GetSharedRightForMode(mode)), "[]")
 	}
 	// Print potentially needed closing parentheses (to balance left parentheses)
 	if printSharedParentheses {
@@ -315,7 +347,12 @@ func (n *Node) StringFlat() string {
 				out += v + " "
 			}
 		}
-		out += n.Left.StringFlat() + " " + n.LogicalOperator + " " + n.Right.StringFlat()
+		if n.IsUnaryNegation() {
+			// Unary negation only holds right side
+			out += n.LogicalOperator + " " + n.Right.StringFlat()
+		} else {
+			out += n.Left.StringFlat() + " " + n.LogicalOperator + " " + n.Right.StringFlat()
+		}
 		// Append right shared elements
 		if n.SharedRight != nil && len(n.SharedRight) != 0 && n.SharedRight[0] != "" {
 			out += " "
@@ -640,6 +677,18 @@ Returns boolean indicating success and potential error (in success case TREE_NO_
 func RemoveNodeFromTree(node *Node) (bool, NodeError) {
 
 	if node.Parent != nil {
+		// Negation without operand is void, so remove negation alongside the node
+		if node.IsNegated() {
+			negation := node.Parent
+			if negation.Parent == nil {
+				// If negation is root, it needs to be removed by caller (i.e., the tree is empty after removal)
+				errorMsg := "Attempted to remove operand of negation that is root node of tree. Node: " + node.String()
+				return false, NodeError{ErrorCode: TREE_INVALID_NODE_REMOVAL, ErrorMessage: errorMsg}
+			}
+			negation.Right = nil
+			node.Parent = nil
+			return RemoveNodeFromTree(negation)
+		}
 		// Remove parent's reference to child, and collapse tree structure if necessary
 		if node.Parent.Left == node {
 			// If the parent is a combination and the node's parent has a parent
@@ -767,8 +816,8 @@ func searchUpward(originNode *Node, lastNode *Node, targetNode *Node, opsPath []
 	// If not successful, recurse upwards, and attempt again, with reference to the explore parent as last node (to prevent repeated exploration)
 	if !response {
 
-		// Explicitly include logical operator if moving upward (if populated)
-		if lastNode.Parent.LogicalOperator != "" {
+		// Explicitly include logical operator if moving upward (if populated, and not unary negation, which is not a linkage)
+		if lastNode.Parent.LogicalOperator != "" && !lastNode.Parent.IsUnaryNegation() {
 			opsPath = append(opsPath, lastNode.Parent.LogicalOperator)
 		}
 		//Println("Search one level higher above ", lastNode.Parent)
@@ -807,8 +856,8 @@ func searchDownward(originNode *Node, lastNode *Node, startNode *Node, targetNod
 		ops = append(ops, opsPath...)
 	}
 
-	// Append start node operator
-	if startNode.LogicalOperator != "" {
+	// Append start node operator (unless unary negation, which does not link nodes)
+	if startNode.LogicalOperator != "" && !startNode.IsUnaryNegation() {
 		ops = append(ops, startNode.LogicalOperator)
 		//Println("Added logical operator ", startNode.LogicalOperator)
 	}
@@ -1037,7 +1086,12 @@ func ComponentNode(entry string, leftValue string, rightValue string, componentT
 Validates all nodes from this node downwards with respect to population as linking node or leaf node.
 */
 func (n *Node) Validate() (bool, NodeError) {
-	if n.Entry == nil && (n.Left == nil || n.Right == nil) {
+	if n.Entry == nil && n.LogicalOperator == NOT && n.Left == nil && n.Right == nil {
+		errorMsg := "Negation without operand. Node: " + fmt.Sprint(n.String())
+		return false, NodeError{ErrorCode: TREE_INVALID_TREE, ErrorMessage: errorMsg}
+	}
+	// Unary negation (e.g., ([NOT] value)) only holds right child
+	if n.Entry == nil && (n.Left == nil || n.Right == nil) && !n.IsUnaryNegation() {
 		errorMsg := "Non-leaf node, but missing specification of left and right child, " +
 			"or both. Node: " + fmt.Sprint(n.String())
 		return false, NodeError{ErrorCode: TREE_INVALID_TREE, ErrorMessage: errorMsg}
@@ -1054,14 +1108,14 @@ func (n *Node) Validate() (bool, NodeError) {
 	if !n.IsLeafNode() {
 		downwardResult := false
 		err := NodeError{}
-		// Move downwards
-		if n.Left == nil {
+		// Move downwards (unary negation does not have left child)
+		if n.Left == nil && !n.IsUnaryNegation() {
 			return false, NodeError{ErrorCode: TREE_INVALID_TREE, ErrorMessage: "Empty left node"}
-		} else {
+		} else if n.Left != nil {
 			downwardResult, err = n.Left.Validate()
-		}
-		if !downwardResult {
-			return false, err
+			if !downwardResult {
+				return false, err
+			}
 		}
 		if n.Right == nil {
 			return false, NodeError{ErrorCode: TREE_INVALID_TREE, ErrorMessage: "Empty right node"}
@@ -1126,7 +1180,7 @@ func (n *Node) CalculateStateComplexity() (int, NodeError) {
 			return stmt.CalculateComplexity().TotalStateComplexity, NodeError{ErrorCode: TREE_NO_ERROR}
 		}
 		// ... or component pair statement (i.e., extrapolated into multiple statements)
-		if reflect.TypeOf(n.Entry) == reflect.TypeOf(&Node{}) {
+		if reflect.TypeOf(n.Entry) == reflect.TypeOf([]*Node{}) {
 			stmt := n.Entry.([]*Node)[0].Entry.(*Statement)
 			return stmt.CalculateComplexity().TotalStateComplexity, NodeError{ErrorCode: TREE_NO_ERROR}
 		}
@@ -1134,6 +1188,14 @@ func (n *Node) CalculateStateComplexity() (int, NodeError) {
 		return -1, NodeError{ErrorCode: PARSING_ERROR_INVALID_TYPE_COMPLEXITY_CALCULATION,
 			ErrorMessage: "No complexity calculation possible for type " + reflect.TypeOf(n.Entry).String()}
 	}
+	// Unary negation does not introduce additional states, but inherits those of the negated element
+	if n.IsUnaryNegation() {
+		rightComplexity, err := n.Right.CalculateStateComplexity()
+		if err.ErrorCode != TREE_NO_ERROR {
+			return -1, NodeError{ErrorCode: TREE_INVALID_TREE, ErrorMessage: "Invalid tree structure in negated nested tree: " + err.ErrorMessage}
+		}
+		return rightComplexity, NodeError{ErrorCode: TREE_NO_ERROR}
+	}
 	// Check if nested elements contain complexity
 	if n.Left != nil && n.Right != nil {
 		leftComplexity, err1 := n.Left.CalculateStateComplexity()
@@ -1155,6 +1217,9 @@ func (n *Node) CalculateStateComplexity() (int, NodeError) {
 		} else if n.LogicalOperator == OR {
 			// Return sum of both, alongside additional state (their combined applicability)
 			return leftComplexity + rightComplexity + 1, NodeError{ErrorCode: TREE_NO_ERROR}
+		} else if n.LogicalOperator == NOT {
+			// Left applies while right does not (e.g., left [NOT] right), which reflects a single combined state (as for AND)
+			return leftComplexity + rightComplexity - 1, NodeError{ErrorCode: TREE_NO_ERROR}
 		}
 	}
 	return -1, NodeError{ErrorCode: TREE_INVALID_TREE, ErrorMessage: "Invalid tree structure absence of left or right leaf in combination."}
@@ -1261,6 +1326,11 @@ func (n *Node) GetLeafNodesWithoutGivenNode(aggregateImplicitLinkages bool, node
 		return returnNode
 	}
 
+	// Unary negation only holds right child, so return its leaves
+	if n.IsUnaryNegation() {
+		return n.Right.GetLeafNodesWithoutGivenNode(aggregateImplicitLinkages, nodeToBeIgnored)
+	}
+
 	// Output 2-dim arrays
 	leftNodes := [][]*Node{}
 	rightNodes := [][]*Node{}
@@ -1325,6 +1395,26 @@ func (n *Node) GetTopLevelStatementNodes() []*Node {
 	return findTopLevelStatementBelowNode(root, []*Node{})
 }
 
+/*
+Returns the statement embedded in the given node, irrespective of whether it is held by reference or as
+statement extrapolated from component pair combinations (i.e., as first element of the node collection
+returned by #GetTopLevelStatementNodes()). Returns nil if the node does not embed a statement.
+*/
+func (n *Node) GetEmbeddedStatement() *Statement {
+	if n == nil {
+		return nil
+	}
+	switch entry := n.Entry.(type) {
+	case *Statement:
+		return entry
+	case []*Node:
+		if len(entry) > 0 {
+			return entry[0].GetEmbeddedStatement()
+		}
+	}
+	return nil
+}
+
 /*
 Retrieves top-level statements in nodes on or below given node.
 
@@ -1504,12 +1594,28 @@ func (n *Node) IsLeafNode() bool {
 
 /*
 Indicates whether node contains valid combination (i.e., left and right and logical operator are populated).
+Unary negations (e.g., ([NOT] value)) are considered combinations with right child only.
 */
 func (n *Node) IsCombination() bool {
-	return n.Entry == nil && !n.Left.IsNil() &&
+	return n.Entry == nil && (!n.Left.IsNil() || n.LogicalOperator == NOT) &&
 		!n.Right.IsNil() && n.LogicalOperator != ""
 }
 
+/*
+Indicates whether node is unary negation (e.g., ([NOT] value)), i.e., holds logical operator NOT and right child only.
+*/
+func (n *Node) IsUnaryNegation() bool {
+	return n != nil && n.Entry == nil && n.LogicalOperator == NOT &&
+		n.Left.IsNil() && !n.Right.IsNil()
+}
+
+/*
+Indicates whether node is negated by parent, i.e., is the operand of a unary negation.
+*/
+func (n *Node) IsNegated() bool {
+	return n != nil && n.Parent != nil && n.Parent.IsUnaryNegation() && n.Parent.Right == n
+}
+
 /*
 Indicates whether node has populated logical operator, but does not check for proper assignment of left and right children.
 */
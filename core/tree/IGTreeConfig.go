@@ -1,17 +1,11 @@
 package tree
 
 /*
-Indicates inheritance mode of shared elements from parent to children nodes (see constants below)
+Default inheritance mode of shared elements from parent to children nodes (see constants below).
+Used by #Node.GetSharedLeft() and #Node.GetSharedRight(); output generation relies on
+Options.SharedElementInheritanceMode instead.
 */
-var SHARED_ELEMENT_INHERITANCE_MODE = SHARED_ELEMENT_INHERIT_APPEND
-
-/*
-Indicates whether implicitly linked components (e.g., multiple Cex annotations) should be aggregated
-into single tree, or be returned as separate trees (based on the implicit AND operator)
-NOTE: Interacts closely with create_DYNAMIC_TABULAR_OUTPUT variable
-(accessible via SetDynamicOutput() and ProduceDynamicOutput()) in TabularOutputGeneratorConfig.go
-*/
-var AGGREGATE_IMPLICIT_LINKAGES = true
+const DEFAULT_SHARED_ELEMENT_INHERITANCE_MODE = SHARED_ELEMENT_INHERIT_APPEND
 
 /*
  Disables inheriting shared elements from parent nodes, not even combination nodes embedding the entity.
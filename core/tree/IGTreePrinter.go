@@ -30,6 +30,8 @@ const TREE_PRINTER_KEY_CHILDREN = "\"children\""
 const TREE_PRINTER_KEY_PROPERTIES = "\"prop\""
 const TREE_PRINTER_KEY_ANNOTATIONS = "\"anno\""
 const TREE_PRINTER_KEY_COMPLEXITY = "\"dov\""
+const TREE_PRINTER_KEY_STATEMENT_TYPE = "\"type\""
+const TREE_PRINTER_KEY_DIFF = "\"diff\""
 
 // Separator
 const TREE_PRINTER_EQUALS = ": "
@@ -37,6 +39,10 @@ const TREE_PRINTER_EQUALS = ": "
 // Values
 const TREE_PRINTER_VAL_POSITION_BELOW = "\"b\""
 
+// Names of nodes holding the compared encodings in visual diff output (see PrintDiffTree())
+const TREE_PRINTER_VAL_DIFF_BASE = "Base"
+const TREE_PRINTER_VAL_DIFF_REVISED = "Revised"
+
 // Collection delimiters
 const TREE_PRINTER_COLLECTION_OPEN = "["
 const TREE_PRINTER_COLLECTION_CLOSE = "]"
@@ -44,20 +50,19 @@ const TREE_PRINTER_COLLECTION_CLOSE = "]"
 /*
 Prints JSON output format compatible with tree visualization in D3.
 Takes parent node as input for label generation (component name).
-Allows indication for flat printing (nested property tree structure vs. flat listing of properties).
-Allows indication for printing of binary trees, as opposed to tree aggregated by logical operators for given component.
-Allows indication whether annotations should be included in output (as labels).
-Allows indication whether degree of variability should be included in output (as labels).
-Allows indication as to whether activation conditions should be moved to the beginning of the visual tree output
+Takes options (opts) indicating flat printing (nested property tree structure vs. flat listing of properties),
+printing of binary trees (as opposed to tree aggregated by logical operators for given component),
+inclusion of annotations, degree of variability and statement type in output (as labels), inclusion of shared elements,
+as well as whether activation conditions should be moved to the beginning of the visual tree output.
 Requires specification of nesting level the nodes exists on (Default: 0).
 This function is tested in TabularOutputGenerator_test.go, i.e., tests with focus on visual tree output.
 */
-func (s *Statement) PrintTree(parent *Node, printFlat bool, printBinary bool, includeAnnotations bool, includeDegreeOfVariability bool, moveActivationConditionsToFront bool, nestingLevel int) (strings.Builder, NodeError) {
+func (s *Statement) PrintTree(parent *Node, opts Options, nestingLevel int) (strings.Builder, NodeError) {
 
 	// Default name if statement does not have root node
 	rootName := ""
 
-	if includeDegreeOfVariability {
+	if opts.IncludeDegreeOfVariability {
 		// Use root node label for complexity output
 		rootName = "DoV: " + strconv.Itoa(s.CalculateComplexity().TotalStateComplexity)
 	}
@@ -88,15 +93,28 @@ func (s *Statement) PrintTree(parent *Node, printFlat bool, printBinary bool, in
 	out.WriteString(", ")
 
 	// TODO CHECK ON ROOT NODE - Append annotations for root node if activated (and existing)
-	if includeAnnotations {
+	if opts.IncludeAnnotations {
 		out.WriteString(parent.appendAnnotations("", false, true))
 	}
 
 	// TODO CHECK ON ROOT NODE - Append Degree of Variability
-	if includeDegreeOfVariability {
+	if opts.IncludeDegreeOfVariability {
 		out.WriteString(parent.appendDegreeOfVariability("", false, true))
 	}
 
+	// Append statement type (see #Statement.Classify())
+	if opts.IncludeStatementType {
+		out.WriteString(TREE_PRINTER_KEY_STATEMENT_TYPE)
+		out.WriteString(TREE_PRINTER_EQUALS)
+		out.WriteString("\"")
+		out.WriteString(s.Classify().String())
+		out.WriteString("\"")
+		out.WriteString(", ")
+	}
+
+	// Append diff status of node embedding statement (if existing)
+	out.WriteString(parent.appendDiffStatus("", false, true))
+
 	// Line break to separate children visually
 	out.WriteString(TREE_PRINTER_LINEBREAK)
 
@@ -106,7 +124,7 @@ func (s *Statement) PrintTree(parent *Node, printFlat bool, printBinary bool, in
 	components := []*Node{}
 
 	// Move activation conditions to front if selected
-	if moveActivationConditionsToFront {
+	if opts.MoveActivationConditionsToFront {
 		components = append(components, s.ActivationConditionSimple, s.ActivationConditionComplex)
 	}
 
@@ -140,7 +158,7 @@ func (s *Statement) PrintTree(parent *Node, printFlat bool, printBinary bool, in
 	)
 
 	// Move activation conditions in the idiomatic ADIBCO position
-	if !moveActivationConditionsToFront {
+	if !opts.MoveActivationConditionsToFront {
 		components = append(components, s.ActivationConditionSimple, s.ActivationConditionComplex)
 	}
 
@@ -164,7 +182,7 @@ func (s *Statement) PrintTree(parent *Node, printFlat bool, printBinary bool, in
 				prepend = TREE_PRINTER_SEPARATOR
 			}
 			// Generate actual entry
-			componentString, err := v.PrintNodeTree(s, printFlat, printBinary, includeAnnotations, includeDegreeOfVariability, moveActivationConditionsToFront, nestingLevel)
+			componentString, err := v.PrintNodeTree(s, opts, nestingLevel)
 			if err.ErrorCode != TREE_NO_ERROR {
 				Println("Error when parsing component", v, "-- Error:", err)
 				// Special case of NodeError being embedded in ParsingError
@@ -199,14 +217,10 @@ func (s *Statement) PrintTree(parent *Node, printFlat bool, printBinary bool, in
 
 /*
 Returns JSON output for visual tree rendering of individual nodes using D3.
-Allows indication for flat printing (nested property tree structure vs. flat listing of properties).
-Allows indication for printing of binary trees, as opposed to tree aggregated by logical operators for given component.
-Allows indication for including annotations in output (as labels).
-Allows indication of Degree of Variability in output (as labels).
-Allows indication as to whether activation conditions should be moved to the beginning of the visual tree output
+Takes options (opts) controlling the output (see #Statement.PrintTree()).
 Requires specification of nesting level for node exists on (Default: 0).
 */
-func (n *Node) PrintNodeTree(stmt *Statement, printFlat bool, printBinary bool, includeAnnotations bool, includeDegreeOfVariability bool, moveActivationConditionsToFront bool, nestingLevel int) (string, NodeError) {
+func (n *Node) PrintNodeTree(stmt *Statement, opts Options, nestingLevel int) (string, NodeError) {
 	out := strings.Builder{}
 
 	if !n.IsNil() && !n.IsEmptyOrNilNode() {
@@ -226,8 +240,8 @@ func (n *Node) PrintNodeTree(stmt *Statement, printFlat bool, printBinary bool,
 				out.WriteString("\"")
 				outEntry := ""
 				// Check for left shared elements
-				if IncludeSharedElementsInVisualOutput() && n.GetSharedLeft() != nil {
-					outLeft := shared.StringifySlices(n.GetSharedLeft())
+				if opts.IncludeSharedElementsInVisualOutput && n.GetSharedLeftForMode(opts.SharedElementInheritanceMode) != nil {
+					outLeft := shared.StringifySlices(n.GetSharedLeftForMode(opts.SharedElementInheritanceMode))
 					if outLeft != "" {
 						// If non-empty, prepend left shared part and separate from main entry value
 						outEntry += outLeft + " "
@@ -236,8 +250,8 @@ func (n *Node) PrintNodeTree(stmt *Statement, printFlat bool, printBinary bool,
 				// Append actual entry
 				outEntry += n.Entry.(string)
 				// Check for right shared elements
-				if IncludeSharedElementsInVisualOutput() && n.GetSharedRight() != nil {
-					outRight := shared.StringifySlices(n.GetSharedRight())
+				if opts.IncludeSharedElementsInVisualOutput && n.GetSharedRightForMode(opts.SharedElementInheritanceMode) != nil {
+					outRight := shared.StringifySlices(n.GetSharedRightForMode(opts.SharedElementInheritanceMode))
 					if outRight != "" {
 						// If non-empty, append right shared part and separate from main entry value
 						outEntry += " " + outRight
@@ -252,17 +266,17 @@ func (n *Node) PrintNodeTree(stmt *Statement, printFlat bool, printBinary bool,
 			} else if n.IsCombination() {
 				// Produce output for combination
 
-				if printBinary {
+				if opts.BinaryPrinting {
 					// Fall back to full entry parsing either way - resolving full binary tree structure
 					printFullEntry = true
 				} else {
 					// If non-binary, print only leaf entries linked via same logical operator on same component
-					// without considering logical operators in output
-					if n.Parent != nil && n.LogicalOperator == n.Parent.LogicalOperator &&
+					// without considering logical operators in output (does not apply to negation, which is not associative)
+					if n.Parent != nil && n.LogicalOperator != NOT && n.LogicalOperator == n.Parent.LogicalOperator &&
 						n.GetComponentName() == n.Parent.GetComponentName() {
 
 						// Print left side
-						outTmpL, err := n.Left.PrintNodeTree(stmt, printFlat, printBinary, includeAnnotations, includeDegreeOfVariability, moveActivationConditionsToFront, nestingLevel)
+						outTmpL, err := n.Left.PrintNodeTree(stmt, opts, nestingLevel)
 						if err.ErrorCode != TREE_NO_ERROR {
 							return out.String(), err
 						}
@@ -273,7 +287,7 @@ func (n *Node) PrintNodeTree(stmt *Statement, printFlat bool, printBinary bool,
 						out.WriteString(", ")
 
 						// Print right side
-						outTmpR, err := n.Right.PrintNodeTree(stmt, printFlat, printBinary, includeAnnotations, includeDegreeOfVariability, moveActivationConditionsToFront, nestingLevel)
+						outTmpR, err := n.Right.PrintNodeTree(stmt, opts, nestingLevel)
 						if err.ErrorCode != TREE_NO_ERROR {
 							return out.String(), err
 						}
@@ -304,19 +318,21 @@ func (n *Node) PrintNodeTree(stmt *Statement, printFlat bool, printBinary bool,
 					out.WriteString(TREE_PRINTER_EQUALS)
 					out.WriteString(TREE_PRINTER_COLLECTION_OPEN)
 
-					// Left child
-					outTmpL, err := n.Left.PrintNodeTree(stmt, printFlat, printBinary, includeAnnotations, includeDegreeOfVariability, moveActivationConditionsToFront, nestingLevel)
-					if err.ErrorCode != TREE_NO_ERROR {
-						return out.String(), err
-					}
-					// Append if successful parsing
-					out.WriteString(outTmpL)
+					// Left child (not present for unary negation)
+					if !n.IsUnaryNegation() {
+						outTmpL, err := n.Left.PrintNodeTree(stmt, opts, nestingLevel)
+						if err.ErrorCode != TREE_NO_ERROR {
+							return out.String(), err
+						}
+						// Append if successful parsing
+						out.WriteString(outTmpL)
 
-					// Add separator
-					out.WriteString(TREE_PRINTER_SEPARATOR)
+						// Add separator
+						out.WriteString(TREE_PRINTER_SEPARATOR)
+					}
 
 					// Right child
-					outTmpR, err := n.Right.PrintNodeTree(stmt, printFlat, printBinary, includeAnnotations, includeDegreeOfVariability, moveActivationConditionsToFront, nestingLevel)
+					outTmpR, err := n.Right.PrintNodeTree(stmt, opts, nestingLevel)
 					if err.ErrorCode != TREE_NO_ERROR {
 						return out.String(), err
 					}
@@ -346,21 +362,24 @@ func (n *Node) PrintNodeTree(stmt *Statement, printFlat bool, printBinary bool,
 				out.WriteString(strconv.Itoa(nestingLevel))
 
 				// Print private properties
-				outTmp, err := n.appendPropertyNodes("", stmt, printFlat, printBinary, includeAnnotations, includeDegreeOfVariability, moveActivationConditionsToFront, nestingLevel)
+				outTmp, err := n.appendPropertyNodes("", stmt, opts, nestingLevel)
 				if err.ErrorCode != TREE_NO_ERROR {
 					return out.String(), err
 				}
 
 				// Append annotations (if existing)
-				if includeAnnotations {
+				if opts.IncludeAnnotations {
 					outTmp = n.appendAnnotations(outTmp, true, false)
 				}
 
 				// Append complexity
-				if includeDegreeOfVariability {
+				if opts.IncludeDegreeOfVariability {
 					outTmp = n.appendDegreeOfVariability(outTmp, true, false)
 				}
 
+				// Append diff status (if existing)
+				outTmp = n.appendDiffStatus(outTmp, true, false)
+
 				out.WriteString(outTmp)
 
 				// Close entry
@@ -371,7 +390,7 @@ func (n *Node) PrintNodeTree(stmt *Statement, printFlat bool, printBinary bool,
 
 			// ... prints nested statements (e.g., in nested components and component combinations)
 			if reflect.TypeOf(n.Entry) == reflect.TypeOf(&Statement{}) {
-				outTmp, err := n.Entry.(*Statement).PrintTree(n, printFlat, printBinary, includeAnnotations, includeDegreeOfVariability, moveActivationConditionsToFront, nestingLevel+1)
+				outTmp, err := n.Entry.(*Statement).PrintTree(n, opts, nestingLevel+1)
 				if err.ErrorCode != TREE_NO_ERROR { // Important check on return value - different from all others in the function
 					// Special case of NodeError embedding a ParsingError produced in nested invocation.
 					return out.String(), NodeError{ErrorCode: TREE_ERROR_EMBEDDED_PARSING_ERROR, ErrorMessage: err.ErrorMessage}
@@ -380,7 +399,7 @@ func (n *Node) PrintNodeTree(stmt *Statement, printFlat bool, printBinary bool,
 
 				// ... prints nested nodes (relevant for statement combinations)
 			} else if reflect.TypeOf(n.Entry) == reflect.TypeOf([]*Node{}) {
-				outTmp, err := n.Entry.([]*Node)[0].Entry.(*Statement).PrintTree(n, printFlat, printBinary, includeAnnotations, includeDegreeOfVariability, moveActivationConditionsToFront, nestingLevel+1)
+				outTmp, err := n.Entry.([]*Node)[0].Entry.(*Statement).PrintTree(n, opts, nestingLevel+1)
 				if err.ErrorCode != TREE_NO_ERROR { // Important check on return value - different from all others in the function
 					// Special case of NodeError embedding a ParsingError produced in nested invocation.
 					return out.String(), NodeError{ErrorCode: TREE_ERROR_EMBEDDED_PARSING_ERROR, ErrorMessage: err.ErrorMessage}
@@ -399,19 +418,16 @@ Appends shared and private nodes to D3-consumable JSON output string based on re
 The shared and private property nodes are combined in the order "shared, private".
 Note: In flat output mode only primitive private properties are included in the rendered output.
 Flat output implies the printing of private properties as labels for component nodes, rather than an own node hierarchy.
-Allows indication for printing of binary trees, as opposed to tree aggregated by logical operators for given component.
-Includes indication whether annotations are to be included in output.
-Includes indication whether Degree of Variability is to be included in output.
-Allows indication as to whether activation conditions should be moved to the beginning of the visual tree output
+Takes options (opts) controlling the output (see #Statement.PrintTree()).
 Requires specification of nesting level the property node lies on (Lowest level: 0)
 */
-func (n *Node) appendPropertyNodes(stringToPrepend string, stmt *Statement, printFlat bool, printBinary bool, includeAnnotations bool, includeDegreeOfVariability bool, moveActivationConditionsToFront bool, nestingLevel int) (string, NodeError) {
+func (n *Node) appendPropertyNodes(stringToPrepend string, stmt *Statement, opts Options, nestingLevel int) (string, NodeError) {
 
 	stringToAppendTo := strings.Builder{}
 	stringToAppendTo.WriteString(stringToPrepend)
 
 	// Append potential private and shared property nodes under the condition that those nodes are leaf nodes, or if flat printing is activated
-	if n != nil && (n.IsLeafNode() || printFlat) &&
+	if n != nil && (n.IsLeafNode() || opts.FlatPrinting) &&
 		// Check for shared and private properties
 		len(stmt.GetPropertyComponent(n, true)) > 0 || (len(n.PrivateNodeLinks) > 0 && n.PrivateNodeLinks[0] != nil) {
 
@@ -467,7 +483,7 @@ func (n *Node) appendPropertyNodes(stringToPrepend string, stmt *Statement, prin
 				// Initiate entry structure
 				if !elementPrinted {
 					// Start general output for property only if nothing is printed yet
-					if printFlat {
+					if opts.FlatPrinting {
 						// Initiate flat output for properties
 						stringToAppendTo.WriteString(", ")
 						stringToAppendTo.WriteString(TREE_PRINTER_KEY_PROPERTIES)
@@ -490,13 +506,13 @@ func (n *Node) appendPropertyNodes(stringToPrepend string, stmt *Statement, prin
 				if elementPrinted {
 					// Add separator if element has been added
 					stringToAppendTo.WriteString(", ")
-				} else if printFlat {
+				} else if opts.FlatPrinting {
 					// Prepend quotation
 					stringToAppendTo.WriteString("\"")
 				}
 
 				// Print per-property entry
-				if printFlat {
+				if opts.FlatPrinting {
 					// Consider distinct treatment for complex or primitive properties in the case of flat printing
 					if privateNode.IsCombination() {
 						// Decompose and print combinations
@@ -528,6 +544,10 @@ func (n *Node) appendPropertyNodes(stringToPrepend string, stmt *Statement, prin
 									v.Entry = elem
 								}
 
+								// Indicate negated entries (e.g., ([NOT] value))
+								if v.IsNegated() {
+									stringToAppendTo.WriteString(NOT + " ")
+								}
 								// Append each entry individually as string
 								stringToAppendTo.WriteString(shared.EscapeSymbolsForExport(v.Entry.(string)))
 								entryAdded = true
@@ -542,7 +562,7 @@ func (n *Node) appendPropertyNodes(stringToPrepend string, stmt *Statement, prin
 					}
 				} else {
 					// If no flat printing, append complete nested tree structure (property tree)
-					stringTmp, err := privateNode.PrintNodeTree(stmt, printFlat, printBinary, includeAnnotations, includeDegreeOfVariability, moveActivationConditionsToFront, nestingLevel)
+					stringTmp, err := privateNode.PrintNodeTree(stmt, opts, nestingLevel)
 					if err.ErrorCode != TREE_NO_ERROR {
 						return "", err
 					}
@@ -554,7 +574,7 @@ func (n *Node) appendPropertyNodes(stringToPrepend string, stmt *Statement, prin
 
 			// Handle termination of entries
 			if elementPrinted {
-				if printFlat {
+				if opts.FlatPrinting {
 					// Close flat entry
 					stringToAppendTo.WriteString("\"")
 				} else {
@@ -630,3 +650,91 @@ func (n *Node) appendDegreeOfVariability(stringToPrepend string, prependSeparato
 	// Return potentially extended string
 	return stringToAppendTo.String()
 }
+
+/*
+Appends diff status of node (see Node.DiffStatus) to node-specific output, if populated.
+Input is the string to be appended to (stringToAppendTo), as well as a parameter indicating whether
+termination separator (", ") should be added (either prepended, appended, or both) if the status is added.
+*/
+func (n *Node) appendDiffStatus(stringToPrepend string, prependSeparator bool, appendSeparator bool) string {
+
+	stringToAppendTo := strings.Builder{}
+	stringToAppendTo.WriteString(stringToPrepend)
+
+	if n != nil && n.DiffStatus != "" {
+		if prependSeparator {
+			stringToAppendTo.WriteString(", ")
+		}
+		stringToAppendTo.WriteString(TREE_PRINTER_KEY_DIFF)
+		stringToAppendTo.WriteString(TREE_PRINTER_EQUALS)
+		stringToAppendTo.WriteString("\"")
+		stringToAppendTo.WriteString(n.DiffStatus)
+		stringToAppendTo.WriteString("\"")
+		if appendSeparator {
+			stringToAppendTo.WriteString(", ")
+		}
+	}
+	// Return potentially extended string
+	return stringToAppendTo.String()
+}
+
+/*
+Returns JSON output for visual tree rendering of two encodings of a statement using D3, with the trees of
+both encodings (see #Node.PrintNodeTree()) being nested in nodes named TREE_PRINTER_VAL_DIFF_BASE and
+TREE_PRINTER_VAL_DIFF_REVISED. Nodes marked with a diff status (see Node.DiffStatus) hold the status in
+the output, which allows for the highlighting of added, removed and changed nodes.
+Takes the root nodes of both encodings (as returned by the parser) and options (opts) controlling the output
+(see #Statement.PrintTree()).
+*/
+func PrintDiffTree(base *Node, revised *Node, opts Options) (string, NodeError) {
+
+	out := strings.Builder{}
+	out.WriteString(TREE_PRINTER_OPEN_BRACE)
+	out.WriteString(TREE_PRINTER_KEY_NAME)
+	out.WriteString(TREE_PRINTER_EQUALS)
+	out.WriteString("\"\"")
+	out.WriteString(", ")
+	out.WriteString(TREE_PRINTER_KEY_NESTING_LEVEL)
+	out.WriteString(TREE_PRINTER_EQUALS)
+	out.WriteString("0")
+	out.WriteString(", ")
+	out.WriteString(TREE_PRINTER_KEY_CHILDREN)
+	out.WriteString(TREE_PRINTER_EQUALS)
+	out.WriteString(TREE_PRINTER_COLLECTION_OPEN)
+	out.WriteString(TREE_PRINTER_LINEBREAK)
+
+	for i, encoding := range []*Node{base, revised} {
+		name := TREE_PRINTER_VAL_DIFF_BASE
+		if i > 0 {
+			out.WriteString(TREE_PRINTER_SEPARATOR)
+			name = TREE_PRINTER_VAL_DIFF_REVISED
+		}
+		encodingString, err := encoding.PrintNodeTree(nil, opts, 0)
+		if err.ErrorCode != TREE_NO_ERROR {
+			return out.String(), err
+		}
+		out.WriteString(TREE_PRINTER_OPEN_BRACE)
+		out.WriteString(TREE_PRINTER_KEY_NAME)
+		out.WriteString(TREE_PRINTER_EQUALS)
+		out.WriteString("\"" + name + "\"")
+		out.WriteString(", ")
+		out.WriteString(TREE_PRINTER_KEY_NESTING_LEVEL)
+		out.WriteString(TREE_PRINTER_EQUALS)
+		out.WriteString("0")
+		out.WriteString(", ")
+		out.WriteString(TREE_PRINTER_KEY_CHILDREN)
+		out.WriteString(TREE_PRINTER_EQUALS)
+		out.WriteString(TREE_PRINTER_COLLECTION_OPEN)
+		out.WriteString(TREE_PRINTER_LINEBREAK)
+		out.WriteString(encodingString)
+		out.WriteString(TREE_PRINTER_LINEBREAK)
+		out.WriteString(TREE_PRINTER_COLLECTION_CLOSE)
+		out.WriteString(TREE_PRINTER_CLOSE_BRACE)
+	}
+
+	out.WriteString(TREE_PRINTER_LINEBREAK)
+	out.WriteString(TREE_PRINTER_COLLECTION_CLOSE)
+	out.WriteString(TREE_PRINTER_CLOSE_BRACE)
+
+	return out.String(), NodeError{ErrorCode: TREE_NO_ERROR}
+}
@@ -533,7 +533,7 @@ Test for inheriting shared elements using the append inheritance mode.
 */
 func TestExtractInheritAppend(t *testing.T) {
 
-	SHARED_ELEMENT_INHERITANCE_MODE = SHARED_ELEMENT_INHERIT_APPEND
+	mode := SHARED_ELEMENT_INHERIT_APPEND
 
 	root := Node{}
 	root.SharedLeft = []string{"Shared top left"}
@@ -581,27 +581,27 @@ func TestExtractInheritAppend(t *testing.T) {
 
 	fmt.Println(root.String())
 
-	if len(leftLeftChild.GetSharedLeft()) != 2 {
+	if len(leftLeftChild.GetSharedLeftForMode(mode)) != 2 {
 		t.Fatal("Left left child does not have the correct number of shared left elements")
 	}
 
-	if leftLeftChild.GetSharedLeft()[0] != "Shared top left" {
+	if leftLeftChild.GetSharedLeftForMode(mode)[0] != "Shared top left" {
 		t.Fatal("Left left child's first element is incorrect.")
 	}
 
-	if leftLeftChild.GetSharedLeft()[1] != "Shared middle left" {
+	if leftLeftChild.GetSharedLeftForMode(mode)[1] != "Shared middle left" {
 		t.Fatal("Left left child's second element is incorrect.")
 	}
 
-	if len(rightLeftChild.GetSharedRight()) != 2 {
-		t.Fatal("Right left child does not have the correct number of shared right elements: ", rightLeftChild.GetSharedRight())
+	if len(rightLeftChild.GetSharedRightForMode(mode)) != 2 {
+		t.Fatal("Right left child does not have the correct number of shared right elements: ", rightLeftChild.GetSharedRightForMode(mode))
 	}
 
-	if rightLeftChild.GetSharedRight()[0] != "Shared top right" {
+	if rightLeftChild.GetSharedRightForMode(mode)[0] != "Shared top right" {
 		t.Fatal("Right left child's first element is incorrect.")
 	}
 
-	if rightLeftChild.GetSharedRight()[1] != "Shared middle right" {
+	if rightLeftChild.GetSharedRightForMode(mode)[1] != "Shared middle right" {
 		t.Fatal("Left left child's second element is incorrect.")
 	}
 
@@ -612,7 +612,7 @@ Test for inheriting shared elements using the overrid inheritance mode.
 */
 func TestExtractInheritOverride(t *testing.T) {
 
-	SHARED_ELEMENT_INHERITANCE_MODE = SHARED_ELEMENT_INHERIT_OVERRIDE
+	mode := SHARED_ELEMENT_INHERIT_OVERRIDE
 
 	root := Node{}
 	root.SharedLeft = []string{"Shared top left"}
@@ -660,27 +660,27 @@ func TestExtractInheritOverride(t *testing.T) {
 
 	fmt.Println(root.String())
 
-	if len(leftLeftChild.GetSharedLeft()) != 2 {
+	if len(leftLeftChild.GetSharedLeftForMode(mode)) != 2 {
 		t.Fatal("Left left child does not have the correct number of shared left elements")
 	}
 
-	if leftLeftChild.GetSharedLeft()[0] != "Shared top left" {
+	if leftLeftChild.GetSharedLeftForMode(mode)[0] != "Shared top left" {
 		t.Fatal("Left left child's first element is incorrect.")
 	}
 
-	if leftLeftChild.GetSharedLeft()[1] != "Shared middle left" {
+	if leftLeftChild.GetSharedLeftForMode(mode)[1] != "Shared middle left" {
 		t.Fatal("Left left child's second element is incorrect.")
 	}
 
-	if len(rightLeftChild.GetSharedRight()) != 2 {
-		t.Fatal("Right left child does not have the correct number of shared right elements: ", rightLeftChild.GetSharedRight())
+	if len(rightLeftChild.GetSharedRightForMode(mode)) != 2 {
+		t.Fatal("Right left child does not have the correct number of shared right elements: ", rightLeftChild.GetSharedRightForMode(mode))
 	}
 
-	if rightLeftChild.GetSharedRight()[0] != "Shared top right" {
+	if rightLeftChild.GetSharedRightForMode(mode)[0] != "Shared top right" {
 		t.Fatal("Right left child's first element is incorrect.")
 	}
 
-	if rightLeftChild.GetSharedRight()[1] != "Shared middle right" {
+	if rightLeftChild.GetSharedRightForMode(mode)[1] != "Shared middle right" {
 		t.Fatal("Left left child's second element is incorrect.")
 	}
 
@@ -691,7 +691,7 @@ Test for inheriting shared elements using the override inheritance mode, even if
 */
 func TestExtractInheritOverrideChildElement(t *testing.T) {
 
-	SHARED_ELEMENT_INHERITANCE_MODE = SHARED_ELEMENT_INHERIT_OVERRIDE
+	mode := SHARED_ELEMENT_INHERIT_OVERRIDE
 
 	root := Node{}
 	root.SharedLeft = []string{"Shared top left"}
@@ -740,27 +740,27 @@ func TestExtractInheritOverrideChildElement(t *testing.T) {
 
 	fmt.Println(root.String())
 
-	if len(leftLeftChild.GetSharedLeft()) != 2 {
+	if len(leftLeftChild.GetSharedLeftForMode(mode)) != 2 {
 		t.Fatal("Left left child does not have the correct number of shared left elements")
 	}
 
-	if leftLeftChild.GetSharedLeft()[0] != "Shared top left" {
+	if leftLeftChild.GetSharedLeftForMode(mode)[0] != "Shared top left" {
 		t.Fatal("Left left child's first element is incorrect.")
 	}
 
-	if leftLeftChild.GetSharedLeft()[1] != "Shared middle left" {
+	if leftLeftChild.GetSharedLeftForMode(mode)[1] != "Shared middle left" {
 		t.Fatal("Left left child's second element is incorrect.")
 	}
 
-	if len(rightLeftChild.GetSharedRight()) != 2 {
-		t.Fatal("Right left child does not have the correct number of shared right elements: ", rightLeftChild.GetSharedRight())
+	if len(rightLeftChild.GetSharedRightForMode(mode)) != 2 {
+		t.Fatal("Right left child does not have the correct number of shared right elements: ", rightLeftChild.GetSharedRightForMode(mode))
 	}
 
-	if rightLeftChild.GetSharedRight()[0] != "Shared top right" {
+	if rightLeftChild.GetSharedRightForMode(mode)[0] != "Shared top right" {
 		t.Fatal("Right left child's first element is incorrect.")
 	}
 
-	if rightLeftChild.GetSharedRight()[1] != "Shared middle right" {
+	if rightLeftChild.GetSharedRightForMode(mode)[1] != "Shared middle right" {
 		t.Fatal("Left left child's second element is incorrect.")
 	}
 
@@ -772,7 +772,7 @@ Test for inheriting shared elements using the no inheritance mode, even if child
 func TestExtractInheritNoOverride(t *testing.T) {
 
 	// Nothing is inherited, only content of leaf node
-	SHARED_ELEMENT_INHERITANCE_MODE = SHARED_ELEMENT_INHERIT_NOTHING
+	mode := SHARED_ELEMENT_INHERIT_NOTHING
 
 	root := Node{}
 	root.SharedLeft = []string{"Shared top left"}
@@ -823,15 +823,15 @@ func TestExtractInheritNoOverride(t *testing.T) {
 
 	fmt.Println(root.String())
 
-	if len(leftLeftChild.GetSharedLeft()) != 0 {
-		t.Fatal("Left left child does not have the correct number of shared left elements: ", leftLeftChild.GetSharedLeft())
+	if len(leftLeftChild.GetSharedLeftForMode(mode)) != 0 {
+		t.Fatal("Left left child does not have the correct number of shared left elements: ", leftLeftChild.GetSharedLeftForMode(mode))
 	}
 
-	if len(rightLeftChild.GetSharedRight()) != 1 {
-		t.Fatal("Right left child does not have the correct number of shared right elements: ", rightLeftChild.GetSharedRight())
+	if len(rightLeftChild.GetSharedRightForMode(mode)) != 1 {
+		t.Fatal("Right left child does not have the correct number of shared right elements: ", rightLeftChild.GetSharedRightForMode(mode))
 	}
 
-	if rightLeftChild.GetSharedRight()[0] != "right left leaf shared entry" {
+	if rightLeftChild.GetSharedRightForMode(mode)[0] != "right left leaf shared entry" {
 		t.Fatal("Right left child's first element is incorrect.")
 	}
 
@@ -843,7 +843,7 @@ Test for inheriting shared elements using the inheritance from next higher combi
 func TestExtractInheritFromNextHigherCombination(t *testing.T) {
 
 	// Only elements from next-higher combination are inherited (including leaf-level elements)
-	SHARED_ELEMENT_INHERITANCE_MODE = SHARED_ELEMENT_INHERIT_FROM_COMBINATION
+	mode := SHARED_ELEMENT_INHERIT_FROM_COMBINATION
 
 	root := Node{}
 	root.SharedLeft = []string{"Shared top left"}
@@ -894,23 +894,23 @@ func TestExtractInheritFromNextHigherCombination(t *testing.T) {
 
 	fmt.Println(root.String())
 
-	if len(leftLeftChild.GetSharedLeft()) != 1 {
-		t.Fatal("Left left child does not have the correct number of shared left elements: ", leftLeftChild.GetSharedLeft())
+	if len(leftLeftChild.GetSharedLeftForMode(mode)) != 1 {
+		t.Fatal("Left left child does not have the correct number of shared left elements: ", leftLeftChild.GetSharedLeftForMode(mode))
 	}
 
-	if leftLeftChild.GetSharedLeft()[0] != "Shared middle left" {
+	if leftLeftChild.GetSharedLeftForMode(mode)[0] != "Shared middle left" {
 		t.Fatal("Left left child's first element is incorrect.")
 	}
 
-	if len(rightLeftChild.GetSharedRight()) != 2 {
-		t.Fatal("Right left child does not have the correct number of shared right elements: ", rightLeftChild.GetSharedRight())
+	if len(rightLeftChild.GetSharedRightForMode(mode)) != 2 {
+		t.Fatal("Right left child does not have the correct number of shared right elements: ", rightLeftChild.GetSharedRightForMode(mode))
 	}
 
-	if rightLeftChild.GetSharedRight()[0] != "Shared middle right" {
+	if rightLeftChild.GetSharedRightForMode(mode)[0] != "Shared middle right" {
 		t.Fatal("Right left child's first element is incorrect.")
 	}
 
-	if rightLeftChild.GetSharedRight()[1] != "right left leaf shared entry" {
+	if rightLeftChild.GetSharedRightForMode(mode)[1] != "right left leaf shared entry" {
 		t.Fatal("Right left child's second element is incorrect.")
 	}
 
@@ -1387,6 +1387,130 @@ func TestNode_CalculateStateComplexity(t *testing.T) {
 
 }
 
+/*
+Tests calculation of state complexity for combinations of component pairs (i.e., leaves embedding statements in node arrays)
+*/
+func TestNode_CalculateStateComplexityComponentPairs(t *testing.T) {
+
+	aimLeft := Node{LogicalOperator: XOR}
+	aimLeft.InsertLeftNode(&Node{Entry: "sell"})
+	aimLeft.InsertRightNode(&Node{Entry: "buy"})
+	left := Node{Entry: []*Node{{Entry: &Statement{Attributes: &Node{Entry: "farmer"}, Aim: &aimLeft}}}}
+	right := Node{Entry: []*Node{{Entry: &Statement{Attributes: &Node{Entry: "trader"}, Aim: &Node{Entry: "trade"}}}}}
+	root := Node{LogicalOperator: XOR}
+	root.InsertLeftNode(&left)
+	root.InsertRightNode(&right)
+
+	complexity, err := root.CalculateStateComplexity()
+	if err.ErrorCode != TREE_NO_ERROR {
+		t.Fatal("Error when calculating complexity:", err)
+	}
+	if complexity != 3 {
+		t.Error("Test returning wrong state complexity. Value:", complexity)
+	}
+
+}
+
+/*
+Tests retrieval of statements embedded in nodes, both held by reference and extrapolated from component pair combinations.
+*/
+func TestNode_GetEmbeddedStatement(t *testing.T) {
+
+	stmt := &Statement{Attributes: &Node{Entry: "farmer"}, Aim: &Node{Entry: "sell"}}
+	if (&Node{Entry: stmt}).GetEmbeddedStatement() != stmt {
+		t.Fatal("Should return statement held by reference")
+	}
+
+	pairStmt := &Statement{Attributes: &Node{Entry: "trader"}, Aim: &Node{Entry: "trade"}}
+	left := Node{Entry: []*Node{{Entry: pairStmt}}}
+	right := Node{Entry: []*Node{{Entry: stmt}}}
+	root := Node{LogicalOperator: XOR}
+	root.InsertLeftNode(&left)
+	root.InsertRightNode(&right)
+
+	stmtNodes := root.GetTopLevelStatementNodes()
+	if len(stmtNodes) != 2 || stmtNodes[0].GetEmbeddedStatement() != pairStmt || stmtNodes[1].GetEmbeddedStatement() != stmt {
+		t.Fatal("Should return statements extrapolated from component pairs, but returned", stmtNodes)
+	}
+
+	for _, node := range []*Node{nil, {Entry: "farmer"}, {Entry: []*Node{}}, &root} {
+		if node.GetEmbeddedStatement() != nil {
+			t.Fatal("Should not return statement for node without embedded statement:", node)
+		}
+	}
+
+}
+
+/*
+Tests validation, state complexity calculation, leaf retrieval and node removal for trees containing unary and binary negations.
+*/
+func TestNode_Negation(t *testing.T) {
+
+	// Unary negation of XOR combination
+	negatedLeft := Node{Entry: "negatedLeft"}
+	negatedRight := Node{Entry: "negatedRight"}
+	xor := Node{LogicalOperator: XOR}
+	xor.InsertLeftNode(&negatedLeft)
+	xor.InsertRightNode(&negatedRight)
+	negation := Node{LogicalOperator: NOT}
+	res, err := negation.InsertRightNode(&xor)
+	if err.ErrorCode != TREE_NO_ERROR || !res {
+		t.Fatal("Error when populating tree. Error:", err)
+	}
+
+	// Binary negation with negated combination on the right side
+	left := Node{Entry: "left"}
+	root := Node{LogicalOperator: NOT, ComponentType: "topComp"}
+	root.InsertLeftNode(&left)
+	root.InsertRightNode(&negation)
+
+	if !negation.IsUnaryNegation() || root.IsUnaryNegation() {
+		t.Fatal("Unary negation has not been correctly identified")
+	}
+	if !xor.IsNegated() || left.IsNegated() || negatedLeft.IsNegated() {
+		t.Fatal("Negated node has not been correctly identified")
+	}
+	if !negation.IsCombination() {
+		t.Fatal("Unary negation should be considered combination")
+	}
+
+	valid, err := root.Validate()
+	if !valid || err.ErrorCode != TREE_NO_ERROR {
+		t.Fatal("Tree with negations should be valid. Error:", err)
+	}
+
+	// Binary negation reflects single state (1 + 2 - 1), unary negation the complexity of the negated element (2)
+	complexity, err := root.CalculateStateComplexity()
+	if err.ErrorCode != TREE_NO_ERROR {
+		t.Fatal("Error when calculating complexity:", err)
+	}
+	if complexity != 2 {
+		t.Error("Test returning wrong state complexity. Value:", complexity)
+	}
+
+	leaves := Flatten(root.GetLeafNodes(true))
+	if len(leaves) != 3 {
+		t.Fatal("Wrong number of leaf nodes:", leaves)
+	}
+
+	// Remove negated combination (which removes the negation alongside)
+	res, err = RemoveNodeFromTree(&xor)
+	if !res || err.ErrorCode != TREE_NO_ERROR {
+		t.Fatal("Removal of negated node should succeed. Error:", err)
+	}
+	if !root.IsLeafNode() || root.Entry != "left" {
+		t.Fatal("Negation has not been removed from tree:", root.String())
+	}
+
+	// Unary negation without operand is invalid
+	invalid := Node{LogicalOperator: NOT}
+	valid, err = invalid.Validate()
+	if valid || err.ErrorCode == TREE_NO_ERROR {
+		t.Fatal("Negation without operand should be invalid")
+	}
+
+}
+
 /*
 Tests substitution of node in tree structure on intermediate level.
 */
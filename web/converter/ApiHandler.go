@@ -0,0 +1,501 @@
+package converter
+
+import (
+	"IG-Parser/core/config"
+	"IG-Parser/core/coverage"
+	"IG-Parser/core/diff"
+	"IG-Parser/core/endpoints"
+	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/linter"
+	"IG-Parser/core/parser"
+	"IG-Parser/core/query"
+	"IG-Parser/core/reliability"
+	"IG-Parser/core/statistics"
+	"IG-Parser/core/tree"
+	"IG-Parser/web/converter/shared"
+	"embed"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+/*
+This file contains the handlers of the JSON REST API, which exposes tabular output, visual output (including diffs),
+statement validation, inter-coder reliability analysis, corpus statistics and structural queries to other tools (as opposed to the HTML form handlers in Handler.go).
+The API is described in the OpenAPI document (see api/openapi.json), which is served by #ApiHandlerOpenAPI().
+*/
+
+// OpenAPI document describing the API
+//
+//go:embed api/openapi.json
+var apiFiles embed.FS
+
+// Path of OpenAPI document in embedded filesystem
+const API_OPENAPI_FILE = "api/openapi.json"
+
+/*
+Returns handler for tabular output via API, which reports violations of coding guidelines based on the
+given linter configuration (see core/linter).
+*/
+func ApiHandlerTabular(lintConfig linter.Config) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		Println("Invoked TABULAR API handler")
+		request, ok := readApiRequest(w, r)
+		if !ok {
+			return
+		}
+
+		// Apply defaults for parameters not specified
+		printHeaders := true
+		if request.IncludeHeaders != nil {
+			printHeaders = *request.IncludeHeaders
+		}
+		if request.PrintOriginalStatement == "" {
+			request.PrintOriginalStatement = tabular.DEFAULT_ORIGINAL_STATEMENT_OUTPUT
+		}
+		if request.PrintIgScript == "" {
+			request.PrintIgScript = tabular.DEFAULT_IG_SCRIPT_OUTPUT
+		}
+		if request.OutputType == "" {
+			request.OutputType = tabular.DEFAULT_OUTPUT_TYPES
+		}
+
+		// Validate parameters
+		if !contains(tabular.OUTPUT_TYPES, request.OutputType) {
+			writeApiError(w, http.StatusBadRequest, tree.PARSING_ERROR_INVALID_OUTPUT_TYPE,
+				"Invalid output type '"+request.OutputType+"'.")
+			return
+		}
+		if !contains(tabular.ORIGINAL_STATEMENT_INCLUSION_OPTIONS, request.PrintOriginalStatement) {
+			writeApiError(w, http.StatusBadRequest, shared.API_ERROR_INVALID_REQUEST,
+				"Invalid value for parameter '"+shared.PARAM_PRINT_ORIGINAL_STATEMENT+"': '"+request.PrintOriginalStatement+"'.")
+			return
+		}
+		if !contains(tabular.IG_SCRIPT_INCLUSION_OPTIONS, request.PrintIgScript) {
+			writeApiError(w, http.StatusBadRequest, shared.API_ERROR_INVALID_REQUEST,
+				"Invalid value for parameter '"+shared.PARAM_PRINT_IG_SCRIPT+"': '"+request.PrintIgScript+"'.")
+			return
+		}
+
+		// Prepare options based on request
+		opts := shared.DefaultOptions()
+		opts.SetDynamicOutput(request.DynamicOutput)
+		opts.IGExtendedOutput = request.IGExtendedOutput
+		opts.IncludeAnnotations = request.IncludeAnnotations
+		opts.IncludeStatementType = request.IncludeStatementType
+		opts.IncludeComplexity = request.IncludeComplexity
+		opts.IncludeHeaders = printHeaders
+
+		// Convert input
+		results, err := endpoints.ConvertIGScriptToTabularOutput(request.RawStmt, request.CodedStmt, request.StmtId,
+			request.OutputType, "", true, opts, request.PrintOriginalStatement, request.PrintIgScript)
+
+		response := shared.ApiResponse{Version: config.IG_PARSER_VERSION, StmtId: request.StmtId}
+		if !isError(err) {
+			for _, res := range results {
+				response.Output += res.Output
+				response.Tabular = append(response.Tabular, shared.ApiTabularResult{Output: res.Output,
+					HeaderSymbols: res.HeaderSymbols, HeaderNames: res.HeaderNames})
+			}
+		}
+		writeApiResponse(w, response, request.RawStmt, request.CodedStmt, err, lintConfig)
+	}
+}
+
+/*
+Returns handler for visual tree output via API, which reports violations of coding guidelines based on the
+given linter configuration (see core/linter).
+*/
+func ApiHandlerVisual(lintConfig linter.Config) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		Println("Invoked VISUAL API handler")
+		request, ok := readApiRequest(w, r)
+		if !ok {
+			return
+		}
+
+		// Apply defaults for canvas size and validate
+		if request.Width == 0 {
+			request.Width = shared.WIDTH
+		}
+		if request.Height == 0 {
+			request.Height = shared.HEIGHT
+		}
+		if request.Width < shared.MIN_WIDTH {
+			writeApiError(w, http.StatusBadRequest, shared.API_ERROR_INVALID_REQUEST,
+				"Invalid value for parameter '"+shared.PARAM_WIDTH+"' (Minimum value: "+strconv.Itoa(shared.MIN_WIDTH)+").")
+			return
+		}
+		if request.Height < shared.MIN_HEIGHT {
+			writeApiError(w, http.StatusBadRequest, shared.API_ERROR_INVALID_REQUEST,
+				"Invalid value for parameter '"+shared.PARAM_HEIGHT+"' (Minimum value: "+strconv.Itoa(shared.MIN_HEIGHT)+").")
+			return
+		}
+
+		// Prepare options based on request
+		opts := shared.DefaultOptions()
+		opts.SetDynamicOutput(request.DynamicOutput)
+		opts.IGExtendedOutput = request.IGExtendedOutput
+		opts.IncludeAnnotations = request.IncludeAnnotations
+		opts.IncludeStatementType = request.IncludeStatementType
+		opts.IncludeDegreeOfVariability = request.IncludeDoV
+		opts.FlatPrinting = !request.PrintPropertyTree
+		opts.BinaryPrinting = request.PrintBinaryTree
+		opts.MoveActivationConditionsToFront = request.ActivationConditionsOnTop
+
+		// Convert input (comparing it with base encoding in diff mode)
+		output := ""
+		edits := []diff.Edit{}
+		err := tree.ParsingError{}
+		if request.BaseCodedStmt != "" {
+			output, edits, err = endpoints.ConvertIGScriptDiffToVisualTree(request.BaseCodedStmt, request.CodedStmt, "", opts)
+		} else {
+			output, err = endpoints.ConvertIGScriptToVisualTree(request.CodedStmt, request.StmtId, "", opts)
+		}
+
+		response := shared.ApiResponse{Version: config.IG_PARSER_VERSION, StmtId: request.StmtId,
+			Width: request.Width, Height: request.Height}
+		if output != "" && !isError(err) {
+			response.VisualTree = json.RawMessage(output)
+			if request.BaseCodedStmt != "" {
+				response.Diff = convertEdits(edits)
+			}
+		}
+		writeApiResponse(w, response, request.RawStmt, request.CodedStmt, err, lintConfig)
+	}
+}
+
+/*
+Returns handler for validation of IG Script-coded statements via API. The handler returns all errors, warnings
+and information for the statement (see parser.ParseStatementWithDiagnostics()), as well as violations of
+coding guidelines based on the given linter configuration (see core/linter) and the coverage of the original
+statement (see core/coverage) if the statement does not contain errors.
+*/
+func ApiHandlerValidate(lintConfig linter.Config) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		Println("Invoked VALIDATION API handler")
+		request, ok := readApiRequest(w, r)
+		if !ok {
+			return
+		}
+
+		stmts, diagnostics := parser.ParseStatementWithDiagnostics(request.CodedStmt)
+		success := !tree.HasErrors(diagnostics)
+		if success {
+			// Add violations of coding guidelines
+			diagnostics = append(diagnostics, linter.Lint(stmts, lintConfig)...)
+		}
+
+		response := shared.ApiResponse{Version: config.IG_PARSER_VERSION, StmtId: request.StmtId,
+			Success: success, Errors: convertDiagnostics(diagnostics)}
+		if success {
+			response.Coverage = convertCoverage(request.RawStmt, request.CodedStmt)
+		}
+		status := http.StatusOK
+		if !response.Success {
+			status = http.StatusUnprocessableEntity
+		}
+		writeJson(w, status, response)
+	}
+}
+
+/*
+Handler for inter-coder reliability analysis of statements coded by multiple coders via API (see core/reliability).
+Returns the reliability report as JSON object, or in CSV format as output.
+*/
+func ApiHandlerReliability(w http.ResponseWriter, r *http.Request) {
+	Println("Invoked RELIABILITY API handler")
+	request := shared.ApiReliabilityRequest{}
+	if !decodeApiRequest(w, r, &request) {
+		return
+	}
+
+	if request.Format == "" {
+		request.Format = reliability.OUTPUT_FORMAT_JSON
+	}
+	if !contains(reliability.OUTPUT_FORMATS, request.Format) {
+		writeApiError(w, http.StatusBadRequest, shared.API_ERROR_INVALID_REQUEST,
+			"Invalid value for parameter 'format': '"+request.Format+"'.")
+		return
+	}
+
+	codings := []reliability.Coding{}
+	for _, coding := range request.Codings {
+		codings = append(codings, reliability.Coding{StmtId: coding.StmtId, Coder: coding.Coder, Statement: coding.CodedStmt})
+	}
+	output, err := endpoints.AnalyzeReliability(codings, request.Format, "")
+
+	response := shared.ApiResponse{Version: config.IG_PARSER_VERSION, Success: !isError(err), Errors: []shared.ApiError{}}
+	status := http.StatusOK
+	if isError(err) {
+		status = http.StatusUnprocessableEntity
+		response.Errors = convertDiagnostics([]tree.Diagnostic{tree.NewDiagnostic(err)})
+	} else if request.Format == reliability.OUTPUT_FORMAT_JSON {
+		response.Reliability = json.RawMessage(output)
+	} else {
+		response.Output = output
+	}
+	writeJson(w, status, response)
+}
+
+/*
+Handler for corpus statistics of statements via API (see core/statistics). Returns the statistics report as
+JSON object, or in CSV format as output. Statements that cannot be parsed are listed as excluded in the report.
+*/
+func ApiHandlerStatistics(w http.ResponseWriter, r *http.Request) {
+	Println("Invoked STATISTICS API handler")
+	request := shared.ApiStatisticsRequest{}
+	if !decodeApiRequest(w, r, &request) {
+		return
+	}
+
+	if request.Format == "" {
+		request.Format = statistics.OUTPUT_FORMAT_JSON
+	}
+	if !contains(statistics.OUTPUT_FORMATS, request.Format) {
+		writeApiError(w, http.StatusBadRequest, shared.API_ERROR_INVALID_REQUEST,
+			"Invalid value for parameter 'format': '"+request.Format+"'.")
+		return
+	}
+
+	records := []statistics.Record{}
+	for _, stmt := range request.Statements {
+		records = append(records, statistics.Record{StmtId: stmt.StmtId, Statement: stmt.CodedStmt})
+	}
+	output, err := endpoints.AnalyzeStatistics(records, request.Format, "")
+
+	response := shared.ApiResponse{Version: config.IG_PARSER_VERSION, Success: !isError(err), Errors: []shared.ApiError{}}
+	status := http.StatusOK
+	if isError(err) {
+		status = http.StatusUnprocessableEntity
+		response.Errors = convertDiagnostics([]tree.Diagnostic{tree.NewDiagnostic(err)})
+	} else if request.Format == statistics.OUTPUT_FORMAT_JSON {
+		response.Statistics = json.RawMessage(output)
+	} else {
+		response.Output = output
+	}
+	writeJson(w, status, response)
+}
+
+/*
+Handler for structural queries over statements via API (see core/query). Statements are taken from the corpus
+(see readCorpusInput()) followed by the individual statements of the request. Returns the query result as JSON
+object, or in CSV format as output. Statements that cannot be parsed are listed as excluded in the result.
+*/
+func ApiHandlerQuery(w http.ResponseWriter, r *http.Request) {
+	Println("Invoked QUERY API handler")
+	request := shared.ApiQueryRequest{}
+	if !decodeApiRequest(w, r, &request) {
+		return
+	}
+
+	if request.Format == "" {
+		request.Format = query.OUTPUT_FORMAT_JSON
+	}
+	if !contains(query.OUTPUT_FORMATS, request.Format) {
+		writeApiError(w, http.StatusBadRequest, shared.API_ERROR_INVALID_REQUEST,
+			"Invalid value for parameter 'format': '"+request.Format+"'.")
+		return
+	}
+	if _, err := query.Parse(request.Query); err != nil {
+		writeApiError(w, http.StatusBadRequest, shared.API_ERROR_INVALID_REQUEST,
+			"Invalid value for parameter 'query': "+err.Error())
+		return
+	}
+
+	records := []query.Record{}
+	for _, stmt := range append(readCorpusInput(request.Corpus), request.Statements...) {
+		records = append(records, query.Record{StmtId: stmt.StmtId, Statement: stmt.CodedStmt})
+	}
+	output, err := endpoints.QueryStatements(records, request.Query, request.Format, "")
+
+	response := shared.ApiResponse{Version: config.IG_PARSER_VERSION, Success: !isError(err), Errors: []shared.ApiError{}}
+	status := http.StatusOK
+	if isError(err) {
+		status = http.StatusUnprocessableEntity
+		response.Errors = convertDiagnostics([]tree.Diagnostic{tree.NewDiagnostic(err)})
+	} else if request.Format == query.OUTPUT_FORMAT_JSON {
+		response.QueryResult = json.RawMessage(output)
+	} else {
+		response.Output = output
+	}
+	writeJson(w, status, response)
+}
+
+/*
+Handler serving the OpenAPI document describing the API.
+*/
+func ApiHandlerOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeApiError(w, http.StatusMethodNotAllowed, shared.API_ERROR_METHOD_NOT_ALLOWED, "Only GET requests are supported.")
+		return
+	}
+	content, err := apiFiles.ReadFile(API_OPENAPI_FILE)
+	if err != nil {
+		log.Println("Error reading OpenAPI document:", err.Error())
+		http.Error(w, "Could not process request.", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, err = w.Write(content)
+	if err != nil {
+		log.Println("Error writing OpenAPI document:", err.Error())
+	}
+}
+
+/*
+Reads and decodes the JSON payload of an API request. Writes error response and returns false
+if the request is not a POST request or the payload cannot be decoded.
+*/
+func readApiRequest(w http.ResponseWriter, r *http.Request) (shared.ApiRequest, bool) {
+	request := shared.ApiRequest{}
+	ok := decodeApiRequest(w, r, &request)
+	return request, ok
+}
+
+/*
+Decodes the JSON payload of an API request into the given request struct. Writes error response and returns false
+if the request is not a POST request or the payload cannot be decoded.
+*/
+func decodeApiRequest(w http.ResponseWriter, r *http.Request, request interface{}) bool {
+	if r.Method != http.MethodPost {
+		writeApiError(w, http.StatusMethodNotAllowed, shared.API_ERROR_METHOD_NOT_ALLOWED, "Only POST requests are supported.")
+		return false
+	}
+	decoder := json.NewDecoder(r.Body)
+	// Reject unknown parameters (e.g., misspelled ones)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(request); err != nil {
+		writeApiError(w, http.StatusBadRequest, shared.API_ERROR_INVALID_REQUEST, "Invalid request payload: "+err.Error())
+		return false
+	}
+	Println("API request:", request)
+	return true
+}
+
+/*
+Completes response with the outcome of the conversion and writes it to client.
+In case of errors, all errors and warnings for the statement are reported (see parser.ParseStatementWithDiagnostics()),
+unless they do not contain errors (e.g., for errors during output generation), in which case the given error is reported.
+Otherwise, potential warnings are reported alongside violations of coding guidelines (see core/linter) and
+the coverage of the original statement (see core/coverage).
+*/
+func writeApiResponse(w http.ResponseWriter, response shared.ApiResponse, rawStmt string, codedStmt string, err tree.ParsingError, lintConfig linter.Config) {
+	status := http.StatusOK
+	response.Success = true
+	diagnostics := []tree.Diagnostic{}
+	if err.ErrorCode != tree.PARSING_NO_ERROR {
+		diagnostics = append(diagnostics, tree.NewDiagnostic(err))
+		if isError(err) {
+			response.Success = false
+			status = http.StatusUnprocessableEntity
+			if _, all := parser.ParseStatementWithDiagnostics(codedStmt); tree.HasErrors(all) {
+				diagnostics = all
+			}
+		}
+	}
+	if response.Success {
+		// Add violations of coding guidelines
+		violations, _ := endpoints.LintIGScript(codedStmt, lintConfig)
+		diagnostics = append(diagnostics, violations...)
+		response.Coverage = convertCoverage(rawStmt, codedStmt)
+	}
+	response.Errors = convertDiagnostics(diagnostics)
+	writeJson(w, status, response)
+}
+
+/*
+Writes error response for invalid requests to client.
+*/
+func writeApiError(w http.ResponseWriter, status int, code string, message string) {
+	writeJson(w, status, shared.ApiResponse{Version: config.IG_PARSER_VERSION,
+		Errors: []shared.ApiError{{Severity: tree.SEVERITY_ERROR, Code: code, Message: message}}})
+}
+
+/*
+Writes given value as JSON to client.
+*/
+func writeJson(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(value)
+	if err != nil {
+		log.Println("Error writing API response:", err.Error())
+	}
+}
+
+/*
+Indicates whether a given parsing error is an actual error (as opposed to no error or a warning).
+*/
+func isError(err tree.ParsingError) bool {
+	return err.ErrorCode != tree.PARSING_NO_ERROR && tree.SeverityOf(err.ErrorCode) == tree.SEVERITY_ERROR
+}
+
+/*
+Converts diagnostics into their API representation.
+*/
+func convertDiagnostics(diagnostics []tree.Diagnostic) []shared.ApiError {
+	errs := []shared.ApiError{}
+	for _, d := range diagnostics {
+		apiErr := shared.ApiError{Severity: d.Severity, Code: d.ErrorCode, Message: d.ErrorMessage,
+			IgnoredElements: d.ErrorIgnoredElements}
+		if len(d.ErrorSpans) > 0 {
+			apiErr.Spans = convertSpans(d.ErrorSpans)
+		}
+		errs = append(errs, apiErr)
+	}
+	return errs
+}
+
+/*
+Converts source spans into their API representation.
+*/
+func convertSpans(spans []tree.SourceSpan) []shared.ApiSpan {
+	result := []shared.ApiSpan{}
+	for _, span := range spans {
+		result = append(result, shared.ApiSpan{Offset: span.Offset, EndOffset: span.EndOffset,
+			RuneOffset: span.RuneOffset, RuneEndOffset: span.RuneEndOffset, Line: span.Line, Column: span.Column,
+			EndLine: span.EndLine, EndColumn: span.EndColumn, Text: span.Text})
+	}
+	return result
+}
+
+/*
+Converts edits into their API representation.
+*/
+func convertEdits(edits []diff.Edit) []shared.ApiEdit {
+	result := []shared.ApiEdit{}
+	for _, edit := range edits {
+		result = append(result, shared.ApiEdit{Operation: edit.Operation, Kind: edit.Kind, Path: edit.Path,
+			Old: edit.Old, New: edit.New})
+	}
+	return result
+}
+
+/*
+Analyzes the coverage of the original statement by the coded statement and returns its API representation.
+Returns nil if the original statement is empty.
+*/
+func convertCoverage(rawStmt string, codedStmt string) *shared.ApiCoverage {
+	if strings.TrimSpace(rawStmt) == "" {
+		return nil
+	}
+	result := coverage.Analyze(rawStmt, codedStmt)
+	return &shared.ApiCoverage{Coverage: result.Coverage, OriginalWords: result.OriginalWordCount,
+		EncodedWords: result.EncodedWordCount, UnencodedWords: convertSpans(result.UnencodedWords),
+		UnmatchedWords: convertSpans(result.UnmatchedWords)}
+}
+
+/*
+Indicates whether a given value is contained in a string slice.
+*/
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
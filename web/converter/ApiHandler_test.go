@@ -0,0 +1,440 @@
+package converter
+
+import (
+	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/linter"
+	"IG-Parser/core/tree"
+	"IG-Parser/web/converter/shared"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+/*
+Performs API request with given payload against handler and decodes response.
+*/
+func performApiRequest(t *testing.T, handler http.HandlerFunc, method string, payload string) (int, shared.ApiResponse) {
+	// Deactivate logging
+	Logging = false
+	// Spin up server
+	server := httptest.NewServer(handler)
+	// Tear down at the end of the function
+	defer server.Close()
+
+	req, err := http.NewRequest(method, server.URL, strings.NewReader(payload))
+	if err != nil {
+		t.Fatal("Error when creating HTTP request. Error:", err.Error())
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal("Error when performing HTTP request. Error:", err.Error())
+	}
+	defer res.Body.Close()
+
+	if res.Header.Get("Content-Type") != "application/json" {
+		t.Fatal("Response has wrong content type:", res.Header.Get("Content-Type"))
+	}
+
+	response := shared.ApiResponse{}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		t.Fatal("Error when decoding response. Error:", err.Error())
+	}
+	return res.StatusCode, response
+}
+
+/*
+Tests tabular output via API, including header information.
+*/
+func TestApiHandlerTabular(t *testing.T) {
+
+	payload := `{"codedStmt": "A(farmer) D(must) I(comply)", "stmtId": "123", "outputType": "` + tabular.OUTPUT_TYPE_CSV + `", "dynamicSchema": true}`
+
+	status, response := performApiRequest(t, ApiHandlerTabular(linter.DefaultConfig()), http.MethodPost, payload)
+	if status != http.StatusOK || !response.Success {
+		t.Fatal("Request should succeed, but returned status", status, "and errors", response.Errors)
+	}
+	if response.StmtId != "123" || len(response.Errors) != 0 {
+		t.Fatal("Response is incorrect:", response)
+	}
+	if len(response.Tabular) != 1 || response.Output != response.Tabular[0].Output {
+		t.Fatal("Tabular output is incorrect:", response)
+	}
+	expectedOutput := "Statement ID|Attributes|Deontic|Aim|Logical Linkage (Statements)|Logical Linkage (Components)|\n" +
+		"'123|farmer|must|comply|||\n"
+	if response.Output != expectedOutput {
+		t.Fatal("Generated output is incorrect:", response.Output)
+	}
+	if strings.Join(response.Tabular[0].HeaderSymbols, ",") != "Statement ID,A,D,I,Logical Linkage (Statements),Logical Linkage (Components)" ||
+		strings.Join(response.Tabular[0].HeaderNames, ",") != "Statement ID,Attributes,Deontic,Aim,Logical Linkage (Statements),Logical Linkage (Components)" {
+		t.Fatal("Header information is incorrect:", response.Tabular[0])
+	}
+}
+
+/*
+Tests inclusion of statement type in tabular output via API.
+*/
+func TestApiHandlerTabularStatementType(t *testing.T) {
+
+	payload := `{"codedStmt": "E(council) F(is) P(established)", "stmtId": "123", "outputType": "` + tabular.OUTPUT_TYPE_CSV + `", "dynamicSchema": true, "stmtType": true}`
+
+	status, response := performApiRequest(t, ApiHandlerTabular(linter.DefaultConfig()), http.MethodPost, payload)
+	if status != http.StatusOK || !response.Success {
+		t.Fatal("Request should succeed, but returned status", status, "and errors", response.Errors)
+	}
+	expectedOutput := "Statement ID|Statement Type|Constituted Entity|Constitutive Function|Constituting Properties|Logical Linkage (Statements)|Logical Linkage (Components)|\n" +
+		"'123|constitutive|council|is|established|||\n"
+	if response.Output != expectedOutput {
+		t.Fatal("Generated output is incorrect:", response.Output)
+	}
+}
+
+/*
+Tests inclusion of complexity measures in tabular output via API.
+*/
+func TestApiHandlerTabularComplexity(t *testing.T) {
+
+	payload := `{"codedStmt": "A(farmer) I((sell [XOR] buy))", "stmtId": "123", "outputType": "` + tabular.OUTPUT_TYPE_CSV + `", "dynamicSchema": true, "complexity": true}`
+
+	status, response := performApiRequest(t, ApiHandlerTabular(linter.DefaultConfig()), http.MethodPost, payload)
+	if status != http.StatusOK || !response.Success {
+		t.Fatal("Request should succeed, but returned status", status, "and errors", response.Errors)
+	}
+	expectedOutput := "Statement ID|Attributes|Aim|Logical Linkage (Statements)|Logical Linkage (Components)|Degree of Variability|Nesting Depth|Nested Statements|Options (A)|Complexity (A)|Options (I)|Complexity (I)|\n" +
+		"'123.1|farmer|sell||[XOR].I.[123.2]|2|0|0|1|1|2|2|\n" +
+		"'123.2|farmer|buy||[XOR].I.[123.1]|2|0|0|1|1|2|2|\n"
+	if response.Output != expectedOutput {
+		t.Fatal("Generated output is incorrect:", response.Output)
+	}
+}
+
+/*
+Tests reporting of all errors of a statement via API, including positions.
+*/
+func TestApiHandlerTabularParsingErrors(t *testing.T) {
+
+	payload := `{"codedStmt": "A((x [AND] y [OR] z)) D(must) I((a [AND] b [XOR] c))", "stmtId": "123"}`
+
+	status, response := performApiRequest(t, ApiHandlerTabular(linter.DefaultConfig()), http.MethodPost, payload)
+	if status != http.StatusUnprocessableEntity || response.Success {
+		t.Fatal("Request should fail, but returned status", status)
+	}
+	if response.Output != "" || len(response.Errors) != 2 {
+		t.Fatal("Response should contain two errors and no output:", response)
+	}
+	for i, offset := range []int{0, 30} {
+		apiErr := response.Errors[i]
+		if apiErr.Severity != tree.SEVERITY_ERROR || apiErr.Code != tree.PARSING_ERROR_INVALID_OPERATOR_COMBINATIONS ||
+			len(apiErr.Spans) != 1 || apiErr.Spans[0].Offset != offset {
+			t.Fatal("Error is incorrect:", apiErr)
+		}
+	}
+}
+
+/*
+Tests visual output via API, including warnings.
+*/
+func TestApiHandlerVisual(t *testing.T) {
+
+	payload := `{"codedStmt": "A(farmer) D(must) I(comply) (remark)", "canvasWidth": 1000}`
+
+	status, response := performApiRequest(t, ApiHandlerVisual(linter.DefaultConfig()), http.MethodPost, payload)
+	if status != http.StatusOK || !response.Success {
+		t.Fatal("Request should succeed, but returned status", status, "and errors", response.Errors)
+	}
+	if response.Width != 1000 || response.Height != shared.HEIGHT {
+		t.Fatal("Canvas size is incorrect:", response.Width, response.Height)
+	}
+	if len(response.Errors) != 1 || response.Errors[0].Severity != tree.SEVERITY_WARNING ||
+		response.Errors[0].Code != tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
+		t.Fatal("Response should contain warning:", response.Errors)
+	}
+
+	visualTree := map[string]interface{}{}
+	if err := json.Unmarshal(response.VisualTree, &visualTree); err != nil {
+		t.Fatal("Visual tree is not a JSON object:", string(response.VisualTree))
+	}
+	if children, ok := visualTree["children"].([]interface{}); !ok || len(children) != 3 {
+		t.Fatal("Visual tree is incorrect:", string(response.VisualTree))
+	}
+}
+
+/*
+Tests visual output in diff mode (i.e., comparison of coded statement with base encoding) via API.
+*/
+func TestApiHandlerVisualDiff(t *testing.T) {
+
+	payload := `{"baseCodedStmt": "A(farmer) D(may) I(comply)", "codedStmt": "A(farmer) D(must) I(comply)"}`
+
+	status, response := performApiRequest(t, ApiHandlerVisual(linter.DefaultConfig()), http.MethodPost, payload)
+	if status != http.StatusOK || !response.Success {
+		t.Fatal("Request should succeed, but returned status", status, "and errors", response.Errors)
+	}
+	if len(response.Diff) != 1 || response.Diff[0].Path != tree.DEONTIC || response.Diff[0].Old != "may" || response.Diff[0].New != "must" {
+		t.Fatal("Response should contain changed Deontic, but contained", response.Diff)
+	}
+
+	visualTree := map[string]interface{}{}
+	if err := json.Unmarshal(response.VisualTree, &visualTree); err != nil {
+		t.Fatal("Visual tree is not a JSON object:", string(response.VisualTree))
+	}
+	if children, ok := visualTree["children"].([]interface{}); !ok || len(children) != 2 {
+		t.Fatal("Visual tree should hold both encodings:", string(response.VisualTree))
+	}
+}
+
+/*
+Tests validation of statements via API.
+*/
+func TestApiHandlerValidate(t *testing.T) {
+
+	status, response := performApiRequest(t, ApiHandlerValidate(linter.DefaultConfig()), http.MethodPost, `{"codedStmt": "A(farmer) D(must) I(comply"}`)
+	if status != http.StatusUnprocessableEntity || response.Success {
+		t.Fatal("Validation should fail, but returned status", status)
+	}
+	if len(response.Errors) != 1 || response.Errors[0].Code != tree.PARSING_ERROR_IMBALANCED_PARENTHESES ||
+		len(response.Errors[0].Spans) != 1 || response.Errors[0].Spans[0].Column != 20 {
+		t.Fatal("Validation errors are incorrect:", response.Errors)
+	}
+
+	status, response = performApiRequest(t, ApiHandlerValidate(linter.DefaultConfig()), http.MethodPost, `{"codedStmt": "A(farmer) D(must) I(comply)"}`)
+	if status != http.StatusOK || !response.Success || len(response.Errors) != 0 {
+		t.Fatal("Validation should succeed, but returned status", status, "and errors", response.Errors)
+	}
+}
+
+/*
+Tests reporting of coding guideline violations as warnings via API, considering the linter configuration.
+*/
+func TestApiHandlerValidateLint(t *testing.T) {
+
+	payload := `{"codedStmt": "A(farmer) D(must) M(may) I(comply)"}`
+
+	status, response := performApiRequest(t, ApiHandlerValidate(linter.DefaultConfig()), http.MethodPost, payload)
+	if status != http.StatusOK || !response.Success {
+		t.Fatal("Validation should succeed, but returned status", status, "and errors", response.Errors)
+	}
+	if len(response.Errors) != 1 || response.Errors[0].Severity != tree.SEVERITY_WARNING ||
+		response.Errors[0].Code != linter.RULE_DEONTIC_WITH_MODAL {
+		t.Fatal("Response should contain coding guideline violation:", response.Errors)
+	}
+
+	// Disable rule
+	config, err := linter.ParseConfig(strings.NewReader(`{"rules": {"` + linter.RULE_DEONTIC_WITH_MODAL + `": {"enabled": false}}}`))
+	if err != nil {
+		t.Fatal("Parsing of linter configuration should not fail. Error:", err)
+	}
+
+	_, response = performApiRequest(t, ApiHandlerTabular(config), http.MethodPost, payload)
+	if !response.Success || len(response.Errors) != 0 {
+		t.Fatal("Response should not contain disabled coding guideline violation:", response.Errors)
+	}
+}
+
+/*
+Tests reporting of the coverage of the original statement via API (only if original statement is provided).
+*/
+func TestApiHandlerCoverage(t *testing.T) {
+
+	payload := `{"rawStmt": "Farmers must comply with the regulation.", "codedStmt": "A(farmer) D(must) I(comply) Cex(with the regulation)"}`
+
+	for _, handler := range []http.HandlerFunc{ApiHandlerTabular(linter.DefaultConfig()), ApiHandlerValidate(linter.DefaultConfig())} {
+		status, response := performApiRequest(t, handler, http.MethodPost, payload)
+		if status != http.StatusOK || !response.Success || response.Coverage == nil {
+			t.Fatal("Response should contain coverage, but returned status", status, "and errors", response.Errors)
+		}
+		if response.Coverage.Coverage >= 100 || response.Coverage.OriginalWords != 6 || response.Coverage.EncodedWords != 5 {
+			t.Fatal("Coverage is incorrect:", *response.Coverage)
+		}
+		if len(response.Coverage.UnencodedWords) != 1 || response.Coverage.UnencodedWords[0].Text != "Farmers" ||
+			len(response.Coverage.UnmatchedWords) != 1 || response.Coverage.UnmatchedWords[0].Offset != 2 {
+			t.Fatal("Unencoded and unmatched words are incorrect:", *response.Coverage)
+		}
+	}
+
+	_, response := performApiRequest(t, ApiHandlerValidate(linter.DefaultConfig()), http.MethodPost, `{"codedStmt": "A(farmer) D(must) I(comply)"}`)
+	if response.Coverage != nil {
+		t.Fatal("Response should not contain coverage without original statement:", *response.Coverage)
+	}
+}
+
+/*
+Tests reliability analysis via API in JSON and CSV format, as well as rejection of unparseable codings.
+*/
+func TestApiHandlerReliability(t *testing.T) {
+
+	codings := `"codings": [{"stmtId": "1", "coder": "alice", "codedStmt": "A(farmer) D(must) I(comply)"},
+		{"stmtId": "1", "coder": "bob", "codedStmt": "A(farmer) D(may) I(comply)"}]`
+
+	status, response := performApiRequest(t, ApiHandlerReliability, http.MethodPost, "{"+codings+"}")
+	if status != http.StatusOK || !response.Success || !strings.Contains(string(response.Reliability), "\"kappaMeasure\":\"Cohen\"") {
+		t.Fatal("Response should contain reliability report, but returned status", status, "and report", string(response.Reliability))
+	}
+
+	status, response = performApiRequest(t, ApiHandlerReliability, http.MethodPost, `{"format": "csv", `+codings+"}")
+	if status != http.StatusOK || !strings.HasPrefix(response.Output, "Scope,Statement ID") || response.Reliability != nil {
+		t.Fatal("Response should contain CSV report, but returned status", status, "and output", response.Output)
+	}
+
+	status, response = performApiRequest(t, ApiHandlerReliability, http.MethodPost,
+		`{"codings": [{"stmtId": "1", "coder": "alice", "codedStmt": "A(farmer) I(comply"}]}`)
+	if status != http.StatusUnprocessableEntity || response.Success || len(response.Errors) != 1 ||
+		response.Errors[0].Code != tree.PARSING_ERROR_IMBALANCED_PARENTHESES {
+		t.Fatal("Unparseable coding should be rejected, but returned status", status, "and errors", response.Errors)
+	}
+
+	status, _ = performApiRequest(t, ApiHandlerReliability, http.MethodPost, `{"format": "xlsx", `+codings+"}")
+	if status != http.StatusBadRequest {
+		t.Fatal("Invalid format should be rejected, but returned status", status)
+	}
+}
+
+/*
+Tests corpus statistics via API in JSON and CSV format, including the exclusion of unparseable statements.
+*/
+func TestApiHandlerStatistics(t *testing.T) {
+
+	statements := `"statements": [{"stmtId": "1", "codedStmt": "A(farmer) D(must) I((comply [XOR] leave))"},
+		{"stmtId": "2", "codedStmt": "A(farmer) I(comply"}]`
+
+	status, response := performApiRequest(t, ApiHandlerStatistics, http.MethodPost, "{"+statements+"}")
+	if status != http.StatusOK || !response.Success || !strings.Contains(string(response.Statistics), "\"atomicStatements\":{\"min\":2") ||
+		!strings.Contains(string(response.Statistics), "\"excluded\":[{\"stmtId\":\"2\"") {
+		t.Fatal("Response should contain statistics report, but returned status", status, "and report", string(response.Statistics))
+	}
+
+	status, response = performApiRequest(t, ApiHandlerStatistics, http.MethodPost, `{"format": "csv", `+statements+"}")
+	if status != http.StatusOK || !strings.HasPrefix(response.Output, "Scope,Statement ID,Measure") || response.Statistics != nil {
+		t.Fatal("Response should contain CSV report, but returned status", status, "and output", response.Output)
+	}
+
+	status, _ = performApiRequest(t, ApiHandlerStatistics, http.MethodPost, `{"format": "xlsx", `+statements+"}")
+	if status != http.StatusBadRequest {
+		t.Fatal("Invalid format should be rejected, but returned status", status)
+	}
+}
+
+/*
+Tests structural queries via API over corpus and individual statements in JSON and CSV format, including the
+exclusion of unparseable statements and rejection of invalid queries.
+*/
+func TestApiHandlerQuery(t *testing.T) {
+
+	statements := `"corpus": "# Corpus\n1\tA(farmer) D(must) I((comply [XOR] leave))\nA(farmer) I(comply\n",
+		"statements": [{"stmtId": "S3", "codedStmt": "A(farmer) D(may) I(sell) Cac{A(council) I(approves)}"}]`
+
+	status, response := performApiRequest(t, ApiHandlerQuery, http.MethodPost, `{"query": "I[operator=XOR] | Cac > A", `+statements+"}")
+	if status != http.StatusOK || !response.Success || !strings.Contains(string(response.QueryResult), "\"matchingStatements\":2") ||
+		!strings.Contains(string(response.QueryResult), "\"excluded\":[{\"stmtId\":\"3\"") {
+		t.Fatal("Response should contain query result, but returned status", status, "and result", string(response.QueryResult))
+	}
+
+	status, response = performApiRequest(t, ApiHandlerQuery, http.MethodPost, `{"query": "Cac > A", "format": "csv", `+statements+"}")
+	if status != http.StatusOK || response.Output != "Statement ID,Path,Component,Content,Suffix,Annotations,Level\nS3,Cac/A,A,council,,,1\n" ||
+		response.QueryResult != nil {
+		t.Fatal("Response should contain CSV result, but returned status", status, "and output", response.Output)
+	}
+
+	for _, payload := range []string{`{"query": "A[color=red]", ` + statements + "}", `{"query": "A", "format": "xlsx", ` + statements + "}"} {
+		status, response = performApiRequest(t, ApiHandlerQuery, http.MethodPost, payload)
+		if status != http.StatusBadRequest || response.Success {
+			t.Fatal("Invalid query or format should be rejected, but returned status", status)
+		}
+	}
+}
+
+/*
+Tests rejection of invalid API requests.
+*/
+func TestApiHandlerInvalidRequests(t *testing.T) {
+
+	requests := []struct {
+		handler http.HandlerFunc
+		method  string
+		payload string
+		status  int
+		code    string
+	}{
+		{ApiHandlerTabular(linter.DefaultConfig()), http.MethodGet, "", http.StatusMethodNotAllowed, shared.API_ERROR_METHOD_NOT_ALLOWED},
+		{ApiHandlerTabular(linter.DefaultConfig()), http.MethodPost, `{"codedStmt": `, http.StatusBadRequest, shared.API_ERROR_INVALID_REQUEST},
+		{ApiHandlerTabular(linter.DefaultConfig()), http.MethodPost, `{"codedStatement": "A(farmer)"}`, http.StatusBadRequest, shared.API_ERROR_INVALID_REQUEST},
+		{ApiHandlerTabular(linter.DefaultConfig()), http.MethodPost, `{"codedStmt": "A(farmer)", "outputType": "Excel"}`, http.StatusBadRequest, tree.PARSING_ERROR_INVALID_OUTPUT_TYPE},
+		{ApiHandlerTabular(linter.DefaultConfig()), http.MethodPost, `{"codedStmt": "A(farmer)", "printIgScript": "all"}`, http.StatusBadRequest, shared.API_ERROR_INVALID_REQUEST},
+		{ApiHandlerVisual(linter.DefaultConfig()), http.MethodPost, `{"codedStmt": "A(farmer)", "canvasWidth": 10}`, http.StatusBadRequest, shared.API_ERROR_INVALID_REQUEST},
+		{ApiHandlerOpenAPI, http.MethodPost, "", http.StatusMethodNotAllowed, shared.API_ERROR_METHOD_NOT_ALLOWED},
+	}
+
+	for _, req := range requests {
+		status, response := performApiRequest(t, req.handler, req.method, req.payload)
+		if status != req.status || response.Success || len(response.Errors) != 1 || response.Errors[0].Code != req.code {
+			t.Fatal("Request with payload '"+req.payload+"' should be rejected with status", req.status, "and code", req.code,
+				"but returned", status, response.Errors)
+		}
+	}
+}
+
+/*
+Tests consistency of OpenAPI document with request parameters and parameter values.
+*/
+func TestApiHandlerOpenAPI(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(ApiHandlerOpenAPI))
+	defer server.Close()
+
+	res, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatal("Error when performing HTTP request. Error:", err.Error())
+	}
+	defer res.Body.Close()
+
+	doc := struct {
+		Paths      map[string]interface{}
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]struct {
+					Enum []string
+				}
+			}
+		}
+	}{}
+	if err := json.NewDecoder(res.Body).Decode(&doc); err != nil {
+		t.Fatal("OpenAPI document is not valid JSON. Error:", err.Error())
+	}
+
+	for _, path := range []string{"/api/v1/tabular", "/api/v1/visual", "/api/v1/validate"} {
+		if _, ok := doc.Paths[path]; !ok {
+			t.Fatal("OpenAPI document does not describe path", path)
+		}
+	}
+
+	// All request parameters need to be documented for the respective endpoints
+	requestFields := map[string][]string{
+		"TabularRequest": {shared.PARAM_RAW_STATEMENT, shared.PARAM_CODED_STATEMENT, shared.PARAM_STATEMENT_ID, shared.PARAM_DYNAMIC_SCHEMA,
+			shared.PARAM_EXTENDED_OUTPUT, shared.PARAM_LOGICO_OUTPUT, shared.PARAM_PRINT_HEADERS, shared.PARAM_PRINT_ORIGINAL_STATEMENT,
+			shared.PARAM_PRINT_IG_SCRIPT, shared.PARAM_OUTPUT_TYPE},
+		"VisualRequest": {shared.PARAM_CODED_STATEMENT, shared.PARAM_STATEMENT_ID, shared.PARAM_DYNAMIC_SCHEMA, shared.PARAM_EXTENDED_OUTPUT,
+			shared.PARAM_LOGICO_OUTPUT, shared.PARAM_PROPERTY_TREE, shared.PARAM_BINARY_TREE, shared.PARAM_DOV,
+			shared.PARAM_ACTIVATION_CONDITION_ON_TOP, shared.PARAM_WIDTH, shared.PARAM_HEIGHT},
+		"ValidationRequest": {shared.PARAM_CODED_STATEMENT, shared.PARAM_STATEMENT_ID},
+	}
+	for schema, fields := range requestFields {
+		for _, field := range fields {
+			if _, ok := doc.Components.Schemas[schema].Properties[field]; !ok {
+				t.Fatal("OpenAPI schema", schema, "does not document parameter", field)
+			}
+		}
+	}
+
+	// Documented parameter values need to correspond to accepted values
+	tabularProperties := doc.Components.Schemas["TabularRequest"].Properties
+	for field, values := range map[string][]string{
+		shared.PARAM_OUTPUT_TYPE:              tabular.OUTPUT_TYPES,
+		shared.PARAM_PRINT_ORIGINAL_STATEMENT: tabular.ORIGINAL_STATEMENT_INCLUSION_OPTIONS,
+		shared.PARAM_PRINT_IG_SCRIPT:          tabular.IG_SCRIPT_INCLUSION_OPTIONS,
+	} {
+		if strings.Join(tabularProperties[field].Enum, "|") != strings.Join(values, "|") {
+			t.Fatal("Documented values for parameter", field, "are incorrect:", tabularProperties[field].Enum)
+		}
+	}
+}
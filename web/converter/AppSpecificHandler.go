@@ -2,12 +2,13 @@ package converter
 
 import (
 	"IG-Parser/core/endpoints"
-	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/linter"
 	"IG-Parser/core/tree"
 	"IG-Parser/web/converter/shared"
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -19,22 +20,28 @@ Contains output-specific handler to be invoked by GenericParserHandler.go.
 Third-level handler generating tabular output in response to web request.
 Should be invoked by #converterHandler().
 */
-func handleTabularOutput(w http.ResponseWriter, originalStatement string, codedStmt string, stmtId string, retStruct shared.ReturnStruct, dynamicOutput bool, produceIGExtendedOutput bool, includeAnnotations bool, outputType string, printHeaders bool, printOriginalStatement string, printIgScriptInput string) {
-	// Run default configuration
-	shared.SetDefaultConfig()
+func handleTabularOutput(w http.ResponseWriter, originalStatement string, codedStmt string, stmtId string, retStruct shared.ReturnStruct, dynamicOutput bool, produceIGExtendedOutput bool, includeAnnotations bool, includeStatementType bool, includeComplexity bool, outputType string, printHeaders bool, printOriginalStatement string, printIgScriptInput string, lintConfig linter.Config) {
+	// Retrieve default configuration
+	opts := shared.DefaultOptions()
 	// Now, adjust to user settings based on UI output
 	// Define whether output is dynamic
 	Println("Setting dynamic output:", dynamicOutput)
-	tabular.SetDynamicOutput(dynamicOutput)
+	opts.SetDynamicOutput(dynamicOutput)
 	// Define whether output is IG Extended (component-level nesting)
 	Println("Setting IG Extended output:", produceIGExtendedOutput)
-	tabular.SetProduceIGExtendedOutput(produceIGExtendedOutput)
+	opts.IGExtendedOutput = produceIGExtendedOutput
 	// Define whether annotations are included
 	Println("Setting annotations:", includeAnnotations)
-	tabular.SetIncludeAnnotations(includeAnnotations)
+	opts.IncludeAnnotations = includeAnnotations
+	// Define whether statement type is included
+	Println("Setting statement type:", includeStatementType)
+	opts.IncludeStatementType = includeStatementType
+	// Define whether complexity measures are included
+	Println("Setting complexity measures:", includeComplexity)
+	opts.IncludeComplexity = includeComplexity
 	// Define whether header row is included
 	Println("Setting header row:", printHeaders)
-	tabular.SetIncludeHeaders(printHeaders)
+	opts.IncludeHeaders = printHeaders
 	// Indicate whether Original Statement input is included in output
 	Println("Include Original Statement input in generated output:", printOriginalStatement)
 	// Indicate whether IG Script input is included in output
@@ -44,7 +51,7 @@ func handleTabularOutput(w http.ResponseWriter, originalStatement string, codedS
 	// Prepopulate coded statement in return structure
 	retStruct.CodedStmt = codedStmt
 	// Convert input
-	output, err2 := endpoints.ConvertIGScriptToTabularOutput(originalStatement, codedStmt, stmtId, outputType, "", true, tabular.IncludeHeader(), printOriginalStatement, printIgScriptInput)
+	output, err2 := endpoints.ConvertIGScriptToTabularOutput(originalStatement, codedStmt, stmtId, outputType, "", true, opts, printOriginalStatement, printIgScriptInput)
 	// Stringified output delivered back to client in case of no error or warning
 	finalOutput := ""
 	if err2.ErrorCode == tree.PARSING_NO_ERROR || err2.ErrorCode == tree.PARSING_WARNING_POSSIBLY_NON_PARSED_CONTENT {
@@ -56,42 +63,45 @@ func handleTabularOutput(w http.ResponseWriter, originalStatement string, codedS
 		finalOutput = tabularOutput
 	}
 	// Deliver parsed content back to client
-	deliverParsedOutput(w, retStruct, TEMPLATE_NAME_PARSER_TABULAR, finalOutput, err2)
+	deliverParsedOutput(w, retStruct, TEMPLATE_NAME_PARSER_TABULAR, finalOutput, err2, lintConfig)
 }
 
 /*
 Third-level handler generating visual tree output in response to web request.
 Should be invoked by #converterHandler().
 */
-func handleVisualOutput(w http.ResponseWriter, codedStmt string, stmtId string, retStruct shared.ReturnStruct, flatOutput bool, binaryOutput bool, moveActivationConditionsToTop bool, dynamicOutput bool, produceIGExtendedOutput bool, includeAnnotations bool, includeDoV bool) {
-	// Run default configuration
-	shared.SetDefaultConfig()
+func handleVisualOutput(w http.ResponseWriter, codedStmt string, stmtId string, retStruct shared.ReturnStruct, flatOutput bool, binaryOutput bool, moveActivationConditionsToTop bool, dynamicOutput bool, produceIGExtendedOutput bool, includeAnnotations bool, includeStatementType bool, includeDoV bool, lintConfig linter.Config) {
+	// Retrieve default configuration
+	opts := shared.DefaultOptions()
 	// Now, adjust to user settings based on UI output
 	// Define whether output is dynamic
 	Println("Setting dynamic output:", dynamicOutput)
-	tabular.SetDynamicOutput(dynamicOutput)
+	opts.SetDynamicOutput(dynamicOutput)
 	// Define whether output is IG Extended (component-level nesting)
 	Println("Setting IG Extended output:", produceIGExtendedOutput)
-	tabular.SetProduceIGExtendedOutput(produceIGExtendedOutput)
+	opts.IGExtendedOutput = produceIGExtendedOutput
 	// Define whether annotations are included
 	Println("Setting annotations:", includeAnnotations)
-	tabular.SetIncludeAnnotations(includeAnnotations)
+	opts.IncludeAnnotations = includeAnnotations
+	// Define whether statement type is included
+	Println("Setting statement type:", includeStatementType)
+	opts.IncludeStatementType = includeStatementType
 	// Define whether Degree of Variability is included
 	Println("Setting Degree of Variability (DoV):", includeDoV)
-	tabular.SetIncludeDegreeOfVariability(includeDoV)
+	opts.IncludeDegreeOfVariability = includeDoV
 	// Setting flat printing
 	Println("Setting flat printing of properties:", flatOutput)
-	tree.SetFlatPrinting(flatOutput)
+	opts.FlatPrinting = flatOutput
 	Println("Setting binary tree printing:", binaryOutput)
-	tree.SetBinaryPrinting(binaryOutput)
+	opts.BinaryPrinting = binaryOutput
 	Println("Setting activation condition on top in visual output:", moveActivationConditionsToTop)
-	tree.SetMoveActivationConditionsToFront(moveActivationConditionsToTop)
+	opts.MoveActivationConditionsToFront = moveActivationConditionsToTop
 	// Prepopulate coded statement in return structure
 	retStruct.CodedStmt = codedStmt
 	// Convert input
-	output, err2 := endpoints.ConvertIGScriptToVisualTree(codedStmt, stmtId, "")
+	output, err2 := endpoints.ConvertIGScriptToVisualTree(codedStmt, stmtId, "", opts)
 	// Deliver parsed content back to client
-	deliverParsedOutput(w, retStruct, TEMPLATE_NAME_PARSER_VISUAL, output, err2)
+	deliverParsedOutput(w, retStruct, TEMPLATE_NAME_PARSER_VISUAL, output, err2, lintConfig)
 }
 
 /*
@@ -102,7 +112,7 @@ Processes parsed output and delivers it back to client. Takes input from any kin
 - output: Stringified output to be delivered
 - parsingError: Parsing error object to be processed in frontend
 */
-func deliverParsedOutput(w http.ResponseWriter, retStruct shared.ReturnStruct, template string, output string, parsingError tree.ParsingError) {
+func deliverParsedOutput(w http.ResponseWriter, retStruct shared.ReturnStruct, template string, output string, parsingError tree.ParsingError, lintConfig linter.Config) {
 	if parsingError.ErrorCode != tree.PARSING_NO_ERROR {
 		retStruct.Success = false
 		retStruct.Error = true
@@ -121,6 +131,8 @@ func deliverParsedOutput(w http.ResponseWriter, retStruct shared.ReturnStruct, t
 			// Still allow it to show
 			retStruct.Success = true
 			retStruct.Output = output
+			retStruct = addLintWarnings(retStruct, lintConfig)
+			retStruct = addCoverageWarnings(retStruct)
 		default:
 			retStruct.Message = "Parsing error (" + parsingError.ErrorCode + "): " + parsingError.ErrorMessage
 		}
@@ -152,6 +164,8 @@ func deliverParsedOutput(w http.ResponseWriter, retStruct shared.ReturnStruct, t
 	// Return success if parsing was successful
 	retStruct.Success = true
 	retStruct.Output = output
+	retStruct = addLintWarnings(retStruct, lintConfig)
+	retStruct = addCoverageWarnings(retStruct)
 	err := tmpl.ExecuteTemplate(w, template, retStruct)
 	if err != nil {
 		log.Println("Error processing template:", err.Error())
@@ -167,3 +181,70 @@ func deliverParsedOutput(w http.ResponseWriter, retStruct shared.ReturnStruct, t
 	}
 	return
 }
+
+/*
+Checks coded statement in return structure against coding guidelines (based on the given linter configuration)
+and adds potential violations as warnings to the return structure.
+*/
+func addLintWarnings(retStruct shared.ReturnStruct, lintConfig linter.Config) shared.ReturnStruct {
+	diagnostics, err := endpoints.LintIGScript(retStruct.CodedStmt, lintConfig)
+	if isError(err) {
+		return retStruct
+	}
+	for _, d := range diagnostics {
+		Println("Lint " + d.Severity + ": " + d.ErrorCode + " - " + d.ErrorMessage)
+		retStruct.Warnings = append(retStruct.Warnings, d.ErrorMessage+" ("+d.ErrorCode+")")
+	}
+	if len(retStruct.Warnings) > 0 {
+		retStruct.WarningsMessage = shared.WARNING_LINT_VIOLATIONS
+	}
+	return retStruct
+}
+
+/*
+Analyzes the coverage of the original statement in return structure by the coded statement and adds
+unencoded words of the original statement, as well as words of the coded statement that do not appear in the
+original statement, as warnings to the return structure. No analysis is performed if the original statement is empty.
+*/
+func addCoverageWarnings(retStruct shared.ReturnStruct) shared.ReturnStruct {
+	if strings.TrimSpace(retStruct.RawStmt) == "" {
+		return retStruct
+	}
+	result, err := endpoints.AnalyzeCoverage(retStruct.RawStmt, retStruct.CodedStmt)
+	if isError(err) || result.IsComplete() {
+		return retStruct
+	}
+	Println("Coverage: " + strconv.FormatFloat(result.Coverage, 'f', 1, 64) + "%")
+	if len(retStruct.Warnings) > 0 {
+		retStruct.WarningsMessage = shared.WARNING_LINT_VIOLATIONS_AND_COVERAGE
+	} else {
+		retStruct.WarningsMessage = shared.WARNING_COVERAGE
+	}
+	retStruct.Warnings = append(retStruct.Warnings, "Coverage of 'Original Statement': "+
+		strconv.FormatFloat(result.Coverage, 'f', 1, 64)+"% ("+strconv.Itoa(result.EncodedWordCount)+" of "+
+		strconv.Itoa(result.OriginalWordCount)+" words encoded)")
+	if len(result.UnencodedWords) > 0 {
+		retStruct.Warnings = append(retStruct.Warnings, "Words of 'Original Statement' not encoded in any component: "+
+			joinWords(result.UnencodedWords))
+	}
+	if len(result.UnmatchedWords) > 0 {
+		retStruct.Warnings = append(retStruct.Warnings, "Words of 'Encoded Statement' not contained in 'Original Statement' (e.g., typos or paraphrases): "+
+			joinWords(result.UnmatchedWords))
+	}
+	return retStruct
+}
+
+/*
+Returns comma-separated list of quoted words (with line and column in case of multi-line input).
+*/
+func joinWords(spans []tree.SourceSpan) string {
+	words := []string{}
+	for _, span := range spans {
+		if span.Line > 1 || span.EndLine > 1 {
+			words = append(words, "'"+span.Text+"' (line "+strconv.Itoa(span.Line)+", column "+strconv.Itoa(span.Column)+")")
+		} else {
+			words = append(words, "'"+span.Text+"' (column "+strconv.Itoa(span.Column)+")")
+		}
+	}
+	return strings.Join(words, ", ")
+}
@@ -3,6 +3,7 @@ package converter
 import (
 	"IG-Parser/core/config"
 	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/linter"
 	"IG-Parser/web/converter/shared"
 	"IG-Parser/web/helper"
 	"fmt"
@@ -22,9 +23,10 @@ Generic handler for pre-processing of web application input.
 /*
 Second-level general handler that retrieves and preprocesses information from input.
 Delegates to third-order handler for output-specific generation.
-Should be invoked by #ConverterHandlerTabular() and #ConverterHandlerVisual().
+Should be invoked by #ConverterHandlerTabular() and #ConverterHandlerVisual(), which provide the linter configuration
+applied to report violations of coding guidelines.
 */
-func converterHandler(w http.ResponseWriter, r *http.Request, templateName string) {
+func converterHandler(w http.ResponseWriter, r *http.Request, templateName string, lintConfig linter.Config) {
 
 	//// STEP 1: Read all parameters from returned form
 
@@ -37,6 +39,8 @@ func converterHandler(w http.ResponseWriter, r *http.Request, templateName strin
 	formValueDynamicOutput := r.FormValue(shared.PARAM_DYNAMIC_SCHEMA)
 	formValueIncludeAnnotations := r.FormValue(shared.PARAM_LOGICO_OUTPUT)
 	formValueIncludeDoV := r.FormValue(shared.PARAM_DOV)
+	formValueIncludeStatementType := r.FormValue(shared.PARAM_STATEMENT_TYPE)
+	formValueIncludeComplexity := r.FormValue(shared.PARAM_COMPLEXITY)
 	formValueIgExtendedOutput := r.FormValue(shared.PARAM_EXTENDED_OUTPUT)
 	formValueIncludeHeaders := r.FormValue(shared.PARAM_PRINT_HEADERS)
 	formValuePrintOriginalStatement := r.FormValue(shared.PARAM_PRINT_ORIGINAL_STATEMENT)
@@ -72,6 +76,28 @@ func converterHandler(w http.ResponseWriter, r *http.Request, templateName strin
 		includeAnnotations = false
 	}
 
+	// Statement type in output
+	includeStatementType := false
+	Println("Form field (both)    - Statement type: ", formValueIncludeStatementType)
+	if formValueIncludeStatementType == shared.CHECKBOX_ON {
+		formValueIncludeStatementType = shared.CHECKBOX_CHECKED
+		includeStatementType = true
+	} else {
+		formValueIncludeStatementType = shared.CHECKBOX_UNCHECKED
+		includeStatementType = false
+	}
+
+	// Complexity measures in output
+	includeComplexity := false
+	Println("Form field (tabular) - Complexity: ", formValueIncludeComplexity)
+	if formValueIncludeComplexity == shared.CHECKBOX_ON {
+		formValueIncludeComplexity = shared.CHECKBOX_CHECKED
+		includeComplexity = true
+	} else {
+		formValueIncludeComplexity = shared.CHECKBOX_UNCHECKED
+		includeComplexity = false
+	}
+
 	// DoV in output
 	includeDoV := false
 	Println("Form field (visual)  - DoV: ", formValueIncludeDoV)
@@ -174,6 +200,8 @@ func converterHandler(w http.ResponseWriter, r *http.Request, templateName strin
 		IGExtendedOutput:                formValueIgExtendedOutput,
 		IncludeAnnotations:              formValueIncludeAnnotations,
 		IncludeDoV:                      formValueIncludeDoV,
+		IncludeStatementType:            formValueIncludeStatementType,
+		IncludeComplexity:               formValueIncludeComplexity,
 		IncludeHeaders:                  formValueIncludeHeaders,
 		PrintOriginalStatement:          formValuePrintOriginalStatement,
 		PrintOriginalStatementSelection: tabular.ORIGINAL_STATEMENT_INCLUSION_OPTIONS,
@@ -333,6 +361,30 @@ func converterHandler(w http.ResponseWriter, r *http.Request, templateName strin
 			includeAnnotations = false
 		}
 
+		// Parameter: Statement type
+		val, suc = extractUrlParameters(r, shared.PARAM_STATEMENT_TYPE)
+		check = evaluateBooleanUrlParameters(shared.PARAM_STATEMENT_TYPE, val, suc)
+		// Assign values
+		if check {
+			retStruct.IncludeStatementType = shared.CHECKBOX_CHECKED
+			includeStatementType = true
+		} else {
+			retStruct.IncludeStatementType = shared.CHECKBOX_UNCHECKED
+			includeStatementType = false
+		}
+
+		// Parameter: Complexity measures
+		val, suc = extractUrlParameters(r, shared.PARAM_COMPLEXITY)
+		check = evaluateBooleanUrlParameters(shared.PARAM_COMPLEXITY, val, suc)
+		// Assign values
+		if check {
+			retStruct.IncludeComplexity = shared.CHECKBOX_CHECKED
+			includeComplexity = true
+		} else {
+			retStruct.IncludeComplexity = shared.CHECKBOX_UNCHECKED
+			includeComplexity = false
+		}
+
 		// Parameter: Header row printing
 		val, suc = extractUrlParameters(r, shared.PARAM_PRINT_HEADERS)
 		check = evaluateBooleanUrlParameters(shared.PARAM_PRINT_HEADERS, val, suc)
@@ -535,10 +587,10 @@ func converterHandler(w http.ResponseWriter, r *http.Request, templateName strin
 		// Delegate to specific output handlers ...
 		if templateName == TEMPLATE_NAME_PARSER_TABULAR {
 			Println("Tabular output requested")
-			handleTabularOutput(w, retStruct.RawStmt, retStruct.CodedStmt, retStruct.StmtId, retStruct, dynamicOutput, produceIGExtendedOutput, includeAnnotations, retStruct.OutputType, printHeaders, formValuePrintOriginalStatement, formValuePrintIgScript)
+			handleTabularOutput(w, retStruct.RawStmt, retStruct.CodedStmt, retStruct.StmtId, retStruct, dynamicOutput, produceIGExtendedOutput, includeAnnotations, includeStatementType, includeComplexity, retStruct.OutputType, printHeaders, formValuePrintOriginalStatement, formValuePrintIgScript, lintConfig)
 		} else if templateName == TEMPLATE_NAME_PARSER_VISUAL {
 			Println("Visual output requested")
-			handleVisualOutput(w, retStruct.CodedStmt, retStruct.StmtId, retStruct, printFlatProperties, printBinaryTree, printActivationConditionsOnTop, dynamicOutput, produceIGExtendedOutput, includeAnnotations, includeDoV)
+			handleVisualOutput(w, retStruct.CodedStmt, retStruct.StmtId, retStruct, printFlatProperties, printBinaryTree, printActivationConditionsOnTop, dynamicOutput, produceIGExtendedOutput, includeAnnotations, includeStatementType, includeDoV, lintConfig)
 		} else {
 			log.Fatal("Output variant " + templateName + " not found.")
 		}
@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"IG-Parser/core/linter"
 	"embed"
 	"html/template"
 	"log"
@@ -24,6 +25,9 @@ const TEMPLATE_NAME_PARSER_VISUAL = "ig-parser-visualizer.html"
 // Help template
 const TEMPLATE_NAME_HELP = "ig-parser-user-guide.html"
 
+// Statistics report template
+const TEMPLATE_NAME_STATISTICS = "ig-parser-statistics.html"
+
 // Embed templates in compiled binary
 //
 //go:embed templates/*
@@ -75,17 +79,23 @@ func Init() {
 }
 
 /*
-Handler for tabular output.
+Returns handler for tabular output, which reports violations of coding guidelines based on the given
+linter configuration (see core/linter).
 */
-func ConverterHandlerTabular(w http.ResponseWriter, r *http.Request) {
-	Println("Invoked TABULAR output handler")
-	converterHandler(w, r, TEMPLATE_NAME_PARSER_TABULAR)
+func ConverterHandlerTabular(lintConfig linter.Config) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		Println("Invoked TABULAR output handler")
+		converterHandler(w, r, TEMPLATE_NAME_PARSER_TABULAR, lintConfig)
+	}
 }
 
 /*
-Handler for visual output.
+Returns handler for visual output, which reports violations of coding guidelines based on the given
+linter configuration (see core/linter).
 */
-func ConverterHandlerVisual(w http.ResponseWriter, r *http.Request) {
-	Println("Invoked VISUAL output handler")
-	converterHandler(w, r, TEMPLATE_NAME_PARSER_VISUAL)
+func ConverterHandlerVisual(lintConfig linter.Config) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		Println("Invoked VISUAL output handler")
+		converterHandler(w, r, TEMPLATE_NAME_PARSER_VISUAL, lintConfig)
+	}
 }
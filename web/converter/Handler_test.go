@@ -2,6 +2,7 @@ package converter
 
 import (
 	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/linter"
 	"fmt"
 	"io"
 	"net/http"
@@ -24,7 +25,7 @@ func TestConverterHandlerGoogleSheetsGet(t *testing.T) {
 	// Deactivate logging
 	Logging = false
 	// Spin up server
-	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerTabular))
+	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerTabular(linter.DefaultConfig())))
 	// Tear down at the end of the function
 	defer server.Close()
 
@@ -80,7 +81,7 @@ func TestConverterHandlerVisualGet(t *testing.T) {
 	// Deactivate logging
 	Logging = false
 	// Spin up server
-	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerVisual))
+	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerVisual(linter.DefaultConfig())))
 	// Tear down at the end of the function
 	defer server.Close()
 
@@ -136,7 +137,7 @@ func TestConverterHandlerVisualPost(t *testing.T) {
 	// Deactivate logging
 	Logging = false
 	// Spin up server
-	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerVisual))
+	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerVisual(linter.DefaultConfig())))
 	// Tear down at the end of the function
 	defer server.Close()
 
@@ -235,7 +236,7 @@ func TestConverterHandlerVisualPostWarning(t *testing.T) {
 	// Deactivate logging
 	Logging = false
 	// Spin up server
-	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerVisual))
+	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerVisual(linter.DefaultConfig())))
 	// Tear down at the end of the function
 	defer server.Close()
 
@@ -334,7 +335,7 @@ func TestConverterHandlerGoogleSheetsPost(t *testing.T) {
 	// Deactivate logging
 	Logging = false
 	// Spin up server
-	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerTabular))
+	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerTabular(linter.DefaultConfig())))
 	// Tear down at the end of the function
 	defer server.Close()
 
@@ -433,7 +434,7 @@ func TestConverterHandlerGoogleSheetsPostWarning(t *testing.T) {
 	// Deactivate logging
 	Logging = false
 	// Spin up server
-	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerTabular))
+	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerTabular(linter.DefaultConfig())))
 	// Tear down at the end of the function
 	defer server.Close()
 
@@ -532,7 +533,7 @@ func TestConverterHandlerCSVPost(t *testing.T) {
 	// Deactivate logging
 	Logging = false
 	// Spin up server
-	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerTabular))
+	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerTabular(linter.DefaultConfig())))
 	// Tear down at the end of the function
 	defer server.Close()
 
@@ -631,7 +632,7 @@ func TestConverterHandlerCSVPostWithExplicitHeader(t *testing.T) {
 	// Deactivate logging
 	Logging = false
 	// Spin up server
-	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerTabular))
+	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerTabular(linter.DefaultConfig())))
 	// Tear down at the end of the function
 	defer server.Close()
 
@@ -730,7 +731,7 @@ func TestConverterHandlerCSVPostWithoutHeaders(t *testing.T) {
 	// Deactivate logging
 	Logging = false
 	// Spin up server
-	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerTabular))
+	server := httptest.NewServer(http.HandlerFunc(ConverterHandlerTabular(linter.DefaultConfig())))
 	// Tear down at the end of the function
 	defer server.Close()
 
@@ -0,0 +1,105 @@
+package converter
+
+import (
+	"IG-Parser/core/config"
+	"IG-Parser/core/endpoints"
+	"IG-Parser/core/statistics"
+	"IG-Parser/core/tree"
+	"IG-Parser/web/converter/shared"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+/*
+This file contains the top-level handler to serve the corpus statistics (see core/statistics) as HTML report page,
+or as CSV or JSON download.
+*/
+
+// Format of HTML report page (as opposed to downloads in statistics.OUTPUT_FORMATS)
+const REPORT_FORMAT_HTML = "html"
+
+// Separator between statement ID and IG Script-encoded statement in corpus input lines
+const CORPUS_INPUT_SEPARATOR = "\t"
+
+// Prefix for comment lines in corpus input
+const CORPUS_INPUT_COMMENT_PREFIX = "#"
+
+// Name of downloaded report file (without extension, which corresponds to format)
+const STATISTICS_DOWNLOAD_FILENAME = "ig-parser-statistics"
+
+/*
+Handler for corpus statistics. Shows the input form for GET requests, and the report for the submitted statements
+for POST requests, either as HTML report page or as download (CSV or JSON), depending on the requested format.
+*/
+func StatisticsHandler(w http.ResponseWriter, r *http.Request) {
+	Println("Invoked STATISTICS handler")
+
+	data := shared.StatisticsReturnStruct{CodedStmtsHelp: shared.HELP_CODED_STMTS, Version: config.IG_PARSER_VERSION}
+
+	if r.Method == http.MethodPost {
+		data.CodedStmts = r.FormValue(shared.PARAM_CODED_STATEMENTS)
+		format := r.FormValue(shared.PARAM_REPORT_FORMAT)
+		records := []statistics.Record{}
+		for _, stmt := range readCorpusInput(data.CodedStmts) {
+			records = append(records, statistics.Record{StmtId: stmt.StmtId, Statement: stmt.CodedStmt})
+		}
+
+		switch {
+		case len(records) == 0:
+			data.Error = true
+			data.Message = "Please provide at least one encoded statement."
+		case format == "" || format == REPORT_FORMAT_HTML:
+			report := statistics.Analyze(records)
+			data.Report = &report
+		case contains(statistics.OUTPUT_FORMATS, format):
+			output, err := endpoints.AnalyzeStatistics(records, format, "")
+			if err.ErrorCode == tree.PARSING_NO_ERROR {
+				contentType := "text/csv"
+				if format == statistics.OUTPUT_FORMAT_JSON {
+					contentType = "application/json"
+				}
+				w.Header().Set("Content-Type", contentType+"; charset=utf-8")
+				w.Header().Set("Content-Disposition", "attachment; filename=\""+STATISTICS_DOWNLOAD_FILENAME+"."+format+"\"")
+				if _, err2 := w.Write([]byte(output)); err2 != nil {
+					log.Println("Error writing statistics report:", err2.Error())
+				}
+				return
+			}
+			data.Error = true
+			data.Message = err.ErrorMessage
+		default:
+			data.Error = true
+			data.Message = "Invalid report format: '" + format + "'"
+		}
+	}
+
+	err := tmpl.ExecuteTemplate(w, TEMPLATE_NAME_STATISTICS, data)
+	if err != nil {
+		log.Println("Error generating error response for template processing:", err.Error())
+		http.Error(w, "Could not process request.", http.StatusInternalServerError)
+	}
+}
+
+/*
+Reads statements from the given corpus input, with one statement per line, either as 'IG Script',
+'ID<TAB>IG Script' or 'ID<TAB>Original Statement<TAB>IG Script'. Statements without ID are identified by line number.
+Empty lines and comment lines (starting with '#') are ignored.
+*/
+func readCorpusInput(input string) []shared.ApiStatement {
+	stmts := []shared.ApiStatement{}
+	for i, line := range strings.Split(input, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(strings.TrimSpace(line), CORPUS_INPUT_COMMENT_PREFIX) {
+			continue
+		}
+		columns := strings.Split(line, CORPUS_INPUT_SEPARATOR)
+		id := strconv.Itoa(i + 1)
+		if len(columns) > 1 && strings.TrimSpace(columns[0]) != "" {
+			id = strings.TrimSpace(columns[0])
+		}
+		stmts = append(stmts, shared.ApiStatement{StmtId: id, CodedStmt: strings.TrimSpace(columns[len(columns)-1])})
+	}
+	return stmts
+}
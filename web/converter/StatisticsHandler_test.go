@@ -0,0 +1,110 @@
+package converter
+
+import (
+	"IG-Parser/web/converter/shared"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+/*
+Performs request on statistics handler with given form values (GET request if nil) and returns response and body.
+*/
+func performStatisticsRequest(t *testing.T, values url.Values) (*http.Response, string) {
+
+	// Initialize templates
+	Init()
+	server := httptest.NewServer(http.HandlerFunc(StatisticsHandler))
+	defer server.Close()
+
+	var res *http.Response
+	var err error
+	if values == nil {
+		res, err = http.Get(server.URL)
+	} else {
+		res, err = http.PostForm(server.URL, values)
+	}
+	if err != nil {
+		t.Fatal("Error when performing HTTP request. Error:", err.Error())
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal("Error when reading response. Error:", err.Error())
+	}
+	return res, string(body)
+}
+
+/*
+Tests the statistics report page, including the input form and the report for submitted statements.
+*/
+func TestStatisticsHandlerReport(t *testing.T) {
+
+	res, body := performStatisticsRequest(t, nil)
+	if res.StatusCode != http.StatusOK || !strings.Contains(body, "name=\"codedStmts\"") || strings.Contains(body, "Statistics Report") {
+		t.Fatal("GET request should return input form without report, but returned:\n", body)
+	}
+
+	res, body = performStatisticsRequest(t, url.Values{
+		shared.PARAM_CODED_STATEMENTS: {"A(farmer) D(must) I((sell [XOR] buy))\n\nS1\tA(farmer) I(sell\n"},
+		shared.PARAM_REPORT_FORMAT:    {REPORT_FORMAT_HTML}})
+	if res.StatusCode != http.StatusOK || !strings.Contains(body, "Analyzed statements: 1 (excluded statements: 1)") ||
+		!strings.Contains(body, "<tr><td>Atomic statements</td><td>2</td><td>2</td><td>2.00</td><td>2.00</td><td>2</td><td>2: 1</td></tr>") ||
+		!strings.Contains(body, "<tr><td>XOR</td><td>1</td><td>1</td></tr>") ||
+		!strings.Contains(body, "<tr><td>S1</td><td class=\"error\">IMBALANCED_PARENTHESES: ") {
+		t.Fatal("Report page does not contain expected report:\n", body)
+	}
+
+	_, body = performStatisticsRequest(t, url.Values{shared.PARAM_CODED_STATEMENTS: {" \n# comment"}})
+	if !strings.Contains(body, "Please provide at least one encoded statement.") {
+		t.Fatal("Request without statements should return error, but returned:\n", body)
+	}
+}
+
+/*
+Tests the download of statistics reports in CSV and JSON format.
+*/
+func TestStatisticsHandlerDownload(t *testing.T) {
+
+	res, body := performStatisticsRequest(t, url.Values{
+		shared.PARAM_CODED_STATEMENTS: {"S1\tA(farmer) I(sell)"},
+		shared.PARAM_REPORT_FORMAT:    {"csv"}})
+	if !strings.HasPrefix(res.Header.Get("Content-Type"), "text/csv") ||
+		res.Header.Get("Content-Disposition") != "attachment; filename=\""+STATISTICS_DOWNLOAD_FILENAME+".csv\"" ||
+		!strings.Contains(body, "statement,S1,atomic statements,,1,") {
+		t.Fatal("CSV download does not contain expected report:", res.Header, "\n", body)
+	}
+
+	res, body = performStatisticsRequest(t, url.Values{
+		shared.PARAM_CODED_STATEMENTS: {"S1\tA(farmer) I(sell)"},
+		shared.PARAM_REPORT_FORMAT:    {"json"}})
+	if !strings.HasPrefix(res.Header.Get("Content-Type"), "application/json") || !strings.Contains(body, "\"stmtId\": \"S1\"") {
+		t.Fatal("JSON download does not contain expected report:", res.Header, "\n", body)
+	}
+
+	_, body = performStatisticsRequest(t, url.Values{
+		shared.PARAM_CODED_STATEMENTS: {"S1\tA(farmer) I(sell)"},
+		shared.PARAM_REPORT_FORMAT:    {"xlsx"}})
+	if !strings.Contains(body, "Invalid report format: &#39;xlsx&#39;") {
+		t.Fatal("Invalid format should return error, but returned:\n", body)
+	}
+}
+
+/*
+Tests reading of statements with and without statement IDs.
+*/
+func TestReadCorpusInput(t *testing.T) {
+	stmts := readCorpusInput("A(farmer) I(sell)\r\n# comment\n\nS2\tA(trader) I(buy)\nS3\tTraders buy.\tA(trader) I(buy)\n")
+	expected := [][]string{{"1", "A(farmer) I(sell)"}, {"S2", "A(trader) I(buy)"}, {"S3", "A(trader) I(buy)"}}
+	actual := [][]string{}
+	for _, stmt := range stmts {
+		actual = append(actual, []string{stmt.StmtId, stmt.CodedStmt})
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatal("Wrong statements read from input:", actual)
+	}
+}
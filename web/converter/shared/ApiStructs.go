@@ -0,0 +1,233 @@
+package shared
+
+import (
+	"encoding/json"
+)
+
+/*
+Structs for the JSON REST API (see converter.ApiHandler.go and the OpenAPI document in converter/api/openapi.json).
+JSON field names correspond to the URL parameter keys (see UrlParameters.go).
+*/
+
+/*
+Request payload for all API endpoints. Fields not relevant for a given endpoint are ignored.
+*/
+type ApiRequest struct {
+	// Original unparsed statement
+	RawStmt string `json:"rawStmt"`
+	// IG Script-coded statement
+	CodedStmt string `json:"codedStmt"`
+	// IG Script-coded base encoding the coded statement is compared with (visual output only, activates diff mode)
+	BaseCodedStmt string `json:"baseCodedStmt"`
+	// Statement ID
+	StmtId string `json:"stmtId"`
+	// Dynamic output indicator
+	DynamicOutput bool `json:"dynamicSchema"`
+	// IG Extended output indicator (component-level nesting)
+	IGExtendedOutput bool `json:"igExtended"`
+	// Annotation inclusion indicator
+	IncludeAnnotations bool `json:"annotations"`
+	// Statement type inclusion indicator
+	IncludeStatementType bool `json:"stmtType"`
+	// Complexity measures inclusion indicator (tabular output)
+	IncludeComplexity bool `json:"complexity"`
+	// Header row inclusion indicator (defaults to true if not specified)
+	IncludeHeaders *bool `json:"includeHeaders"`
+	// Inclusion of Original Statement in output (see tabular.ORIGINAL_STATEMENT_INCLUSION_OPTIONS)
+	PrintOriginalStatement string `json:"printOriginalStatement"`
+	// Inclusion of IG Script-coded statement in output (see tabular.IG_SCRIPT_INCLUSION_OPTIONS)
+	PrintIgScript string `json:"printIgScript"`
+	// Output type (see tabular.OUTPUT_TYPES)
+	OutputType string `json:"outputType"`
+	// Property tree printing indicator (as opposed to flat printing of properties)
+	PrintPropertyTree bool `json:"propertyTree"`
+	// Binary tree printing indicator
+	PrintBinaryTree bool `json:"binaryTree"`
+	// Degree of Variability inclusion indicator
+	IncludeDoV bool `json:"dov"`
+	// Indicator whether activation conditions are printed on top of visual tree
+	ActivationConditionsOnTop bool `json:"actCondTop"`
+	// Width of output canvas (visual output)
+	Width int `json:"canvasWidth"`
+	// Height of output canvas (visual output)
+	Height int `json:"canvasHeight"`
+}
+
+/*
+Request payload for the reliability analysis (see #ApiRequest for all other endpoints).
+*/
+type ApiReliabilityRequest struct {
+	// Codings of statements by individual coders
+	Codings []ApiCoding `json:"codings"`
+	// Output format of reliability report (see reliability.OUTPUT_FORMATS, defaults to JSON)
+	Format string `json:"format"`
+}
+
+/*
+IG Script coding of a statement by an individual coder (see reliability.Coding).
+*/
+type ApiCoding struct {
+	// Statement ID
+	StmtId string `json:"stmtId"`
+	// Coder
+	Coder string `json:"coder"`
+	// IG Script-coded statement
+	CodedStmt string `json:"codedStmt"`
+}
+
+/*
+Request payload for the corpus statistics (see #ApiRequest for all other endpoints).
+*/
+type ApiStatisticsRequest struct {
+	// IG Script-coded statements
+	Statements []ApiStatement `json:"statements"`
+	// Output format of statistics report (see statistics.OUTPUT_FORMATS, defaults to JSON)
+	Format string `json:"format"`
+}
+
+/*
+Request payload for structural queries (see #ApiRequest for all other endpoints). Statements are provided either
+as corpus (i.e., the content of a corpus file) or as individual statements (or both).
+*/
+type ApiQueryRequest struct {
+	// Query (see query.Parse())
+	Query string `json:"query"`
+	// Corpus with one statement per line ('IG Script', 'ID<TAB>IG Script' or 'ID<TAB>Original Statement<TAB>IG Script')
+	Corpus string `json:"corpus"`
+	// IG Script-coded statements
+	Statements []ApiStatement `json:"statements"`
+	// Output format of query result (see query.OUTPUT_FORMATS, defaults to JSON)
+	Format string `json:"format"`
+}
+
+/*
+IG Script-coded statement (see statistics.Record, query.Record).
+*/
+type ApiStatement struct {
+	// Statement ID
+	StmtId string `json:"stmtId"`
+	// IG Script-coded statement
+	CodedStmt string `json:"codedStmt"`
+}
+
+/*
+Response payload of API endpoints.
+*/
+type ApiResponse struct {
+	// Indicates whether operation was successful (i.e., no errors, but potentially warnings)
+	Success bool `json:"success"`
+	// IG Parser version
+	Version string `json:"version"`
+	// Statement ID
+	StmtId string `json:"stmtId,omitempty"`
+	// Generated tabular output (all atomic statements), or reliability or statistics report in CSV format
+	Output string `json:"output,omitempty"`
+	// Generated tabular output per statement (tabular output only)
+	Tabular []ApiTabularResult `json:"tabular,omitempty"`
+	// Visual tree structure (visual output only)
+	VisualTree json.RawMessage `json:"visualTree,omitempty"`
+	// Width of output canvas (visual output only)
+	Width int `json:"canvasWidth,omitempty"`
+	// Height of output canvas (visual output only)
+	Height int `json:"canvasHeight,omitempty"`
+	// Reliability report (reliability analysis in JSON format only)
+	Reliability json.RawMessage `json:"reliability,omitempty"`
+	// Statistics report (corpus statistics in JSON format only)
+	Statistics json.RawMessage `json:"statistics,omitempty"`
+	// Query result (structural queries in JSON format only)
+	QueryResult json.RawMessage `json:"queryResult,omitempty"`
+	// Edit script transforming base encoding into coded statement (visual output in diff mode only)
+	Diff []ApiEdit `json:"diff,omitempty"`
+	// Errors, warnings and information
+	Errors []ApiError `json:"errors"`
+	// Coverage of original statement by coded statement (only if original statement is provided)
+	Coverage *ApiCoverage `json:"coverage,omitempty"`
+}
+
+/*
+Coverage of original statement by coded statement (see coverage.Result).
+*/
+type ApiCoverage struct {
+	// Percentage of words of original statement encoded in coded statement (0-100)
+	Coverage float64 `json:"coverage"`
+	// Number of words in original statement
+	OriginalWords int `json:"originalWords"`
+	// Number of words of original statement encoded in coded statement
+	EncodedWords int `json:"encodedWords"`
+	// Words of original statement not encoded in any component (positions refer to original statement)
+	UnencodedWords []ApiSpan `json:"unencodedWords"`
+	// Words of coded statement not contained in original statement (positions refer to coded statement)
+	UnmatchedWords []ApiSpan `json:"unmatchedWords"`
+}
+
+/*
+Tabular output generated for an individual statement (see tabular.TabularOutputResult).
+*/
+type ApiTabularResult struct {
+	// Generated output
+	Output string `json:"output"`
+	// Symbols of header columns (e.g., A, D, I)
+	HeaderSymbols []string `json:"headerSymbols"`
+	// Names of header columns (e.g., Attributes, Deontic, Aim)
+	HeaderNames []string `json:"headerNames"`
+}
+
+/*
+Structured representation of a parsing error, warning or information (see tree.Diagnostic).
+*/
+type ApiError struct {
+	// Severity (see tree.SEVERITY_* constants)
+	Severity string `json:"severity"`
+	// Error code (see tree.PARSING_* constants)
+	Code string `json:"code"`
+	// Error message
+	Message string `json:"message,omitempty"`
+	// Elements ignored during parsing
+	IgnoredElements []string `json:"ignoredElements,omitempty"`
+	// Positions of offending content in input
+	Spans []ApiSpan `json:"spans,omitempty"`
+}
+
+/*
+Edit of edit script transforming base encoding into coded statement (see diff.Edit).
+*/
+type ApiEdit struct {
+	// Operation (see diff.OPERATION_* constants)
+	Operation string `json:"operation"`
+	// Kind of element affected by edit (see diff.KIND_* constants)
+	Kind string `json:"kind"`
+	// Path of component the element belongs to (e.g., 'Cac/A'), or empty string for the statement itself
+	Path string `json:"path"`
+	// Value in base encoding (empty for added elements)
+	Old string `json:"old"`
+	// Value in coded statement (empty for removed elements)
+	New string `json:"new"`
+}
+
+/*
+Position of offending content in input (see tree.SourceSpan).
+*/
+type ApiSpan struct {
+	// Byte offset of span start
+	Offset int `json:"offset"`
+	// Byte offset of span end (exclusive)
+	EndOffset int `json:"endOffset"`
+	// Rune offset of span start
+	RuneOffset int `json:"runeOffset"`
+	// Rune offset of span end (exclusive)
+	RuneEndOffset int `json:"runeEndOffset"`
+	// Line of span start
+	Line int `json:"line"`
+	// Column of span start
+	Column int `json:"column"`
+	// Line of span end
+	EndLine int `json:"endLine"`
+	// Column of span end (exclusive)
+	EndColumn int `json:"endColumn"`
+	// Offending content
+	Text string `json:"text"`
+}
+
+// Error codes for invalid API requests (complementing the parsing error codes, see tree.PARSING_* constants)
+const API_ERROR_INVALID_REQUEST = "INVALID_REQUEST"
+const API_ERROR_METHOD_NOT_ALLOWED = "METHOD_NOT_ALLOWED"
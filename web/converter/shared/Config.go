@@ -1,13 +1,15 @@
 package shared
 
 import (
-	"IG-Parser/core/exporter/tabular"
+	"IG-Parser/core/tree"
 )
 
 /*
-Contains the default configuration for tabular output.
+Returns the default output options for the web frontend (to be adjusted based on user settings per request).
 */
-func SetDefaultConfig() {
-	tabular.SetIncludeSharedElementsInTabularOutput(true)
-	tabular.SetProduceIGExtendedOutput(false)
+func DefaultOptions() tree.Options {
+	opts := tree.DefaultOptions()
+	opts.IncludeSharedElementsInTabularOutput = true
+	opts.IGExtendedOutput = false
+	return opts
 }
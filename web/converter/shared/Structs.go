@@ -1,6 +1,9 @@
 package shared
 
-import "html/template"
+import (
+	"IG-Parser/core/statistics"
+	"html/template"
+)
 
 /*
 Struct for interacting with template via handler
@@ -12,6 +15,10 @@ type ReturnStruct struct {
 	Error bool
 	// Message shown to user
 	Message string
+	// Message introducing warnings
+	WarningsMessage string
+	// Violations of coding guidelines shown to user (see core/linter)
+	Warnings []string
 	// Override browser-saved values (if values are passed by URL parameters)
 	OverrideSavedStmts bool
 	// Original unparsed statement
@@ -34,6 +41,10 @@ type ReturnStruct struct {
 	IncludeAnnotations string
 	// Degree of Variability inclusion indicator
 	IncludeDoV string
+	// Statement type inclusion indicator
+	IncludeStatementType string
+	// Complexity measures inclusion indicator
+	IncludeComplexity string
 	// Include headers in output
 	IncludeHeaders string
 	// Include Original Statement in output (Value: 0 --> no inclusion, 1 --> only on first atomic statement, 2 --> on all atomic statements)
@@ -91,3 +102,21 @@ type ReturnStruct struct {
 	// Version ID output in frontend
 	Version string
 }
+
+/*
+Struct for interacting with statistics report template via handler
+*/
+type StatisticsReturnStruct struct {
+	// Indicates whether an error has occurred
+	Error bool
+	// Message shown to user
+	Message string
+	// IG Script-encoded statements (one per line)
+	CodedStmts string
+	// Help message for encoded statements
+	CodedStmtsHelp string
+	// Statistics report (nil if no statements have been analyzed)
+	Report *statistics.Report
+	// Version ID output in frontend
+	Version string
+}
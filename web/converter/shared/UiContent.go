@@ -161,6 +161,9 @@ const HELP_IG_SCRIPT_OUTPUT = "Indicates whether the IG Script-encoded statement
 // Help for output field
 const HELP_OUTPUT_TYPE = "The application currently supports two output types, either Google Sheets output, which can be directly copied into any Google sheet in your browser, or CSV format, which can be used for further processing in Excel or by scripts. Both output variants use the pipe symbol ('|') as delimiter/separator. Please click on the label to see additional considerations specific to Google Sheets output processing."
 
+// Help for encoded statements field of statistics report
+const HELP_CODED_STMTS = "This entry field holds the IG Script-encoded statements to be analyzed, with one statement per line. Lines can optionally be prefixed with a statement ID separated by a tab ('ID<TAB>IG Script', or 'ID<TAB>Original Statement<TAB>IG Script' as used by the command-line version of IG Parser); otherwise statements are identified by line number."
+
 // Help for report error field
 const HELP_REPORT = "Clicking on this link should open your mail client with a pre-populated mail." + LINEBREAK +
 	"Alternatively, right-click on the link, copy the e-mail address, and send a mail manually. Ensure to provide the Request ID in the subject line or body of your mail."
@@ -8,6 +8,9 @@ Lists constants used for direct interaction with users.
 const ERROR_INPUT_STATEMENT_ID = "Error: The Statement ID is missing. Please review corresponding field."
 const ERROR_INPUT_NO_STATEMENT = "Error: The 'Encoded Statement' field does not contain IG Script-encoded content."
 const ERROR_INPUT_IGNORED_ELEMENTS = "Error: Please review the 'Encoded Statement' for the following element(s) that could not be parsed: "
+const WARNING_LINT_VIOLATIONS = "Warning: The 'Encoded Statement' might not follow the IG 2.0 coding guidelines. Please review the following observations (or ignore them if your coding is intentional):"
+const WARNING_COVERAGE = "Warning: The 'Encoded Statement' might not fully cover the 'Original Statement'. Please review the following observations (or ignore them if your coding is intentional):"
+const WARNING_LINT_VIOLATIONS_AND_COVERAGE = "Warning: The 'Encoded Statement' might not follow the IG 2.0 coding guidelines or fully cover the 'Original Statement'. Please review the following observations (or ignore them if your coding is intentional):"
 const WARNING_INPUT_NON_PARSED_ELEMENTS = "Warning: The input text might have contained IG Script text fragments that have not been parsed (e.g., annotation parts, nested statements). If you believe the following text, or parts of it, should have been parsed, please review your coding accordingly (else ignore this message): "
 
 // Made the following ones variables to allow flexible concatenation of variables.
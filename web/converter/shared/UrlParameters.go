@@ -38,11 +38,17 @@ const PARAM_PRINT_IG_SCRIPT = "printIgScript"
 // Output type
 const PARAM_OUTPUT_TYPE = "outputType"
 
+// Complexity measures (Degree of Variability, nesting depth, nested statements, options and complexity per component)
+const PARAM_COMPLEXITY = "complexity"
+
 // SHARED AMONGST TABULAR AND VISUAL OUTPUT
 
 // Annotations
 const PARAM_LOGICO_OUTPUT = "annotations"
 
+// Statement type (regulative, constitutive, hybrid)
+const PARAM_STATEMENT_TYPE = "stmtType"
+
 // VISUAL ONLY
 
 // Properties as tree structure
@@ -63,6 +69,14 @@ const PARAM_WIDTH = "canvasWidth"
 // Canvas height for visual output
 const PARAM_HEIGHT = "canvasHeight"
 
+// STATISTICS ONLY
+
+// Encoded statements (one per line)
+const PARAM_CODED_STATEMENTS = "codedStmts"
+
+// Format of statistics report (HTML report page, or download as CSV or JSON)
+const PARAM_REPORT_FORMAT = "format"
+
 // CHECKBOX CONSTANTS
 
 // Checkbox constant as read from form input
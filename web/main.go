@@ -2,6 +2,7 @@ package main
 
 import (
 	"IG-Parser/core/config"
+	"IG-Parser/core/linter"
 	"IG-Parser/web/converter"
 	"IG-Parser/web/helper"
 	"embed"
@@ -17,10 +18,11 @@ This file is the main entry point for the IG Parser as a web application.
 It relies on the IG Parser core package functionality.
 */
 
-// Environment variables (port, logging activation)
+// Environment variables (port, logging activation, linter configuration)
 const ENV_VAR_PORT = "IG_PARSER_PORT"
 const ENV_VAR_LOGGING = "IG_PARSER_LOGGING"
 const ENV_VAR_LOGGING_PATH = "IG_PARSER_LOGGING_PATH"
+const ENV_VAR_LINT_CONFIG = "IG_PARSER_LINT_CONFIG"
 
 // Default values
 const DEFAULT_LOGGING_PATH = "./logs"
@@ -33,6 +35,8 @@ const SUPPRESS_CONSOLE_OUTPUT = false
 const TABULAR_PATH = "" // empty per default
 const VISUAL_PATH = "visual/"
 const HELP_PATH = "help/"
+const STATISTICS_PATH = "statistics/"
+const API_PATH = "api/v1/"
 
 // Embed external files in compiled binary filesystem
 
@@ -53,6 +57,17 @@ func main() {
 	// Initializes templating and determines correct relative path for templates and CSS
 	converter.Init()
 
+	// Check for linter configuration (default: all rules enabled), which is passed to handlers reporting violations of coding guidelines
+	lintConfig := linter.DefaultConfig()
+	lintConfigPath := os.Getenv(ENV_VAR_LINT_CONFIG)
+	if lintConfigPath != "" {
+		var err error
+		lintConfig, err = linter.LoadConfig(lintConfigPath)
+		if err != nil {
+			log.Fatal("Failed to load linter configuration. Error: ", err)
+		}
+	}
+
 	// Register static resources
 
 	// D3 & ACE libraries
@@ -63,11 +78,21 @@ func main() {
 	// Register handlers
 
 	// Conventional tabular output handler (path per default empty)
-	http.HandleFunc("/"+TABULAR_PATH, converter.ConverterHandlerTabular)
+	http.HandleFunc("/"+TABULAR_PATH, converter.ConverterHandlerTabular(lintConfig))
 	// Visual tree output handler
-	http.HandleFunc("/"+VISUAL_PATH, converter.ConverterHandlerVisual)
+	http.HandleFunc("/"+VISUAL_PATH, converter.ConverterHandlerVisual(lintConfig))
 	// Help handler
 	http.HandleFunc("/"+HELP_PATH, converter.HelpHandler)
+	// Corpus statistics handler
+	http.HandleFunc("/"+STATISTICS_PATH, converter.StatisticsHandler)
+	// JSON API handlers (tabular output, visual output, validation, reliability, statistics, query) and OpenAPI document
+	http.HandleFunc("/"+API_PATH+"tabular", converter.ApiHandlerTabular(lintConfig))
+	http.HandleFunc("/"+API_PATH+"visual", converter.ApiHandlerVisual(lintConfig))
+	http.HandleFunc("/"+API_PATH+"validate", converter.ApiHandlerValidate(lintConfig))
+	http.HandleFunc("/"+API_PATH+"reliability", converter.ApiHandlerReliability)
+	http.HandleFunc("/"+API_PATH+"statistics", converter.ApiHandlerStatistics)
+	http.HandleFunc("/"+API_PATH+"query", converter.ApiHandlerQuery)
+	http.HandleFunc("/"+API_PATH+"openapi.json", converter.ApiHandlerOpenAPI)
 
 	// Check for custom port
 	port := os.Getenv(ENV_VAR_PORT)
@@ -109,8 +134,15 @@ func main() {
 	log.Println(" - Website: https://newinstitutionalgrammar.org/ig-parser")
 	log.Println(" - Logging enabled: " + fmt.Sprint(converter.Logging))
 	log.Println(" - Logging path: " + fmt.Sprint(converter.LoggingPath))
+	if lintConfigPath != "" {
+		log.Println(" - Linter configuration: " + lintConfigPath)
+	} else {
+		log.Println(" - Linter configuration: default (all rules enabled)")
+	}
 	log.Printf("Navigate to the URL http://localhost%s/"+TABULAR_PATH+" in your browser to open the tabular output version of IG Parser.\n", portSuffix)
 	log.Printf("Navigate to the URL http://localhost%s/"+VISUAL_PATH+" in your browser to open the visual output version of IG Parser.\n", portSuffix)
+	log.Printf("Navigate to the URL http://localhost%s/"+STATISTICS_PATH+" in your browser to generate corpus statistics for encoded statements.\n", portSuffix)
+	log.Printf("The JSON API is described in the OpenAPI document at http://localhost%s/"+API_PATH+"openapi.json.\n", portSuffix)
 	// Attempt launch of URL in browser
 	err0 := helper.OpenBrowser("http://localhost" + portSuffix + "/" + VISUAL_PATH)
 	if err0 != nil {
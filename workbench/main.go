@@ -63,20 +63,21 @@ func mainWorkbench() {
 		" Cac{ Cac{A(precond2) I(dksjld)} [XOR] Cac{A(ldkjsjg) I(sdgjls)}} " +
 		"{ Cac{A(precond2) I(dksjld)} [XOR] Cac{A(ldkjsjg) I(sdgjls)}}"
 
-	tabular.SetIncludeSharedElementsInTabularOutput(true)
-	tabular.SetDynamicOutput(false)
+	opts := tree.DefaultOptions()
+	opts.IncludeSharedElementsInTabularOutput = true
+	opts.SetDynamicOutput(false)
 
 	fmt.Println("Shared mode:")
-	fmt.Println(tree.SHARED_ELEMENT_INHERITANCE_MODE)
+	fmt.Println(opts.SharedElementInheritanceMode)
 
-	tree.SetFlatPrinting(true)
+	opts.FlatPrinting = true
 
 	/*_, err := parser.ParseStatement(text)
 	if err.ErrorCode != tree.PARSING_NO_ERROR {
 		log.Fatal(err.Error())
 	}*/
 
-	endpoints.ConvertIGScriptToTabularOutput("", text, "1.1", tabular.OUTPUT_TYPE_CSV, "example.csv", true, true, tabular.ORIGINAL_STATEMENT_OUTPUT_NONE, tabular.IG_SCRIPT_OUTPUT_NONE)
+	endpoints.ConvertIGScriptToTabularOutput("", text, "1.1", tabular.OUTPUT_TYPE_CSV, "example.csv", true, opts, tabular.ORIGINAL_STATEMENT_OUTPUT_NONE, tabular.IG_SCRIPT_OUTPUT_NONE)
 
 	//fmt.Println(output)
 